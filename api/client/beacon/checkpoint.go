@@ -0,0 +1,112 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statev2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrCheckpointMismatch is returned when the block retrieved from the remote node does not
+// match the latest block header in the finalized state.
+var ErrCheckpointMismatch = errors.New("checkpoint block does not match finalized state")
+
+// OriginData represents the BeaconState and SignedBeaconBlock necessary to start an empty Beacon Node
+// using Checkpoint Sync. The marshaled values are kept so they can be handed to SaveOrigin as-is.
+type OriginData struct {
+	StateBytes []byte
+	BlockBytes []byte
+	State      state.BeaconStateAltair
+	Block      *ethpb.SignedBeaconBlockAltair
+	BlockRoot  [32]byte
+}
+
+// DownloadOriginData retrieves the finalized BeaconState from the remote beacon node, along with the
+// SignedBeaconBlock that the state's latest block header points at. Before returning, it checks that
+// the block and state belong together: the root of the block must match the root of the state's latest
+// block header. Since the header commits to the block's state root, this also covers the state root.
+func DownloadOriginData(ctx context.Context, client *Client) (*OriginData, error) {
+	stateVersion, sb, err := client.GetState(ctx, IdFinalized)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve finalized state from checkpoint sync host")
+	}
+	if err := ensureSupportedVersion(stateVersion); err != nil {
+		return nil, err
+	}
+	st, err := statev2.InitializeFromSSZBytes(sb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal finalized state from checkpoint sync host")
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute finalized state root")
+	}
+
+	// The state root in the latest block header is only filled in during the next slot's processing,
+	// so it will be zero if the finalized state is the post-state of the finalized block.
+	header := ethpb.CopyBeaconBlockHeader(st.LatestBlockHeader())
+	if bytesutil.ToBytes32(header.StateRoot) == [32]byte{} {
+		header.StateRoot = stateRoot[:]
+	}
+	expectedRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute root of latest block header in finalized state")
+	}
+
+	blockVersion, bb, err := client.GetBlock(ctx, IdFromRoot(expectedRoot))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve block %#x from checkpoint sync host", expectedRoot)
+	}
+	if err := ensureSupportedVersion(blockVersion); err != nil {
+		return nil, err
+	}
+	blk := &ethpb.SignedBeaconBlockAltair{}
+	if err := blk.UnmarshalSSZ(bb); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint block from checkpoint sync host")
+	}
+	if err := verifyCheckpoint(blk, expectedRoot); err != nil {
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"blockRoot": fmt.Sprintf("%#x", expectedRoot),
+		"stateRoot": fmt.Sprintf("%#x", stateRoot),
+		"slot":      blk.Block.Slot,
+	}).Info("Downloaded checkpoint sync origin state and block")
+	return &OriginData{
+		StateBytes: sb,
+		BlockBytes: bb,
+		State:      st,
+		Block:      blk,
+		BlockRoot:  expectedRoot,
+	}, nil
+}
+
+func verifyCheckpoint(blk *ethpb.SignedBeaconBlockAltair, expectedRoot [32]byte) error {
+	if blk == nil || blk.Block == nil {
+		return errors.Wrap(ErrCheckpointMismatch, "nil block")
+	}
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute checkpoint block root")
+	}
+	if blockRoot != expectedRoot {
+		return errors.Wrapf(ErrCheckpointMismatch, "block root %#x != latest block header root %#x", blockRoot, expectedRoot)
+	}
+	return nil
+}
+
+// SaveOrigin can currently only decode altair states and blocks, so we refuse anything else up front
+// rather than failing halfway through writing to the db.
+func ensureSupportedVersion(v string) error {
+	if v != "" && v != version.String(version.Altair) {
+		return fmt.Errorf("checkpoint sync does not support fork version %s, only %s is supported", v, version.String(version.Altair))
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package beacon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// testCheckpoint builds a finalized altair state along with the block that state is the post-state of,
+// in the same shape a beacon node would serve them for the "finalized" state id.
+func testCheckpoint(t *testing.T) (state.BeaconStateAltair, *ethpb.SignedBeaconBlockAltair) {
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(64))
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.Slot = 64
+	blk.Block.ParentRoot = bytes32(0x01)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:          blk.Block.Slot,
+		ProposerIndex: blk.Block.ProposerIndex,
+		ParentRoot:    blk.Block.ParentRoot,
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	return st, blk
+}
+
+func bytes32(b byte) []byte {
+	r := make([]byte, 32)
+	r[0] = b
+	return r
+}
+
+// testCheckpointServer serves the given ssz fixtures the way the beacon node api would.
+func testCheckpointServer(t *testing.T, stateVersion string, sb []byte, blockId StateOrBlockId, bb []byte) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v2/debug/beacon/states/finalized", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(versionHeader, stateVersion)
+		_, err := w.Write(sb)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v2/beacon/blocks/"+string(blockId), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(versionHeader, "altair")
+		_, err := w.Write(bb)
+		require.NoError(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestDownloadOriginData(t *testing.T) {
+	st, blk := testCheckpoint(t)
+	sb, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	bb, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	srv := testCheckpointServer(t, "altair", sb, IdFromRoot(root), bb)
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	od, err := DownloadOriginData(context.Background(), c)
+	require.NoError(t, err)
+	require.Equal(t, root, od.BlockRoot)
+	require.DeepEqual(t, sb, od.StateBytes)
+	require.DeepEqual(t, bb, od.BlockBytes)
+	require.Equal(t, st.Slot(), od.State.Slot())
+}
+
+func TestDownloadOriginData_Mismatch(t *testing.T) {
+	st, blk := testCheckpoint(t)
+	sb, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	// Serve a block with a different state root under the expected block root.
+	blk.Block.StateRoot = bytes32(0x02)
+	bb, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	srv := testCheckpointServer(t, "altair", sb, IdFromRoot(root), bb)
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = DownloadOriginData(context.Background(), c)
+	require.Equal(t, true, errors.Is(err, ErrCheckpointMismatch))
+}
+
+func TestDownloadOriginData_UnsupportedVersion(t *testing.T) {
+	srv := testCheckpointServer(t, "phase0", []byte{}, IdHead, []byte{})
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = DownloadOriginData(context.Background(), c)
+	require.ErrorContains(t, "does not support fork version phase0", err)
+}
+
+func TestDownloadOriginData_StateAfterBlockSlot(t *testing.T) {
+	st, blk := testCheckpoint(t)
+	// Process the empty slots following the block, as for a finalized state at an epoch boundary
+	// with skipped slots: the header's state root is filled in and the state root changes.
+	header := st.LatestBlockHeader()
+	header.StateRoot = blk.Block.StateRoot
+	require.NoError(t, st.SetLatestBlockHeader(header))
+	require.NoError(t, st.SetSlot(blk.Block.Slot+3))
+	sb, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	bb, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	srv := testCheckpointServer(t, "altair", sb, IdFromRoot(root), bb)
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	od, err := DownloadOriginData(context.Background(), c)
+	require.NoError(t, err)
+	require.Equal(t, root, od.BlockRoot)
+	require.Equal(t, blk.Block.Slot+3, od.State.Slot())
+}
+
+func TestVerifyCheckpoint(t *testing.T) {
+	_, blk := testCheckpoint(t)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, verifyCheckpoint(blk, root))
+	require.Equal(t, true, errors.Is(verifyCheckpoint(blk, [32]byte{0x03}), ErrCheckpointMismatch))
+	require.Equal(t, true, errors.Is(verifyCheckpoint(nil, root), ErrCheckpointMismatch))
+}
@@ -0,0 +1,188 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/api/client"
+)
+
+const (
+	getStatePath = "/eth/v2/debug/beacon/states"
+	getBlockPath = "/eth/v2/beacon/blocks"
+
+	// versionHeader is set by the beacon node api to the lowercase name of the fork
+	// of an ssz-encoded state or block response, ie "altair".
+	versionHeader = "Eth-Consensus-Version"
+)
+
+// ErrNotOK is returned (possibly wrapped) when the beacon node api responds with a non-2xx status code.
+var ErrNotOK = client.ErrNotOK
+
+// ErrNotFound is returned (possibly wrapped) when the requested state or block does not exist on the remote node.
+var ErrNotFound = client.ErrNotFound
+
+// ErrUnavailable is returned (possibly wrapped) when the beacon node api responds with a 503 status code, for
+// instance while the node is syncing.
+var ErrUnavailable = client.ErrUnavailable
+
+// StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
+// StateOrBlockId supports the following values:
+// - "head" (canonical head in node's view)
+// - "genesis"
+// - "finalized"
+// - <slot>
+// - <hex encoded state or block root with 0x prefix>
+type StateOrBlockId string
+
+const (
+	IdGenesis   StateOrBlockId = "genesis"
+	IdHead      StateOrBlockId = "head"
+	IdFinalized StateOrBlockId = "finalized"
+)
+
+// IdFromRoot encodes a block root in the format expected by the API in places where a root can be used to identify
+// a BeaconState or SignedBeaconBlock.
+func IdFromRoot(r [32]byte) StateOrBlockId {
+	return StateOrBlockId(fmt.Sprintf("%#x", r))
+}
+
+// IdFromSlot encodes a Slot in the format expected by the API in places where a slot can be used to identify
+// a BeaconState or SignedBeaconBlock.
+func IdFromSlot(s types.Slot) StateOrBlockId {
+	return StateOrBlockId(strconv.FormatUint(uint64(s), 10))
+}
+
+// ClientOpt is a functional option for the Client type (http.Client wrapper)
+type ClientOpt func(*Client)
+
+// WithTimeout sets the .Timeout attribute of the wrapped http.Client.
+func WithTimeout(timeout time.Duration) ClientOpt {
+	return func(c *Client) {
+		c.hc.Timeout = timeout
+	}
+}
+
+// Client provides a collection of helper methods for calling the Eth Beacon Node API endpoints.
+type Client struct {
+	hc      *http.Client
+	baseURL *url.URL
+}
+
+// NewClient constructs a new client with the provided options (ex WithTimeout).
+// `host` is the base host + port used to construct request urls. This value can be
+// a URL string, or NewClient will assume an http endpoint if just `host:port` is used.
+func NewClient(host string, opts ...ClientOpt) (*Client, error) {
+	u, err := client.URLForHost(host)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		hc:      &http.Client{},
+		baseURL: u,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c, nil
+}
+
+// GetState retrieves the ssz-encoded BeaconState identified by the given StateOrBlockId, along with the
+// name of the fork the state belongs to, as reported by the Eth-Consensus-Version response header.
+func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) (version string, marshaled []byte, err error) {
+	return c.getSSZ(ctx, path.Join(getStatePath, string(stateId)))
+}
+
+// GetBlock retrieves the ssz-encoded SignedBeaconBlock identified by the given StateOrBlockId, along with the
+// name of the fork the block belongs to, as reported by the Eth-Consensus-Version response header.
+func (c *Client) GetBlock(ctx context.Context, blockId StateOrBlockId) (version string, marshaled []byte, err error) {
+	return c.getSSZ(ctx, path.Join(getBlockPath, string(blockId)))
+}
+
+func (c *Client) getSSZ(ctx context.Context, p string) (string, []byte, error) {
+	u := c.baseURL.ResolveReference(&url.URL{Path: p})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "request to %s failed", u.String())
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, client.Non200Err(resp)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error reading http response body from %s", u.String())
+	}
+	return strings.ToLower(resp.Header.Get(versionHeader)), b, nil
+}
+
+// GetJSON sends a GET request to the given endpoint of the beacon node api, which may include a query string,
+// and decodes the JSON response body into resp.
+func (c *Client) GetJSON(ctx context.Context, endpoint string, resp interface{}) error {
+	return c.doJSON(ctx, http.MethodGet, endpoint, nil, nil, resp)
+}
+
+// PostJSON sends a POST request with the given JSON body and additional headers to the given endpoint of the
+// beacon node api. If resp is not nil, the JSON response body is decoded into it.
+func (c *Client) PostJSON(ctx context.Context, endpoint string, headers map[string]string, body []byte, resp interface{}) error {
+	return c.doJSON(ctx, http.MethodPost, endpoint, headers, bytes.NewReader(body), resp)
+}
+
+func (c *Client) doJSON(ctx context.Context, method, endpoint string, headers map[string]string, body io.Reader, resp interface{}) error {
+	ref, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.Wrapf(err, "invalid endpoint %s", endpoint)
+	}
+	u := c.baseURL.ResolveReference(ref)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	r, err := c.hc.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "request to %s failed", u.String())
+	}
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if r.StatusCode < 200 || r.StatusCode >= 300 {
+		return client.Non200Err(r)
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(resp); err != nil {
+		return errors.Wrapf(err, "could not decode response from %s", u.String())
+	}
+	return nil
+}
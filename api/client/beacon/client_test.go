@@ -0,0 +1,135 @@
+package beacon
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestNewClient(t *testing.T) {
+	cases := []struct {
+		host   string
+		expect string
+		err    bool
+	}{
+		{host: "http://localhost:3500", expect: "http://localhost:3500"},
+		{host: "https://node.example.com", expect: "https://node.example.com"},
+		{host: "localhost:3500", expect: "http://localhost:3500"},
+		{host: "127.0.0.1:3500", expect: "http://127.0.0.1:3500"},
+		{host: "localhost", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.host, func(t *testing.T) {
+			cl, err := NewClient(c.host)
+			if c.err {
+				require.NotNil(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expect, cl.baseURL.String())
+		})
+	}
+}
+
+func TestIdFromRoot(t *testing.T) {
+	r := [32]byte{0xab}
+	require.Equal(t, StateOrBlockId("0xab00000000000000000000000000000000000000000000000000000000000000"), IdFromRoot(r))
+	require.Equal(t, StateOrBlockId("42"), IdFromSlot(42))
+}
+
+func TestClient_GetState(t *testing.T) {
+	expected := []byte{1, 2, 3}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v2/debug/beacon/states/finalized", r.URL.Path)
+		require.Equal(t, "application/octet-stream", r.Header.Get("Accept"))
+		w.Header().Set(versionHeader, "ALTAIR")
+		_, err := w.Write(expected)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	v, b, err := c.GetState(context.Background(), IdFinalized)
+	require.NoError(t, err)
+	require.Equal(t, "altair", v)
+	require.DeepEqual(t, expected, b)
+}
+
+func TestClient_GetBlock_NotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v2/beacon/blocks/10", r.URL.Path)
+		http.Error(w, "block not found", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	_, _, err = c.GetBlock(context.Background(), IdFromSlot(10))
+	require.Equal(t, true, errors.Is(err, ErrNotFound))
+	require.Equal(t, true, errors.Is(err, ErrNotOK))
+}
+
+func TestClient_GetBlock_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "oops", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	_, _, err = c.GetBlock(context.Background(), IdHead)
+	require.Equal(t, true, errors.Is(err, ErrNotOK))
+	require.Equal(t, false, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_GetJSON(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/validator/attestation_data", r.URL.Path)
+		require.Equal(t, "5", r.URL.Query().Get("slot"))
+		require.Equal(t, "application/json", r.Header.Get("Accept"))
+		if r.URL.Query().Get("committee_index") != "1" {
+			http.Error(w, `{"code":400,"message":"invalid committee index"}`, http.StatusBadRequest)
+			return
+		}
+		_, err := w.Write([]byte(`{"data":{"slot":"5"}}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	resp := &struct {
+		Data struct {
+			Slot string `json:"slot"`
+		} `json:"data"`
+	}{}
+	require.NoError(t, c.GetJSON(context.Background(), "/eth/v1/validator/attestation_data?slot=5&committee_index=1", resp))
+	require.Equal(t, "5", resp.Data.Slot)
+
+	err = c.GetJSON(context.Background(), "/eth/v1/validator/attestation_data?slot=5&committee_index=2", resp)
+	require.ErrorContains(t, "invalid committee index", err)
+	require.Equal(t, true, errors.Is(err, ErrNotOK))
+}
+
+func TestClient_PostJSON(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "altair", r.Header.Get("Eth-Consensus-Version"))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, `["1"]`, string(b))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	headers := map[string]string{"Eth-Consensus-Version": "altair"}
+	require.NoError(t, c.PostJSON(context.Background(), "/eth/v1/beacon/blocks", headers, []byte(`["1"]`), nil))
+}
@@ -0,0 +1,5 @@
+/*
+Package beacon provides a client for the standard Eth Beacon Node API, used by the beacon node to retrieve
+ssz-encoded states and blocks from another (trusted) beacon node, for instance to start up using checkpoint sync.
+*/
+package beacon
@@ -0,0 +1,185 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/api/client"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+const (
+	getExecHeaderPath          = "/eth/v1/builder/header"
+	getStatusPath              = "/eth/v1/builder/status"
+	postBlindedBeaconBlockPath = "/eth/v1/builder/blinded_blocks"
+	postRegisterValidatorPath  = "/eth/v1/builder/validators"
+)
+
+// ErrNotOK is returned (possibly wrapped) when the builder api responds with a non-2xx status code.
+var ErrNotOK = client.ErrNotOK
+
+// ErrNoContent is returned when the builder has no bid for the requested slot and parent hash.
+var ErrNoContent = errors.New("recv 204 no content response from API, no bid available")
+
+// BuilderClient describes the methods of the builder API used by the beacon node.
+type BuilderClient interface {
+	NodeURL() string
+	GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubkey [48]byte) (*ethpb.SignedBuilderBid, error)
+	RegisterValidator(ctx context.Context, svr []*ethpb.SignedValidatorRegistrationV1) error
+	SubmitBlindedBlock(ctx context.Context, sb *ethpb.SignedBlindedBeaconBlockBellatrix) (*enginev1.ExecutionPayload, error)
+	Status(ctx context.Context) error
+}
+
+// ClientOpt is a functional option for the Client type (http.Client wrapper)
+type ClientOpt func(*Client)
+
+// WithTimeout sets the .Timeout attribute of the wrapped http.Client.
+func WithTimeout(timeout time.Duration) ClientOpt {
+	return func(c *Client) {
+		c.hc.Timeout = timeout
+	}
+}
+
+// Client provides a collection of helper methods for calling the builder API endpoints.
+type Client struct {
+	hc      *http.Client
+	baseURL *url.URL
+}
+
+var _ = BuilderClient(&Client{})
+
+// NewClient constructs a new client with the provided options (ex WithTimeout).
+// `host` is the base host + port used to construct request urls. This value can be
+// a URL string, or NewClient will assume an http endpoint if just `host:port` is used.
+func NewClient(host string, opts ...ClientOpt) (*Client, error) {
+	u, err := client.URLForHost(host)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		hc:      &http.Client{},
+		baseURL: u,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c, nil
+}
+
+// NodeURL returns a human-readable string representation of the builder URL.
+func (c *Client) NodeURL() string {
+	return c.baseURL.String()
+}
+
+// GetHeader is used by a proposer to request the execution payload header of the builder's best bid
+// for the given slot, on top of the given execution parent hash.
+func (c *Client) GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubkey [48]byte) (*ethpb.SignedBuilderBid, error) {
+	p := fmt.Sprintf("%s/%s/%#x/%#x", getExecHeaderPath, strconv.FormatUint(uint64(slot), 10), parentHash, pubkey)
+	b, err := c.do(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, ErrNoContent
+	}
+	hr := &ExecHeaderResponse{}
+	if err := json.Unmarshal(b, hr); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling the builder getHeader response")
+	}
+	if hr.Data == nil {
+		return nil, errors.New("builder getHeader response is missing data")
+	}
+	return hr.Data.ToProto()
+}
+
+// RegisterValidator registers the fee recipients and gas limits of the given validators with the builder.
+func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValidatorRegistrationV1) error {
+	vs := make([]*SignedValidatorRegistration, len(svr))
+	for i, r := range svr {
+		if r == nil || r.Message == nil {
+			return errors.New("nil validator registration")
+		}
+		vs[i] = SignedValidatorRegistrationFromProto(r)
+	}
+	body, err := json.Marshal(vs)
+	if err != nil {
+		return errors.Wrap(err, "error encoding the SignedValidatorRegistration value body")
+	}
+	_, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
+	return err
+}
+
+// SubmitBlindedBlock submits a signed blinded block to the builder, which reveals the full execution
+// payload matching the header in the block.
+func (c *Client) SubmitBlindedBlock(ctx context.Context, sb *ethpb.SignedBlindedBeaconBlockBellatrix) (*enginev1.ExecutionPayload, error) {
+	v, err := SignedBlindedBeaconBlockBellatrixFromProto(sb)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding the SignedBlindedBeaconBlockBellatrix value body")
+	}
+	b, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "error posting the SignedBlindedBeaconBlockBellatrix to the builder api")
+	}
+	ep := &ExecPayloadResponse{}
+	if err := json.Unmarshal(b, ep); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling the builder submitBlindedBlock response")
+	}
+	if ep.Data == nil {
+		return nil, errors.New("builder submitBlindedBlock response is missing data")
+	}
+	return ep.Data.ToProto(), nil
+}
+
+// Status asks the builder whether it is currently able to produce bids. A nil error means the builder
+// is healthy.
+func (c *Client) Status(ctx context.Context) error {
+	_, err := c.do(ctx, http.MethodGet, getStatusPath, nil)
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method string, p string, body io.Reader) ([]byte, error) {
+	u := c.baseURL.ResolveReference(&url.URL{Path: p})
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "request to %s failed", u.String())
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, client.Non200Err(resp)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading http response body from %s", u.String())
+	}
+	return b, nil
+}
@@ -0,0 +1,185 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestNewClient(t *testing.T) {
+	cases := []struct {
+		host   string
+		expect string
+		err    bool
+	}{
+		{host: "http://localhost:18550", expect: "http://localhost:18550"},
+		{host: "https://relay.example.com", expect: "https://relay.example.com"},
+		{host: "localhost:18550", expect: "http://localhost:18550"},
+		{host: "localhost", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.host, func(t *testing.T) {
+			cl, err := NewClient(c.host)
+			if c.err {
+				require.NotNil(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expect, cl.NodeURL())
+		})
+	}
+}
+
+func TestUint256_JSON(t *testing.T) {
+	v := Uint256(bytesutil.PadTo([]byte{0x01, 0x02}, 32))
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.Equal(t, `"513"`, string(b))
+
+	var got Uint256
+	require.NoError(t, json.Unmarshal(b, &got))
+	require.DeepEqual(t, v, got)
+
+	require.ErrorContains(t, "could not parse", json.Unmarshal([]byte(`"-1"`), &got))
+	require.ErrorContains(t, "could not parse", json.Unmarshal([]byte(`"0x01"`), &got))
+}
+
+func TestClient_Status(t *testing.T) {
+	healthy := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getStatusPath, r.URL.Path)
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, c.Status(context.Background()))
+	healthy = false
+	err = c.Status(context.Background())
+	require.Equal(t, true, errors.Is(err, ErrNotOK))
+}
+
+func TestClient_RegisterValidator(t *testing.T) {
+	reg := &ethpb.SignedValidatorRegistrationV1{
+		Message: &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: bytesutil.PadTo([]byte{0x01}, 20),
+			GasLimit:     30000000,
+			Timestamp:    1650000000,
+			Pubkey:       bytesutil.PadTo([]byte{0x02}, 48),
+		},
+		Signature: bytesutil.PadTo([]byte{0x03}, 96),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, postRegisterValidatorPath, r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var regs []map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &regs))
+		require.Equal(t, 1, len(regs))
+		msg, ok := regs[0]["message"].(map[string]interface{})
+		require.Equal(t, true, ok)
+		require.Equal(t, "30000000", msg["gas_limit"])
+		require.Equal(t, "1650000000", msg["timestamp"])
+		require.Equal(t, fmt.Sprintf("%#x", reg.Message.FeeRecipient), msg["fee_recipient"])
+		require.Equal(t, fmt.Sprintf("%#x", reg.Message.Pubkey), msg["pubkey"])
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, c.RegisterValidator(context.Background(), []*ethpb.SignedValidatorRegistrationV1{reg}))
+}
+
+func TestClient_GetHeader(t *testing.T) {
+	slot := types.Slot(23)
+	parentHash := [32]byte{0xaa}
+	pubkey := [48]byte{0xbb}
+	header := util.HydrateBlindedBeaconBlockBodyBellatrix(nil).ExecutionPayloadHeader
+	header.BlockNumber = 10
+	header.BaseFeePerGas = bytesutil.PadTo([]byte{0x07}, 32)
+	bid := &SignedBuilderBid{
+		Message: &BuilderBid{
+			Header: ExecutionPayloadHeaderFromProto(header),
+			Value:  bytesutil.PadTo([]byte{0x05}, 32),
+			Pubkey: pubkey[:],
+		},
+		Signature: make([]byte, 96),
+	}
+	noBid := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("%s/23/%#x/%#x", getExecHeaderPath, parentHash, pubkey), r.URL.Path)
+		if noBid {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(&ExecHeaderResponse{Version: "bellatrix", Data: bid}))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	got, err := c.GetHeader(context.Background(), slot, parentHash, pubkey)
+	require.NoError(t, err)
+	require.DeepEqual(t, header, got.Message.Header)
+	require.DeepEqual(t, []byte(bid.Message.Value), got.Message.Value)
+	require.DeepEqual(t, pubkey[:], got.Message.Pubkey)
+
+	noBid = true
+	_, err = c.GetHeader(context.Background(), slot, parentHash, pubkey)
+	require.ErrorIs(t, err, ErrNoContent)
+}
+
+func TestClient_SubmitBlindedBlock(t *testing.T) {
+	sb := util.HydrateSignedBlindedBeaconBlockBellatrix(&ethpb.SignedBlindedBeaconBlockBellatrix{})
+	sb.Block.Slot = 5
+	sb.Block.Body.Attestations = []*ethpb.Attestation{util.HydrateAttestation(&ethpb.Attestation{})}
+	payload := &enginev1.ExecutionPayload{
+		ParentHash:    make([]byte, 32),
+		FeeRecipient:  make([]byte, 20),
+		StateRoot:     make([]byte, 32),
+		ReceiptsRoot:  make([]byte, 32),
+		LogsBloom:     make([]byte, 256),
+		PrevRandao:    make([]byte, 32),
+		BlockNumber:   3,
+		ExtraData:     []byte{},
+		BaseFeePerGas: make([]byte, 32),
+		BlockHash:     bytesutil.PadTo([]byte{0x09}, 32),
+		Transactions:  [][]byte{{0x01, 0x02}},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+		require.Equal(t, http.MethodPost, r.Method)
+		got := &SignedBlindedBeaconBlockBellatrix{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+		require.Equal(t, Uint64String(5), got.Message.Slot)
+		require.Equal(t, 1, len(got.Message.Body.Attestations))
+		require.DeepEqual(t, sb.Block.Body.ExecutionPayloadHeader, got.Message.Body.ExecutionPayloadHeader.ToProto())
+		require.NoError(t, json.NewEncoder(w).Encode(&ExecPayloadResponse{Version: "bellatrix", Data: ExecutionPayloadFromProto(payload)}))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	got, err := c.SubmitBlindedBlock(context.Background(), sb)
+	require.NoError(t, err)
+	require.DeepEqual(t, payload, got)
+
+	_, err = c.SubmitBlindedBlock(context.Background(), nil)
+	require.ErrorContains(t, "nil blinded block", err)
+}
@@ -0,0 +1,6 @@
+/*
+Package builder provides a client for the external block builder API, used by the beacon node to register
+validators with a builder (or MEV relay), to request execution payload headers for blocks it proposes and to
+reveal the full execution payload of a signed blinded block.
+*/
+package builder
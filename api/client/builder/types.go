@@ -0,0 +1,520 @@
+package builder
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// Uint64String is a uint64 which is encoded as a decimal string in json, as required by the builder API.
+type Uint64String uint64
+
+// MarshalText --
+func (s Uint64String) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(s), 10)), nil
+}
+
+// UnmarshalText --
+func (s *Uint64String) UnmarshalText(t []byte) error {
+	u, err := strconv.ParseUint(string(t), 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "could not parse %q as uint64", string(t))
+	}
+	*s = Uint64String(u)
+	return nil
+}
+
+// Uint256 holds a 32 byte little endian value, as used for ssz encoded uint256 fields. It is encoded
+// as a decimal string in json.
+type Uint256 []byte
+
+// MarshalText --
+func (u Uint256) MarshalText() ([]byte, error) {
+	return []byte(new(big.Int).SetBytes(bytesutil.ReverseByteOrder(u)).String()), nil
+}
+
+// UnmarshalText --
+func (u *Uint256) UnmarshalText(t []byte) error {
+	b, ok := new(big.Int).SetString(string(t), 10)
+	if !ok || b.Sign() < 0 || b.BitLen() > 256 {
+		return errors.Errorf("could not parse %q as uint256", string(t))
+	}
+	buf := make([]byte, 32)
+	b.FillBytes(buf)
+	*u = bytesutil.ReverseByteOrder(buf)
+	return nil
+}
+
+// ValidatorRegistration is the json representation of ethpb.ValidatorRegistrationV1.
+type ValidatorRegistration struct {
+	FeeRecipient hexutil.Bytes `json:"fee_recipient"`
+	GasLimit     Uint64String  `json:"gas_limit"`
+	Timestamp    Uint64String  `json:"timestamp"`
+	Pubkey       hexutil.Bytes `json:"pubkey"`
+}
+
+// SignedValidatorRegistration is the json representation of ethpb.SignedValidatorRegistrationV1.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature hexutil.Bytes          `json:"signature"`
+}
+
+// SignedValidatorRegistrationFromProto converts a signed validator registration into its json representation.
+func SignedValidatorRegistrationFromProto(r *ethpb.SignedValidatorRegistrationV1) *SignedValidatorRegistration {
+	return &SignedValidatorRegistration{
+		Message: &ValidatorRegistration{
+			FeeRecipient: r.Message.FeeRecipient,
+			GasLimit:     Uint64String(r.Message.GasLimit),
+			Timestamp:    Uint64String(r.Message.Timestamp),
+			Pubkey:       r.Message.Pubkey,
+		},
+		Signature: r.Signature,
+	}
+}
+
+// ToProto converts the json representation back into a signed validator registration.
+func (r *SignedValidatorRegistration) ToProto() (*ethpb.SignedValidatorRegistrationV1, error) {
+	if r.Message == nil {
+		return nil, errors.New("missing registration message")
+	}
+	return &ethpb.SignedValidatorRegistrationV1{
+		Message: &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: r.Message.FeeRecipient,
+			GasLimit:     uint64(r.Message.GasLimit),
+			Timestamp:    uint64(r.Message.Timestamp),
+			Pubkey:       r.Message.Pubkey,
+		},
+		Signature: r.Signature,
+	}, nil
+}
+
+// ExecutionPayloadHeader is the json representation of ethpb.ExecutionPayloadHeader.
+type ExecutionPayloadHeader struct {
+	ParentHash       hexutil.Bytes `json:"parent_hash"`
+	FeeRecipient     hexutil.Bytes `json:"fee_recipient"`
+	StateRoot        hexutil.Bytes `json:"state_root"`
+	ReceiptsRoot     hexutil.Bytes `json:"receipts_root"`
+	LogsBloom        hexutil.Bytes `json:"logs_bloom"`
+	PrevRandao       hexutil.Bytes `json:"prev_randao"`
+	BlockNumber      Uint64String  `json:"block_number"`
+	GasLimit         Uint64String  `json:"gas_limit"`
+	GasUsed          Uint64String  `json:"gas_used"`
+	Timestamp        Uint64String  `json:"timestamp"`
+	ExtraData        hexutil.Bytes `json:"extra_data"`
+	BaseFeePerGas    Uint256       `json:"base_fee_per_gas"`
+	BlockHash        hexutil.Bytes `json:"block_hash"`
+	TransactionsRoot hexutil.Bytes `json:"transactions_root"`
+}
+
+// ExecutionPayloadHeaderFromProto converts an execution payload header into its json representation.
+func ExecutionPayloadHeaderFromProto(h *ethpb.ExecutionPayloadHeader) *ExecutionPayloadHeader {
+	return &ExecutionPayloadHeader{
+		ParentHash:       h.ParentHash,
+		FeeRecipient:     h.FeeRecipient,
+		StateRoot:        h.StateRoot,
+		ReceiptsRoot:     h.ReceiptRoot,
+		LogsBloom:        h.LogsBloom,
+		PrevRandao:       h.PrevRandao,
+		BlockNumber:      Uint64String(h.BlockNumber),
+		GasLimit:         Uint64String(h.GasLimit),
+		GasUsed:          Uint64String(h.GasUsed),
+		Timestamp:        Uint64String(h.Timestamp),
+		ExtraData:        h.ExtraData,
+		BaseFeePerGas:    h.BaseFeePerGas,
+		BlockHash:        h.BlockHash,
+		TransactionsRoot: h.TransactionsRoot,
+	}
+}
+
+// ToProto converts the json representation back into an execution payload header.
+func (h *ExecutionPayloadHeader) ToProto() *ethpb.ExecutionPayloadHeader {
+	return &ethpb.ExecutionPayloadHeader{
+		ParentHash:       h.ParentHash,
+		FeeRecipient:     h.FeeRecipient,
+		StateRoot:        h.StateRoot,
+		ReceiptRoot:      h.ReceiptsRoot,
+		LogsBloom:        h.LogsBloom,
+		PrevRandao:       h.PrevRandao,
+		BlockNumber:      uint64(h.BlockNumber),
+		GasLimit:         uint64(h.GasLimit),
+		GasUsed:          uint64(h.GasUsed),
+		Timestamp:        uint64(h.Timestamp),
+		ExtraData:        h.ExtraData,
+		BaseFeePerGas:    h.BaseFeePerGas,
+		BlockHash:        h.BlockHash,
+		TransactionsRoot: h.TransactionsRoot,
+	}
+}
+
+// BuilderBid is the json representation of ethpb.BuilderBid.
+type BuilderBid struct {
+	Header *ExecutionPayloadHeader `json:"header"`
+	Value  Uint256                 `json:"value"`
+	Pubkey hexutil.Bytes           `json:"pubkey"`
+}
+
+// SignedBuilderBid is the json representation of ethpb.SignedBuilderBid.
+type SignedBuilderBid struct {
+	Message   *BuilderBid   `json:"message"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// ToProto converts the json representation of a signed builder bid into its protobuf form.
+func (b *SignedBuilderBid) ToProto() (*ethpb.SignedBuilderBid, error) {
+	if b.Message == nil || b.Message.Header == nil {
+		return nil, errors.New("missing builder bid message")
+	}
+	return &ethpb.SignedBuilderBid{
+		Message: &ethpb.BuilderBid{
+			Header: b.Message.Header.ToProto(),
+			Value:  b.Message.Value,
+			Pubkey: b.Message.Pubkey,
+		},
+		Signature: b.Signature,
+	}, nil
+}
+
+// ExecHeaderResponse is the response body of the builder API getHeader endpoint.
+type ExecHeaderResponse struct {
+	Version string            `json:"version"`
+	Data    *SignedBuilderBid `json:"data"`
+}
+
+// ExecutionPayload is the json representation of enginev1.ExecutionPayload.
+type ExecutionPayload struct {
+	ParentHash    hexutil.Bytes   `json:"parent_hash"`
+	FeeRecipient  hexutil.Bytes   `json:"fee_recipient"`
+	StateRoot     hexutil.Bytes   `json:"state_root"`
+	ReceiptsRoot  hexutil.Bytes   `json:"receipts_root"`
+	LogsBloom     hexutil.Bytes   `json:"logs_bloom"`
+	PrevRandao    hexutil.Bytes   `json:"prev_randao"`
+	BlockNumber   Uint64String    `json:"block_number"`
+	GasLimit      Uint64String    `json:"gas_limit"`
+	GasUsed       Uint64String    `json:"gas_used"`
+	Timestamp     Uint64String    `json:"timestamp"`
+	ExtraData     hexutil.Bytes   `json:"extra_data"`
+	BaseFeePerGas Uint256         `json:"base_fee_per_gas"`
+	BlockHash     hexutil.Bytes   `json:"block_hash"`
+	Transactions  []hexutil.Bytes `json:"transactions"`
+}
+
+// ExecutionPayloadFromProto converts an execution payload into its json representation.
+func ExecutionPayloadFromProto(p *enginev1.ExecutionPayload) *ExecutionPayload {
+	txs := make([]hexutil.Bytes, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		txs[i] = tx
+	}
+	return &ExecutionPayload{
+		ParentHash:    p.ParentHash,
+		FeeRecipient:  p.FeeRecipient,
+		StateRoot:     p.StateRoot,
+		ReceiptsRoot:  p.ReceiptsRoot,
+		LogsBloom:     p.LogsBloom,
+		PrevRandao:    p.PrevRandao,
+		BlockNumber:   Uint64String(p.BlockNumber),
+		GasLimit:      Uint64String(p.GasLimit),
+		GasUsed:       Uint64String(p.GasUsed),
+		Timestamp:     Uint64String(p.Timestamp),
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: p.BaseFeePerGas,
+		BlockHash:     p.BlockHash,
+		Transactions:  txs,
+	}
+}
+
+// ToProto converts the json representation back into an execution payload.
+func (p *ExecutionPayload) ToProto() *enginev1.ExecutionPayload {
+	txs := make([][]byte, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		txs[i] = tx
+	}
+	return &enginev1.ExecutionPayload{
+		ParentHash:    p.ParentHash,
+		FeeRecipient:  p.FeeRecipient,
+		StateRoot:     p.StateRoot,
+		ReceiptsRoot:  p.ReceiptsRoot,
+		LogsBloom:     p.LogsBloom,
+		PrevRandao:    p.PrevRandao,
+		BlockNumber:   uint64(p.BlockNumber),
+		GasLimit:      uint64(p.GasLimit),
+		GasUsed:       uint64(p.GasUsed),
+		Timestamp:     uint64(p.Timestamp),
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: p.BaseFeePerGas,
+		BlockHash:     p.BlockHash,
+		Transactions:  txs,
+	}
+}
+
+// ExecPayloadResponse is the response body of the builder API submitBlindedBlock endpoint.
+type ExecPayloadResponse struct {
+	Version string            `json:"version"`
+	Data    *ExecutionPayload `json:"data"`
+}
+
+// Eth1Data is the json representation of ethpb.Eth1Data.
+type Eth1Data struct {
+	DepositRoot  hexutil.Bytes `json:"deposit_root"`
+	DepositCount Uint64String  `json:"deposit_count"`
+	BlockHash    hexutil.Bytes `json:"block_hash"`
+}
+
+// BeaconBlockHeader is the json representation of ethpb.BeaconBlockHeader.
+type BeaconBlockHeader struct {
+	Slot          Uint64String  `json:"slot"`
+	ProposerIndex Uint64String  `json:"proposer_index"`
+	ParentRoot    hexutil.Bytes `json:"parent_root"`
+	StateRoot     hexutil.Bytes `json:"state_root"`
+	BodyRoot      hexutil.Bytes `json:"body_root"`
+}
+
+// SignedBeaconBlockHeader is the json representation of ethpb.SignedBeaconBlockHeader.
+type SignedBeaconBlockHeader struct {
+	Message   *BeaconBlockHeader `json:"message"`
+	Signature hexutil.Bytes      `json:"signature"`
+}
+
+// ProposerSlashing is the json representation of ethpb.ProposerSlashing.
+type ProposerSlashing struct {
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// Checkpoint is the json representation of ethpb.Checkpoint.
+type Checkpoint struct {
+	Epoch Uint64String  `json:"epoch"`
+	Root  hexutil.Bytes `json:"root"`
+}
+
+// AttestationData is the json representation of ethpb.AttestationData.
+type AttestationData struct {
+	Slot            Uint64String  `json:"slot"`
+	Index           Uint64String  `json:"index"`
+	BeaconBlockRoot hexutil.Bytes `json:"beacon_block_root"`
+	Source          *Checkpoint   `json:"source"`
+	Target          *Checkpoint   `json:"target"`
+}
+
+// IndexedAttestation is the json representation of ethpb.IndexedAttestation.
+type IndexedAttestation struct {
+	AttestingIndices []Uint64String   `json:"attesting_indices"`
+	Data             *AttestationData `json:"data"`
+	Signature        hexutil.Bytes    `json:"signature"`
+}
+
+// AttesterSlashing is the json representation of ethpb.AttesterSlashing.
+type AttesterSlashing struct {
+	Attestation1 *IndexedAttestation `json:"attestation_1"`
+	Attestation2 *IndexedAttestation `json:"attestation_2"`
+}
+
+// Attestation is the json representation of ethpb.Attestation.
+type Attestation struct {
+	AggregationBits hexutil.Bytes    `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       hexutil.Bytes    `json:"signature"`
+}
+
+// DepositData is the json representation of ethpb.Deposit_Data.
+type DepositData struct {
+	Pubkey                hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials"`
+	Amount                Uint64String  `json:"amount"`
+	Signature             hexutil.Bytes `json:"signature"`
+}
+
+// Deposit is the json representation of ethpb.Deposit.
+type Deposit struct {
+	Proof []hexutil.Bytes `json:"proof"`
+	Data  *DepositData    `json:"data"`
+}
+
+// VoluntaryExit is the json representation of ethpb.VoluntaryExit.
+type VoluntaryExit struct {
+	Epoch          Uint64String `json:"epoch"`
+	ValidatorIndex Uint64String `json:"validator_index"`
+}
+
+// SignedVoluntaryExit is the json representation of ethpb.SignedVoluntaryExit.
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message"`
+	Signature hexutil.Bytes  `json:"signature"`
+}
+
+// SyncAggregate is the json representation of ethpb.SyncAggregate.
+type SyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+// BlindedBeaconBlockBodyBellatrix is the json representation of ethpb.BlindedBeaconBlockBodyBellatrix.
+type BlindedBeaconBlockBodyBellatrix struct {
+	RandaoReveal           hexutil.Bytes           `json:"randao_reveal"`
+	Eth1Data               *Eth1Data               `json:"eth1_data"`
+	Graffiti               hexutil.Bytes           `json:"graffiti"`
+	ProposerSlashings      []*ProposerSlashing     `json:"proposer_slashings"`
+	AttesterSlashings      []*AttesterSlashing     `json:"attester_slashings"`
+	Attestations           []*Attestation          `json:"attestations"`
+	Deposits               []*Deposit              `json:"deposits"`
+	VoluntaryExits         []*SignedVoluntaryExit  `json:"voluntary_exits"`
+	SyncAggregate          *SyncAggregate          `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+}
+
+// BlindedBeaconBlockBellatrix is the json representation of ethpb.BlindedBeaconBlockBellatrix.
+type BlindedBeaconBlockBellatrix struct {
+	Slot          Uint64String                     `json:"slot"`
+	ProposerIndex Uint64String                     `json:"proposer_index"`
+	ParentRoot    hexutil.Bytes                    `json:"parent_root"`
+	StateRoot     hexutil.Bytes                    `json:"state_root"`
+	Body          *BlindedBeaconBlockBodyBellatrix `json:"body"`
+}
+
+// SignedBlindedBeaconBlockBellatrix is the json representation of ethpb.SignedBlindedBeaconBlockBellatrix,
+// which is the request body of the builder API submitBlindedBlock endpoint.
+type SignedBlindedBeaconBlockBellatrix struct {
+	Message   *BlindedBeaconBlockBellatrix `json:"message"`
+	Signature hexutil.Bytes                `json:"signature"`
+}
+
+// SignedBlindedBeaconBlockBellatrixFromProto converts a signed blinded block into its json representation.
+func SignedBlindedBeaconBlockBellatrixFromProto(sb *ethpb.SignedBlindedBeaconBlockBellatrix) (*SignedBlindedBeaconBlockBellatrix, error) {
+	if sb == nil || sb.Block == nil || sb.Block.Body == nil {
+		return nil, errors.New("nil blinded block")
+	}
+	b := sb.Block.Body
+	if b.Eth1Data == nil || b.SyncAggregate == nil || b.ExecutionPayloadHeader == nil {
+		return nil, errors.New("incomplete blinded block body")
+	}
+	body := &BlindedBeaconBlockBodyBellatrix{
+		RandaoReveal: b.RandaoReveal,
+		Eth1Data: &Eth1Data{
+			DepositRoot:  b.Eth1Data.DepositRoot,
+			DepositCount: Uint64String(b.Eth1Data.DepositCount),
+			BlockHash:    b.Eth1Data.BlockHash,
+		},
+		Graffiti:          b.Graffiti,
+		ProposerSlashings: make([]*ProposerSlashing, len(b.ProposerSlashings)),
+		AttesterSlashings: make([]*AttesterSlashing, len(b.AttesterSlashings)),
+		Attestations:      make([]*Attestation, len(b.Attestations)),
+		Deposits:          make([]*Deposit, len(b.Deposits)),
+		VoluntaryExits:    make([]*SignedVoluntaryExit, len(b.VoluntaryExits)),
+		SyncAggregate: &SyncAggregate{
+			SyncCommitteeBits:      hexutil.Bytes(b.SyncAggregate.SyncCommitteeBits),
+			SyncCommitteeSignature: b.SyncAggregate.SyncCommitteeSignature,
+		},
+		ExecutionPayloadHeader: ExecutionPayloadHeaderFromProto(b.ExecutionPayloadHeader),
+	}
+	for i, s := range b.ProposerSlashings {
+		body.ProposerSlashings[i] = &ProposerSlashing{
+			SignedHeader1: signedBeaconBlockHeaderFromProto(s.Header_1),
+			SignedHeader2: signedBeaconBlockHeaderFromProto(s.Header_2),
+		}
+	}
+	for i, s := range b.AttesterSlashings {
+		body.AttesterSlashings[i] = &AttesterSlashing{
+			Attestation1: indexedAttestationFromProto(s.Attestation_1),
+			Attestation2: indexedAttestationFromProto(s.Attestation_2),
+		}
+	}
+	for i, a := range b.Attestations {
+		body.Attestations[i] = &Attestation{
+			AggregationBits: hexutil.Bytes(a.AggregationBits),
+			Data:            attestationDataFromProto(a.Data),
+			Signature:       a.Signature,
+		}
+	}
+	for i, d := range b.Deposits {
+		proof := make([]hexutil.Bytes, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = p
+		}
+		body.Deposits[i] = &Deposit{Proof: proof}
+		if d.Data != nil {
+			body.Deposits[i].Data = &DepositData{
+				Pubkey:                d.Data.PublicKey,
+				WithdrawalCredentials: d.Data.WithdrawalCredentials,
+				Amount:                Uint64String(d.Data.Amount),
+				Signature:             d.Data.Signature,
+			}
+		}
+	}
+	for i, e := range b.VoluntaryExits {
+		body.VoluntaryExits[i] = &SignedVoluntaryExit{Signature: e.Signature}
+		if e.Exit != nil {
+			body.VoluntaryExits[i].Message = &VoluntaryExit{
+				Epoch:          Uint64String(e.Exit.Epoch),
+				ValidatorIndex: Uint64String(e.Exit.ValidatorIndex),
+			}
+		}
+	}
+	return &SignedBlindedBeaconBlockBellatrix{
+		Message: &BlindedBeaconBlockBellatrix{
+			Slot:          Uint64String(sb.Block.Slot),
+			ProposerIndex: Uint64String(sb.Block.ProposerIndex),
+			ParentRoot:    sb.Block.ParentRoot,
+			StateRoot:     sb.Block.StateRoot,
+			Body:          body,
+		},
+		Signature: sb.Signature,
+	}, nil
+}
+
+func signedBeaconBlockHeaderFromProto(h *ethpb.SignedBeaconBlockHeader) *SignedBeaconBlockHeader {
+	if h == nil || h.Header == nil {
+		return nil
+	}
+	return &SignedBeaconBlockHeader{
+		Message: &BeaconBlockHeader{
+			Slot:          Uint64String(h.Header.Slot),
+			ProposerIndex: Uint64String(h.Header.ProposerIndex),
+			ParentRoot:    h.Header.ParentRoot,
+			StateRoot:     h.Header.StateRoot,
+			BodyRoot:      h.Header.BodyRoot,
+		},
+		Signature: h.Signature,
+	}
+}
+
+func indexedAttestationFromProto(a *ethpb.IndexedAttestation) *IndexedAttestation {
+	if a == nil {
+		return nil
+	}
+	indices := make([]Uint64String, len(a.AttestingIndices))
+	for i, idx := range a.AttestingIndices {
+		indices[i] = Uint64String(idx)
+	}
+	return &IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             attestationDataFromProto(a.Data),
+		Signature:        a.Signature,
+	}
+}
+
+func attestationDataFromProto(d *ethpb.AttestationData) *AttestationData {
+	if d == nil {
+		return nil
+	}
+	return &AttestationData{
+		Slot:            Uint64String(d.Slot),
+		Index:           Uint64String(d.CommitteeIndex),
+		BeaconBlockRoot: d.BeaconBlockRoot,
+		Source:          checkpointFromProto(d.Source),
+		Target:          checkpointFromProto(d.Target),
+	}
+}
+
+func checkpointFromProto(c *ethpb.Checkpoint) *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &Checkpoint{
+		Epoch: Uint64String(c.Epoch),
+		Root:  c.Root,
+	}
+}
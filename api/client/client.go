@@ -0,0 +1,59 @@
+// Package client holds the helpers shared by the HTTP API clients in its subpackages.
+package client
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotOK is returned (possibly wrapped) when an api responds with a non-2xx status code.
+var ErrNotOK = errors.New("did not receive 2xx response from API")
+
+// ErrNotFound is returned (possibly wrapped) when an api responds with a 404 status code.
+var ErrNotFound = errors.Wrap(ErrNotOK, "recv 404 NotFound response from API")
+
+// ErrUnavailable is returned (possibly wrapped) when an api responds with a 503 status code.
+var ErrUnavailable = errors.Wrap(ErrNotOK, "recv 503 Service Unavailable response from API")
+
+// URLForHost parses h as a url, or as a `host:port` pair served over http.
+func URLForHost(h string) (*url.URL, error) {
+	// try to parse as url (being permissive)
+	u, err := url.Parse(h)
+	if err == nil && u.Host != "" {
+		return u, nil
+	}
+	// try to parse as host:port
+	host, port, err := net.SplitHostPort(h)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s as a url or host:port", h)
+	}
+	return &url.URL{Host: net.JoinHostPort(host, port), Scheme: "http"}, nil
+}
+
+// Non200Err builds the error returned for a response with a non-2xx status code. The error wraps ErrNotFound
+// for a 404 status code, ErrUnavailable for a 503 status code and ErrNotOK otherwise, and includes the start
+// of the response body.
+func Non200Err(response *http.Response) error {
+	bodyBytes, err := ioutil.ReadAll(io.LimitReader(response.Body, 1<<10))
+	var body string
+	if err != nil {
+		body = "(Unable to read response body.)"
+	} else {
+		body = "response body:\n" + string(bodyBytes)
+	}
+	msg := fmt.Sprintf("code=%d, url=%s, body=%s", response.StatusCode, response.Request.URL, body)
+	switch response.StatusCode {
+	case http.StatusNotFound:
+		return errors.Wrap(ErrNotFound, msg)
+	case http.StatusServiceUnavailable:
+		return errors.Wrap(ErrUnavailable, msg)
+	default:
+		return errors.Wrap(ErrNotOK, msg)
+	}
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestURLForHost(t *testing.T) {
+	cases := []struct {
+		host   string
+		expect string
+		err    bool
+	}{
+		{host: "http://localhost:3500", expect: "http://localhost:3500"},
+		{host: "https://node.example.com", expect: "https://node.example.com"},
+		{host: "localhost:3500", expect: "http://localhost:3500"},
+		{host: "127.0.0.1:3500", expect: "http://127.0.0.1:3500"},
+		{host: "localhost", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.host, func(t *testing.T) {
+			u, err := URLForHost(c.host)
+			if c.err {
+				require.NotNil(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expect, u.String())
+		})
+	}
+}
+
+func TestNon200Err(t *testing.T) {
+	u, err := url.Parse("http://localhost:3500/eth/v1/node/version")
+	require.NoError(t, err)
+	resp := func(code int) *http.Response {
+		return &http.Response{
+			StatusCode: code,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("nope")),
+			Request:    &http.Request{URL: u},
+		}
+	}
+
+	err = Non200Err(resp(http.StatusNotFound))
+	require.Equal(t, true, errors.Is(err, ErrNotFound))
+	require.Equal(t, true, errors.Is(err, ErrNotOK))
+	require.ErrorContains(t, "code=404", err)
+
+	err = Non200Err(resp(http.StatusServiceUnavailable))
+	require.Equal(t, true, errors.Is(err, ErrUnavailable))
+	require.Equal(t, true, errors.Is(err, ErrNotOK))
+
+	err = Non200Err(resp(http.StatusInternalServerError))
+	require.Equal(t, false, errors.Is(err, ErrNotFound))
+	require.Equal(t, true, errors.Is(err, ErrNotOK))
+	require.ErrorContains(t, "nope", err)
+}
@@ -190,6 +190,10 @@ func handlePostRequestForEndpoint(endpoint *Endpoint, w http.ResponseWriter, req
 }
 
 func handleDeleteRequestForEndpoint(endpoint *Endpoint, req *http.Request) ErrorJson {
+	// Endpoints without a DELETE request container take all their input from the URL.
+	if endpoint.DeleteRequest == nil {
+		return nil
+	}
 	if errJson := DeserializeRequestBodyIntoContainer(req.Body, endpoint.DeleteRequest); errJson != nil {
 		return errJson
 	}
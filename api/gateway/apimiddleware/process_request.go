@@ -114,6 +114,9 @@ func HandleGrpcResponseError(errJson ErrorJson, resp *http.Response, respBody []
 				w.Header().Set(h, v)
 			}
 		}
+		if v, ok := resp.Header["Grpc-Metadata-"+grpc.RetryAfterMetadataKey]; ok && len(v) > 0 {
+			w.Header().Set("Retry-After", v[0])
+		}
 		// Handle gRPC timeout.
 		if resp.StatusCode == http.StatusGatewayTimeout {
 			WriteError(w, TimeoutError(), resp.Header)
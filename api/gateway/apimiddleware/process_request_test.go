@@ -191,6 +191,28 @@ func TestHandleGrpcResponseError(t *testing.T) {
 	assert.Equal(t, 400, errJson.StatusCode())
 }
 
+func TestHandleGrpcResponseError_RetryAfter(t *testing.T) {
+	response := &http.Response{
+		StatusCode: 503,
+		Header: http.Header{
+			"Grpc-Metadata-" + grpc.RetryAfterMetadataKey: []string{"12"},
+		},
+	}
+	writer := httptest.NewRecorder()
+	errJson := &testErrorJson{
+		Message: "foo",
+		Code:    503,
+	}
+	b, err := json.Marshal(errJson)
+	require.NoError(t, err)
+
+	hasError, e := HandleGrpcResponseError(errJson, response, b, writer)
+	require.Equal(t, true, e == nil)
+	assert.Equal(t, true, hasError)
+	assert.Equal(t, "12", writer.Header().Get("Retry-After"))
+	assert.Equal(t, 503, writer.Code)
+}
+
 func TestGrpcResponseIsEmpty(t *testing.T) {
 	t.Run("nil", func(t *testing.T) {
 		assert.Equal(t, true, GrpcResponseIsEmpty(nil))
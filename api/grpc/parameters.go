@@ -6,3 +6,6 @@ const CustomErrorMetadataKey = "Custom-Error"
 
 // HttpCodeMetadataKey is the key to use when setting custom HTTP status codes in gRPC metadata.
 const HttpCodeMetadataKey = "X-Http-Code"
+
+// RetryAfterMetadataKey is the key to use when asking HTTP clients to retry a request after a number of seconds.
+const RetryAfterMetadataKey = "Retry-After"
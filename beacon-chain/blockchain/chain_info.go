@@ -80,6 +80,17 @@ type FinalizationFetcher interface {
 	PreviousJustifiedCheckpt() *ethpb.Checkpoint
 }
 
+// LightClientFetcher retrieves the latest light client updates computed from processed blocks.
+type LightClientFetcher interface {
+	LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate
+}
+
+// LivenessFetcher reports whether validators were seen attesting or proposing in a recent epoch.
+type LivenessFetcher interface {
+	IsLive(epoch types.Epoch, indices []types.ValidatorIndex) ([]bool, error)
+}
+
 // FinalizedCheckpt returns the latest finalized checkpoint from chain store.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	cp := s.store.FinalizedCheckpt()
@@ -179,10 +179,16 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 
 	// Forward an event capturing a new chain head over a common event feed
 	// done in a goroutine to avoid blocking the critical runtime main routine.
+	enteredEpoch := slots.ToEpoch(newHeadSlot) != slots.ToEpoch(headSlot)
 	go func() {
 		if err := s.notifyNewHeadEvent(newHeadSlot, newHeadState, newStateRoot, headRoot[:]); err != nil {
 			log.WithError(err).Error("Could not notify event feed of new chain head")
 		}
+		if enteredEpoch {
+			if err := s.notifyActiveValidatorCount(newHeadState); err != nil {
+				log.WithError(err).Error("Could not notify event feed of active validator count")
+			}
+		}
 	}()
 
 	return nil
@@ -347,6 +353,24 @@ func (s *Service) notifyNewHeadEvent(
 	return nil
 }
 
+// Notifies a common event feed of the number of active validators in the epoch of
+// the head state. Called when the chain head enters a new epoch.
+func (s *Service) notifyActiveValidatorCount(headState state.BeaconState) error {
+	epoch := slots.ToEpoch(headState.Slot())
+	count, err := helpers.ActiveValidatorCount(s.ctx, headState, epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not get active validator count")
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.ActiveValidatorCount,
+		Data: &statefeed.ActiveValidatorCountData{
+			Epoch: epoch,
+			Count: count,
+		},
+	})
+	return nil
+}
+
 // This saves the attestations inside the beacon block with respect to root `orphanedRoot` back into the
 // attestation pool. It also filters out the attestations that is one epoch older as a
 // defense so invalid attestations don't flow into the attestation pool.
@@ -8,6 +8,8 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -216,6 +218,29 @@ func Test_notifyNewHeadEvent(t *testing.T) {
 	})
 }
 
+func Test_notifyActiveValidatorCount(t *testing.T) {
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+	bState, _ := util.DeterministicGenesisState(t, 10)
+	epoch1Start, err := slots.EpochStart(1)
+	require.NoError(t, err)
+	require.NoError(t, bState.SetSlot(epoch1Start))
+	notifier := &mock.MockStateNotifier{RecordEvents: true}
+	srv := &Service{
+		ctx: context.Background(),
+		cfg: &config{
+			StateNotifier: notifier,
+		},
+	}
+	require.NoError(t, srv.notifyActiveValidatorCount(bState))
+	events := notifier.ReceivedEvents()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, statefeed.ActiveValidatorCount, int(events[0].Type))
+	data, ok := events[0].Data.(*statefeed.ActiveValidatorCountData)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, &statefeed.ActiveValidatorCountData{Epoch: 1, Count: 10}, data)
+}
+
 func TestSaveOrphanedAtts(t *testing.T) {
 	resetCfg := features.InitWithReset(&features.Flags{
 		CorrectlyInsertOrphanedAtts: true,
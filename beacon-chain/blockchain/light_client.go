@@ -0,0 +1,174 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	lightclient "github.com/prysmaticlabs/prysm/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// LightClientFinalityUpdate returns the latest light client finality update, or nil if
+// no block has produced one since the node started.
+func (s *Service) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.finalityUpdate
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update, or nil if
+// no block has produced one since the node started.
+func (s *Service) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.optimisticUpdate
+}
+
+// saveLightClientData computes the light client data of a processed head block, given the justified
+// and finalized checkpoints of its post state. The bootstraps of the checkpoint blocks and the best
+// update of every sync committee period are saved to the database, while the latest finality and
+// optimistic updates are kept in memory for gossip.
+func (s *Service) saveLightClientData(ctx context.Context, signed block.SignedBeaconBlock, justified, finalized *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveLightClientData")
+	defer span.End()
+	if signed.Version() == version.Phase0 {
+		return nil
+	}
+	// Blocks are processed in the background, so the best update of a period is read and written
+	// under a lock.
+	s.lightClientDataLock.Lock()
+	defer s.lightClientDataLock.Unlock()
+	if err := s.saveLightClientBootstraps(ctx, justified, finalized); err != nil {
+		return err
+	}
+	parentRoot := bytesutil.ToBytes32(signed.Block().ParentRoot())
+	attestedBlock, err := s.cfg.BeaconDB.Block(ctx, parentRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get parent block")
+	}
+	if attestedBlock == nil || attestedBlock.IsNil() {
+		return nil
+	}
+
+	if attestedBlock.Version() == version.Phase0 {
+		return nil
+	}
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get parent state")
+	}
+	// Until the chain has finalized past genesis, the finalized checkpoint root is zero and the
+	// finalized block is the genesis block. The finalized block is unknown if the node was synced
+	// from a checkpoint after it.
+	var finalizedBlock block.SignedBeaconBlock
+	if finalized := attestedState.FinalizedCheckpoint(); finalized.Epoch == 0 {
+		finalizedBlock, err = s.cfg.BeaconDB.GenesisBlock(ctx)
+	} else {
+		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, bytesutil.ToBytes32(finalized.Root))
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	update, err := lightclient.NewLightClientUpdate(ctx, signed, attestedState, attestedBlock, finalizedBlock)
+	if errors.Is(err, lightclient.ErrNotEnoughParticipants) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	period := lightclient.SyncCommitteePeriod(update.AttestedHeader.Slot)
+	best, err := s.cfg.BeaconDB.LightClientUpdates(ctx, period, 1)
+	if err != nil {
+		return errors.Wrap(err, "could not get best light client update")
+	}
+	if len(best) == 0 || lightclient.IsBetterUpdate(update, best[0]) {
+		if err := s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update); err != nil {
+			return errors.Wrap(err, "could not save light client update")
+		}
+	}
+
+	s.updateLatestLightClientUpdates(update)
+	return nil
+}
+
+// saveLightClientBootstraps saves the bootstrap of every checkpoint block which has none yet. Light
+// clients request bootstraps by the checkpoint roots they trust, which are the last block before the
+// epoch start slot whenever that slot is skipped.
+func (s *Service) saveLightClientBootstraps(ctx context.Context, checkpoints ...*ethpb.Checkpoint) error {
+	for _, c := range checkpoints {
+		root := bytesutil.ToBytes32(c.Root)
+		if root == params.BeaconConfig().ZeroHash {
+			continue
+		}
+		bootstrap, err := s.cfg.BeaconDB.LightClientBootstrap(ctx, root)
+		if err != nil {
+			return errors.Wrap(err, "could not get light client bootstrap")
+		}
+		if bootstrap != nil {
+			continue
+		}
+		blk, err := s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return errors.Wrap(err, "could not get checkpoint block")
+		}
+		if blk == nil || blk.IsNil() || blk.Version() == version.Phase0 {
+			continue
+		}
+		st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+		if err != nil {
+			return errors.Wrap(err, "could not get checkpoint state")
+		}
+		bootstrap, err = lightclient.NewLightClientBootstrap(ctx, st, blk)
+		if err != nil {
+			return errors.Wrap(err, "could not create light client bootstrap")
+		}
+		if err := s.cfg.BeaconDB.SaveLightClientBootstrap(ctx, root, bootstrap); err != nil {
+			return errors.Wrap(err, "could not save light client bootstrap")
+		}
+	}
+	return nil
+}
+
+// updateLatestLightClientUpdates replaces the latest optimistic update if the update attests a newer
+// header, and the latest finality update if the update finalizes a newer header or newly reaches
+// a supermajority of the sync committee for the same one. New updates are sent to the state feed.
+func (s *Service) updateLatestLightClientUpdates(update *ethpb.LightClientUpdate) {
+	s.lightClientLock.Lock()
+	var newFinality *ethpb.LightClientFinalityUpdate
+	if lightclient.IsFinalityUpdate(update) {
+		old := s.finalityUpdate
+		if old == nil || update.FinalizedHeader.Slot > old.FinalizedHeader.Slot ||
+			(update.FinalizedHeader.Slot == old.FinalizedHeader.Slot &&
+				lightclient.HasSupermajority(update.SyncAggregate) && !lightclient.HasSupermajority(old.SyncAggregate)) {
+			newFinality = lightclient.NewLightClientFinalityUpdate(update)
+			s.finalityUpdate = newFinality
+		}
+	}
+	var newOptimistic *ethpb.LightClientOptimisticUpdate
+	if s.optimisticUpdate == nil || update.AttestedHeader.Slot > s.optimisticUpdate.AttestedHeader.Slot {
+		newOptimistic = lightclient.NewLightClientOptimisticUpdate(update)
+		s.optimisticUpdate = newOptimistic
+	}
+	s.lightClientLock.Unlock()
+
+	if newFinality != nil {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientFinalityUpdate,
+			Data: newFinality,
+		})
+	}
+	if newOptimistic != nil {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientOptimisticUpdate,
+			Data: newOptimistic,
+		})
+	}
+}
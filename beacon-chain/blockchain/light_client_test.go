@@ -0,0 +1,133 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	lightclient "github.com/prysmaticlabs/prysm/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// saveAltairBlock saves an Altair block at the given slot with the given post state, signed by the
+// given number of sync committee participants.
+func saveAltairBlock(t *testing.T, s *Service, slot types.Slot, parentRoot []byte, st state.BeaconState, participants uint64) (block.SignedBeaconBlock, [32]byte) {
+	ctx := context.Background()
+	require.NoError(t, st.SetSlot(slot))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = slot
+	b.Block.StateRoot = stateRoot[:]
+	if parentRoot != nil {
+		b.Block.ParentRoot = parentRoot
+	}
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	b.Block.Body.SyncAggregate.SyncCommitteeBits = bits
+	blk, err := wrapper.WrappedAltairSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.BeaconDB.SaveBlock(ctx, blk))
+	require.NoError(t, s.cfg.BeaconDB.SaveState(ctx, st, root))
+	return blk, root
+}
+
+func TestService_SaveLightClientData(t *testing.T) {
+	ctx := context.Background()
+	opts := append(testServiceOptsWithDB(t), WithStateNotifier(&mock.MockStateNotifier{}))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	genesis, _ := util.DeterministicGenesisStateAltair(t, 64)
+	_, finalizedRoot := saveAltairBlock(t, service, 32, nil, genesis.Copy(), 0)
+	attestedState := genesis.Copy()
+	require.NoError(t, attestedState.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	_, attestedRoot := saveAltairBlock(t, service, 100, nil, attestedState, 0)
+
+	// The start slot 128 of epoch 4 is skipped, so the block at slot 100 is its checkpoint block.
+	postState := attestedState.Copy()
+	require.NoError(t, postState.SetCurrentJustifiedCheckpoint(&ethpb.Checkpoint{Epoch: 4, Root: attestedRoot[:]}))
+	blk, root := saveAltairBlock(t, service, 161, attestedRoot[:], postState, 100)
+	require.NoError(t, service.saveLightClientData(ctx, blk, postState.CurrentJustifiedCheckpoint(), postState.FinalizedCheckpoint()))
+
+	bootstrap, err := service.cfg.BeaconDB.LightClientBootstrap(ctx, attestedRoot)
+	require.NoError(t, err)
+	require.NotNil(t, bootstrap)
+	assert.Equal(t, types.Slot(100), bootstrap.Header.Slot)
+	bootstrap, err = service.cfg.BeaconDB.LightClientBootstrap(ctx, finalizedRoot)
+	require.NoError(t, err)
+	require.NotNil(t, bootstrap)
+	assert.Equal(t, types.Slot(32), bootstrap.Header.Slot)
+	bootstrap, err = service.cfg.BeaconDB.LightClientBootstrap(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.LightClientBootstrap)(nil), bootstrap)
+
+	updates, err := service.cfg.BeaconDB.LightClientUpdates(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, types.Slot(100), updates[0].AttestedHeader.Slot)
+	assert.Equal(t, true, lightclient.IsFinalityUpdate(updates[0]))
+	require.NotNil(t, service.LightClientFinalityUpdate())
+	assert.Equal(t, types.Slot(32), service.LightClientFinalityUpdate().FinalizedHeader.Slot)
+	require.NotNil(t, service.LightClientOptimisticUpdate())
+	assert.Equal(t, types.Slot(100), service.LightClientOptimisticUpdate().AttestedHeader.Slot)
+
+	// A later block with a supermajority is a better update but saves no bootstrap.
+	nextState := postState.Copy()
+	next, nextRoot := saveAltairBlock(t, service, 162, root[:], nextState, 400)
+	require.NoError(t, service.saveLightClientData(ctx, next, nextState.CurrentJustifiedCheckpoint(), nextState.FinalizedCheckpoint()))
+	bootstrap, err = service.cfg.BeaconDB.LightClientBootstrap(ctx, nextRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.LightClientBootstrap)(nil), bootstrap)
+	updates, err = service.cfg.BeaconDB.LightClientUpdates(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, types.Slot(161), updates[0].AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(161), service.LightClientOptimisticUpdate().AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(162), service.LightClientFinalityUpdate().SignatureSlot)
+
+	// A block without enough participants produces no update.
+	lastState := nextState.Copy()
+	last, _ := saveAltairBlock(t, service, 163, nextRoot[:], lastState, 0)
+	require.NoError(t, service.saveLightClientData(ctx, last, lastState.CurrentJustifiedCheckpoint(), lastState.FinalizedCheckpoint()))
+	assert.Equal(t, types.Slot(161), service.LightClientOptimisticUpdate().AttestedHeader.Slot)
+}
+
+func TestService_SaveLightClientData_BeforeFinalization(t *testing.T) {
+	ctx := context.Background()
+	opts := append(testServiceOptsWithDB(t), WithStateNotifier(&mock.MockStateNotifier{}))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	genesis, _ := util.DeterministicGenesisStateAltair(t, 64)
+	_, genesisRoot := saveAltairBlock(t, service, 0, nil, genesis.Copy(), 0)
+	require.NoError(t, service.cfg.BeaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	attestedState := genesis.Copy()
+	_, attestedRoot := saveAltairBlock(t, service, 10, nil, attestedState, 0)
+	postState := attestedState.Copy()
+	blk, _ := saveAltairBlock(t, service, 11, attestedRoot[:], postState, 100)
+	require.NoError(t, service.saveLightClientData(ctx, blk, postState.CurrentJustifiedCheckpoint(), postState.FinalizedCheckpoint()))
+
+	// The update has an empty finalized header but the proof of the zero finalized root.
+	updates, err := service.cfg.BeaconDB.LightClientUpdates(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, types.Slot(10), updates[0].AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(0), updates[0].FinalizedHeader.Slot)
+	assert.DeepEqual(t, make([]byte, 32), updates[0].FinalizedHeader.BodyRoot)
+	branch, err := attestedState.FinalizedRootProof(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, branch, updates[0].FinalityBranch)
+}
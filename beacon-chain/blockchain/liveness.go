@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"github.com/pkg/errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// recordLiveness marks the given validators as live in the given epoch. Validators are marked from
+// the attestations and blocks fed into fork choice, so records do not depend on any cache being warm.
+// Only the current and previous epochs are kept, older records are ignored and pruned.
+func (s *Service) recordLiveness(epoch types.Epoch, indices ...types.ValidatorIndex) {
+	currentEpoch := slots.ToEpoch(s.CurrentSlot())
+	if epoch+1 < currentEpoch {
+		return
+	}
+
+	s.livenessLock.Lock()
+	defer s.livenessLock.Unlock()
+	if s.livenessStartEpoch == nil {
+		// Attestations and blocks from before this epoch may have been missed, so the liveness of
+		// this epoch is incomplete and only later epochs can be reported on.
+		s.livenessStartEpoch = &currentEpoch
+	}
+	if s.liveness == nil {
+		s.liveness = make(map[types.Epoch]map[types.ValidatorIndex]bool)
+	}
+	for e := range s.liveness {
+		if e+1 < currentEpoch {
+			delete(s.liveness, e)
+		}
+	}
+	live, ok := s.liveness[epoch]
+	if !ok {
+		live = make(map[types.ValidatorIndex]bool)
+		s.liveness[epoch] = live
+	}
+	for _, idx := range indices {
+		live[idx] = true
+	}
+}
+
+// recordAttestersLiveness marks the attesting indices of an attestation as live in its target epoch.
+func (s *Service) recordAttestersLiveness(epoch types.Epoch, attestingIndices []uint64) {
+	indices := make([]types.ValidatorIndex, len(attestingIndices))
+	for i, idx := range attestingIndices {
+		indices[i] = types.ValidatorIndex(idx)
+	}
+	s.recordLiveness(epoch, indices...)
+}
+
+// IsLive returns, for each of the given validator indices, whether the validator was seen attesting
+// or proposing a block in the given epoch. An error is returned when the node has not been following
+// the chain for the whole epoch, as validators would otherwise be wrongly reported as not live.
+func (s *Service) IsLive(epoch types.Epoch, indices []types.ValidatorIndex) ([]bool, error) {
+	currentEpoch := slots.ToEpoch(s.CurrentSlot())
+	if epoch+1 < currentEpoch {
+		return nil, errors.Errorf("liveness of epoch %d is no longer kept, current epoch is %d", epoch, currentEpoch)
+	}
+
+	s.livenessLock.RLock()
+	defer s.livenessLock.RUnlock()
+	if s.livenessStartEpoch == nil || epoch <= *s.livenessStartEpoch {
+		return nil, errors.Errorf("liveness of epoch %d is not known, validators have not been followed for the whole epoch", epoch)
+	}
+	live := make([]bool, len(indices))
+	for i, idx := range indices {
+		live[i] = s.liveness[epoch][idx]
+	}
+	return live, nil
+}
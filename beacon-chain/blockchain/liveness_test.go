@@ -0,0 +1,83 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/store"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// genesisTimeAtEpoch returns a genesis time for which the current epoch is the given epoch.
+func genesisTimeAtEpoch(epoch types.Epoch) time.Time {
+	secondsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch) * params.BeaconConfig().SecondsPerSlot
+	return time.Now().Add(-time.Duration(uint64(epoch)*secondsPerEpoch+1) * time.Second)
+}
+
+func TestIsLive(t *testing.T) {
+	s := &Service{genesisTime: genesisTimeAtEpoch(3)}
+
+	_, err := s.IsLive(3, []types.ValidatorIndex{1})
+	assert.ErrorContains(t, "liveness of epoch 3 is not known", err)
+
+	s.recordLiveness(2, 1)
+	s.recordLiveness(3, 1, 2)
+	_, err = s.IsLive(3, []types.ValidatorIndex{1})
+	assert.ErrorContains(t, "liveness of epoch 3 is not known", err)
+
+	// Later epochs are fully followed.
+	s.genesisTime = genesisTimeAtEpoch(4)
+	s.recordLiveness(4, 2)
+	live, err := s.IsLive(4, []types.ValidatorIndex{1, 2, 3})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []bool{false, true, false}, live)
+
+	// Records older than the previous epoch are pruned and ignored.
+	s.genesisTime = genesisTimeAtEpoch(5)
+	s.recordLiveness(3, 3)
+	s.recordLiveness(5, 3)
+	_, ok := s.liveness[3]
+	assert.Equal(t, false, ok)
+	live, err = s.IsLive(5, []types.ValidatorIndex{2, 3})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []bool{false, true}, live)
+	_, err = s.IsLive(3, []types.ValidatorIndex{1})
+	assert.ErrorContains(t, "liveness of epoch 3 is no longer kept", err)
+}
+
+func TestInsertBlockAndAttestationsToForkChoiceStore_RecordsLiveness(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		cfg:         &config{ForkChoiceStore: doublylinkedtree.New(0, 0), BeaconDB: testDB.SetupDB(t)},
+		store:       &store.Store{},
+		genesisTime: genesisTimeAtEpoch(1),
+	}
+	s.store.SetFinalizedCheckpt(&ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]})
+	st, _ := util.DeterministicGenesisState(t, 64)
+
+	aggBits := bitfield.NewBitlist(2)
+	aggBits.SetBitAt(1, true)
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: aggBits})
+	b := util.NewBeaconBlock()
+	b.Block.ProposerIndex = 7
+	b.Block.Body.Attestations = []*ethpb.Attestation{att}
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.insertBlockAndAttestationsToForkChoiceStore(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b).Block(), r, st, false))
+
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(committee))
+	assert.DeepEqual(t, map[types.ValidatorIndex]bool{7: true, committee[1]: true}, s.liveness[0])
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 )
 
 type mockEngineService struct {
@@ -47,3 +48,15 @@ func (m *mockEngineService) ExecutionBlockByHash(_ context.Context, hash common.
 	}
 	return blk, nil
 }
+
+func (m *mockEngineService) ExecutionBlockByHashWithTxs(ctx context.Context, hash common.Hash) (*enginev1.ExecutionBlock, error) {
+	return m.ExecutionBlockByHash(ctx, hash)
+}
+
+func (*mockEngineService) ReconstructFullBellatrixBlock(context.Context, block.SignedBeaconBlock) (block.SignedBeaconBlock, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (*mockEngineService) ReconstructFullBellatrixBlockBatch(context.Context, []block.SignedBeaconBlock) ([]block.SignedBeaconBlock, error) {
+	return nil, errors.New("not implemented")
+}
@@ -5,13 +5,17 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/sirupsen/logrus"
@@ -20,7 +24,7 @@ import (
 // notifyForkchoiceUpdate signals execution engine the fork choice updates. Execution engine should:
 // 1. Re-organizes the execution payload chain and corresponding state to make head_block_hash the head.
 // 2. Applies finality to the execution state: it irreversibly persists the chain of all execution payloads and corresponding state, up to and including finalized_block_hash.
-func (s *Service) notifyForkchoiceUpdate(ctx context.Context, headBlk block.BeaconBlock, finalizedRoot [32]byte) (*enginev1.PayloadIDBytes, error) {
+func (s *Service) notifyForkchoiceUpdate(ctx context.Context, headBlk block.BeaconBlock, headRoot, finalizedRoot [32]byte) (*enginev1.PayloadIDBytes, error) {
 	if headBlk == nil || headBlk.IsNil() || headBlk.Body().IsNil() {
 		return nil, errors.New("nil head block")
 	}
@@ -35,7 +39,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, headBlk block.Beac
 	if !isExecutionBlk {
 		return nil, nil
 	}
-	headPayload, err := headBlk.Body().ExecutionPayload()
+	headHash, err := blocks.ExecutionBlockHash(headBlk.Body())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get execution payload")
 	}
@@ -47,16 +51,15 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, headBlk block.Beac
 	if isPreBellatrix(finalizedBlock.Block().Version()) {
 		finalizedHash = params.BeaconConfig().ZeroHash[:]
 	} else {
-		payload, err := finalizedBlock.Block().Body().ExecutionPayload()
+		finalizedHash, err = blocks.ExecutionBlockHash(finalizedBlock.Block().Body())
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get finalized block execution payload")
 		}
-		finalizedHash = payload.BlockHash
 	}
 
 	fcs := &enginev1.ForkchoiceState{
-		HeadBlockHash:      headPayload.BlockHash,
-		SafeBlockHash:      headPayload.BlockHash,
+		HeadBlockHash:      headHash,
+		SafeBlockHash:      headHash,
 		FinalizedBlockHash: finalizedHash,
 	}
 
@@ -67,17 +70,55 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, headBlk block.Beac
 		case v1.ErrAcceptedSyncingPayloadStatus:
 			log.WithFields(logrus.Fields{
 				"headSlot":      headBlk.Slot(),
-				"headHash":      fmt.Sprintf("%#x", bytesutil.Trunc(headPayload.BlockHash)),
+				"headHash":      fmt.Sprintf("%#x", bytesutil.Trunc(headHash)),
 				"finalizedHash": fmt.Sprintf("%#x", bytesutil.Trunc(finalizedHash)),
 			}).Info("Called fork choice updated with optimistic block")
 			return payloadID, nil
+		case v1.ErrInvalidPayloadStatus:
+			if err := s.notifyBlockValidity(ctx, headBlk.Slot(), headRoot, false /* valid */); err != nil {
+				log.WithError(err).Error("Could not notify invalid block")
+			}
+			return nil, errors.Wrap(err, "could not notify forkchoice update from execution engine")
 		default:
 			return nil, errors.Wrap(err, "could not notify forkchoice update from execution engine")
 		}
 	}
+	if err := s.notifyBlockValidity(ctx, headBlk.Slot(), headRoot, true /* valid */); err != nil {
+		log.WithError(err).Error("Could not notify valid block")
+	}
 	return payloadID, nil
 }
 
+// notifyBlockValidity sends a block validity event for a head block that was optimistically imported
+// in fork choice and whose execution payload has now been found VALID or INVALID by the execution engine.
+// A VALID block is also marked as such in fork choice. Blocks that are not optimistic are ignored.
+func (s *Service) notifyBlockValidity(ctx context.Context, slot types.Slot, root [32]byte, valid bool) error {
+	if !s.cfg.ForkChoiceStore.HasNode(root) {
+		return nil
+	}
+	optimistic, err := s.cfg.ForkChoiceStore.IsOptimistic(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not check if block is optimistic")
+	}
+	if !optimistic {
+		return nil
+	}
+	if valid {
+		if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, root); err != nil {
+			return errors.Wrap(err, "could not set optimistic block to valid")
+		}
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.BlockValidity,
+		Data: &ethpbv1.EventBlockValidity{
+			Slot:  slot,
+			Block: root[:],
+			Valid: valid,
+		},
+	})
+	return nil
+}
+
 // notifyForkchoiceUpdate signals execution engine on a new payload
 func (s *Service) notifyNewPayload(ctx context.Context, preState, postState state.BeaconState, blk block.SignedBeaconBlock) error {
 	if preState == nil || postState == nil {
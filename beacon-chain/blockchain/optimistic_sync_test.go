@@ -5,6 +5,9 @@ import (
 	"testing"
 	"time"
 
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	engine "github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1"
@@ -14,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
@@ -41,6 +45,7 @@ func Test_NotifyForkchoiceUpdate(t *testing.T) {
 		WithDatabase(beaconDB),
 		WithStateGen(stategen.New(beaconDB)),
 		WithForkChoiceStore(fcs),
+		WithStateNotifier(&mock.MockStateNotifier{}),
 	}
 	service, err := NewService(ctx, opts...)
 	require.NoError(t, err)
@@ -154,7 +159,7 @@ func Test_NotifyForkchoiceUpdate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			engine := &mockEngineService{forkchoiceError: tt.newForkchoiceErr}
 			service.cfg.ExecutionEngineCaller = engine
-			_, err := service.notifyForkchoiceUpdate(ctx, tt.blk, tt.finalizedRoot)
+			_, err := service.notifyForkchoiceUpdate(ctx, tt.blk, [32]byte{}, tt.finalizedRoot)
 			if tt.errString != "" {
 				require.ErrorContains(t, tt.errString, err)
 			} else {
@@ -164,6 +169,85 @@ func Test_NotifyForkchoiceUpdate(t *testing.T) {
 	}
 }
 
+func Test_NotifyForkchoiceUpdate_BlockValidity(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	altairBlk, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlockAltair())
+	require.NoError(t, err)
+	altairBlkRoot, err := altairBlk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, altairBlk))
+
+	newOptimisticBlock := func(slot types.Slot) (block.BeaconBlock, [32]byte) {
+		b, err := wrapper.WrappedBeaconBlock(&ethpb.BeaconBlockBellatrix{
+			Slot: slot,
+			Body: &ethpb.BeaconBlockBodyBellatrix{
+				ExecutionPayload: &v1.ExecutionPayload{BlockHash: bytesutil.PadTo([]byte{byte(slot)}, fieldparams.RootLength)},
+			},
+		})
+		require.NoError(t, err)
+		return b, [32]byte{byte(slot)}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		fcs := protoarray.New(0, 0, [32]byte{'a'})
+		notifier := &mock.MockStateNotifier{RecordEvents: true}
+		service, err := NewService(ctx,
+			WithDatabase(beaconDB),
+			WithStateGen(stategen.New(beaconDB)),
+			WithForkChoiceStore(fcs),
+			WithStateNotifier(notifier),
+		)
+		require.NoError(t, err)
+		service.cfg.ExecutionEngineCaller = &mockEngineService{}
+
+		blk, root := newOptimisticBlock(1)
+		require.NoError(t, fcs.ProcessBlock(ctx, blk.Slot(), root, [32]byte{'a'}, 0, 0, true))
+		optimistic, err := fcs.IsOptimistic(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, true, optimistic)
+
+		_, err = service.notifyForkchoiceUpdate(ctx, blk, root, altairBlkRoot)
+		require.NoError(t, err)
+		optimistic, err = fcs.IsOptimistic(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, false, optimistic)
+
+		events := notifier.ReceivedEvents()
+		require.Equal(t, 1, len(events))
+		require.Equal(t, statefeed.BlockValidity, int(events[0].Type))
+		require.DeepEqual(t, &ethpbv1.EventBlockValidity{Slot: 1, Block: root[:], Valid: true}, events[0].Data)
+
+		// The block is no longer optimistic, so no further event is sent.
+		_, err = service.notifyForkchoiceUpdate(ctx, blk, root, altairBlkRoot)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(notifier.ReceivedEvents()))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		fcs := protoarray.New(0, 0, [32]byte{'a'})
+		notifier := &mock.MockStateNotifier{RecordEvents: true}
+		service, err := NewService(ctx,
+			WithDatabase(beaconDB),
+			WithStateGen(stategen.New(beaconDB)),
+			WithForkChoiceStore(fcs),
+			WithStateNotifier(notifier),
+		)
+		require.NoError(t, err)
+		service.cfg.ExecutionEngineCaller = &mockEngineService{forkchoiceError: engine.ErrInvalidPayloadStatus}
+
+		blk, root := newOptimisticBlock(2)
+		require.NoError(t, fcs.ProcessBlock(ctx, blk.Slot(), root, [32]byte{'a'}, 0, 0, true))
+
+		_, err = service.notifyForkchoiceUpdate(ctx, blk, root, altairBlkRoot)
+		require.ErrorContains(t, "payload status is INVALID", err)
+
+		events := notifier.ReceivedEvents()
+		require.Equal(t, 1, len(events))
+		require.Equal(t, statefeed.BlockValidity, int(events[0].Type))
+		require.DeepEqual(t, &ethpbv1.EventBlockValidity{Slot: 2, Block: root[:], Valid: false}, events[0].Data)
+	})
+}
+
 func Test_NotifyNewPayload(t *testing.T) {
 	cfg := params.BeaconConfig()
 	cfg.TerminalTotalDifficulty = "2"
@@ -98,6 +98,7 @@ func (s *Service) OnAttestation(ctx context.Context, a *ethpb.Attestation) error
 
 	// Update forkchoice store with the new attestation for updating weight.
 	s.cfg.ForkChoiceStore.ProcessAttestation(ctx, indexedAtt.AttestingIndices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
+	s.recordAttestersLiveness(a.Data.Target.Epoch, indexedAtt.AttestingIndices)
 
 	return nil
 }
@@ -176,6 +176,17 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 		log.WithError(err).Warn("Could not update head")
 	}
 
+	// Light client data is only computed for canonical blocks, and in the background as it is not
+	// needed by the rest of block processing.
+	if features.Get().EnableLightClientServer && s.headRoot() == blockRoot {
+		justified, finalized := postState.CurrentJustifiedCheckpoint(), postState.FinalizedCheckpoint()
+		go func() {
+			if err := s.saveLightClientData(s.ctx, signed, justified, finalized); err != nil {
+				log.WithError(err).Error("Could not save light client data")
+			}
+		}()
+	}
+
 	if err := s.pruneCanonicalAttsFromPool(ctx, blockRoot, signed); err != nil {
 		return err
 	}
@@ -436,7 +447,9 @@ func (s *Service) insertBlockAndAttestationsToForkChoiceStore(ctx context.Contex
 			return err
 		}
 		s.cfg.ForkChoiceStore.ProcessAttestation(ctx, indices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
+		s.recordAttestersLiveness(a.Data.Target.Epoch, indices)
 	}
+	s.recordLiveness(slots.ToEpoch(blk.Slot()), blk.ProposerIndex())
 	return nil
 }
 
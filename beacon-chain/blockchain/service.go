@@ -66,6 +66,13 @@ type Service struct {
 	justifiedBalances     *stateBalanceCache
 	wsVerifier            *WeakSubjectivityVerifier
 	store                 *store.Store
+	lightClientDataLock   sync.Mutex
+	lightClientLock       sync.RWMutex
+	finalityUpdate        *ethpb.LightClientFinalityUpdate
+	optimisticUpdate      *ethpb.LightClientOptimisticUpdate
+	livenessLock          sync.RWMutex
+	liveness              map[types.Epoch]map[types.ValidatorIndex]bool
+	livenessStartEpoch    *types.Epoch
 }
 
 // config options for the service.
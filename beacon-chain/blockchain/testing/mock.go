@@ -61,6 +61,8 @@ type ChainService struct {
 	SyncCommitteePubkeys        [][]byte
 	Genesis                     time.Time
 	ForkChoiceStore             forkchoice.ForkChoicer
+	FinalityUpdate              *ethpb.LightClientFinalityUpdate
+	OptimisticUpdate            *ethpb.LightClientOptimisticUpdate
 }
 
 // ForkChoicer mocks the same method in the chain service
@@ -450,3 +452,13 @@ func (s *ChainService) IsOptimistic(_ context.Context) (bool, error) {
 func (s *ChainService) IsOptimisticForRoot(_ context.Context, _ [32]byte) (bool, error) {
 	return s.Optimistic, nil
 }
+
+// LightClientFinalityUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	return s.FinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	return s.OptimisticUpdate
+}
@@ -0,0 +1,5 @@
+package builder
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "builder")
@@ -0,0 +1,22 @@
+package builder
+
+import "github.com/prysmaticlabs/prysm/api/client/builder"
+
+// Option for the builder service.
+type Option func(s *Service) error
+
+// WithBuilderEndpoint sets the endpoint of the external block builder (or MEV relay).
+func WithBuilderEndpoint(endpoint string) Option {
+	return func(s *Service) error {
+		s.cfg.builderEndpoint = endpoint
+		return nil
+	}
+}
+
+// WithBuilderClient sets the client used to reach the builder, instead of creating one from the endpoint.
+func WithBuilderClient(c builder.BuilderClient) Option {
+	return func(s *Service) error {
+		s.c = c
+		return nil
+	}
+}
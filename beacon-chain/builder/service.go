@@ -0,0 +1,129 @@
+package builder
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/api/client/builder"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ErrNoBuilder is returned when the beacon node was not configured with an external block builder.
+var ErrNoBuilder = errors.New("builder endpoint not configured")
+
+// BlockBuilder defines the interface for interacting with an external block builder, such as an MEV relay.
+type BlockBuilder interface {
+	SubmitBlindedBlock(ctx context.Context, block *ethpb.SignedBlindedBeaconBlockBellatrix) (*enginev1.ExecutionPayload, error)
+	GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error)
+	RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error
+	Configured() bool
+}
+
+type config struct {
+	builderEndpoint string
+}
+
+// Service defines a service that provides a client for interacting with the beacon chain and an external
+// block builder.
+type Service struct {
+	cfg    *config
+	c      builder.BuilderClient
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+var _ = BlockBuilder(&Service{})
+
+// NewService instantiates a new builder service.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    &config{},
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+	if s.c == nil && s.cfg.builderEndpoint != "" {
+		c, err := builder.NewClient(s.cfg.builderEndpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create builder client")
+		}
+		s.c = c
+	}
+	return s, nil
+}
+
+// Start initializes the service and logs whether the configured builder is reachable.
+func (s *Service) Start() {
+	if s.c == nil {
+		return
+	}
+	if err := s.c.Status(s.ctx); err != nil {
+		log.WithError(err).WithField("endpoint", s.c.NodeURL()).Error(
+			"Could not reach the builder, block proposals will fall back to the local execution client")
+		return
+	}
+	log.WithField("endpoint", s.c.NodeURL()).Info("Connected to the builder")
+}
+
+// Stop halts the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns nil. An unreachable builder is not an error, as proposals fall back to local payloads.
+func (*Service) Status() error {
+	return nil
+}
+
+// SubmitBlindedBlock submits a signed blinded block to the builder, and returns the execution payload
+// revealed in exchange.
+func (s *Service) SubmitBlindedBlock(ctx context.Context, b *ethpb.SignedBlindedBeaconBlockBellatrix) (*enginev1.ExecutionPayload, error) {
+	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
+	defer span.End()
+	if s.c == nil {
+		return nil, ErrNoBuilder
+	}
+	return s.c.SubmitBlindedBlock(ctx, b)
+}
+
+// GetHeader retrieves the header of the builder's best execution payload for a given slot, parent hash and
+// proposer public key.
+func (s *Service) GetHeader(ctx context.Context, slot types.Slot, parentHash [32]byte, pubKey [48]byte) (*ethpb.SignedBuilderBid, error) {
+	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
+	defer span.End()
+	if s.c == nil {
+		return nil, ErrNoBuilder
+	}
+	return s.c.GetHeader(ctx, slot, parentHash, pubKey)
+}
+
+// RegisterValidator registers the given validators with the builder.
+func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
+	defer span.End()
+	if s.c == nil {
+		return ErrNoBuilder
+	}
+	if err := s.c.RegisterValidator(ctx, reg); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"count": len(reg),
+	}).Debug("Registered validators with the builder")
+	return nil
+}
+
+// Configured returns true if the user has configured a builder endpoint.
+func (s *Service) Configured() bool {
+	return s.c != nil
+}
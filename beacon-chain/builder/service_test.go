@@ -0,0 +1,53 @@
+package builder
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_NotConfigured(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, s.Configured())
+
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.ErrorIs(t, err, ErrNoBuilder)
+	_, err = s.SubmitBlindedBlock(ctx, nil)
+	require.ErrorIs(t, err, ErrNoBuilder)
+	require.ErrorIs(t, s.RegisterValidator(ctx, nil), ErrNoBuilder)
+}
+
+func TestService_Start(t *testing.T) {
+	hook := logTest.NewGlobal()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/builder/status", r.URL.Path)
+	}))
+	defer srv.Close()
+
+	s, err := NewService(context.Background(), WithBuilderEndpoint(srv.URL))
+	require.NoError(t, err)
+	require.Equal(t, true, s.Configured())
+	s.Start()
+	require.LogsContain(t, hook, "Connected to the builder")
+	require.NoError(t, s.Stop())
+}
+
+func TestService_Start_Unreachable(t *testing.T) {
+	hook := logTest.NewGlobal()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s, err := NewService(context.Background(), WithBuilderEndpoint(srv.URL))
+	require.NoError(t, err)
+	s.Start()
+	require.LogsContain(t, hook, "Could not reach the builder")
+	require.NoError(t, s.Status())
+}
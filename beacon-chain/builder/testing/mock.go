@@ -0,0 +1,41 @@
+package testing
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// MockBuilderService is a mock builder.
+type MockBuilderService struct {
+	HasConfigured         bool
+	Payload               *enginev1.ExecutionPayload
+	ErrSubmitBlindedBlock error
+	Bid                   *ethpb.SignedBuilderBid
+	ErrGetHeader          error
+	ErrRegisterValidator  error
+	Registrations         []*ethpb.SignedValidatorRegistrationV1
+}
+
+// Configured for mocking.
+func (s *MockBuilderService) Configured() bool {
+	return s.HasConfigured
+}
+
+// SubmitBlindedBlock for mocking.
+func (s *MockBuilderService) SubmitBlindedBlock(context.Context, *ethpb.SignedBlindedBeaconBlockBellatrix) (*enginev1.ExecutionPayload, error) {
+	return s.Payload, s.ErrSubmitBlindedBlock
+}
+
+// GetHeader for mocking.
+func (s *MockBuilderService) GetHeader(context.Context, types.Slot, [32]byte, [48]byte) (*ethpb.SignedBuilderBid, error) {
+	return s.Bid, s.ErrGetHeader
+}
+
+// RegisterValidator for mocking.
+func (s *MockBuilderService) RegisterValidator(_ context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	s.Registrations = append(s.Registrations, reg...)
+	return s.ErrRegisterValidator
+}
@@ -0,0 +1,61 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+)
+
+// Initializer describes a type that is able to obtain the checkpoint sync data (BeaconState and SignedBeaconBlock)
+// in some way and perform database setup to prepare the beacon node for syncing from the given checkpoint.
+type Initializer interface {
+	Initialize(ctx context.Context, d iface.HeadAccessDatabase) error
+}
+
+// defaultTimeout is generous because the finalized state can be hundreds of MB on mainnet.
+const defaultTimeout = 5 * time.Minute
+
+// APIInitializer manages initializing the beacon node using checkpoint sync, retrieving the checkpoint state and block
+// from the remote beacon node api.
+type APIInitializer struct {
+	c *beacon.Client
+}
+
+// NewAPIInitializer creates an APIInitializer, handling the set up of a beacon node api client
+// using the provided host string.
+func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
+	c, err := beacon.NewClient(beaconNodeHost, beacon.WithTimeout(defaultTimeout))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", beaconNodeHost)
+	}
+	return &APIInitializer{c: c}, nil
+}
+
+// Initialize downloads origin state and block for checkpoint sync and initializes database records to
+// prepare the node to begin syncing from that point. If the database already has an origin block root,
+// checkpoint sync has already been performed and Initialize is a no-op.
+func (dl *APIInitializer) Initialize(ctx context.Context, d iface.HeadAccessDatabase) error {
+	origin, err := d.OriginBlockRoot(ctx)
+	if err == nil {
+		log.WithField("root", fmt.Sprintf("%#x", origin)).Info("Origin checkpoint found in db, skipping checkpoint sync")
+		return nil
+	}
+	if !errors.Is(err, db.ErrNotFound) {
+		return errors.Wrap(err, "error while checking database for origin root")
+	}
+	od, err := beacon.DownloadOriginData(ctx, dl.c)
+	if err != nil {
+		return errors.Wrap(err, "error retrieving checkpoint origin state and block")
+	}
+	if err := d.SaveOrigin(ctx, bytes.NewReader(od.StateBytes), bytes.NewReader(od.BlockBytes)); err != nil {
+		return errors.Wrap(err, "could not save checkpoint origin state and block")
+	}
+	log.WithField("root", fmt.Sprintf("%#x", od.BlockRoot)).Info("Database initialized from checkpoint sync origin")
+	return nil
+}
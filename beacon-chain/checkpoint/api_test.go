@@ -0,0 +1,103 @@
+package checkpoint
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func checkpointServer(t *testing.T) (*httptest.Server, [32]byte) {
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	slot := params.BeaconConfig().SlotsPerEpoch * 2
+	require.NoError(t, st.SetSlot(slot))
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.Slot = slot
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	sb, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	bb, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v2/debug/beacon/states/finalized", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Eth-Consensus-Version", "altair")
+		_, err := w.Write(sb)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/eth/v2/beacon/blocks/"+string(beacon.IdFromRoot(root)), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Eth-Consensus-Version", "altair")
+		_, err := w.Write(bb)
+		require.NoError(t, err)
+	})
+	return httptest.NewServer(mux), root
+}
+
+func TestAPIInitializer_Initialize(t *testing.T) {
+	ctx := context.Background()
+	srv, root := checkpointServer(t)
+	defer srv.Close()
+	d := dbtest.SetupDB(t)
+
+	dl, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, dl.Initialize(ctx, d))
+
+	origin, err := d.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, root, origin)
+	require.Equal(t, true, d.HasBlock(ctx, root))
+	require.Equal(t, true, d.HasState(ctx, root))
+	cp, err := d.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, root[:], cp.Root)
+	require.Equal(t, params.BeaconConfig().SlotsPerEpoch*2, params.BeaconConfig().SlotsPerEpoch.Mul(uint64(cp.Epoch)))
+}
+
+func TestAPIInitializer_Initialize_ExistingOrigin(t *testing.T) {
+	ctx := context.Background()
+	srv, root := checkpointServer(t)
+	d := dbtest.SetupDB(t)
+	dl, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, dl.Initialize(ctx, d))
+	srv.Close()
+
+	// The server is gone, so any further download attempt would fail.
+	require.NoError(t, dl.Initialize(ctx, d))
+	origin, err := d.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, root, origin)
+}
+
+func TestAPIInitializer_Initialize_DownloadError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	dl, err := NewAPIInitializer(srv.URL)
+	require.NoError(t, err)
+	err = dl.Initialize(context.Background(), dbtest.SetupDB(t))
+	require.ErrorContains(t, "error retrieving checkpoint origin state and block", err)
+}
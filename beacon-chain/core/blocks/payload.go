@@ -60,13 +60,43 @@ func ExecutionBlock(body block.BeaconBlockBody) (bool, error) {
 	payload, err := body.ExecutionPayload()
 	if err != nil {
 		if strings.HasPrefix(err.Error(), "ExecutionPayload is not supported in") {
-			return false, nil
+			return blindedExecutionBlock(body)
 		}
 		return false, err
 	}
 	return !isEmptyPayload(payload), nil
 }
 
+// blindedExecutionBlock returns whether a blinded block has a non-empty ExecutionPayloadHeader.
+// Bodies that carry neither a payload nor a header are not execution blocks.
+func blindedExecutionBlock(body block.BeaconBlockBody) (bool, error) {
+	header, err := body.ExecutionPayloadHeader()
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "ExecutionPayloadHeader is not supported in") {
+			return false, nil
+		}
+		return false, err
+	}
+	return !isEmptyHeader(header), nil
+}
+
+// ExecutionBlockHash returns the execution block hash of the payload of a block, or of its
+// payload header when the block is blinded.
+func ExecutionBlockHash(body block.BeaconBlockBody) ([]byte, error) {
+	payload, err := body.ExecutionPayload()
+	if err == nil {
+		return payload.BlockHash, nil
+	}
+	if !strings.HasPrefix(err.Error(), "ExecutionPayload is not supported in") {
+		return nil, err
+	}
+	header, err := body.ExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	return header.BlockHash, nil
+}
+
 // ExecutionEnabled returns true if the beacon chain can begin executing.
 // Meaning the payload header is beacon state is non-empty or the payload in block body is non-empty.
 //
@@ -188,6 +218,64 @@ func ProcessPayload(st state.BeaconState, payload *enginev1.ExecutionPayload) (s
 	return st, nil
 }
 
+// ValidatePayloadHeaderWhenMergeCompletes validates the payload header of a blinded block
+// against the beacon state. Like ValidatePayloadWhenMergeCompletes, it only applies post merge.
+func ValidatePayloadHeaderWhenMergeCompletes(st state.BeaconState, header *ethpb.ExecutionPayloadHeader) error {
+	complete, err := MergeTransitionComplete(st)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		return nil
+	}
+
+	latest, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(header.ParentHash, latest.BlockHash) {
+		return errors.New("incorrect block hash")
+	}
+	return nil
+}
+
+// ValidatePayloadHeader validates the prev randao and timestamp of a blinded block's
+// payload header against the beacon state.
+func ValidatePayloadHeader(st state.BeaconState, header *ethpb.ExecutionPayloadHeader) error {
+	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(header.PrevRandao, random) {
+		return errors.New("incorrect prev randao")
+	}
+	t, err := slots.ToTime(st.GenesisTime(), st.Slot())
+	if err != nil {
+		return err
+	}
+	if header.Timestamp != uint64(t.Unix()) {
+		return errors.New("incorrect timestamp")
+	}
+	return nil
+}
+
+// ProcessPayloadHeader processes the execution payload header of a blinded block. It performs the
+// same checks as ProcessPayload and caches the header in the state, without access to the
+// transactions of the full payload.
+func ProcessPayloadHeader(st state.BeaconState, header *ethpb.ExecutionPayloadHeader) (state.BeaconState, error) {
+	if err := ValidatePayloadHeaderWhenMergeCompletes(st, header); err != nil {
+		return nil, err
+	}
+	if err := ValidatePayloadHeader(st, header); err != nil {
+		return nil, err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(header); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
 // PayloadToHeader converts `payload` into execution payload header format.
 func PayloadToHeader(payload *enginev1.ExecutionPayload) (*ethpb.ExecutionPayloadHeader, error) {
 	txRoot, err := ssz.TransactionsRoot(payload.Transactions)
@@ -384,6 +384,30 @@ func Test_IsExecutionBlock(t *testing.T) {
 	}
 }
 
+func Test_ExecutionBlockHash(t *testing.T) {
+	hash := bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength)
+	blk := util.NewBeaconBlockBellatrix()
+	blk.Block.Body.ExecutionPayload.BlockHash = hash
+	wrappedBlock, err := wrapper.WrappedBellatrixBeaconBlock(blk.Block)
+	require.NoError(t, err)
+	got, err := blocks.ExecutionBlockHash(wrappedBlock.Body())
+	require.NoError(t, err)
+	require.DeepEqual(t, hash, got)
+
+	blinded := util.NewBlindedBeaconBlockBellatrix()
+	blinded.Block.Body.ExecutionPayloadHeader.BlockHash = hash
+	wrappedBlinded, err := wrapper.WrappedBlindedBeaconBlockBellatrix(blinded.Block)
+	require.NoError(t, err)
+	got, err = blocks.ExecutionBlockHash(wrappedBlinded.Body())
+	require.NoError(t, err)
+	require.DeepEqual(t, hash, got)
+
+	wrappedAltair, err := wrapper.WrappedAltairBeaconBlock(util.NewBeaconBlockAltair().Block)
+	require.NoError(t, err)
+	_, err = blocks.ExecutionBlockHash(wrappedAltair.Body())
+	require.ErrorContains(t, "not supported", err)
+}
+
 func Test_ExecutionEnabled(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -606,6 +630,71 @@ func Test_ProcessPayload(t *testing.T) {
 	}
 }
 
+func Test_ProcessPayloadHeader(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
+	require.NoError(t, err)
+	ts, err := slots.ToTime(st.GenesisTime(), st.Slot())
+	require.NoError(t, err)
+	tests := []struct {
+		name   string
+		header *ethpb.ExecutionPayloadHeader
+		err    error
+	}{
+		{
+			name: "process passes",
+			header: func() *ethpb.ExecutionPayloadHeader {
+				h := emptyPayloadHeader()
+				h.PrevRandao = random
+				h.Timestamp = uint64(ts.Unix())
+				return h
+			}(), err: nil,
+		},
+		{
+			name:   "incorrect prev randao",
+			header: emptyPayloadHeader(),
+			err:    errors.New("incorrect prev randao"),
+		},
+		{
+			name: "incorrect timestamp",
+			header: func() *ethpb.ExecutionPayloadHeader {
+				h := emptyPayloadHeader()
+				h.PrevRandao = random
+				h.Timestamp = 1
+				return h
+			}(),
+			err: errors.New("incorrect timestamp"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, err := blocks.ProcessPayloadHeader(st, tt.header)
+			if err != nil {
+				require.Equal(t, tt.err.Error(), err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+				got, err := st.LatestExecutionPayloadHeader()
+				require.NoError(t, err)
+				require.DeepSSZEqual(t, tt.header, got)
+			}
+		})
+	}
+}
+
+func Test_IsExecutionBlock_Blinded(t *testing.T) {
+	blk := util.HydrateBlindedBeaconBlockBellatrix(&ethpb.BlindedBeaconBlockBellatrix{})
+	wrappedBlock, err := wrapper.WrappedBlindedBeaconBlockBellatrix(blk)
+	require.NoError(t, err)
+	got, err := blocks.ExecutionBlock(wrappedBlock.Body())
+	require.NoError(t, err)
+	require.Equal(t, false, got)
+
+	blk.Body.ExecutionPayloadHeader.ParentHash = bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength)
+	got, err = blocks.ExecutionBlock(wrappedBlock.Body())
+	require.NoError(t, err)
+	require.Equal(t, true, got)
+}
+
 func Test_PayloadToHeader(t *testing.T) {
 	p := emptyPayload()
 	h, err := blocks.PayloadToHeader(p)
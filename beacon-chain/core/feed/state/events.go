@@ -26,6 +26,16 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// PayloadAttributes is sent when the node asks the execution engine to build a payload for a proposal.
+	PayloadAttributes
+	// BlockValidity is sent when an optimistically imported block is found valid or invalid by the execution engine.
+	BlockValidity
+	// LightClientFinalityUpdate is sent when a processed block produces a newer light client finality update.
+	LightClientFinalityUpdate
+	// LightClientOptimisticUpdate is sent when a processed block produces a newer light client optimistic update.
+	LightClientOptimisticUpdate
+	// ActiveValidatorCount is sent when the head of the chain enters a new epoch.
+	ActiveValidatorCount
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -59,3 +69,11 @@ type InitializedData struct {
 	// GenesisValidatorsRoot represents state.validators.HashTreeRoot().
 	GenesisValidatorsRoot []byte
 }
+
+// ActiveValidatorCountData is the data sent with ActiveValidatorCount events.
+type ActiveValidatorCountData struct {
+	// Epoch of the head state.
+	Epoch types.Epoch
+	// Count is the number of validators active in the epoch of the head state.
+	Count uint64
+}
@@ -0,0 +1,426 @@
+// Package lightclient builds the data served by a beacon node to light clients, as defined
+// in the Altair light client sync protocol.
+package lightclient
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+const (
+	// SyncCommitteeBranchDepth is the length of the Merkle proofs of the sync committees
+	// of a beacon state, floorlog2(NEXT_SYNC_COMMITTEE_INDEX).
+	SyncCommitteeBranchDepth = 5
+	// FinalityBranchDepth is the length of the Merkle proof of the finalized checkpoint root
+	// of a beacon state, floorlog2(FINALIZED_ROOT_INDEX).
+	FinalityBranchDepth = 6
+)
+
+// ErrNotEnoughParticipants is returned when a block's sync aggregate has fewer participants
+// than required for light clients to accept its signature.
+var ErrNotEnoughParticipants = errors.New("not enough sync committee participants")
+
+// SyncCommitteePeriod returns the sync committee period of a slot.
+//
+// Spec code:
+// def compute_sync_committee_period_at_slot(slot: Slot) -> uint64:
+//    return compute_sync_committee_period(compute_epoch_at_slot(slot))
+func SyncCommitteePeriod(slot types.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// NewLightClientBootstrap creates the light client bootstrap of a block from the block's post state.
+//
+// Spec code:
+// def create_light_client_bootstrap(state: BeaconState) -> LightClientBootstrap:
+//    assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+//    assert state.slot == state.latest_block_header.slot
+//
+//    return LightClientBootstrap(
+//        header=BeaconBlockHeader(
+//            slot=state.latest_block_header.slot,
+//            proposer_index=state.latest_block_header.proposer_index,
+//            parent_root=state.latest_block_header.parent_root,
+//            state_root=hash_tree_root(state),
+//            body_root=state.latest_block_header.body_root,
+//        ),
+//        current_sync_committee=state.current_sync_committee,
+//        current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX)
+//    )
+func NewLightClientBootstrap(ctx context.Context, st state.BeaconState, blk block.SignedBeaconBlock) (*ethpb.LightClientBootstrap, error) {
+	if st.Version() == version.Phase0 {
+		return nil, errors.New("light client bootstrap is not supported before Altair")
+	}
+	if st.Slot() != blk.Block().Slot() {
+		return nil, errors.Errorf("state slot %d does not match block slot %d", st.Slot(), blk.Block().Slot())
+	}
+	header, err := blockHeader(blk)
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+	if !bytes.Equal(stateRoot[:], header.StateRoot) {
+		return nil, errors.New("state is not the post state of the block")
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee")
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current sync committee proof")
+	}
+	return &ethpb.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}, nil
+}
+
+// NewLightClientUpdate creates the light client update signed by the sync aggregate of a block.
+// The attested block is the parent of the block and the attested state is its post state. The
+// finalized block is the block of the finalized checkpoint of the attested state, or nil if it
+// is not known.
+//
+// Spec code:
+// def create_light_client_update(state: BeaconState,
+//                                block: SignedBeaconBlock,
+//                                attested_state: BeaconState,
+//                                finalized_block: Optional[SignedBeaconBlock]) -> LightClientUpdate:
+//    assert compute_epoch_at_slot(attested_state.slot) >= ALTAIR_FORK_EPOCH
+//    assert sum(block.message.body.sync_aggregate.sync_committee_bits) >= MIN_SYNC_COMMITTEE_PARTICIPANTS
+//
+//    assert state.slot == state.latest_block_header.slot
+//    header = state.latest_block_header.copy()
+//    header.state_root = hash_tree_root(state)
+//    assert hash_tree_root(header) == hash_tree_root(block.message)
+//    update_signature_period = compute_sync_committee_period_at_slot(block.message.slot)
+//
+//    assert attested_state.slot == attested_state.latest_block_header.slot
+//    attested_header = attested_state.latest_block_header.copy()
+//    attested_header.state_root = hash_tree_root(attested_state)
+//    assert hash_tree_root(attested_header) == block.message.parent_root
+//    update_attested_period = compute_sync_committee_period_at_slot(attested_header.slot)
+//
+//    # `next_sync_committee` is only useful if the message is signed by the current sync committee
+//    if update_attested_period == update_signature_period:
+//        next_sync_committee = attested_state.next_sync_committee
+//        next_sync_committee_branch = compute_merkle_proof_for_state(attested_state, NEXT_SYNC_COMMITTEE_INDEX)
+//    else:
+//        next_sync_committee = SyncCommittee()
+//        next_sync_committee_branch = [Bytes32() for _ in range(floorlog2(NEXT_SYNC_COMMITTEE_INDEX))]
+//
+//    # Indicate finality whenever possible
+//    if finalized_block is not None:
+//        if finalized_block.message.slot != GENESIS_SLOT:
+//            finalized_header = BeaconBlockHeader(
+//                slot=finalized_block.message.slot,
+//                proposer_index=finalized_block.message.proposer_index,
+//                parent_root=finalized_block.message.parent_root,
+//                state_root=finalized_block.message.state_root,
+//                body_root=hash_tree_root(finalized_block.message.body),
+//            )
+//            assert hash_tree_root(finalized_header) == attested_state.finalized_checkpoint.root
+//        else:
+//            assert attested_state.finalized_checkpoint.root == Bytes32()
+//            finalized_header = BeaconBlockHeader()
+//        finality_branch = compute_merkle_proof_for_state(attested_state, FINALIZED_ROOT_INDEX)
+//    else:
+//        finalized_header = BeaconBlockHeader()
+//        finality_branch = [Bytes32() for _ in range(floorlog2(FINALIZED_ROOT_INDEX))]
+//
+//    return LightClientUpdate(
+//        attested_header=attested_header,
+//        next_sync_committee=next_sync_committee,
+//        next_sync_committee_branch=next_sync_committee_branch,
+//        finalized_header=finalized_header,
+//        finality_branch=finality_branch,
+//        sync_aggregate=block.message.body.sync_aggregate,
+//        signature_slot=block.message.slot,
+//    )
+func NewLightClientUpdate(
+	ctx context.Context,
+	blk block.SignedBeaconBlock,
+	attestedState state.BeaconState,
+	attestedBlock block.SignedBeaconBlock,
+	finalizedBlock block.SignedBeaconBlock,
+) (*ethpb.LightClientUpdate, error) {
+	if attestedState.Version() == version.Phase0 || blk.Version() == version.Phase0 {
+		return nil, errors.New("light client updates are not supported before Altair")
+	}
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil, ErrNotEnoughParticipants
+	}
+
+	attestedHeader, err := blockHeader(attestedBlock)
+	if err != nil {
+		return nil, err
+	}
+	attestedRoot, err := attestedHeader.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attested header root")
+	}
+	if attestedRoot != bytesutil.ToBytes32(blk.Block().ParentRoot()) {
+		return nil, errors.New("attested block is not the parent of the block")
+	}
+	if attestedState.Slot() != attestedHeader.Slot {
+		return nil, errors.Errorf("attested state slot %d does not match attested block slot %d", attestedState.Slot(), attestedHeader.Slot)
+	}
+	attestedStateRoot, err := attestedState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attested state root")
+	}
+	if !bytes.Equal(attestedStateRoot[:], attestedHeader.StateRoot) {
+		return nil, errors.New("attested state is not the post state of the attested block")
+	}
+
+	nextSyncCommittee := emptySyncCommittee()
+	nextSyncCommitteeBranch := emptyBranch(SyncCommitteeBranchDepth)
+	if SyncCommitteePeriod(attestedHeader.Slot) == SyncCommitteePeriod(blk.Block().Slot()) {
+		nextSyncCommittee, err = attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get next sync committee")
+		}
+		nextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute next sync committee proof")
+		}
+	}
+
+	finalizedHeader := emptyHeader()
+	finalityBranch := emptyBranch(FinalityBranchDepth)
+	if finalizedBlock != nil && !finalizedBlock.IsNil() {
+		finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root)
+		if finalizedBlock.Block().Slot() != params.BeaconConfig().GenesisSlot {
+			finalizedHeader, err = blockHeader(finalizedBlock)
+			if err != nil {
+				return nil, err
+			}
+			root, err := finalizedHeader.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not compute finalized header root")
+			}
+			if root != finalizedRoot {
+				return nil, errors.New("finalized block is not the finalized checkpoint of the attested state")
+			}
+		} else if finalizedRoot != params.BeaconConfig().ZeroHash {
+			return nil, errors.New("finalized checkpoint of the attested state is not the genesis checkpoint")
+		}
+		finalityBranch, err = attestedState.FinalizedRootProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute finalized root proof")
+		}
+	}
+
+	return &ethpb.LightClientUpdate{
+		AttestedHeader:          attestedHeader,
+		NextSyncCommittee:       nextSyncCommittee,
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         finalizedHeader,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           syncAggregate,
+		SignatureSlot:           blk.Block().Slot(),
+	}, nil
+}
+
+// NewLightClientFinalityUpdate creates the finality update of a light client update.
+func NewLightClientFinalityUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientFinalityUpdate {
+	return &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+}
+
+// NewLightClientOptimisticUpdate creates the optimistic update of a light client update.
+func NewLightClientOptimisticUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+}
+
+// IsSyncCommitteeUpdate returns whether the update carries the next sync committee.
+//
+// Spec code:
+// def is_sync_committee_update(update: LightClientUpdate) -> bool:
+//    return update.next_sync_committee_branch != [Bytes32() for _ in range(floorlog2(NEXT_SYNC_COMMITTEE_INDEX))]
+func IsSyncCommitteeUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.NextSyncCommitteeBranch)
+}
+
+// IsFinalityUpdate returns whether the update carries the finalized header.
+//
+// Spec code:
+// def is_finality_update(update: LightClientUpdate) -> bool:
+//    return update.finality_branch != [Bytes32() for _ in range(floorlog2(FINALIZED_ROOT_INDEX))]
+func IsFinalityUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.FinalityBranch)
+}
+
+// HasSupermajority returns whether at least two thirds of the sync committee signed the sync aggregate.
+func HasSupermajority(syncAggregate *ethpb.SyncAggregate) bool {
+	return syncAggregate.SyncCommitteeBits.Count()*3 >= syncAggregate.SyncCommitteeBits.Len()*2
+}
+
+// IsBetterUpdate returns whether the new update is better than the old one, when both attest to
+// a header of the same sync committee period.
+//
+// Spec code:
+// def is_better_update(new_update: LightClientUpdate, old_update: LightClientUpdate) -> bool:
+//    # Compare supermajority (> 2/3) sync committee participation
+//    max_active_participants = len(new_update.sync_aggregate.sync_committee_bits)
+//    new_num_active_participants = sum(new_update.sync_aggregate.sync_committee_bits)
+//    old_num_active_participants = sum(old_update.sync_aggregate.sync_committee_bits)
+//    new_has_supermajority = new_num_active_participants * 3 >= max_active_participants * 2
+//    old_has_supermajority = old_num_active_participants * 3 >= max_active_participants * 2
+//    if new_has_supermajority != old_has_supermajority:
+//        return new_has_supermajority > old_has_supermajority
+//    if not new_has_supermajority and new_num_active_participants != old_num_active_participants:
+//        return new_num_active_participants > old_num_active_participants
+//
+//    # Compare presence of relevant sync committee
+//    new_has_relevant_sync_committee = is_sync_committee_update(new_update) and (
+//        compute_sync_committee_period_at_slot(new_update.attested_header.slot)
+//        == compute_sync_committee_period_at_slot(new_update.signature_slot)
+//    )
+//    old_has_relevant_sync_committee = is_sync_committee_update(old_update) and (
+//        compute_sync_committee_period_at_slot(old_update.attested_header.slot)
+//        == compute_sync_committee_period_at_slot(old_update.signature_slot)
+//    )
+//    if new_has_relevant_sync_committee != old_has_relevant_sync_committee:
+//        return new_has_relevant_sync_committee
+//
+//    # Compare indication of any finality
+//    new_has_finality = is_finality_update(new_update)
+//    old_has_finality = is_finality_update(old_update)
+//    if new_has_finality != old_has_finality:
+//        return new_has_finality
+//
+//    # Compare sync committee finality
+//    if new_has_finality:
+//        new_has_sync_committee_finality = (
+//            compute_sync_committee_period_at_slot(new_update.finalized_header.slot)
+//            == compute_sync_committee_period_at_slot(new_update.attested_header.slot)
+//        )
+//        old_has_sync_committee_finality = (
+//            compute_sync_committee_period_at_slot(old_update.finalized_header.slot)
+//            == compute_sync_committee_period_at_slot(old_update.attested_header.slot)
+//        )
+//        if new_has_sync_committee_finality != old_has_sync_committee_finality:
+//            return new_has_sync_committee_finality
+//
+//    # Tiebreaker 1: Sync committee participation beyond supermajority
+//    if new_num_active_participants != old_num_active_participants:
+//        return new_num_active_participants > old_num_active_participants
+//
+//    # Tiebreaker 2: Prefer older data (fewer changes to best)
+//    if new_update.attested_header.slot != old_update.attested_header.slot:
+//        return new_update.attested_header.slot < old_update.attested_header.slot
+//    return new_update.signature_slot < old_update.signature_slot
+func IsBetterUpdate(newUpdate, oldUpdate *ethpb.LightClientUpdate) bool {
+	newParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newHasSupermajority := HasSupermajority(newUpdate.SyncAggregate)
+	oldHasSupermajority := HasSupermajority(oldUpdate.SyncAggregate)
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	newHasRelevantSyncCommittee := IsSyncCommitteeUpdate(newUpdate) &&
+		SyncCommitteePeriod(newUpdate.AttestedHeader.Slot) == SyncCommitteePeriod(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := IsSyncCommitteeUpdate(oldUpdate) &&
+		SyncCommitteePeriod(oldUpdate.AttestedHeader.Slot) == SyncCommitteePeriod(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	newHasFinality := IsFinalityUpdate(newUpdate)
+	oldHasFinality := IsFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+	if newHasFinality {
+		newHasSyncCommitteeFinality := SyncCommitteePeriod(newUpdate.FinalizedHeader.Slot) == SyncCommitteePeriod(newUpdate.AttestedHeader.Slot)
+		oldHasSyncCommitteeFinality := SyncCommitteePeriod(oldUpdate.FinalizedHeader.Slot) == SyncCommitteePeriod(oldUpdate.AttestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func blockHeader(blk block.SignedBeaconBlock) (*ethpb.BeaconBlockHeader, error) {
+	header, err := blk.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header")
+	}
+	return header.Header, nil
+}
+
+func emptyBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, 32)
+	}
+	return branch
+}
+
+func isEmptyBranch(branch [][]byte) bool {
+	for _, node := range branch {
+		if !bytes.Equal(node, params.BeaconConfig().ZeroHash[:]) {
+			return false
+		}
+	}
+	return true
+}
+
+func emptyHeader() *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+}
+
+func emptySyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}
+}
@@ -0,0 +1,252 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// newAltairBlock returns a signed Altair block at the given slot, on top of the parent root,
+// with a sync aggregate signed by the given number of participants.
+func newAltairBlock(t *testing.T, slot types.Slot, parentRoot, stateRoot []byte, participants uint64) block.SignedBeaconBlock {
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = slot
+	if parentRoot != nil {
+		b.Block.ParentRoot = parentRoot
+	}
+	if stateRoot != nil {
+		b.Block.StateRoot = stateRoot
+	}
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	b.Block.Body.SyncAggregate.SyncCommitteeBits = bits
+	blk, err := wrapper.WrappedAltairSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
+
+// newAttestedState returns an Altair state at the given slot which finalized the given block,
+// together with the block it is the post state of.
+func newAttestedState(t *testing.T, slot types.Slot, finalized block.SignedBeaconBlock) (state.BeaconState, block.SignedBeaconBlock) {
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, st.SetSlot(slot))
+	if finalized != nil {
+		root, err := finalized.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: root[:]}))
+	}
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	return st, newAltairBlock(t, slot, nil, stateRoot[:], 0)
+}
+
+func TestNewLightClientUpdate(t *testing.T) {
+	ctx := context.Background()
+	finalized := newAltairBlock(t, 32, nil, nil, 0)
+	attestedState, attested := newAttestedState(t, 100, finalized)
+	attestedRoot, err := attested.Block().HashTreeRoot()
+	require.NoError(t, err)
+	blk := newAltairBlock(t, 101, attestedRoot[:], nil, 400)
+
+	update, err := NewLightClientUpdate(ctx, blk, attestedState, attested, finalized)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(101), update.SignatureSlot)
+	assert.Equal(t, types.Slot(100), update.AttestedHeader.Slot)
+	headerRoot, err := update.AttestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, attestedRoot, headerRoot)
+	assert.Equal(t, uint64(400), update.SyncAggregate.SyncCommitteeBits.Count())
+
+	stateRoot, err := attestedState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	nextSyncCommittee, err := attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, nextSyncCommittee, update.NextSyncCommittee)
+	committeeRoot, err := update.NextSyncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, true, trie.VerifyMerkleProof(stateRoot[:], committeeRoot[:], v2.NextSyncCommitteeGeneralizedIndex(), update.NextSyncCommitteeBranch))
+	assert.Equal(t, true, IsSyncCommitteeUpdate(update))
+
+	finalizedRoot, err := finalized.Block().HashTreeRoot()
+	require.NoError(t, err)
+	finalizedHeaderRoot, err := update.FinalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, finalizedRoot, finalizedHeaderRoot)
+	assert.Equal(t, FinalityBranchDepth, len(update.FinalityBranch))
+	assert.Equal(t, true, trie.VerifyMerkleProof(stateRoot[:], finalizedRoot[:], v2.FinalizedRootGeneralizedIndex(), update.FinalityBranch))
+	assert.Equal(t, true, IsFinalityUpdate(update))
+
+	finalityUpdate := NewLightClientFinalityUpdate(update)
+	assert.DeepEqual(t, update.FinalizedHeader, finalityUpdate.FinalizedHeader)
+	assert.Equal(t, update.SignatureSlot, finalityUpdate.SignatureSlot)
+	optimisticUpdate := NewLightClientOptimisticUpdate(update)
+	assert.DeepEqual(t, update.AttestedHeader, optimisticUpdate.AttestedHeader)
+	assert.DeepEqual(t, update.SyncAggregate, optimisticUpdate.SyncAggregate)
+}
+
+func TestNewLightClientUpdate_UnknownFinalizedBlock(t *testing.T) {
+	attestedState, attested := newAttestedState(t, 100, nil)
+	attestedRoot, err := attested.Block().HashTreeRoot()
+	require.NoError(t, err)
+	blk := newAltairBlock(t, 101, attestedRoot[:], nil, 1)
+
+	update, err := NewLightClientUpdate(context.Background(), blk, attestedState, attested, nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, IsFinalityUpdate(update))
+	assert.Equal(t, FinalityBranchDepth, len(update.FinalityBranch))
+	assert.Equal(t, types.Slot(0), update.FinalizedHeader.Slot)
+}
+
+func TestNewLightClientUpdate_SignedInNextPeriod(t *testing.T) {
+	periodStart := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	attestedState, attested := newAttestedState(t, periodStart-1, nil)
+	attestedRoot, err := attested.Block().HashTreeRoot()
+	require.NoError(t, err)
+	blk := newAltairBlock(t, periodStart, attestedRoot[:], nil, 1)
+
+	update, err := NewLightClientUpdate(context.Background(), blk, attestedState, attested, nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, IsSyncCommitteeUpdate(update))
+	assert.Equal(t, SyncCommitteeBranchDepth, len(update.NextSyncCommitteeBranch))
+}
+
+func TestNewLightClientUpdate_Invalid(t *testing.T) {
+	ctx := context.Background()
+	attestedState, attested := newAttestedState(t, 100, nil)
+	attestedRoot, err := attested.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	_, err = NewLightClientUpdate(ctx, newAltairBlock(t, 101, attestedRoot[:], nil, 0), attestedState, attested, nil)
+	require.ErrorIs(t, err, ErrNotEnoughParticipants)
+
+	_, err = NewLightClientUpdate(ctx, newAltairBlock(t, 101, make([]byte, 32), nil, 1), attestedState, attested, nil)
+	assert.ErrorContains(t, "attested block is not the parent of the block", err)
+
+	otherFinalized := newAltairBlock(t, 64, nil, nil, 0)
+	_, err = NewLightClientUpdate(ctx, newAltairBlock(t, 101, attestedRoot[:], nil, 1), attestedState, attested, otherFinalized)
+	assert.ErrorContains(t, "finalized block is not the finalized checkpoint", err)
+}
+
+func TestNewLightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	st, blk := newAttestedState(t, 100, nil)
+
+	bootstrap, err := NewLightClientBootstrap(ctx, st, blk)
+	require.NoError(t, err)
+	blkRoot, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := bootstrap.Header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blkRoot, headerRoot)
+	committeeRoot, err := bootstrap.CurrentSyncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, true, trie.VerifyMerkleProof(bootstrap.Header.StateRoot, committeeRoot[:], v2.CurrentSyncCommitteeGeneralizedIndex(), bootstrap.CurrentSyncCommitteeBranch))
+
+	_, err = NewLightClientBootstrap(ctx, st, newAltairBlock(t, 100, nil, nil, 0))
+	assert.ErrorContains(t, "state is not the post state of the block", err)
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	newUpdate := func(participants uint64, attestedSlot, signatureSlot, finalizedSlot types.Slot, syncCommittee, finality bool) *ethpb.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < participants; i++ {
+			bits.SetBitAt(i, true)
+		}
+		update := &ethpb.LightClientUpdate{
+			AttestedHeader:          &ethpb.BeaconBlockHeader{Slot: attestedSlot},
+			FinalizedHeader:         &ethpb.BeaconBlockHeader{Slot: finalizedSlot},
+			NextSyncCommitteeBranch: emptyBranch(SyncCommitteeBranchDepth),
+			FinalityBranch:          emptyBranch(FinalityBranchDepth),
+			SyncAggregate:           &ethpb.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:           signatureSlot,
+		}
+		if syncCommittee {
+			update.NextSyncCommitteeBranch[0] = []byte{1}
+		}
+		if finality {
+			update.FinalityBranch[0] = []byte{1}
+		}
+		return update
+	}
+	periodStart := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+
+	tests := []struct {
+		name     string
+		new, old *ethpb.LightClientUpdate
+		want     bool
+	}{
+		{
+			name: "supermajority wins",
+			new:  newUpdate(342, 10, 11, 0, false, false),
+			old:  newUpdate(341, 10, 11, 0, true, true),
+			want: true,
+		},
+		{
+			name: "more participants without supermajority",
+			new:  newUpdate(200, 10, 11, 0, false, false),
+			old:  newUpdate(100, 10, 11, 0, true, true),
+			want: true,
+		},
+		{
+			name: "relevant sync committee",
+			new:  newUpdate(400, 10, 11, 0, true, false),
+			old:  newUpdate(500, 10, 11, 0, false, true),
+			want: true,
+		},
+		{
+			name: "sync committee signed in the next period is not relevant",
+			new:  newUpdate(400, periodStart-1, periodStart, 0, true, false),
+			old:  newUpdate(400, periodStart-2, periodStart-1, 0, true, false),
+			want: false,
+		},
+		{
+			name: "finality",
+			new:  newUpdate(400, 10, 11, 0, true, true),
+			old:  newUpdate(500, 10, 11, 0, true, false),
+			want: true,
+		},
+		{
+			name: "sync committee finality",
+			new:  newUpdate(400, periodStart+10, periodStart+11, periodStart, true, true),
+			old:  newUpdate(500, periodStart+10, periodStart+11, periodStart-32, true, true),
+			want: true,
+		},
+		{
+			name: "more participants beyond supermajority",
+			new:  newUpdate(500, 10, 11, 0, true, true),
+			old:  newUpdate(400, 10, 11, 0, true, true),
+			want: true,
+		},
+		{
+			name: "older attested header",
+			new:  newUpdate(400, 9, 11, 0, true, true),
+			old:  newUpdate(400, 10, 11, 0, true, true),
+			want: true,
+		},
+		{
+			name: "older signature",
+			new:  newUpdate(400, 10, 12, 0, true, true),
+			old:  newUpdate(400, 10, 11, 0, true, true),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsBetterUpdate(tt.new, tt.old))
+		})
+	}
+}
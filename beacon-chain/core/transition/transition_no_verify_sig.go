@@ -137,7 +137,7 @@ func CalculateStateRoot(
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not process block")
 	}
-	if signed.Version() == version.Altair || signed.Version() == version.Bellatrix {
+	if signed.Version() == version.Altair || signed.Version() == version.Bellatrix || signed.Version() == version.BellatrixBlind {
 		sa, err := signed.Block().Body().SyncAggregate()
 		if err != nil {
 			return [32]byte{}, err
@@ -258,7 +258,7 @@ func ProcessOperationsNoVerifyAttsSigs(
 		if err != nil {
 			return nil, err
 		}
-	case version.Altair, version.Bellatrix:
+	case version.Altair, version.Bellatrix, version.BellatrixBlind:
 		state, err = altairOperations(ctx, state, signedBeaconBlock)
 		if err != nil {
 			return nil, err
@@ -310,7 +310,16 @@ func ProcessBlockForStateRoot(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not check if execution is enabled")
 		}
-		if enabled {
+		if enabled && blk.Version() == version.BellatrixBlind {
+			header, err := blk.Body().ExecutionPayloadHeader()
+			if err != nil {
+				return nil, err
+			}
+			state, err = b.ProcessPayloadHeader(state, header)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not process execution payload header")
+			}
+		} else if enabled {
 			payload, err := blk.Body().ExecutionPayload()
 			if err != nil {
 				return nil, err
@@ -0,0 +1,151 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Compact writes a compacted copy of the beacon chain database to the target directory.
+func Compact(cliCtx *cli.Context) error {
+	targetDir := cliCtx.String(cmd.CompactTargetDirFlag.Name)
+	if targetDir == "" {
+		return errors.Errorf("--%s is required", cmd.CompactTargetDirFlag.Name)
+	}
+	d, err := openExistingDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	compactDir := path.Join(targetDir, kv.BeaconNodeDbDirName)
+	if err := file.MkdirAll(compactDir); err != nil {
+		return err
+	}
+	compactPath := kv.KVStoreBackendPath(compactDir, d.Backend())
+	log.WithField("path", compactPath).Info("Writing compacted database")
+	if err := d.Compact(cliCtx.Context, compactPath); err != nil {
+		return errors.Wrap(err, "could not compact database")
+	}
+
+	before, err := pathSize(kv.KVStoreBackendPath(d.DatabasePath(), d.Backend()))
+	if err != nil {
+		return err
+	}
+	after, err := pathSize(compactPath)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"before": humanize.Bytes(uint64(before)),
+		"after":  humanize.Bytes(uint64(after)),
+	}).Info("Compaction completed successfully")
+	return nil
+}
+
+// Stats prints the number of keys and the size of each bucket of the beacon chain database.
+func Stats(cliCtx *cli.Context) error {
+	d, err := openExistingDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	stats, err := d.BucketStats(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not get bucket stats")
+	}
+	total, err := pathSize(kv.KVStoreBackendPath(d.DatabasePath(), d.Backend()))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%-40s %14s %12s\n", "BUCKET", "KEYS", "SIZE")
+	for _, s := range stats {
+		fmt.Printf("%-40s %14d %12s\n", s.Name, s.Keys, humanize.Bytes(uint64(s.Size)))
+	}
+	fmt.Printf("%-40s %14s %12s\n", "total file size", "", humanize.Bytes(uint64(total)))
+	return nil
+}
+
+// MigrateBackend copies the beacon chain database to the key-value store set with --db-backend.
+// The previous database is kept next to the new one, under a .bak extension.
+func MigrateBackend(cliCtx *cli.Context) error {
+	kind := backend.Kind(cliCtx.String(cmd.DBBackendFlag.Name))
+	if kind == "" {
+		return errors.Errorf("--%s is required", cmd.DBBackendFlag.Name)
+	}
+	d, err := openExistingDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	from := d.Backend()
+	log.WithFields(logrus.Fields{
+		"from": from,
+		"to":   kind,
+	}).Info("Migrating database")
+	if err := d.MigrateBackend(cliCtx.Context, kind); err != nil {
+		if closeErr := d.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close database")
+		}
+		return errors.Wrap(err, "could not migrate database")
+	}
+	if err := d.Close(); err != nil {
+		return err
+	}
+
+	// Move the previous database aside, so that the directory is opened with the new backend.
+	oldPath := kv.KVStoreBackendPath(d.DatabasePath(), from)
+	backupPath := oldPath + ".bak"
+	if err := os.Rename(oldPath, backupPath); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"path":           kv.KVStoreBackendPath(d.DatabasePath(), kind),
+		"previousDbPath": backupPath,
+	}).Info("Migration completed successfully, the previous database can be removed")
+	return nil
+}
+
+// openExistingDB opens the beacon chain database in the data directory, which must already exist.
+func openExistingDB(cliCtx *cli.Context) (*kv.Store, error) {
+	dbDir := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	if len(kv.ExistingBackends(dbDir)) == 0 {
+		return nil, errors.Errorf("no database found in %s", dbDir)
+	}
+	return kv.NewKVStore(cliCtx.Context, dbDir, &kv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+}
+
+// pathSize returns the size of a file, or of all the files of a directory.
+func pathSize(p string) (int64, error) {
+	var size int64
+	err := filepath.Walk(p, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func TestCompact(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	sourceDb, err := kv.NewKVStore(ctx, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	head := util.NewBeaconBlock()
+	head.Block.Slot = 5000
+	require.NoError(t, sourceDb.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(head)))
+	root, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, sourceDb.SaveState(ctx, st, root))
+	require.NoError(t, sourceDb.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, sourceDb.Close())
+
+	targetDir := t.TempDir()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(cmd.CompactTargetDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.ErrorContains(t, "--compact-target-dir is required", Compact(cliCtx))
+	require.NoError(t, set.Set(cmd.CompactTargetDirFlag.Name, targetDir))
+
+	require.NoError(t, Compact(cliCtx))
+	assert.LogsContain(t, logHook, "Compaction completed successfully")
+	require.NoError(t, Stats(cliCtx))
+
+	compactedDb, err := kv.NewKVStore(ctx, path.Join(targetDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, compactedDb.Close())
+	}()
+	headBlock, err := compactedDb.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5000), headBlock.Block().Slot(), "Compacted database has incorrect data")
+}
+
+func TestMigrateBackend(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	dbDir := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	sourceDb, err := kv.NewKVStore(ctx, dbDir, &kv.Config{})
+	require.NoError(t, err)
+	head := util.NewBeaconBlock()
+	head.Block.Slot = 5000
+	require.NoError(t, sourceDb.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(head)))
+	root, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, sourceDb.SaveState(ctx, st, root))
+	require.NoError(t, sourceDb.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, sourceDb.Close())
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(cmd.DBBackendFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.ErrorContains(t, "--db-backend is required", MigrateBackend(cliCtx))
+	require.NoError(t, set.Set(cmd.DBBackendFlag.Name, string(backend.LevelDB)))
+	require.NoError(t, MigrateBackend(cliCtx))
+	assert.LogsContain(t, logHook, "Migration completed successfully")
+	assert.Equal(t, true, file.FileExists(kv.KVStoreDatafilePath(dbDir)+".bak"))
+	require.NoError(t, Stats(cliCtx))
+
+	migratedDb, err := kv.NewKVStore(ctx, dbDir, &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, migratedDb.Close())
+	}()
+	assert.Equal(t, backend.LevelDB, migratedDb.Backend())
+	headBlock, err := migratedDb.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5000), headBlock.Block().Slot(), "Migrated database has incorrect data")
+}
+
+func TestStats_NoDatabase(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, t.TempDir()))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.ErrorContains(t, "no database found", Stats(cliCtx))
+}
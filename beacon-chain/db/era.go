@@ -0,0 +1,144 @@
+package db
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/era"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// ExportEra writes the finalized eras between --from and --to of the beacon chain database
+// to era files in the era directory.
+func ExportEra(cliCtx *cli.Context) error {
+	dir := cliCtx.String(cmd.EraDirFlag.Name)
+	if dir == "" {
+		return errors.Errorf("--%s is required", cmd.EraDirFlag.Name)
+	}
+	from, to := cliCtx.Uint64(cmd.EraFromFlag.Name), cliCtx.Uint64(cmd.EraToFlag.Name)
+	if from == 0 || to < from {
+		return errors.Errorf("invalid era range [%d, %d], eras start at 1", from, to)
+	}
+	d, err := openExistingDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	if err := file.MkdirAll(dir); err != nil {
+		return err
+	}
+
+	for n := from; n <= to; n++ {
+		e, err := era.Export(cliCtx.Context, d, n)
+		if err != nil {
+			return errors.Wrapf(err, "could not export era %d", n)
+		}
+		p := path.Join(dir, e.FileName())
+		if err := writeEra(p, e); err != nil {
+			return errors.Wrapf(err, "could not write era %d", n)
+		}
+		log.WithFields(logrus.Fields{
+			"era":    n,
+			"blocks": len(e.Blocks),
+			"path":   p,
+		}).Info("Exported era")
+	}
+	return nil
+}
+
+// ImportEra verifies the era files of the era directory and saves their blocks and states to the
+// beacon chain database, which is created if it does not exist yet.
+func ImportEra(cliCtx *cli.Context) error {
+	dir := cliCtx.String(cmd.EraDirFlag.Name)
+	if dir == "" {
+		return errors.Errorf("--%s is required", cmd.EraDirFlag.Name)
+	}
+	// Era numbers are zero padded, so the files are sorted by era.
+	paths, err := filepath.Glob(path.Join(dir, "*.era"))
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return errors.Errorf("no era files found in %s", dir)
+	}
+	d, err := kv.NewKVStore(cliCtx.Context, path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName), &kv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	for _, p := range paths {
+		e, err := readEra(p)
+		if err != nil {
+			return errors.Wrapf(err, "could not read %s", p)
+		}
+		if err := era.Import(cliCtx.Context, d, e); err != nil {
+			return errors.Wrapf(err, "could not import %s", p)
+		}
+		log.WithFields(logrus.Fields{
+			"era":    e.Number,
+			"blocks": len(e.Blocks),
+			"path":   p,
+		}).Info("Imported era")
+	}
+	return nil
+}
+
+// writeEra writes an era file through a temporary file, so that an interrupted export does not
+// leave a partial era file behind.
+func writeEra(p string, e *era.Era) error {
+	if file.FileExists(p) {
+		return errors.Errorf("a file already exists at %s", p)
+	}
+	tmp := p + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	err = e.Write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if rmErr := os.Remove(tmp); rmErr != nil {
+			log.WithError(rmErr).Errorf("Could not remove %s", tmp)
+		}
+		return err
+	}
+	return os.Rename(tmp, p)
+}
+
+func readEra(p string) (*era.Era, error) {
+	f, err := os.Open(p) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close %s", p)
+		}
+	}()
+	return era.Read(bufio.NewReader(f))
+}
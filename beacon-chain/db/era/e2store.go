@@ -0,0 +1,133 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// headerSize is the size of the header of an e2store record: a 2 byte type,
+// a 4 byte little endian data length and 2 reserved bytes.
+const headerSize = 8
+
+// maxRecordSize bounds the data length of a record read from a file, so that a corrupted
+// header cannot make the reader allocate an unbounded amount of memory.
+const maxRecordSize = 1 << 30
+
+var (
+	typeVersion          = [2]byte{0x65, 0x32}
+	typeCompressedBlock  = [2]byte{0x01, 0x00}
+	typeCompressedState  = [2]byte{0x02, 0x00}
+	typeSlotIndex        = [2]byte{0x69, 0x32}
+	errUnexpectedRecord  = errors.New("unexpected record type")
+	errRecordTooLarge    = errors.New("record exceeds maximum size")
+	errReservedBytesUsed = errors.New("reserved header bytes are not zero")
+)
+
+// record is a single entry of an e2store file.
+type record struct {
+	typ  [2]byte
+	data []byte
+}
+
+// e2Writer writes records to an e2store file, keeping track of the offset of each record.
+type e2Writer struct {
+	w      io.Writer
+	offset int64
+}
+
+// write writes a record and returns the offset at which it starts.
+func (e *e2Writer) write(typ [2]byte, data []byte) (int64, error) {
+	if len(data) > maxRecordSize {
+		return 0, errRecordTooLarge
+	}
+	header := make([]byte, headerSize)
+	copy(header, typ[:])
+	binary.LittleEndian.PutUint32(header[2:], uint32(len(data)))
+	start := e.offset
+	if _, err := e.w.Write(header); err != nil {
+		return 0, err
+	}
+	if _, err := e.w.Write(data); err != nil {
+		return 0, err
+	}
+	e.offset += int64(headerSize + len(data))
+	return start, nil
+}
+
+// writeCompressed writes a record with its data compressed with framed snappy.
+func (e *e2Writer) writeCompressed(typ [2]byte, data []byte) (int64, error) {
+	buf := new(bytes.Buffer)
+	sw := snappy.NewBufferedWriter(buf)
+	if _, err := sw.Write(data); err != nil {
+		return 0, err
+	}
+	if err := sw.Close(); err != nil {
+		return 0, err
+	}
+	return e.write(typ, buf.Bytes())
+}
+
+// writeSlotIndex writes a slot index record. Offsets are relative to the start of the index record
+// and are zero for slots without an entry.
+func (e *e2Writer) writeSlotIndex(startSlot uint64, offsets []int64) error {
+	data := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(data, startSlot)
+	for i, o := range offsets {
+		if o != 0 {
+			o -= e.offset
+		}
+		binary.LittleEndian.PutUint64(data[8*(i+1):], uint64(o))
+	}
+	binary.LittleEndian.PutUint64(data[8*(len(offsets)+1):], uint64(len(offsets)))
+	_, err := e.write(typeSlotIndex, data)
+	return err
+}
+
+// readRecord reads the next record of an e2store file. It returns io.EOF once all records are read.
+func readRecord(r io.Reader) (*record, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errors.Wrap(err, "truncated record header")
+		}
+		return nil, err
+	}
+	if header[6] != 0 || header[7] != 0 {
+		return nil, errReservedBytesUsed
+	}
+	size := binary.LittleEndian.Uint32(header[2:6])
+	if size > maxRecordSize {
+		return nil, errRecordTooLarge
+	}
+	rec := &record{data: make([]byte, size)}
+	copy(rec.typ[:], header[:2])
+	if _, err := io.ReadFull(r, rec.data); err != nil {
+		return nil, errors.Wrap(err, "truncated record data")
+	}
+	return rec, nil
+}
+
+// decompress returns the data of a record compressed with framed snappy.
+func (r *record) decompress() ([]byte, error) {
+	return ioutil.ReadAll(snappy.NewReader(bytes.NewReader(r.data)))
+}
+
+// slotIndex decodes the start slot and the number of entries of a slot index record.
+func (r *record) slotIndex() (uint64, uint64, error) {
+	if r.typ != typeSlotIndex {
+		return 0, 0, errUnexpectedRecord
+	}
+	if len(r.data) < 16 || len(r.data)%8 != 0 {
+		return 0, 0, errors.Errorf("invalid slot index length %d", len(r.data))
+	}
+	count := binary.LittleEndian.Uint64(r.data[len(r.data)-8:])
+	if count != uint64(len(r.data)/8-2) {
+		return 0, 0, errors.Errorf("slot index count %d does not match its length", count)
+	}
+	return binary.LittleEndian.Uint64(r.data), count, nil
+}
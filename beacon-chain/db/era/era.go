@@ -0,0 +1,396 @@
+// Package era reads and writes era files, a portable archive of finalized history in the
+// e2store format shared with other consensus clients. An era holds the canonical blocks of
+// SLOTS_PER_HISTORICAL_ROOT slots followed by the state at the end of the period, so history
+// can be moved between nodes without syncing it over p2p.
+package era
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// Era is the content of an era file: the canonical blocks between the start of the era and the
+// slot of its state, and the state at the first slot after the era.
+type Era struct {
+	Number uint64
+	Blocks []block.SignedBeaconBlock
+	State  state.BeaconState
+}
+
+// Export reads an era from the database. The era must be finalized and its ending state must be
+// an archived point, which is the case for nodes running with the default --slots-per-archive-point.
+func Export(ctx context.Context, db iface.ReadOnlyDatabase, number uint64) (*Era, error) {
+	if number == 0 {
+		return nil, errors.New("era 0 only holds the genesis state and can not be exported")
+	}
+	start, end := slotRange(number)
+	root := db.ArchivedPointRoot(ctx, end)
+	if root == params.BeaconConfig().ZeroHash {
+		return nil, errors.Errorf("no archived state at slot %d, era %d is either not finalized or not archived", end, number)
+	}
+	st, err := db.State(ctx, root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state at slot %d", end)
+	}
+	if st == nil || st.IsNil() {
+		return nil, errors.Errorf("archived state at slot %d not found", end)
+	}
+	if st.Slot() != end {
+		return nil, errors.Errorf("archived state has slot %d, wanted %d", st.Slot(), end)
+	}
+
+	// Genesis is not part of any era, it is available to every node.
+	if start == 0 {
+		start = 1
+	}
+	blks, roots, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end-1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get blocks of era %d", number)
+	}
+	blockRoots := st.BlockRoots()
+	e := &Era{Number: number, State: st}
+	for i, b := range blks {
+		if b.Version() == version.BellatrixBlind {
+			return nil, errors.Errorf("block at slot %d is stored without its execution payload", b.Block().Slot())
+		}
+		// Blocks of forks that were not finalized are not part of the era.
+		if !bytes.Equal(blockRoots[b.Block().Slot()%params.BeaconConfig().SlotsPerHistoricalRoot], roots[i][:]) {
+			continue
+		}
+		e.Blocks = append(e.Blocks, b)
+	}
+	sort.Slice(e.Blocks, func(i, j int) bool {
+		return e.Blocks[i].Block().Slot() < e.Blocks[j].Block().Slot()
+	})
+	if err := e.verifyChain(); err != nil {
+		return nil, errors.Wrapf(err, "era %d is incomplete in the database", number)
+	}
+	return e, nil
+}
+
+// Import verifies an era and saves its blocks and state to the database.
+func Import(ctx context.Context, db iface.NoHeadAccessDatabase, e *Era) error {
+	genesis, err := db.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if genesis != nil && !genesis.IsNil() && !bytes.Equal(genesis.GenesisValidatorsRoot(), e.State.GenesisValidatorsRoot()) {
+		return errors.Errorf(
+			"era %d belongs to a different chain, genesis validators root %#x does not match %#x",
+			e.Number, e.State.GenesisValidatorsRoot(), genesis.GenesisValidatorsRoot(),
+		)
+	}
+	if err := e.Verify(); err != nil {
+		return errors.Wrapf(err, "could not verify era %d", e.Number)
+	}
+
+	if err := db.SaveBlocks(ctx, e.Blocks); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	summaries := make([]*ethpb.StateSummary, len(e.Blocks))
+	for i, b := range e.Blocks {
+		r, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		summaries[i] = &ethpb.StateSummary{Slot: b.Block().Slot(), Root: r[:]}
+	}
+	if err := db.SaveStateSummaries(ctx, summaries); err != nil {
+		return errors.Wrap(err, "could not save state summaries")
+	}
+	root, err := latestBlockRoot(ctx, e.State)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(db.SaveState(ctx, e.State, root), "could not save state")
+}
+
+// Verify checks that the blocks of the era are the canonical blocks recorded in its state,
+// that they form a chain leading to the latest block of the state, and that they are signed by
+// their proposers.
+func (e *Era) Verify() error {
+	if e.State == nil || e.State.IsNil() {
+		return errors.New("nil state")
+	}
+	if _, end := slotRange(e.Number); e.State.Slot() != end {
+		return errors.Errorf("state has slot %d, wanted %d", e.State.Slot(), end)
+	}
+	blockRoots := e.State.BlockRoots()
+	for _, b := range e.Blocks {
+		if b == nil || b.IsNil() {
+			return errors.New("nil block")
+		}
+		r, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		slot := b.Block().Slot()
+		if !bytes.Equal(blockRoots[slot%params.BeaconConfig().SlotsPerHistoricalRoot], r[:]) {
+			return errors.Errorf("block root %#x at slot %d does not match the state", r, slot)
+		}
+		if err := verifySignature(e.State, b); err != nil {
+			return errors.Wrapf(err, "invalid signature of block at slot %d", slot)
+		}
+	}
+	return e.verifyChain()
+}
+
+// verifyChain checks that the blocks are in the era, sorted, that each block is the parent of the
+// next one, and that the last block is the latest block of the state.
+func (e *Era) verifyChain() error {
+	start, end := slotRange(e.Number)
+	if start == 0 {
+		start = 1
+	}
+	var parent [32]byte
+	for i, b := range e.Blocks {
+		slot := b.Block().Slot()
+		if slot < start || slot >= end {
+			return errors.Errorf("block at slot %d is outside of era %d", slot, e.Number)
+		}
+		if i > 0 && bytesutil.ToBytes32(b.Block().ParentRoot()) != parent {
+			return errors.Errorf("missing parent %#x of block at slot %d", b.Block().ParentRoot(), slot)
+		}
+		r, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		parent = r
+	}
+	latest, err := latestBlockRoot(context.Background(), e.State)
+	if err != nil {
+		return err
+	}
+	if len(e.Blocks) == 0 {
+		if e.State.LatestBlockHeader().Slot >= start {
+			return errors.Errorf("missing latest block %#x", latest)
+		}
+		return nil
+	}
+	if latest != parent {
+		return errors.Errorf("missing latest block %#x", latest)
+	}
+	return nil
+}
+
+// Write writes the era in the e2store format: a version record, the compressed blocks, the
+// compressed state, and then the indices of the blocks and of the state by slot.
+func (e *Era) Write(w io.Writer) error {
+	ew := &e2Writer{w: w}
+	if _, err := ew.write(typeVersion, nil); err != nil {
+		return err
+	}
+	start, end := slotRange(e.Number)
+	offsets := make([]int64, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for _, b := range e.Blocks {
+		if b.Version() == version.BellatrixBlind {
+			return errors.Errorf("block at slot %d is stored without its execution payload", b.Block().Slot())
+		}
+		enc, err := b.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block at slot %d", b.Block().Slot())
+		}
+		offset, err := ew.writeCompressed(typeCompressedBlock, enc)
+		if err != nil {
+			return err
+		}
+		offsets[b.Block().Slot()-start] = offset
+	}
+	enc, err := e.State.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	stateOffset, err := ew.writeCompressed(typeCompressedState, enc)
+	if err != nil {
+		return err
+	}
+	if err := ew.writeSlotIndex(uint64(start), offsets); err != nil {
+		return err
+	}
+	return ew.writeSlotIndex(uint64(end), []int64{stateOffset})
+}
+
+// Read reads an era written in the e2store format. The era is not verified.
+func Read(r io.Reader) (*Era, error) {
+	rec, err := readRecord(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read version")
+	}
+	if rec.typ != typeVersion {
+		return nil, errors.Wrap(errUnexpectedRecord, "file does not start with a version record")
+	}
+	e := &Era{}
+	var indices int
+	for {
+		rec, err := readRecord(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case rec.typ == typeCompressedBlock && e.State == nil:
+			data, err := rec.decompress()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not decompress block")
+			}
+			b, err := unmarshalBlock(data)
+			if err != nil {
+				return nil, err
+			}
+			e.Blocks = append(e.Blocks, b)
+		case rec.typ == typeCompressedState && e.State == nil:
+			data, err := rec.decompress()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not decompress state")
+			}
+			if e.State, err = unmarshalState(data); err != nil {
+				return nil, err
+			}
+		case rec.typ == typeSlotIndex && e.State != nil:
+			if _, _, err := rec.slotIndex(); err != nil {
+				return nil, err
+			}
+			indices++
+		default:
+			return nil, errors.Wrapf(errUnexpectedRecord, "%#x", rec.typ)
+		}
+	}
+	if e.State == nil || indices != 2 {
+		return nil, errors.New("incomplete era file")
+	}
+	slotsPerEra := params.BeaconConfig().SlotsPerHistoricalRoot
+	if e.State.Slot() == 0 || e.State.Slot()%slotsPerEra != 0 {
+		return nil, errors.Errorf("state slot %d is not at the end of an era", e.State.Slot())
+	}
+	e.Number = uint64(e.State.Slot() / slotsPerEra)
+	return e, nil
+}
+
+// FileName returns the standard name of the era file, made of the network name, the era number
+// and the first bytes of the historical root of the era.
+func (e *Era) FileName() string {
+	root := e.State.GenesisValidatorsRoot()
+	if h := e.State.HistoricalRoots(); uint64(len(h)) >= e.Number && e.Number > 0 {
+		root = h[e.Number-1]
+	}
+	return fmt.Sprintf("%s-%05d-%x.era", params.BeaconConfig().ConfigName, e.Number, root[:4])
+}
+
+// slotRange returns the first slot of the era and the slot of its state.
+func slotRange(number uint64) (types.Slot, types.Slot) {
+	slotsPerEra := params.BeaconConfig().SlotsPerHistoricalRoot
+	end := slotsPerEra.Mul(number)
+	return end - slotsPerEra, end
+}
+
+// latestBlockRoot returns the root of the latest block of the state. The state root of the block
+// header is only filled in when the next slot is processed, so it is the root of the state
+// itself when the block is at the slot of the state.
+func latestBlockRoot(ctx context.Context, st state.BeaconState) ([32]byte, error) {
+	header := ethpb.CopyBeaconBlockHeader(st.LatestBlockHeader())
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		r, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = r[:]
+	}
+	return header.HashTreeRoot()
+}
+
+func verifySignature(st state.BeaconState, b block.SignedBeaconBlock) error {
+	idx := b.Block().ProposerIndex()
+	if uint64(idx) >= uint64(st.NumValidators()) {
+		return errors.Errorf("unknown proposer index %d", idx)
+	}
+	pub := st.PubkeyAtIndex(idx)
+	sig := b.Signature()
+	domain, err := signing.Domain(
+		st.Fork(), slots.ToEpoch(b.Block().Slot()), params.BeaconConfig().DomainBeaconProposer, st.GenesisValidatorsRoot(),
+	)
+	if err != nil {
+		return err
+	}
+	return signing.VerifyBlockSigningRoot(pub[:], sig, domain, b.Block().HashTreeRoot)
+}
+
+// unmarshalBlock decodes an ssz signed block of the fork active at its slot. The slot follows the
+// offset of the block and the signature.
+func unmarshalBlock(enc []byte) (block.SignedBeaconBlock, error) {
+	if len(enc) < 108 {
+		return nil, errors.Errorf("block of %d bytes is too short", len(enc))
+	}
+	epoch := slots.ToEpoch(types.Slot(binary.LittleEndian.Uint64(enc[100:108])))
+	switch {
+	case epoch >= params.BeaconConfig().BellatrixForkEpoch:
+		b := &ethpb.SignedBeaconBlockBellatrix{}
+		if err := b.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal bellatrix block")
+		}
+		return wrapper.WrappedBellatrixSignedBeaconBlock(b)
+	case epoch >= params.BeaconConfig().AltairForkEpoch:
+		b := &ethpb.SignedBeaconBlockAltair{}
+		if err := b.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal altair block")
+		}
+		return wrapper.WrappedAltairSignedBeaconBlock(b)
+	default:
+		b := &ethpb.SignedBeaconBlock{}
+		if err := b.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal phase 0 block")
+		}
+		return wrapper.WrappedPhase0SignedBeaconBlock(b), nil
+	}
+}
+
+// unmarshalState decodes an ssz state of the fork active at its slot. The slot follows the
+// genesis time and the genesis validators root.
+func unmarshalState(enc []byte) (state.BeaconState, error) {
+	if len(enc) < 48 {
+		return nil, errors.Errorf("state of %d bytes is too short", len(enc))
+	}
+	epoch := slots.ToEpoch(types.Slot(binary.LittleEndian.Uint64(enc[40:48])))
+	switch {
+	case epoch >= params.BeaconConfig().BellatrixForkEpoch:
+		st := &ethpb.BeaconStateBellatrix{}
+		if err := st.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal bellatrix state")
+		}
+		return v3.InitializeFromProtoUnsafe(st)
+	case epoch >= params.BeaconConfig().AltairForkEpoch:
+		st := &ethpb.BeaconStateAltair{}
+		if err := st.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal altair state")
+		}
+		return v2.InitializeFromProtoUnsafe(st)
+	default:
+		st := &ethpb.BeaconState{}
+		if err := st.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal phase 0 state")
+		}
+		return v1.InitializeFromProtoUnsafe(st)
+	}
+}
@@ -0,0 +1,201 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// generateEra returns the genesis state, the blocks at the given slots of the first era, and the
+// archived state at the end of the era. Blocks can only be generated in the first epoch.
+func generateEra(t *testing.T, blockSlots []types.Slot) (state.BeaconState, []block.SignedBeaconBlock, state.BeaconState) {
+	ctx := context.Background()
+	genesis, keys := util.DeterministicGenesisState(t, 64)
+	st := genesis.Copy()
+	var blks []block.SignedBeaconBlock
+	for _, slot := range blockSlots {
+		b, err := util.GenerateFullBlock(st, keys, &util.BlockGenConfig{}, slot)
+		require.NoError(t, err)
+		wb := wrapper.WrappedPhase0SignedBeaconBlock(b)
+		st, err = transition.ExecuteStateTransition(ctx, st, wb)
+		require.NoError(t, err)
+		blks = append(blks, wb)
+	}
+	st, err := transition.ProcessSlots(ctx, st, params.BeaconConfig().SlotsPerHistoricalRoot)
+	require.NoError(t, err)
+	return genesis, blks, st
+}
+
+// setupDB opens a database holding the genesis state, the given blocks, and the archived state,
+// when they are set. It is not closed on cleanup, as a second database can only be opened once
+// the first one is closed.
+func setupDB(t *testing.T, genesis state.BeaconState, blks []block.SignedBeaconBlock, st state.BeaconState) *kv.Store {
+	ctx := context.Background()
+	db, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	if genesis == nil {
+		return db
+	}
+	require.NoError(t, db.SaveGenesisData(ctx, genesis))
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	root, err := latestBlockRoot(ctx, st)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+	return db
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	genesis, blks, st := generateEra(t, []types.Slot{1, 2, 5, 17, 31})
+	source := setupDB(t, genesis, blks, st)
+	e, err := Export(ctx, source, 1)
+	require.NoError(t, err)
+	require.NoError(t, source.Close())
+	require.Equal(t, 5, len(e.Blocks))
+	assert.Equal(t, true, strings.HasPrefix(e.FileName(), "test-00001-"))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, e.Write(buf))
+	read, err := Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), read.Number)
+	require.Equal(t, len(e.Blocks), len(read.Blocks))
+	for i := range e.Blocks {
+		assert.DeepEqual(t, e.Blocks[i].Proto(), read.Blocks[i].Proto())
+	}
+	assert.DeepSSZEqual(t, e.State.InnerStateUnsafe(), read.State.InnerStateUnsafe())
+
+	target := setupDB(t, nil, nil, nil)
+	defer func() {
+		require.NoError(t, target.Close())
+	}()
+	require.NoError(t, target.SaveGenesisData(ctx, genesis))
+	require.NoError(t, Import(ctx, target, read))
+	for _, b := range e.Blocks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, target.HasBlock(ctx, r))
+		assert.Equal(t, true, target.HasStateSummary(ctx, r))
+	}
+	root := target.ArchivedPointRoot(ctx, params.BeaconConfig().SlotsPerHistoricalRoot)
+	r, err := e.Blocks[len(e.Blocks)-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, r, root)
+	assert.Equal(t, true, target.HasState(ctx, root))
+}
+
+func TestExport_Errors(t *testing.T) {
+	ctx := context.Background()
+	genesis, blks, st := generateEra(t, []types.Slot{1, 2, 3})
+	db := setupDB(t, genesis, []block.SignedBeaconBlock{blks[0], blks[2]}, st)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	_, err := Export(ctx, db, 0)
+	require.ErrorContains(t, "era 0 only holds the genesis state", err)
+	_, err = Export(ctx, db, 2)
+	require.ErrorContains(t, "no archived state at slot 16384", err)
+	_, err = Export(ctx, db, 1)
+	require.ErrorContains(t, "era 1 is incomplete in the database: missing parent", err)
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	genesis, blks, st := generateEra(t, []types.Slot{1, 2, 3})
+	db := setupDB(t, genesis, blks, st)
+	e, err := Export(ctx, db, 1)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.NoError(t, e.Verify())
+
+	tests := []struct {
+		name   string
+		modify func(e *Era) *Era
+		errMsg string
+	}{
+		{
+			name: "missing block",
+			modify: func(e *Era) *Era {
+				return &Era{Number: e.Number, State: e.State, Blocks: e.Blocks[:2]}
+			},
+			errMsg: "missing latest block",
+		},
+		{
+			name: "bad signature",
+			modify: func(e *Era) *Era {
+				b := e.Blocks[1].Copy().Proto().(*ethpb.SignedBeaconBlock)
+				b.Signature = e.Blocks[0].Signature()
+				return &Era{Number: e.Number, State: e.State, Blocks: []block.SignedBeaconBlock{
+					e.Blocks[0], wrapper.WrappedPhase0SignedBeaconBlock(b), e.Blocks[2],
+				}}
+			},
+			errMsg: "invalid signature of block at slot 2",
+		},
+		{
+			name: "not canonical",
+			modify: func(e *Era) *Era {
+				b := e.Blocks[1].Copy().Proto().(*ethpb.SignedBeaconBlock)
+				b.Block.Body.Graffiti = bytes.Repeat([]byte{'a'}, 32)
+				return &Era{Number: e.Number, State: e.State, Blocks: []block.SignedBeaconBlock{
+					e.Blocks[0], wrapper.WrappedPhase0SignedBeaconBlock(b), e.Blocks[2],
+				}}
+			},
+			errMsg: "at slot 2 does not match the state",
+		},
+		{
+			name: "wrong era",
+			modify: func(e *Era) *Era {
+				return &Era{Number: 2, State: e.State, Blocks: e.Blocks}
+			},
+			errMsg: "state has slot 8192, wanted 16384",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorContains(t, tt.errMsg, tt.modify(e).Verify())
+		})
+	}
+}
+
+func TestImport_DifferentChain(t *testing.T) {
+	ctx := context.Background()
+	_, blks, st := generateEra(t, []types.Slot{1})
+	e := &Era{Number: 1, Blocks: blks, State: st}
+	require.NoError(t, e.Verify())
+
+	target := setupDB(t, nil, nil, nil)
+	defer func() {
+		require.NoError(t, target.Close())
+	}()
+	other, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, other.SetGenesisValidatorsRoot(bytes.Repeat([]byte{'a'}, 32)))
+	require.NoError(t, target.SaveGenesisData(ctx, other))
+	require.ErrorContains(t, "era 1 belongs to a different chain", Import(ctx, target, e))
+}
+
+func TestRead_Corrupted(t *testing.T) {
+	_, blks, st := generateEra(t, []types.Slot{1})
+	e := &Era{Number: 1, Blocks: blks, State: st}
+	buf := new(bytes.Buffer)
+	require.NoError(t, e.Write(buf))
+	enc := buf.Bytes()
+
+	_, err := Read(bytes.NewReader(enc[:len(enc)-10]))
+	require.ErrorContains(t, "truncated record data", err)
+	_, err = Read(bytes.NewReader(enc[headerSize:]))
+	require.ErrorContains(t, "file does not start with a version record", err)
+}
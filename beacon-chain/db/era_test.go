@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func TestExportImportEra(t *testing.T) {
+	// Do not load the hardcoded mainnet genesis state.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "test"
+	params.OverrideBeaconConfig(cfg)
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	sourceDir := t.TempDir()
+	sourceDb, err := kv.NewKVStore(ctx, path.Join(sourceDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	st, keys := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, sourceDb.SaveGenesisData(ctx, st))
+	b, err := util.GenerateFullBlock(st, keys, &util.BlockGenConfig{}, 1)
+	require.NoError(t, err)
+	wb := wrapper.WrappedPhase0SignedBeaconBlock(b)
+	require.NoError(t, sourceDb.SaveBlock(ctx, wb))
+	st, err = transition.ExecuteStateTransition(ctx, st, wb)
+	require.NoError(t, err)
+	st, err = transition.ProcessSlots(ctx, st, params.BeaconConfig().SlotsPerHistoricalRoot)
+	require.NoError(t, err)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, sourceDb.SaveState(ctx, st, root))
+	require.NoError(t, sourceDb.Close())
+
+	eraDir := path.Join(t.TempDir(), "era")
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(cmd.EraDirFlag.Name, "", "")
+	set.Uint64(cmd.EraFromFlag.Name, 1, "")
+	set.Uint64(cmd.EraToFlag.Name, 0, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, sourceDir))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.ErrorContains(t, "--era-dir is required", ExportEra(cliCtx))
+	require.NoError(t, set.Set(cmd.EraDirFlag.Name, eraDir))
+	require.ErrorContains(t, "invalid era range [1, 0]", ExportEra(cliCtx))
+	require.NoError(t, set.Set(cmd.EraToFlag.Name, "1"))
+	require.NoError(t, ExportEra(cliCtx))
+	assert.LogsContain(t, logHook, "Exported era")
+	require.ErrorContains(t, "a file already exists", ExportEra(cliCtx))
+
+	targetDir := t.TempDir()
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, targetDir))
+	require.NoError(t, ImportEra(cliCtx))
+	assert.LogsContain(t, logHook, "Imported era")
+
+	targetDb, err := kv.NewKVStore(ctx, path.Join(targetDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, targetDb.Close())
+	}()
+	assert.Equal(t, true, targetDb.HasBlock(ctx, root))
+	assert.Equal(t, root, targetDb.ArchivedPointRoot(ctx, params.BeaconConfig().SlotsPerHistoricalRoot))
+}
+
+func TestImportEra_NoFiles(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(cmd.EraDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, t.TempDir()))
+	require.NoError(t, set.Set(cmd.EraDirFlag.Name, t.TempDir()))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.ErrorContains(t, "no era files found", ImportEra(cliCtx))
+}
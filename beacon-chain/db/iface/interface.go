@@ -37,6 +37,9 @@ type ReadOnlyDatabase interface {
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotStatesBelow(ctx context.Context, slot types.Slot) ([]state.ReadOnlyBeaconState, error)
+	StateDiff(ctx context.Context, slot types.Slot) (*ethpb.BeaconStateDiff, error)
+	HasStateDiff(ctx context.Context, slot types.Slot) bool
+	HotStateRoots(ctx context.Context) ([]*ethpb.StateSummary, error)
 	// Checkpoint operations.
 	JustifiedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
 	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
@@ -48,9 +51,19 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*ethpb.ETH1ChainData, error)
+	// Fee recipients operations.
+	FeeRecipientByValidatorID(ctx context.Context, id types.ValidatorIndex) (common.Address, error)
+	// Builder registrations operations.
+	RegistrationByValidatorID(ctx context.Context, id types.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
 
 	// origin checkpoint sync support
 	OriginBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	// history retention support
+	HistoryPrunedSlot(ctx context.Context) (types.Slot, error)
+	// Light client operations.
+	LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpb.LightClientUpdate, error)
+	LightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*ethpb.LightClientBootstrap, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -62,6 +75,8 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block block.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []block.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	BackfillFinalizedIndex(ctx context.Context, blocks []block.SignedBeaconBlock, finalizedChildRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
@@ -69,6 +84,9 @@ type NoHeadAccessDatabase interface {
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethpb.StateSummary) error
 	SaveStateSummaries(ctx context.Context, summaries []*ethpb.StateSummary) error
+	SaveStateDiff(ctx context.Context, slot types.Slot, diff *ethpb.BeaconStateDiff) error
+	SaveHotStateRoot(ctx context.Context, summary *ethpb.StateSummary) error
+	DeleteHotStateRoots(ctx context.Context, blockRoots [][32]byte) error
 	// Checkpoint operations.
 	SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
 	SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
@@ -76,6 +94,15 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *ethpb.ETH1ChainData) error
+	// Fee recipients operations.
+	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, addrs []common.Address) error
+	// Builder registrations operations.
+	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error
+	SaveLightClientBootstrap(ctx context.Context, blockRoot [32]byte, bootstrap *ethpb.LightClientBootstrap) error
+	// History retention operations.
+	PruneHistory(ctx context.Context, finalizedEpoch types.Epoch) error
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 
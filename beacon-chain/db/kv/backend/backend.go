@@ -0,0 +1,135 @@
+// Package backend defines the key-value stores the beacon chain database can be written to.
+// Every store organizes keys in named buckets and provides serializable read-only and read-write
+// transactions, following the semantics of bolt.
+package backend
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Kind names a key-value store implementation.
+type Kind string
+
+const (
+	// Bolt is a single file B+tree store, memory mapped for reads.
+	Bolt Kind = "bolt"
+	// LevelDB is a log-structured merge tree store, tuned for write throughput.
+	LevelDB Kind = "leveldb"
+)
+
+// Kinds lists the supported backends.
+var Kinds = []Kind{Bolt, LevelDB}
+
+var (
+	// ErrDatabaseLocked is returned when the database is held by another process.
+	ErrDatabaseLocked = errors.New("cannot obtain database lock, database may be in use by another process")
+	// ErrTxNotWritable is returned when writing to a bucket in a read-only transaction.
+	ErrTxNotWritable = errors.New("tx not writable")
+	// ErrKeyRequired is returned when writing an empty key.
+	ErrKeyRequired = errors.New("key required")
+	// ErrBucketNameRequired is returned when creating a bucket with an empty name.
+	ErrBucketNameRequired = errors.New("bucket name required")
+	// ErrBucketNotFound is returned when deleting a bucket which does not exist.
+	ErrBucketNotFound = errors.New("bucket not found")
+)
+
+// Options for opening a key-value store.
+type Options struct {
+	// Timeout is how long the bolt backend waits for the lock of the database. Zero waits indefinitely.
+	Timeout time.Duration
+	// InitialMMapSize is the initial size of the memory map of the bolt backend.
+	InitialMMapSize int
+	// NoSync skips syncing writes to disk until Sync is called. Only meant for bulk copies.
+	NoSync bool
+	// FillPercent is how full the pages of the bolt backend are filled before they are split.
+	// It can be set to 1 when keys are written in order.
+	FillPercent float64
+}
+
+// DB is a key-value store of named buckets.
+type DB interface {
+	// Kind returns the implementation of the store.
+	Kind() Kind
+	// Path returns the file or directory the store is written to.
+	Path() string
+	// View runs a function in a read-only transaction.
+	View(fn func(Tx) error) error
+	// Update runs a function in a read-write transaction, which is committed if the function
+	// does not return an error. Read-write transactions are serialized.
+	Update(fn func(Tx) error) error
+	// Sync flushes the writes made with NoSync to disk, and syncs every following write.
+	Sync() error
+	// Close releases the store.
+	Close() error
+}
+
+// Tx is a transaction of a store. Keys and values returned by a transaction are only valid
+// until the transaction ends, and must not be modified.
+type Tx interface {
+	// Bucket returns the bucket of the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	// CreateBucketIfNotExists returns the bucket of the given name, creating it if needed.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	// DeleteBucket deletes a bucket and all of its keys.
+	DeleteBucket(name []byte) error
+	// ForEach calls a function for every bucket, in the order of their names.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a sorted collection of keys.
+type Bucket interface {
+	// Get returns the value of a key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	// Put sets the value of a key.
+	Put(key, value []byte) error
+	// Delete removes a key. Deleting a missing key is not an error.
+	Delete(key []byte) error
+	// Cursor returns a cursor over the keys of the bucket.
+	Cursor() Cursor
+	// ForEach calls a function for every key of the bucket, in order. The bucket must not be
+	// modified by the function.
+	ForEach(fn func(k, v []byte) error) error
+	// Stats returns the number of keys of the bucket and the bytes it takes on disk.
+	Stats() BucketStats
+}
+
+// Cursor iterates over the keys of a bucket in order. Every method returns nil when the cursor
+// moves past the first or the last key.
+type Cursor interface {
+	First() (key, value []byte)
+	Last() (key, value []byte)
+	Next() (key, value []byte)
+	Prev() (key, value []byte)
+	// Seek moves to the given key, or to the next key if it does not exist.
+	Seek(seek []byte) (key, value []byte)
+}
+
+// BucketStats is the number of keys of a bucket and the bytes it takes on disk.
+type BucketStats struct {
+	Keys int
+	Size int
+}
+
+// Open opens the store of the given kind at a path, creating it if it does not exist.
+func Open(kind Kind, path string, permissions os.FileMode, opts *Options) (DB, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	var db DB
+	var err error
+	switch kind {
+	case Bolt:
+		db, err = openBolt(path, permissions, opts)
+	case LevelDB:
+		db, err = openLevelDB(path, opts)
+	default:
+		return nil, errors.Errorf("unknown database backend %q, expected one of %v", kind, Kinds)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
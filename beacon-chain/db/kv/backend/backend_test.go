@@ -0,0 +1,263 @@
+package backend
+
+import (
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func openDB(t *testing.T, kind Kind) DB {
+	db, err := Open(kind, filepath.Join(t.TempDir(), "db"), 0600, &Options{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func TestOpen_UnknownKind(t *testing.T) {
+	_, err := Open("foo", t.TempDir(), 0600, nil)
+	require.ErrorContains(t, "unknown database backend \"foo\"", err)
+}
+
+func TestBuckets(t *testing.T) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			db := openDB(t, kind)
+			require.NoError(t, db.Update(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("a")))
+				for _, name := range []string{"b", "a", "c"} {
+					b, err := tx.CreateBucketIfNotExists([]byte(name))
+					require.NoError(t, err)
+					require.NoError(t, b.Put([]byte("k"), []byte(name)))
+				}
+				_, err := tx.CreateBucketIfNotExists(nil)
+				assert.ErrorContains(t, ErrBucketNameRequired.Error(), err)
+				return tx.DeleteBucket([]byte("c"))
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				var names []string
+				require.NoError(t, tx.ForEach(func(name []byte, b Bucket) error {
+					names = append(names, string(name))
+					assert.DeepEqual(t, name, b.Get([]byte("k")))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"a", "b"}, names)
+				assert.Equal(t, nil, tx.Bucket([]byte("c")))
+				assert.ErrorContains(t, ErrTxNotWritable.Error(), tx.Bucket([]byte("a")).Put([]byte("k"), nil))
+				return nil
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				assert.ErrorContains(t, ErrBucketNotFound.Error(), tx.DeleteBucket([]byte("c")))
+				b, err := tx.CreateBucketIfNotExists([]byte("c"))
+				require.NoError(t, err)
+				assert.Equal(t, true, b.Get([]byte("k")) == nil)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestUpdate_Rollback(t *testing.T) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			db := openDB(t, kind)
+			require.NoError(t, db.Update(func(tx Tx) error {
+				b, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				return b.Put([]byte("k"), []byte("v"))
+			}))
+			err := db.Update(func(tx Tx) error {
+				b := tx.Bucket([]byte("a"))
+				require.NoError(t, b.Put([]byte("k"), []byte("w")))
+				require.NoError(t, b.Put([]byte("l"), []byte("w")))
+				assert.DeepEqual(t, []byte("w"), b.Get([]byte("k")))
+				_, err := tx.CreateBucketIfNotExists([]byte("b"))
+				require.NoError(t, err)
+				return fmt.Errorf("rollback")
+			})
+			require.ErrorContains(t, "rollback", err)
+			require.NoError(t, db.View(func(tx Tx) error {
+				b := tx.Bucket([]byte("a"))
+				assert.DeepEqual(t, []byte("v"), b.Get([]byte("k")))
+				assert.Equal(t, true, b.Get([]byte("l")) == nil)
+				assert.Equal(t, nil, tx.Bucket([]byte("b")))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestLevelDB_DeleteWhileIterating(t *testing.T) {
+	db := openDB(t, LevelDB)
+	name := []byte("a")
+	require.NoError(t, db.Update(func(tx Tx) error {
+		b, err := tx.CreateBucketIfNotExists(name)
+		require.NoError(t, err)
+		for i := byte(0); i < 10; i++ {
+			require.NoError(t, b.Put([]byte{i}, []byte{i}))
+		}
+		return nil
+	}))
+	require.NoError(t, db.Update(func(tx Tx) error {
+		b := tx.Bucket(name)
+		require.NoError(t, b.Put([]byte{10}, []byte{10}))
+		c := b.Cursor()
+		deleted := 0
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			require.NoError(t, b.Delete(k))
+			deleted++
+		}
+		assert.Equal(t, 11, deleted)
+		k, _ := b.Cursor().Last()
+		assert.Equal(t, true, k == nil)
+		return nil
+	}))
+	require.NoError(t, db.View(func(tx Tx) error {
+		assert.Equal(t, 0, tx.Bucket(name).Stats().Keys)
+		return nil
+	}))
+}
+
+func TestReopen(t *testing.T) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "db")
+			db, err := Open(kind, p, 0600, &Options{NoSync: true})
+			require.NoError(t, err)
+			require.NoError(t, db.Update(func(tx Tx) error {
+				b, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				return b.Put([]byte("k"), []byte{})
+			}))
+			require.NoError(t, db.Sync())
+			require.NoError(t, db.Close())
+
+			db, err = Open(kind, p, 0600, nil)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+			require.NoError(t, db.View(func(tx Tx) error {
+				b := tx.Bucket([]byte("a"))
+				require.NotNil(t, b)
+				v := b.Get([]byte("k"))
+				assert.Equal(t, true, v != nil && len(v) == 0, "Expected an empty value")
+				assert.Equal(t, 1, b.Stats().Keys)
+				return nil
+			}))
+		})
+	}
+}
+
+// TestCursor_MatchesBolt applies the same random writes to both backends, within transactions
+// that also iterate over the keys they modify, and checks that their cursors agree.
+func TestCursor_MatchesBolt(t *testing.T) {
+	dbs := []DB{openDB(t, Bolt), openDB(t, LevelDB)}
+	name := []byte("bucket")
+	for _, db := range dbs {
+		require.NoError(t, db.Update(func(tx Tx) error {
+			_, err := tx.CreateBucketIfNotExists(name)
+			return err
+		}))
+	}
+	r := rand.New(rand.NewSource(1))
+	randKey := func() []byte {
+		return []byte{byte(r.Intn(64))}
+	}
+	type step struct {
+		op    int
+		key   []byte
+		value []byte
+	}
+	// walk returns the keys and values seen by a cursor moving in random directions. Cursors
+	// which moved past the first or the last key are moved back with First or Last.
+	walk := func(b Bucket, moves []int, seek []byte) []string {
+		c := b.Cursor()
+		var seen []string
+		var k []byte
+		record := func(key, v []byte) {
+			k = key
+			seen = append(seen, fmt.Sprintf("%x=%x", key, v))
+		}
+		record(c.Seek(seek))
+		for _, m := range moves {
+			if k == nil {
+				m = 2 + m%2
+			}
+			switch m {
+			case 0:
+				record(c.Next())
+			case 1:
+				record(c.Prev())
+			case 2:
+				record(c.First())
+			case 3:
+				record(c.Last())
+			}
+		}
+		return seen
+	}
+	for round := 0; round < 50; round++ {
+		steps := make([]step, 40)
+		for i := range steps {
+			steps[i] = step{op: r.Intn(3), key: randKey(), value: []byte{byte(r.Intn(256))}}
+		}
+		moves := make([]int, 30)
+		for i := range moves {
+			moves[i] = r.Intn(4)
+			// Mostly move step by step.
+			if moves[i] > 1 && r.Intn(4) != 0 {
+				moves[i] %= 2
+			}
+		}
+		seek := randKey()
+		var results [][]string
+		for _, db := range dbs {
+			var seen []string
+			require.NoError(t, db.Update(func(tx Tx) error {
+				b := tx.Bucket(name)
+				for i, s := range steps {
+					switch s.op {
+					case 0:
+						require.NoError(t, b.Put(s.key, s.value))
+					case 1:
+						require.NoError(t, b.Delete(s.key))
+					default:
+						seen = append(seen, fmt.Sprintf("%x", b.Get(s.key)))
+					}
+					if i%10 == 0 {
+						seen = append(seen, walk(b, moves, seek)...)
+					}
+				}
+				// Delete every other key. Bolt skips keys when deleting while iterating.
+				var keys [][]byte
+				require.NoError(t, b.ForEach(func(k, _ []byte) error {
+					if k[0]%2 == byte(round%2) {
+						keys = append(keys, append([]byte{}, k...))
+					}
+					return nil
+				}))
+				for _, k := range keys {
+					require.NoError(t, b.Delete(k))
+				}
+				seen = append(seen, walk(b, moves, seek)...)
+				return nil
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				b := tx.Bucket(name)
+				seen = append(seen, walk(b, moves, seek)...)
+				return b.ForEach(func(k, v []byte) error {
+					seen = append(seen, fmt.Sprintf("%x=%x", k, v))
+					return nil
+				})
+			}))
+			results = append(results, seen)
+		}
+		require.DeepEqual(t, results[0], results[1], "Cursors differ in round %d", round)
+	}
+}
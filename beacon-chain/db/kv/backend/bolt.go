@@ -0,0 +1,177 @@
+package backend
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+const boltAllocSize = 8 * 1024 * 1024
+
+// boltDB is a DB written to a bolt file.
+type boltDB struct {
+	db          *bolt.DB
+	fillPercent float64
+}
+
+func openBolt(path string, permissions os.FileMode, opts *Options) (*boltDB, error) {
+	db, err := bolt.Open(path, permissions, &bolt.Options{
+		Timeout:         opts.Timeout,
+		InitialMmapSize: opts.InitialMMapSize,
+		NoSync:          opts.NoSync,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, ErrDatabaseLocked
+		}
+		return nil, err
+	}
+	db.AllocSize = boltAllocSize
+	return &boltDB{db: db, fillPercent: opts.FillPercent}, nil
+}
+
+// UnwrapBolt returns the bolt database of a bolt backend, for bolt specific features such as
+// its metrics.
+func UnwrapBolt(db DB) (*bolt.DB, bool) {
+	b, ok := db.(*boltDB)
+	if !ok {
+		return nil, false
+	}
+	return b.db, true
+}
+
+// Kind --
+func (b *boltDB) Kind() Kind {
+	return Bolt
+}
+
+// Path --
+func (b *boltDB) Path() string {
+	return b.db.Path()
+}
+
+// View --
+func (b *boltDB) View(fn func(Tx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx, fillPercent: b.fillPercent})
+	})
+}
+
+// Update --
+func (b *boltDB) Update(fn func(Tx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx, fillPercent: b.fillPercent})
+	})
+}
+
+// Sync --
+func (b *boltDB) Sync() error {
+	b.db.NoSync = false
+	return b.db.Sync()
+}
+
+// Close --
+func (b *boltDB) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx          *bolt.Tx
+	fillPercent float64
+}
+
+func (t *boltTx) wrap(b *bolt.Bucket) Bucket {
+	// Return an untyped nil so that callers can compare the bucket to nil.
+	if b == nil {
+		return nil
+	}
+	if t.fillPercent != 0 {
+		b.FillPercent = t.fillPercent
+	}
+	return &boltBucket{b: b}
+}
+
+// Bucket --
+func (t *boltTx) Bucket(name []byte) Bucket {
+	return t.wrap(t.tx.Bucket(name))
+}
+
+// CreateBucketIfNotExists --
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.wrap(b), nil
+}
+
+// DeleteBucket --
+func (t *boltTx) DeleteBucket(name []byte) error {
+	if err := t.tx.DeleteBucket(name); err != nil {
+		if errors.Is(err, bolt.ErrBucketNotFound) {
+			return ErrBucketNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ForEach --
+func (t *boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, t.wrap(b))
+	})
+}
+
+type boltBucket struct {
+	b *bolt.Bucket
+}
+
+// Get --
+func (b *boltBucket) Get(key []byte) []byte {
+	return b.b.Get(key)
+}
+
+// Put --
+func (b *boltBucket) Put(key, value []byte) error {
+	if err := b.b.Put(key, value); err != nil {
+		return mapBoltError(err)
+	}
+	return nil
+}
+
+// Delete --
+func (b *boltBucket) Delete(key []byte) error {
+	return mapBoltError(b.b.Delete(key))
+}
+
+// Cursor --
+func (b *boltBucket) Cursor() Cursor {
+	return b.b.Cursor()
+}
+
+// ForEach --
+func (b *boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return b.b.ForEach(fn)
+}
+
+// Stats --
+func (b *boltBucket) Stats() BucketStats {
+	s := b.b.Stats()
+	return BucketStats{
+		Keys: s.KeyN,
+		Size: s.BranchAlloc + s.LeafAlloc + s.InlineBucketInuse,
+	}
+}
+
+func mapBoltError(err error) error {
+	switch {
+	case errors.Is(err, bolt.ErrTxNotWritable):
+		return ErrTxNotWritable
+	case errors.Is(err, bolt.ErrKeyRequired):
+		return ErrKeyRequired
+	default:
+		return err
+	}
+}
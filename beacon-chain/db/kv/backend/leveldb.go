@@ -0,0 +1,513 @@
+package backend
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	levelDBWriteBuffer = 64 * opt.MiB
+	levelDBBlockCache  = 64 * opt.MiB
+	levelDBBloomBits   = 10
+
+	// Values written in a transaction are prefixed with a flag, so that deleted keys hide the
+	// keys of the snapshot the transaction reads from.
+	flagPut    byte = 1
+	flagDelete byte = 0
+)
+
+var (
+	// Keys of a bucket are prefixed with the length of the bucket name and the name. Bucket names
+	// are not empty, so the keys recording which buckets exist, starting with a zero byte, never
+	// collide with the keys of a bucket.
+	bucketsPrefix = []byte{0}
+	// syncKey is written with a synced write to flush the writes made with NoSync.
+	syncKey = []byte{0}
+)
+
+// levelDB is a DB written to a leveldb directory. Buckets are key prefixes of a single keyspace.
+// Read-write transactions buffer their writes in memory, on top of a snapshot of the database,
+// and write them in a single atomic batch when they are committed.
+type levelDB struct {
+	db     *leveldb.DB
+	path   string
+	noSync bool
+
+	// writeLock serializes read-write transactions.
+	writeLock sync.Mutex
+	// buckets holds the names of the existing buckets.
+	bucketsLock sync.RWMutex
+	buckets     map[string]bool
+}
+
+func openLevelDB(path string, opts *Options) (*levelDB, error) {
+	db, err := leveldb.OpenFile(path, &opt.Options{
+		WriteBuffer:        levelDBWriteBuffer,
+		BlockCacheCapacity: levelDBBlockCache,
+		Filter:             filter.NewBloomFilter(levelDBBloomBits),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open leveldb database")
+	}
+	l := &levelDB{
+		db:      db,
+		path:    path,
+		noSync:  opts.NoSync,
+		buckets: make(map[string]bool),
+	}
+	it := db.NewIterator(util.BytesPrefix(bucketsPrefix), nil)
+	defer it.Release()
+	for it.Next() {
+		if name := it.Key()[len(bucketsPrefix):]; len(name) > 0 {
+			l.buckets[string(name)] = true
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, errors.Wrap(err, "could not read buckets")
+	}
+	return l, nil
+}
+
+// Kind --
+func (l *levelDB) Kind() Kind {
+	return LevelDB
+}
+
+// Path --
+func (l *levelDB) Path() string {
+	return l.path
+}
+
+// View --
+func (l *levelDB) View(fn func(Tx) error) error {
+	snap, err := l.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	tx := &levelTx{db: l, snap: snap}
+	defer tx.release()
+	return fn(tx)
+}
+
+// Update --
+func (l *levelDB) Update(fn func(Tx) error) error {
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+
+	snap, err := l.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	tx := &levelTx{
+		db:      l,
+		snap:    snap,
+		writes:  memdb.New(comparer.DefaultComparer, 0),
+		created: make(map[string]bool),
+		deleted: make(map[string]bool),
+	}
+	defer tx.release()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.commit()
+}
+
+// Sync --
+func (l *levelDB) Sync() error {
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+	l.noSync = false
+	return l.db.Put(syncKey, nil, &opt.WriteOptions{Sync: true})
+}
+
+// Close --
+func (l *levelDB) Close() error {
+	return l.db.Close()
+}
+
+type levelTx struct {
+	db   *levelDB
+	snap *leveldb.Snapshot
+	// writes is nil in read-only transactions.
+	writes  *memdb.DB
+	created map[string]bool
+	deleted map[string]bool
+	iters   []iterator.Iterator
+}
+
+func (t *levelTx) release() {
+	for _, it := range t.iters {
+		it.Release()
+	}
+	t.iters = nil
+}
+
+func (t *levelTx) commit() error {
+	batch := new(leveldb.Batch)
+	it := t.writes.NewIterator(nil)
+	for it.Next() {
+		if v := it.Value(); v[0] == flagDelete {
+			batch.Delete(it.Key())
+		} else {
+			batch.Put(it.Key(), v[1:])
+		}
+	}
+	it.Release()
+	if err := t.db.db.Write(batch, &opt.WriteOptions{Sync: !t.db.noSync}); err != nil {
+		return err
+	}
+
+	t.db.bucketsLock.Lock()
+	defer t.db.bucketsLock.Unlock()
+	for name := range t.deleted {
+		delete(t.db.buckets, name)
+	}
+	for name := range t.created {
+		t.db.buckets[name] = true
+	}
+	return nil
+}
+
+func (t *levelTx) hasBucket(name []byte) bool {
+	if t.writes != nil {
+		if t.created[string(name)] {
+			return true
+		}
+		if t.deleted[string(name)] {
+			return false
+		}
+	}
+	t.db.bucketsLock.RLock()
+	defer t.db.bucketsLock.RUnlock()
+	return t.db.buckets[string(name)]
+}
+
+func (t *levelTx) get(key []byte) []byte {
+	if t.writes != nil {
+		if v, err := t.writes.Get(key); err == nil {
+			if v[0] == flagDelete {
+				return nil
+			}
+			return append([]byte{}, v[1:]...)
+		}
+	}
+	v, err := t.snap.Get(key, nil)
+	if err != nil {
+		return nil
+	}
+	if v == nil {
+		return []byte{}
+	}
+	return v
+}
+
+func (t *levelTx) put(key, value []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	v := make([]byte, len(value)+1)
+	v[0] = flagPut
+	copy(v[1:], value)
+	return t.writes.Put(key, v)
+}
+
+func (t *levelTx) delete(key []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	return t.writes.Put(key, []byte{flagDelete})
+}
+
+func (t *levelTx) newCursor(prefix []byte) *levelCursor {
+	c := &levelCursor{prefix: prefix}
+	c.b = t.snap.NewIterator(util.BytesPrefix(prefix), nil)
+	t.iters = append(t.iters, c.b)
+	if t.writes != nil {
+		c.a = t.writes.NewIterator(util.BytesPrefix(prefix))
+		t.iters = append(t.iters, c.a)
+	}
+	return c
+}
+
+// Bucket --
+func (t *levelTx) Bucket(name []byte) Bucket {
+	if !t.hasBucket(name) {
+		return nil
+	}
+	return &levelBucket{tx: t, prefix: bucketPrefix(name)}
+}
+
+// CreateBucketIfNotExists --
+func (t *levelTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if t.writes == nil {
+		return nil, ErrTxNotWritable
+	}
+	if len(name) == 0 {
+		return nil, ErrBucketNameRequired
+	}
+	if len(name) > 255 {
+		return nil, errors.Errorf("bucket name %s is longer than 255 bytes", name)
+	}
+	if !t.hasBucket(name) {
+		if err := t.put(append(bucketsPrefix, name...), nil); err != nil {
+			return nil, err
+		}
+		delete(t.deleted, string(name))
+		t.created[string(name)] = true
+	}
+	return &levelBucket{tx: t, prefix: bucketPrefix(name)}, nil
+}
+
+// DeleteBucket --
+func (t *levelTx) DeleteBucket(name []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	if !t.hasBucket(name) {
+		return ErrBucketNotFound
+	}
+	prefix := bucketPrefix(name)
+	c := t.newCursor(prefix)
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if err := t.delete(append(prefix, k...)); err != nil {
+			return err
+		}
+	}
+	if err := t.delete(append(bucketsPrefix, name...)); err != nil {
+		return err
+	}
+	delete(t.created, string(name))
+	t.deleted[string(name)] = true
+	return nil
+}
+
+// ForEach --
+func (t *levelTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	t.db.bucketsLock.RLock()
+	names := make([]string, 0, len(t.db.buckets)+len(t.created))
+	for name := range t.db.buckets {
+		if !t.deleted[name] {
+			names = append(names, name)
+		}
+	}
+	t.db.bucketsLock.RUnlock()
+	for name := range t.created {
+		if !t.db.buckets[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := fn([]byte(name), &levelBucket{tx: t, prefix: bucketPrefix([]byte(name))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bucketPrefix(name []byte) []byte {
+	prefix := make([]byte, 0, len(name)+1)
+	prefix = append(prefix, byte(len(name)))
+	return append(prefix, name...)
+}
+
+type levelBucket struct {
+	tx     *levelTx
+	prefix []byte
+}
+
+func (b *levelBucket) key(k []byte) []byte {
+	key := make([]byte, 0, len(b.prefix)+len(k))
+	key = append(key, b.prefix...)
+	return append(key, k...)
+}
+
+// Get --
+func (b *levelBucket) Get(key []byte) []byte {
+	return b.tx.get(b.key(key))
+}
+
+// Put --
+func (b *levelBucket) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return ErrKeyRequired
+	}
+	return b.tx.put(b.key(key), value)
+}
+
+// Delete --
+func (b *levelBucket) Delete(key []byte) error {
+	return b.tx.delete(b.key(key))
+}
+
+// Cursor --
+func (b *levelBucket) Cursor() Cursor {
+	return b.tx.newCursor(b.prefix)
+}
+
+// ForEach --
+func (b *levelBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.tx.newCursor(b.prefix)
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stats --
+func (b *levelBucket) Stats() BucketStats {
+	var s BucketStats
+	c := b.tx.newCursor(b.prefix)
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		s.Keys++
+	}
+	sizes, err := b.tx.db.db.SizeOf([]util.Range{*util.BytesPrefix(b.prefix)})
+	if err == nil {
+		s.Size = int(sizes.Sum())
+	}
+	return s
+}
+
+// levelCursor merges the writes of a transaction, when it has any, with the snapshot it reads
+// from. Both iterators are positioned on or past the current key, in the current direction.
+type levelCursor struct {
+	prefix []byte
+	// a iterates over the writes of the transaction, b over the snapshot.
+	a, b       iterator.Iterator
+	key, value []byte
+	forward    bool
+}
+
+// First --
+func (c *levelCursor) First() ([]byte, []byte) {
+	c.each(func(it iterator.Iterator) { it.First() })
+	c.forward = true
+	return c.resolve()
+}
+
+// Last --
+func (c *levelCursor) Last() ([]byte, []byte) {
+	c.each(func(it iterator.Iterator) { it.Last() })
+	c.forward = false
+	return c.resolve()
+}
+
+// Seek --
+func (c *levelCursor) Seek(seek []byte) ([]byte, []byte) {
+	key := append(append([]byte{}, c.prefix...), seek...)
+	c.each(func(it iterator.Iterator) { it.Seek(key) })
+	c.forward = true
+	return c.resolve()
+}
+
+// Next --
+func (c *levelCursor) Next() ([]byte, []byte) {
+	return c.move(true)
+}
+
+// Prev --
+func (c *levelCursor) Prev() ([]byte, []byte) {
+	return c.move(false)
+}
+
+func (c *levelCursor) move(forward bool) ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	key := append(append([]byte{}, c.prefix...), c.key...)
+	if forward != c.forward {
+		// Position both iterators past the current key in the new direction.
+		c.each(func(it iterator.Iterator) {
+			found := it.Seek(key)
+			switch {
+			case forward && found && bytes.Equal(it.Key(), key):
+				it.Next()
+			case !forward && found:
+				it.Prev()
+			case !forward:
+				it.Last()
+			}
+		})
+	} else {
+		c.each(func(it iterator.Iterator) {
+			if it.Valid() && bytes.Equal(it.Key(), key) {
+				step(it, forward)
+			}
+		})
+	}
+	c.forward = forward
+	return c.resolve()
+}
+
+// resolve sets the current key to the closest key of both iterators in the current direction,
+// skipping the keys deleted in the transaction.
+func (c *levelCursor) resolve() ([]byte, []byte) {
+	for {
+		aValid := c.a != nil && c.a.Valid()
+		bValid := c.b.Valid()
+		var cmp int
+		switch {
+		case !aValid && !bValid:
+			c.key, c.value = nil, nil
+			return nil, nil
+		case !aValid:
+			cmp = 1
+		case !bValid:
+			cmp = -1
+		default:
+			cmp = bytes.Compare(c.a.Key(), c.b.Key())
+			if !c.forward {
+				cmp = -cmp
+			}
+		}
+		if cmp > 0 {
+			c.set(c.b.Key(), c.b.Value())
+			return c.key, c.value
+		}
+		v := c.a.Value()
+		if v[0] == flagDelete {
+			if cmp == 0 {
+				step(c.b, c.forward)
+			}
+			step(c.a, c.forward)
+			continue
+		}
+		c.set(c.a.Key(), v[1:])
+		return c.key, c.value
+	}
+}
+
+// set copies the current key and value, as iterators reuse their buffers.
+func (c *levelCursor) set(key, value []byte) {
+	c.key = append([]byte{}, key[len(c.prefix):]...)
+	c.value = append([]byte{}, value...)
+}
+
+func (c *levelCursor) each(fn func(it iterator.Iterator)) {
+	if c.a != nil {
+		fn(c.a)
+	}
+	fn(c.b)
+}
+
+func step(it iterator.Iterator, forward bool) {
+	if forward {
+		it.Next()
+	} else {
+		it.Prev()
+	}
+}
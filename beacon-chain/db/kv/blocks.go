@@ -8,7 +8,10 @@ import (
 	"github.com/golang/snappy"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/container/slice"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -17,7 +20,6 @@ import (
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -33,7 +35,7 @@ func (s *Store) Block(ctx context.Context, blockRoot [32]byte) (block.SignedBeac
 		return v.(block.SignedBeaconBlock), nil
 	}
 	var blk block.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		enc := bkt.Get(blockRoot[:])
 		if enc == nil {
@@ -55,7 +57,7 @@ func (s *Store) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
 	defer span.End()
 
 	var root [32]byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		rootSlice := bkt.Get(originBlockRootKey)
 		if rootSlice == nil {
@@ -68,12 +70,33 @@ func (s *Store) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
 	return root, err
 }
 
+// BackfillBlockRoot returns the value written to the db in SaveBackfillBlockRoot.
+// This is the root of the lowest block below the origin block that has been saved by backfill,
+// used to resume backfilling where it left off.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx backend.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		rootSlice := bkt.Get(backfillBlockRootKey)
+		if rootSlice == nil {
+			return ErrNotFoundBackfillBlockRoot
+		}
+		copy(root[:], rootSlice)
+		return nil
+	})
+
+	return root, err
+}
+
 // HeadBlock returns the latest canonical block in the Ethereum Beacon Chain.
 func (s *Store) HeadBlock(ctx context.Context) (block.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HeadBlock")
 	defer span.End()
 	var headBlock block.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		headRoot := bkt.Get(headBlockRootKey)
 		if headRoot == nil {
@@ -97,7 +120,7 @@ func (s *Store) Blocks(ctx context.Context, f *filters.QueryFilter) ([]block.Sig
 	blocks := make([]block.SignedBeaconBlock, 0)
 	blockRoots := make([][32]byte, 0)
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 
 		keys, err := blockRootsByFilter(ctx, tx, f)
@@ -128,7 +151,7 @@ func (s *Store) BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]b
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockRoots")
 	defer span.End()
 	blockRoots := make([][32]byte, 0)
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		keys, err := blockRootsByFilter(ctx, tx, f)
 		if err != nil {
 			return err
@@ -153,7 +176,7 @@ func (s *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
 		return true
 	}
 	exists := false
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		exists = bkt.Get(blockRoot[:]) != nil
 		return nil
@@ -169,7 +192,7 @@ func (s *Store) BlocksBySlot(ctx context.Context, slot types.Slot) (bool, []bloc
 	defer span.End()
 	blocks := make([]block.SignedBeaconBlock, 0)
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 
 		keys := blockRootsBySlot(ctx, tx, slot)
@@ -191,7 +214,7 @@ func (s *Store) BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, []
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockRootsBySlot")
 	defer span.End()
 	blockRoots := make([][32]byte, 0)
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		keys := blockRootsBySlot(ctx, tx, slot)
 		for i := 0; i < len(keys); i++ {
 			blockRoots = append(blockRoots, bytesutil.ToBytes32(keys[i]))
@@ -215,7 +238,7 @@ func (s *Store) DeleteBlock(ctx context.Context, root [32]byte) error {
 		return errDeleteFinalized
 	}
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
 		if b := bkt.Get(root[:]); b != nil {
 			return errDeleteFinalized
@@ -254,6 +277,7 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []block.SignedBeaconBlock
 	// Performing marshaling, hashing, and indexing outside the bolt transaction
 	// to minimize the time we hold the DB lock.
 	blockRoots := make([][]byte, len(blocks))
+	savedBlocks := make([]block.SignedBeaconBlock, len(blocks))
 	encodedBlocks := make([][]byte, len(blocks))
 	indicesForBlocks := make([]map[string][]byte, len(blocks))
 	for i, blk := range blocks {
@@ -261,25 +285,32 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []block.SignedBeaconBlock
 		if err != nil {
 			return err
 		}
-		enc, err := marshalBlock(ctx, blk)
+		// The block cache holds the block as it is saved, so that reads return the same block
+		// whether or not the cache has been populated yet.
+		saved, err := blockToSave(blk)
+		if err != nil {
+			return err
+		}
+		enc, err := marshalBlock(ctx, saved)
 		if err != nil {
 			return err
 		}
 		blockRoots[i] = blockRoot[:]
+		savedBlocks[i] = saved
 		encodedBlocks[i] = enc
 		indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block())
 		indicesForBlocks[i] = indicesByBucket
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
-		for i, blk := range blocks {
+		for i := range blocks {
 			if existingBlock := bkt.Get(blockRoots[i]); existingBlock != nil {
 				continue
 			}
 			if err := updateValueForIndices(ctx, indicesForBlocks[i], blockRoots[i], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
-			s.blockCache.Set(string(blockRoots[i]), blk, int64(len(encodedBlocks[i])))
+			s.blockCache.Set(string(blockRoots[i]), savedBlocks[i], int64(len(encodedBlocks[i])))
 			if err := bkt.Put(blockRoots[i], encodedBlocks[i]); err != nil {
 				return err
 			}
@@ -292,7 +323,7 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []block.SignedBeaconBlock
 func (s *Store) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	_, span := trace.StartSpan(ctx, "BeaconDB.SaveHeadBlockRoot")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		hasStateSummary := s.hasStateSummaryBytes(tx, blockRoot)
 		hasStateInDB := tx.Bucket(stateBucket).Get(blockRoot[:]) != nil
 		if !(hasStateInDB || hasStateSummary) {
@@ -309,7 +340,7 @@ func (s *Store) GenesisBlock(ctx context.Context) (block.SignedBeaconBlock, erro
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.GenesisBlock")
 	defer span.End()
 	var blk block.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		root := bkt.Get(genesisBlockRootKey)
 		enc := bkt.Get(root)
@@ -327,7 +358,7 @@ func (s *Store) GenesisBlock(ctx context.Context) (block.SignedBeaconBlock, erro
 func (s *Store) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	_, span := trace.StartSpan(ctx, "BeaconDB.SaveGenesisBlockRoot")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(blocksBucket)
 		return bucket.Put(genesisBlockRootKey, blockRoot[:])
 	})
@@ -340,19 +371,29 @@ func (s *Store) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) er
 func (s *Store) SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	_, span := trace.StartSpan(ctx, "BeaconDB.SaveOriginBlockRoot")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(blocksBucket)
 		return bucket.Put(originBlockRootKey, blockRoot[:])
 	})
 }
 
+// SaveBackfillBlockRoot is used to keep track of the lowest block root saved by backfill.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx backend.Tx) error {
+		bucket := tx.Bucket(blocksBucket)
+		return bucket.Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
+
 // HighestSlotBlocksBelow returns the block with the highest slot below the input slot from the db.
 func (s *Store) HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]block.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HighestSlotBlocksBelow")
 	defer span.End()
 
 	var best []byte
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blockSlotIndicesBucket)
 		// Iterate through the index, which is in byte sorted order.
 		c := bkt.Cursor()
@@ -393,7 +434,7 @@ func (s *Store) HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]
 }
 
 // blockRootsByFilter retrieves the block roots given the filter criteria.
-func blockRootsByFilter(ctx context.Context, tx *bolt.Tx, f *filters.QueryFilter) ([][]byte, error) {
+func blockRootsByFilter(ctx context.Context, tx backend.Tx, f *filters.QueryFilter) ([][]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.blockRootsByFilter")
 	defer span.End()
 
@@ -454,7 +495,7 @@ func blockRootsByFilter(ctx context.Context, tx *bolt.Tx, f *filters.QueryFilter
 // However, if step is one, the implemented logic won’t skip half of the slots in the range.
 func blockRootsBySlotRange(
 	ctx context.Context,
-	bkt *bolt.Bucket,
+	bkt backend.Bucket,
 	startSlotEncoded, endSlotEncoded, startEpochEncoded, endEpochEncoded, slotStepEncoded interface{},
 ) ([][]byte, error) {
 	_, span := trace.StartSpan(ctx, "BeaconDB.blockRootsBySlotRange")
@@ -521,7 +562,7 @@ func blockRootsBySlotRange(
 }
 
 // blockRootsBySlot retrieves the block roots by slot
-func blockRootsBySlot(ctx context.Context, tx *bolt.Tx, slot types.Slot) [][]byte {
+func blockRootsBySlot(ctx context.Context, tx backend.Tx, slot types.Slot) [][]byte {
 	_, span := trace.StartSpan(ctx, "BeaconDB.blockRootsBySlot")
 	defer span.End()
 
@@ -595,6 +636,53 @@ func createBlockIndicesFromFilters(ctx context.Context, f *filters.QueryFilter)
 	return indicesByBucket, nil
 }
 
+// blindedBellatrixBlock replaces the execution payload of a block with its header.
+func blindedBellatrixBlock(blk *ethpb.SignedBeaconBlockBellatrix) (*ethpb.SignedBlindedBeaconBlockBellatrix, error) {
+	header, err := blocks.PayloadToHeader(blk.Block.Body.ExecutionPayload)
+	if err != nil {
+		return nil, err
+	}
+	body := blk.Block.Body
+	return &ethpb.SignedBlindedBeaconBlockBellatrix{
+		Block: &ethpb.BlindedBeaconBlockBellatrix{
+			Slot:          blk.Block.Slot,
+			ProposerIndex: blk.Block.ProposerIndex,
+			ParentRoot:    blk.Block.ParentRoot,
+			StateRoot:     blk.Block.StateRoot,
+			Body: &ethpb.BlindedBeaconBlockBodyBellatrix{
+				RandaoReveal:           body.RandaoReveal,
+				Eth1Data:               body.Eth1Data,
+				Graffiti:               body.Graffiti,
+				ProposerSlashings:      body.ProposerSlashings,
+				AttesterSlashings:      body.AttesterSlashings,
+				Attestations:           body.Attestations,
+				Deposits:               body.Deposits,
+				VoluntaryExits:         body.VoluntaryExits,
+				SyncAggregate:          body.SyncAggregate,
+				ExecutionPayloadHeader: header,
+			},
+		},
+		Signature: blk.Signature,
+	}, nil
+}
+
+// blockToSave returns the block in the form it is saved to the database, which is the blinded block
+// for bellatrix blocks when only blinded beacon blocks are stored.
+func blockToSave(blk block.SignedBeaconBlock) (block.SignedBeaconBlock, error) {
+	if blk.Version() != version.Bellatrix || !features.Get().EnableOnlyBlindedBeaconBlocks {
+		return blk, nil
+	}
+	rawBlock, err := blk.PbBellatrixBlock()
+	if err != nil {
+		return nil, err
+	}
+	blindedBlock, err := blindedBellatrixBlock(rawBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not blind block")
+	}
+	return wrapper.WrappedSignedBlindedBeaconBlockBellatrix(blindedBlock)
+}
+
 // unmarshal block from marshaled proto beacon block bytes to versioned beacon block struct type.
 func unmarshalBlock(_ context.Context, enc []byte) (block.SignedBeaconBlock, error) {
 	var err error
@@ -618,6 +706,13 @@ func unmarshalBlock(_ context.Context, enc []byte) (block.SignedBeaconBlock, err
 			return nil, err
 		}
 		return wrapper.WrappedBellatrixSignedBeaconBlock(rawBlock)
+	case hasBellatrixBlindKey(enc):
+		rawBlock := &ethpb.SignedBlindedBeaconBlockBellatrix{}
+		err := rawBlock.UnmarshalSSZ(enc[len(bellatrixBlindKey):])
+		if err != nil {
+			return nil, err
+		}
+		return wrapper.WrappedSignedBlindedBeaconBlockBellatrix(rawBlock)
 	default:
 		// Marshal block bytes to phase 0 beacon block.
 		rawBlock := &ethpb.SignedBeaconBlock{}
@@ -630,12 +725,16 @@ func unmarshalBlock(_ context.Context, enc []byte) (block.SignedBeaconBlock, err
 }
 
 // marshal versioned beacon block from struct type down to bytes.
+// Bellatrix blocks are saved without their execution payload transactions when
+// --enable-only-blinded-beacon-blocks is set.
 func marshalBlock(_ context.Context, blk block.SignedBeaconBlock) ([]byte, error) {
 	obj, err := blk.MarshalSSZ()
 	if err != nil {
 		return nil, err
 	}
 	switch blk.Version() {
+	case version.BellatrixBlind:
+		return snappy.Encode(nil, append(bellatrixBlindKey, obj...)), nil
 	case version.Bellatrix:
 		return snappy.Encode(nil, append(bellatrixKey, obj...)), nil
 	case version.Altair:
@@ -6,11 +6,13 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
@@ -54,6 +56,17 @@ var blockTests = []struct {
 			return wrapper.WrappedBellatrixSignedBeaconBlock(b)
 		},
 	},
+	{
+		name: "bellatrix blind",
+		newBlock: func(slot types.Slot, root []byte) (block.SignedBeaconBlock, error) {
+			b := util.NewBlindedBeaconBlockBellatrix()
+			b.Block.Slot = slot
+			if root != nil {
+				b.Block.ParentRoot = root
+			}
+			return wrapper.WrappedSignedBlindedBeaconBlockBellatrix(b)
+		},
+	},
 }
 
 func TestStore_SaveBlock_NoDuplicates(t *testing.T) {
@@ -589,3 +602,59 @@ func TestStore_BlocksBySlot_BlockRootsBySlot(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.BackfillBlockRoot(ctx)
+	require.ErrorIs(t, err, ErrNotFoundBackfillBlockRoot)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	expected := [32]byte{'b', 'a', 'c', 'k'}
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, expected))
+	actual, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestStore_OnlyBlindedBeaconBlocks(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableOnlyBlindedBeaconBlocks: true,
+	})
+	defer resetCfg()
+
+	db := setupDB(t)
+	ctx := context.Background()
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 10
+	b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte("hash"), 32)
+	b.Block.Body.ExecutionPayload.Transactions = [][]byte{[]byte("tx1"), []byte("tx2")}
+	full, err := wrapper.WrappedBellatrixSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := full.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, full))
+
+	// Blocks are returned as saved, without their payload transactions, with the same root, whether
+	// they are read from the cache or from the database.
+	db.blockCache.Wait()
+	cached, err := db.Block(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, version.BellatrixBlind, cached.Version())
+	db.blockCache.Del(string(root[:]))
+	retrieved, err := db.Block(ctx, root)
+	require.NoError(t, err)
+	require.Equal(t, version.BellatrixBlind, retrieved.Version())
+	retrievedRoot, err := retrieved.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, retrievedRoot)
+	blks, _, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(10).SetEndSlot(10))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+	assert.Equal(t, version.BellatrixBlind, blks[0].Version())
+	_, blks, err = db.BlocksBySlot(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+	assert.Equal(t, version.BellatrixBlind, blks[0].Version())
+}
@@ -0,0 +1,142 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"go.opencensus.io/trace"
+)
+
+// bucketStatsRefreshInterval limits how often the bucket sizes exported to prometheus are
+// recomputed, as it reads every page of the exported buckets.
+const bucketStatsRefreshInterval = 10 * time.Minute
+
+// BucketStats is the number of keys in a bucket of the database and the bytes it takes on disk.
+type BucketStats struct {
+	Name string
+	Keys int
+	Size int
+}
+
+// BucketStats returns the key count and the allocated size of each bucket in the schema. It reads
+// every page of the database, so it is meant for offline use such as the db stats command.
+func (s *Store) BucketStats(ctx context.Context) ([]*BucketStats, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BucketStats")
+	defer span.End()
+	return bucketStats(s.db, nil)
+}
+
+// bucketStats computes the stats of the buckets in the schema, except for the skipped ones.
+func bucketStats(db backend.DB, skip [][]byte) ([]*BucketStats, error) {
+	stats := make([]*BucketStats, 0, len(schemaBuckets))
+	err := db.View(func(tx backend.Tx) error {
+		for _, name := range schemaBuckets {
+			if containsBucket(skip, name) {
+				continue
+			}
+			bkt := tx.Bucket(name)
+			if bkt == nil {
+				continue
+			}
+			bs := bkt.Stats()
+			stats = append(stats, &BucketStats{
+				Name: string(name),
+				Keys: bs.Keys,
+				Size: bs.Size,
+			})
+		}
+		return nil
+	})
+	return stats, err
+}
+
+func containsBucket(buckets [][]byte, name []byte) bool {
+	for _, b := range buckets {
+		if bytes.Equal(b, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// bucketSizeCollector exports the key count and size of the buckets in the schema. Like the bolt
+// collector, it skips the blocked buckets as computing their stats on every scrape is too costly,
+// their sizes are reported by the db stats command instead.
+type bucketSizeCollector struct {
+	db      backend.DB
+	keys    *prometheus.Desc
+	size    *prometheus.Desc
+	lock    sync.Mutex
+	stats   []*BucketStats
+	updated time.Time
+}
+
+func newBucketSizeCollector(db backend.DB) *bucketSizeCollector {
+	return &bucketSizeCollector{
+		db: db,
+		keys: prometheus.NewDesc(
+			"beacondb_bucket_keys",
+			"Number of keys in a bucket of the beacon database.",
+			[]string{"bucket"},
+			nil,
+		),
+		size: prometheus.NewDesc(
+			"beacondb_bucket_size_bytes",
+			"Number of bytes allocated on disk for a bucket of the beacon database.",
+			[]string{"bucket"},
+			nil,
+		),
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *bucketSizeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.keys
+	ch <- c.size
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *bucketSizeCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stats == nil || time.Since(c.updated) > bucketStatsRefreshInterval {
+		stats, err := bucketStats(c.db, blockedBuckets)
+		if err != nil {
+			log.WithError(err).Error("Could not compute bucket stats")
+			return
+		}
+		c.stats = stats
+		c.updated = time.Now()
+	}
+	for _, s := range c.stats {
+		ch <- prometheus.MustNewConstMetric(c.keys, prometheus.GaugeValue, float64(s.Keys), s.Name)
+		ch <- prometheus.MustNewConstMetric(c.size, prometheus.GaugeValue, float64(s.Size), s.Name)
+	}
+}
+
+// dbCollector combines the bolt collector, when bolt is the backend, with the bucket sizes of
+// the beacon database.
+type dbCollector struct {
+	bolt    prometheus.Collector
+	buckets *bucketSizeCollector
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *dbCollector) Describe(ch chan<- *prometheus.Desc) {
+	if c.bolt != nil {
+		c.bolt.Describe(ch)
+	}
+	c.buckets.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *dbCollector) Collect(ch chan<- prometheus.Metric) {
+	if c.bolt != nil {
+		c.bolt.Collect(ch)
+	}
+	c.buckets.Collect(ch)
+}
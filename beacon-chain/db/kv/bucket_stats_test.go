@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_BucketStats(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blks := makeBlocks(t, 0, 10, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	stats, err := db.BucketStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(schemaBuckets), len(stats))
+	for _, s := range stats {
+		if s.Name == string(blocksBucket) {
+			assert.Equal(t, 10, s.Keys)
+			assert.NotEqual(t, 0, s.Size)
+		}
+	}
+}
+
+func TestBucketSizeCollector(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	require.NoError(t, db.SaveBlocks(ctx, makeBlocks(t, 0, 3, genesisBlockRoot)))
+
+	blockKeys := func(c *bucketSizeCollector) int {
+		for _, s := range c.stats {
+			if s.Name == string(blocksBucket) {
+				return s.Keys
+			}
+		}
+		return -1
+	}
+	metadataKeys := func(c *bucketSizeCollector) int {
+		for _, s := range c.stats {
+			if s.Name == string(chainMetadataBucket) {
+				return s.Keys
+			}
+		}
+		return -1
+	}
+	// The blocked buckets are not exported.
+	c := newBucketSizeCollector(db.db)
+	assert.Equal(t, 2*(len(schemaBuckets)-len(blockedBuckets)), testutil.CollectAndCount(c))
+	assert.Equal(t, -1, blockKeys(c))
+	assert.Equal(t, 0, metadataKeys(c))
+
+	// Stats are only recomputed after the refresh interval.
+	require.NoError(t, db.SaveDepositContractAddress(ctx, common.Address{'A'}))
+	testutil.CollectAndCount(c)
+	assert.Equal(t, 0, metadataKeys(c))
+	c.updated = time.Now().Add(-bucketStatsRefreshInterval)
+	testutil.CollectAndCount(c)
+	assert.Equal(t, 1, metadataKeys(c))
+}
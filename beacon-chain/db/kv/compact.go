@@ -0,0 +1,112 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// copyTxMaxSize is the number of bytes copied in a single transaction of the destination database.
+var copyTxMaxSize = 64 * 1024 * 1024
+
+// Compact writes a compacted copy of the database to the given path. Keys are copied in order, so
+// that bolt fills its pages entirely and leveldb writes its tables without overlaps, and the copy
+// does not keep the space left behind by deleted data. The database can still be used while it
+// is being copied.
+func (s *Store) Compact(ctx context.Context, outputPath string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Compact")
+	defer span.End()
+
+	if _, err := os.Stat(outputPath); err == nil {
+		return errors.Errorf("a file already exists at %s", outputPath)
+	}
+	dst, err := backend.Open(s.db.Kind(), outputPath, params.BeaconIoConfig().ReadWritePermissions, &backend.Options{
+		Timeout:     params.BeaconIoConfig().BoltTimeout,
+		NoSync:      true,
+		FillPercent: 1.0,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := dst.Close(); err != nil {
+			log.WithError(err).Error("Failed to close compacted database")
+		}
+	}()
+	return copyBuckets(ctx, s.db, dst)
+}
+
+// copyBuckets copies every bucket of the source database into the destination database, which
+// is synced to disk once done.
+func copyBuckets(ctx context.Context, src, dst backend.DB) error {
+	var names [][]byte
+	if err := src.View(func(tx backend.Tx) error {
+		return tx.ForEach(func(name []byte, _ backend.Bucket) error {
+			names = append(names, bytesutil.SafeCopyBytes(name))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, name := range names {
+		log.Debugf("Copying bucket %s", name)
+		if err := copyBucket(ctx, src, dst, name); err != nil {
+			return errors.Wrapf(err, "could not copy bucket %s", name)
+		}
+	}
+	return dst.Sync()
+}
+
+// copyBucket copies the keys of a bucket into the destination database, in transactions of
+// at most copyTxMaxSize bytes so that neither database holds a long-running transaction.
+func copyBucket(ctx context.Context, src, dst backend.DB, name []byte) error {
+	var last []byte
+	for done := false; !done; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := src.View(func(tx backend.Tx) error {
+			c := tx.Bucket(name).Cursor()
+			k, v := c.First()
+			if last != nil {
+				k, v = c.Seek(last)
+				if k != nil && bytes.Equal(k, last) {
+					k, v = c.Next()
+				}
+			}
+			return dst.Update(func(dstTx backend.Tx) error {
+				b, err := dstTx.CreateBucketIfNotExists(name)
+				if err != nil {
+					return err
+				}
+				size := 0
+				for ; k != nil; k, v = c.Next() {
+					if v == nil {
+						return errors.Errorf("nested bucket %s is not supported", k)
+					}
+					if err := b.Put(k, v); err != nil {
+						return err
+					}
+					last = k
+					size += len(k) + len(v)
+					if size >= copyTxMaxSize {
+						// Keep the last key, as it is only valid during the transaction.
+						last = bytesutil.SafeCopyBytes(last)
+						return nil
+					}
+				}
+				done = true
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package kv
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_Compact(t *testing.T) {
+	// Copy the buckets in many small transactions.
+	defer func(size int) { copyTxMaxSize = size }(copyTxMaxSize)
+	copyTxMaxSize = 1024
+
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{})
+	require.NoError(t, err)
+	ctx := context.Background()
+	blks := makeBlocks(t, 0, uint64(params.BeaconConfig().SlotsPerEpoch)*4, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	deleted, err := blks[0].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.DeleteBlock(ctx, deleted))
+
+	outputPath := filepath.Join(t.TempDir(), DatabaseFileName)
+	require.NoError(t, db.Compact(ctx, outputPath))
+	require.ErrorContains(t, "a file already exists", db.Compact(ctx, outputPath))
+	stats, err := db.BucketStats(ctx)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	compacted, err := NewKVStore(ctx, filepath.Dir(outputPath), &Config{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, compacted.Close())
+	})
+	assert.Equal(t, false, compacted.HasBlock(ctx, deleted))
+	for _, b := range blks[1:] {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, compacted.HasBlock(ctx, r))
+	}
+	_, roots, err := compacted.BlockRootsBySlot(ctx, blks[len(blks)-1].Block().Slot())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(roots))
+
+	compactedStats, err := compacted.BucketStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(stats), len(compactedStats))
+	for i := range stats {
+		assert.Equal(t, stats[i].Keys, compactedStats[i].Keys, "Unexpected key count in bucket %s", stats[i].Name)
+	}
+}
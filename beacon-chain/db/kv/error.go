@@ -5,6 +5,15 @@ import "errors"
 // errDeleteFinalized is raised when we attempt to delete a finalized block/state
 var errDeleteFinalized = errors.New("cannot delete finalized block or state")
 
+// ErrDeleteProtectedState is returned when attempting to delete the genesis, finalized or head state.
+var ErrDeleteProtectedState = errors.New("cannot delete genesis, finalized, or head state")
+
+// errEmptyBlockSlice is returned when a method that operates on a batch of blocks is given none.
+var errEmptyBlockSlice = errors.New("got empty block slice")
+
+// errNotConnectedToFinalized is returned when backfilled blocks do not form a chain leading to an indexed finalized block.
+var errNotConnectedToFinalized = errors.New("blocks are not connected to the finalized index")
+
 // ErrNotFound can be used directly, or as a wrapped DBError, whenever a db method needs to
 // indicate that a value couldn't be found.
 var ErrNotFound = errors.New("not found in db")
@@ -12,6 +21,15 @@ var ErrNotFound = errors.New("not found in db")
 // ErrNotFoundOriginBlockRoot is an error specifically for the origin block root getter
 var ErrNotFoundOriginBlockRoot = WrapDBError(ErrNotFound, "OriginBlockRoot")
 
+// ErrNotFoundBackfillBlockRoot is an error specifically for the backfill block root getter
+var ErrNotFoundBackfillBlockRoot = WrapDBError(ErrNotFound, "BackfillBlockRoot")
+
+// ErrNotFoundFeeRecipient is an error specifically for the fee recipient getter
+var ErrNotFoundFeeRecipient = WrapDBError(ErrNotFound, "FeeRecipientByValidatorID")
+
+// ErrNotFoundRegistration is an error specifically for the builder registration getter
+var ErrNotFoundRegistration = WrapDBError(ErrNotFound, "RegistrationByValidatorID")
+
 // WrapDBError wraps an error in a DBError. See commentary on DBError for more context.
 func WrapDBError(e error, outer string) error {
 	return DBError{
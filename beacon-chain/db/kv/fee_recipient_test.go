@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_FeeRecipientByValidatorID(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	ids := []types.ValidatorIndex{0, 1, 2, 3}
+	feeRecipients := []common.Address{{'a'}, {'b'}, {'c'}, {'d'}}
+	require.NoError(t, db.SaveFeeRecipientsByValidatorIDs(ctx, ids, feeRecipients))
+	for i, id := range ids {
+		f, err := db.FeeRecipientByValidatorID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, feeRecipients[i], f)
+	}
+
+	// Registering again replaces the previous address.
+	require.NoError(t, db.SaveFeeRecipientsByValidatorIDs(ctx, []types.ValidatorIndex{1}, []common.Address{{'e'}}))
+	f, err := db.FeeRecipientByValidatorID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, common.Address{'e'}, f)
+
+	_, err = db.FeeRecipientByValidatorID(ctx, 4)
+	require.ErrorIs(t, err, ErrNotFoundFeeRecipient)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = db.SaveFeeRecipientsByValidatorIDs(ctx, []types.ValidatorIndex{5}, []common.Address{})
+	require.ErrorContains(t, "do not match in length", err)
+}
@@ -4,13 +4,14 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -37,7 +38,7 @@ var containerFinalizedButNotCanonical = []byte("recent block needs reindexing to
 //
 // This method ensures that all blocks from the current finalized epoch are considered "final" while
 // maintaining only canonical and finalized blocks older than the current finalized epoch.
-func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, checkpoint *ethpb.Checkpoint) error {
+func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx backend.Tx, checkpoint *ethpb.Checkpoint) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.updateFinalizedBlockRoots")
 	defer span.End()
 
@@ -46,6 +47,7 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	root := checkpoint.Root
 	var previousRoot []byte
 	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originBlockRootKey)
 
 	// De-index recent finalized block roots, to be re-indexed.
 	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
@@ -104,6 +106,12 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 			return err
 		}
 
+		// Blocks before the checkpoint sync origin are not in the db, so stop here after
+		// indexing the origin block rather than searching for its parent.
+		if originRoot != nil && bytes.Equal(root, originRoot) {
+			break
+		}
+
 		// Found parent, loop exit condition.
 		if parentBytes := bkt.Get(block.ParentRoot()); parentBytes != nil {
 			parent := &ethpb.FinalizedBlockRootContainer{}
@@ -154,6 +162,70 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	return bkt.Put(previousFinalizedCheckpointKey, enc)
 }
 
+// BackfillFinalizedIndex adds blocks saved by backfill to the finalized block roots index. Backfilled blocks
+// sit below the checkpoint sync origin, so they are finalized by definition, but they are never visited by
+// updateFinalizedBlockRoots, which stops walking the ancestry chain at the origin block.
+// The given blocks must be sorted by ascending slot and form a chain, where the last block is the parent
+// of the already indexed block with root finalizedChildRoot.
+func (s *Store) BackfillFinalizedIndex(ctx context.Context, blocks []block.SignedBeaconBlock, finalizedChildRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillFinalizedIndex")
+	defer span.End()
+	if len(blocks) == 0 {
+		return errEmptyBlockSlice
+	}
+
+	roots := make([][32]byte, len(blocks))
+	for i, b := range blocks {
+		if err := helpers.BeaconBlockIsNil(b); err != nil {
+			return err
+		}
+		r, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = r
+		if i > 0 && bytesutil.ToBytes32(b.Block().ParentRoot()) != roots[i-1] {
+			return errors.Wrapf(errNotConnectedToFinalized, "parent root of block %#x does not match root of previous block %#x", r, roots[i-1])
+		}
+	}
+
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		childEnc := bkt.Get(finalizedChildRoot[:])
+		if childEnc == nil {
+			return errors.Wrapf(errNotConnectedToFinalized, "child root %#x is not in the finalized index", finalizedChildRoot)
+		}
+		child := &ethpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, childEnc, child); err != nil {
+			return err
+		}
+		last := roots[len(roots)-1]
+		if bytesutil.ToBytes32(child.ParentRoot) != last {
+			return errors.Wrapf(errNotConnectedToFinalized, "parent root of finalized child %#x does not match root of last block %#x", child.ParentRoot, last)
+		}
+
+		for i, b := range blocks {
+			childRoot := finalizedChildRoot
+			if i < len(blocks)-1 {
+				childRoot = roots[i+1]
+			}
+			enc, err := encode(ctx, &ethpb.FinalizedBlockRootContainer{
+				ParentRoot: b.Block().ParentRoot(),
+				ChildRoot:  childRoot[:],
+			})
+			if err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			if err := bkt.Put(roots[i][:], enc); err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // IsFinalizedBlock returns true if the block root is present in the finalized block root index.
 // A beacon block root contained exists in this index if it is considered finalized and canonical.
 // Note: beacon blocks from the latest finalized epoch return true, whether or not they are
@@ -163,7 +235,7 @@ func (s *Store) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 	defer span.End()
 
 	var exists bool
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		exists = tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:]) != nil
 		// Check genesis block root.
 		if !exists {
@@ -186,7 +258,7 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (bl
 	defer span.End()
 
 	var blk block.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		blkBytes := tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:])
 		if blkBytes == nil {
 			return nil
@@ -55,6 +55,76 @@ func TestStore_IsFinalizedBlock(t *testing.T) {
 	}
 }
 
+func TestStore_IsFinalizedBlock_OriginCheckpoint(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// The parent of the origin block is unknown to the db, as it would be after checkpoint sync.
+	blks := makeBlocks(t, slotsPerEpoch*2, slotsPerEpoch, bytesutil.ToBytes32([]byte("unknown parent")))
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	originRoot, err := blks[0].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, originRoot))
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: originRoot[:]}))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, originRoot))
+}
+
+func TestStore_BackfillFinalizedIndex(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// History below the origin block, which is missing after checkpoint sync.
+	history := makeBlocks(t, 0, slotsPerEpoch*2, genesisBlockRoot)
+	lastRoot, err := history[len(history)-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	blks := makeBlocks(t, slotsPerEpoch*2, slotsPerEpoch, lastRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	originRoot, err := blks[0].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: originRoot[:]}))
+
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, []block.SignedBeaconBlock{}, originRoot), errEmptyBlockSlice)
+	// Blocks must connect to an indexed child.
+	err = db.BackfillFinalizedIndex(ctx, history[slotsPerEpoch:], [32]byte{'n', 'o', 'p', 'e'})
+	require.ErrorIs(t, err, errNotConnectedToFinalized)
+	// The last block must be the parent of the child.
+	err = db.BackfillFinalizedIndex(ctx, history[:slotsPerEpoch], originRoot)
+	require.ErrorIs(t, err, errNotConnectedToFinalized)
+	// Blocks must form a chain.
+	gap := append([]block.SignedBeaconBlock{history[0]}, history[2:]...)
+	err = db.BackfillFinalizedIndex(ctx, gap, originRoot)
+	require.ErrorIs(t, err, errNotConnectedToFinalized)
+
+	// Index the history in two batches, walking backwards like backfill does.
+	require.NoError(t, db.SaveBlocks(ctx, history))
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, history[slotsPerEpoch:], originRoot))
+	batchRoot, err := history[slotsPerEpoch].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, history[:slotsPerEpoch], batchRoot))
+	for i, b := range history {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+	}
+
+	// The finalized child of the last backfilled block is the origin block.
+	child, err := db.FinalizedChildBlock(ctx, lastRoot)
+	require.NoError(t, err)
+	childRoot, err := child.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, originRoot, childRoot)
+}
+
 func TestStore_IsFinalizedBlockGenesis(t *testing.T) {
 	db := setupDB(t)
 	ctx := context.Background()
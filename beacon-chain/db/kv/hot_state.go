@@ -0,0 +1,67 @@
+package kv
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// SaveHotStateRoot records that the state of a block root after the finalized checkpoint was
+// saved to the DB, so that it can be found and deleted once the chain finalizes again.
+func (s *Store) SaveHotStateRoot(ctx context.Context, summary *ethpb.StateSummary) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveHotStateRoot")
+	defer span.End()
+
+	if len(summary.Root) != 32 {
+		return errors.Errorf("invalid block root length %d", len(summary.Root))
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(hotStateRootsBucket).Put(summary.Root, bytesutil.SlotToBytesBigEndian(summary.Slot))
+	})
+}
+
+// HotStateRoots returns the slots and block roots of the hot states recorded in the DB, by
+// increasing slot.
+func (s *Store) HotStateRoots(ctx context.Context) ([]*ethpb.StateSummary, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HotStateRoots")
+	defer span.End()
+
+	var summaries []*ethpb.StateSummary
+	if err := s.db.View(func(tx backend.Tx) error {
+		return tx.Bucket(hotStateRootsBucket).ForEach(func(k, v []byte) error {
+			summaries = append(summaries, &ethpb.StateSummary{
+				Slot: bytesutil.BytesToSlotBigEndian(v),
+				Root: bytesutil.SafeCopyBytes(k),
+			})
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Slot < summaries[j].Slot
+	})
+	return summaries, nil
+}
+
+// DeleteHotStateRoots removes block roots from the recorded hot states. The states themselves
+// are not deleted.
+func (s *Store) DeleteHotStateRoots(ctx context.Context, blockRoots [][32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteHotStateRoots")
+	defer span.End()
+
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(hotStateRootsBucket)
+		for _, r := range blockRoots {
+			if err := bkt.Delete(r[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_HotStateRoots(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	roots, err := db.HotStateRoots(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+
+	summaries := []*ethpb.StateSummary{
+		{Slot: 256, Root: bytesutil.PadTo([]byte{'a'}, 32)},
+		{Slot: 128, Root: bytesutil.PadTo([]byte{'b'}, 32)},
+		{Slot: 384, Root: bytesutil.PadTo([]byte{'c'}, 32)},
+	}
+	for _, s := range summaries {
+		require.NoError(t, db.SaveHotStateRoot(ctx, s))
+	}
+	require.ErrorContains(t, "invalid block root length", db.SaveHotStateRoot(ctx, &ethpb.StateSummary{Root: []byte{'d'}}))
+
+	roots, err = db.HotStateRoots(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.StateSummary{summaries[1], summaries[0], summaries[2]}, roots)
+
+	require.NoError(t, db.DeleteHotStateRoots(ctx, [][32]byte{bytesutil.ToBytes32(summaries[0].Root), {'e'}}))
+	roots, err = db.HotStateRoots(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.StateSummary{summaries[1], summaries[2]}, roots)
+}
@@ -1,5 +1,5 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation of the Database
+// interface defined by a Prysm beacon node, written to bolt-db by default.
 package kv
 
 import (
@@ -12,11 +12,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
 	prombolt "github.com/prysmaticlabs/prombbolt"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/io/file"
-	bolt "go.etcd.io/bbolt"
 )
 
 var _ iface.Database = (*Store)(nil)
@@ -31,8 +32,9 @@ const (
 	BeaconNodeDbDirName = "beaconchaindata"
 	// DatabaseFileName is the name of the beacon node database.
 	DatabaseFileName = "beaconchain.db"
+	// LevelDBDirName is the name of the directory of the beacon node database with the leveldb backend.
+	LevelDBDirName = "beaconchain-leveldb"
 
-	boltAllocSize = 8 * 1024 * 1024
 	// The size of hash length in bytes
 	hashLength = 32
 )
@@ -68,20 +70,30 @@ var blockedBuckets = [][]byte{
 	finalizedBlockRootsIndexBucket,
 }
 
-// Config for the bolt db kv store.
+// Config for the kv store.
 type Config struct {
+	// Backend is the key-value store the database is written to. It defaults to the backend
+	// of the existing database, and to bolt for a new database.
+	Backend         backend.Kind
 	InitialMMapSize int
+	// HistoryRetentionEpochs is the number of epochs of history kept behind the finalized
+	// checkpoint. The full history is kept when it is zero.
+	HistoryRetentionEpochs types.Epoch
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for Ethereum Beacon Nodes.
+// using a key-value store, BoltDB by default, as the underlying persistent kv-store for Ethereum Beacon Nodes.
 type Store struct {
-	db                  *bolt.DB
+	db                  backend.DB
 	databasePath        string
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
+
+	// historyRetentionEpochs is the number of epochs of blocks and states kept behind the
+	// finalized checkpoint. Older history is pruned by the pruner service as checkpoints are finalized.
+	historyRetentionEpochs types.Epoch
 }
 
 // KVStoreDatafilePath is the canonical construction of a full
@@ -91,7 +103,54 @@ func KVStoreDatafilePath(dirPath string) string {
 	return path.Join(dirPath, DatabaseFileName)
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// KVStoreBackendPath returns the path of the database of the given backend in the directory,
+// which is a file for bolt and a directory for leveldb.
+func KVStoreBackendPath(dirPath string, kind backend.Kind) string {
+	if kind == backend.LevelDB {
+		return path.Join(dirPath, LevelDBDirName)
+	}
+	return KVStoreDatafilePath(dirPath)
+}
+
+// ExistingBackends returns the backends of the databases found in the directory.
+func ExistingBackends(dirPath string) []backend.Kind {
+	var kinds []backend.Kind
+	for _, kind := range backend.Kinds {
+		if _, err := os.Stat(KVStoreBackendPath(dirPath, kind)); err == nil {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// selectBackend returns the backend of the database in the directory. When no backend is
+// configured, the backend of the existing database is used, and bolt for a new database.
+func selectBackend(dirPath string, kind backend.Kind) (backend.Kind, error) {
+	existing := ExistingBackends(dirPath)
+	if kind == "" {
+		if len(existing) > 1 {
+			return "", errors.Errorf("found databases of several backends %v in %s, set the backend to use", existing, dirPath)
+		}
+		if len(existing) == 1 {
+			return existing[0], nil
+		}
+		return backend.Bolt, nil
+	}
+	for _, k := range existing {
+		if k == kind {
+			return kind, nil
+		}
+	}
+	if len(existing) > 0 {
+		return "", errors.Errorf(
+			"the database in %s uses the %s backend, migrate it to the %s backend with the db migrate-backend command",
+			dirPath, existing[0], kind,
+		)
+	}
+	return kind, nil
+}
+
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
@@ -104,27 +163,23 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			return nil, err
 		}
 	}
-	datafile := KVStoreDatafilePath(dirPath)
+	kind, err := selectBackend(dirPath, config.Backend)
+	if err != nil {
+		return nil, err
+	}
+	datafile := KVStoreBackendPath(dirPath, kind)
 	start := time.Now()
-	log.Infof("Opening Bolt DB at %s", datafile)
-	boltDB, err := bolt.Open(
-		datafile,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{
-			Timeout:         1 * time.Second,
-			InitialMmapSize: config.InitialMMapSize,
-		},
-	)
+	log.Infof("Opening %s DB at %s", kind, datafile)
+	kvDB, err := backend.Open(kind, datafile, params.BeaconIoConfig().ReadWritePermissions, &backend.Options{
+		Timeout:         1 * time.Second,
+		InitialMMapSize: config.InitialMMapSize,
+	})
 	if err != nil {
-		log.WithField("elapsed", time.Since(start)).Error("Failed to open Bolt DB")
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
+		log.WithField("elapsed", time.Since(start)).Errorf("Failed to open %s DB", kind)
 		return nil, err
 	}
-	log.WithField("elapsed", time.Since(start)).Info("Opened Bolt DB")
+	log.WithField("elapsed", time.Since(start)).Infof("Opened %s DB", kind)
 
-	boltDB.AllocSize = boltAllocSize
 	start = time.Now()
 	log.Infof("Creating block cache...")
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
@@ -152,53 +207,26 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	log.WithField("elapsed", time.Since(start)).Info("Created validator cache")
 
 	kv := &Store{
-		db:                  boltDB,
+		db:                  kvDB,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorEntryCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
 		ctx:                 ctx,
+
+		historyRetentionEpochs: config.HistoryRetentionEpochs,
 	}
 	start = time.Now()
 	log.Infof("Updating DB and creating buckets...")
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
-		return createBuckets(
-			tx,
-			attestationsBucket,
-			blocksBucket,
-			stateBucket,
-			proposerSlashingsBucket,
-			attesterSlashingsBucket,
-			voluntaryExitsBucket,
-			chainMetadataBucket,
-			checkpointBucket,
-			powchainBucket,
-			stateSummaryBucket,
-			stateValidatorsBucket,
-			validatedTips,
-			// Indices buckets.
-			attestationHeadBlockRootBucket,
-			attestationSourceRootIndicesBucket,
-			attestationSourceEpochIndicesBucket,
-			attestationTargetRootIndicesBucket,
-			attestationTargetEpochIndicesBucket,
-			blockSlotIndicesBucket,
-			stateSlotIndicesBucket,
-			blockParentRootIndicesBucket,
-			finalizedBlockRootsIndexBucket,
-			blockRootValidatorHashesBucket,
-			// State management service bucket.
-			newStateServiceCompatibleBucket,
-			// Migrations
-			migrationsBucket,
-		)
+	if err := kv.db.Update(func(tx backend.Tx) error {
+		return createBuckets(tx, schemaBuckets...)
 	}); err != nil {
 		log.WithField("elapsed", time.Since(start)).Error("Failed to update db and create buckets")
 		return nil, err
 	}
 	log.WithField("elapsed", time.Since(start)).Info("Updated db and created buckets")
 
-	err = prometheus.Register(createBoltCollector(kv.db))
+	err = prometheus.Register(createCollector(kv.db))
 
 	return kv, err
 }
@@ -208,16 +236,16 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	prometheus.Unregister(createCollector(s.db))
+	if err := os.RemoveAll(s.db.Path()); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying key-value store.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	prometheus.Unregister(createCollector(s.db))
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
@@ -232,7 +260,12 @@ func (s *Store) DatabasePath() string {
 	return s.databasePath
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+// Backend returns the key-value store the database is written to.
+func (s *Store) Backend() backend.Kind {
+	return s.db.Kind()
+}
+
+func createBuckets(tx backend.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
@@ -241,7 +274,12 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	return nil
 }
 
-// createBoltCollector returns a prometheus collector specifically configured for boltdb.
-func createBoltCollector(db *bolt.DB) prometheus.Collector {
-	return prombolt.New("boltDB", db, blockedBuckets...)
+// createCollector returns a prometheus collector of the sizes of the buckets which are not
+// blocked, along with the metrics of boltdb when it is the backend.
+func createCollector(db backend.DB) prometheus.Collector {
+	c := &dbCollector{buckets: newBucketSizeCollector(db)}
+	if boltDB, ok := backend.UnwrapBolt(db); ok {
+		c.bolt = prombolt.New("boltDB", boltDB, blockedBuckets...)
+	}
+	return c
 }
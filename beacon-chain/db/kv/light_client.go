@@ -0,0 +1,86 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// LightClientUpdates returns the best light client updates of the sync committee periods starting
+// at startPeriod, in order. At most count updates are returned, and the updates stop at the
+// first period without one.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpb.LightClientUpdate, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+	updates := make([]*ethpb.LightClientUpdate, 0)
+	err := s.db.View(func(tx backend.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		period := startPeriod
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startPeriod)); k != nil && uint64(len(updates)) < count; k, v = c.Next() {
+			if bytesutil.BytesToUint64BigEndian(k) != period {
+				break
+			}
+			update := &ethpb.LightClientUpdate{}
+			if err := update.UnmarshalSSZ(v); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+			period++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+// SaveLightClientUpdate saves the best light client update of a sync committee period, replacing
+// any previously saved update of the period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+	enc, err := update.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(lightClientUpdatesBucket).Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+}
+
+// LightClientBootstrap returns the light client bootstrap of a block root, or nil if none was saved.
+func (s *Store) LightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*ethpb.LightClientBootstrap, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientBootstrap")
+	defer span.End()
+	var bootstrap *ethpb.LightClientBootstrap
+	err := s.db.View(func(tx backend.Tx) error {
+		enc := tx.Bucket(lightClientBootstrapsBucket).Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+		bootstrap = &ethpb.LightClientBootstrap{}
+		return bootstrap.UnmarshalSSZ(enc)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bootstrap, nil
+}
+
+// SaveLightClientBootstrap saves the light client bootstrap of a block root. Bootstraps are pruned
+// together with their blocks.
+func (s *Store) SaveLightClientBootstrap(ctx context.Context, blockRoot [32]byte, bootstrap *ethpb.LightClientBootstrap) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientBootstrap")
+	defer span.End()
+	enc, err := bootstrap.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(lightClientBootstrapsBucket).Put(blockRoot[:], enc)
+	})
+}
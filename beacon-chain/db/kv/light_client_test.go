@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	updates, err := db.LightClientUpdates(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+
+	for _, period := range []uint64{2, 3, 4, 6} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, util.NewLightClientUpdate(types.Slot(period))))
+	}
+	// Saving the update of a period again replaces it.
+	best := util.NewLightClientUpdate(100)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 3, best))
+
+	updates, err = db.LightClientUpdates(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(updates))
+	assert.DeepSSZEqual(t, util.NewLightClientUpdate(2), updates[0])
+	assert.DeepSSZEqual(t, best, updates[1])
+	assert.DeepSSZEqual(t, util.NewLightClientUpdate(4), updates[2])
+
+	updates, err = db.LightClientUpdates(ctx, 3, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.DeepSSZEqual(t, best, updates[0])
+
+	// Updates stop at the first missing period.
+	updates, err = db.LightClientUpdates(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+	updates, err = db.LightClientUpdates(ctx, 6, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(updates))
+}
+
+func TestStore_LightClientBootstrap(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	root := [32]byte{'a'}
+	bootstrap, err := db.LightClientBootstrap(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.LightClientBootstrap)(nil), bootstrap)
+
+	want := util.NewLightClientBootstrap(32)
+	require.NoError(t, db.SaveLightClientBootstrap(ctx, root, want))
+	bootstrap, err = db.LightClientBootstrap(ctx, root)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, bootstrap)
+}
@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"go.opencensus.io/trace"
+)
+
+// MigrateBackend copies every bucket of the database to a new database of the given backend,
+// in the same directory. The database keeps using its current backend; the copy is used once
+// the directory is opened with the new backend.
+func (s *Store) MigrateBackend(ctx context.Context, kind backend.Kind) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.MigrateBackend")
+	defer span.End()
+
+	if kind == s.db.Kind() {
+		return errors.Errorf("database already uses the %s backend", kind)
+	}
+	p := KVStoreBackendPath(s.databasePath, kind)
+	if _, err := os.Stat(p); err == nil {
+		return errors.Errorf("a %s database already exists at %s", kind, p)
+	}
+	dst, err := backend.Open(kind, p, params.BeaconIoConfig().ReadWritePermissions, &backend.Options{
+		NoSync:      true,
+		FillPercent: 1.0,
+	})
+	if err != nil {
+		return err
+	}
+	if err := copyBuckets(ctx, s.db, dst); err != nil {
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close migrated database")
+		}
+		// Do not leave a partial copy behind, which would be opened as the database.
+		if rmErr := os.RemoveAll(p); rmErr != nil {
+			log.WithError(rmErr).Errorf("Could not remove %s", p)
+		}
+		return err
+	}
+	return dst.Close()
+}
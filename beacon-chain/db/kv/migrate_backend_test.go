@@ -0,0 +1,81 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_MigrateBackend(t *testing.T) {
+	// Copy the buckets in many small transactions.
+	defer func(size int) { copyTxMaxSize = size }(copyTxMaxSize)
+	copyTxMaxSize = 1024
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, backend.Bolt, db.Backend())
+	blks := makeBlocks(t, 0, uint64(params.BeaconConfig().SlotsPerEpoch)*2, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	root, err := blks[len(blks)-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+
+	require.ErrorContains(t, "database already uses the bolt backend", db.MigrateBackend(ctx, backend.Bolt))
+	require.NoError(t, db.MigrateBackend(ctx, backend.LevelDB))
+	require.ErrorContains(t, "a leveldb database already exists", db.MigrateBackend(ctx, backend.LevelDB))
+	stats, err := db.BucketStats(ctx)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	assert.DeepEqual(t, []backend.Kind{backend.Bolt, backend.LevelDB}, ExistingBackends(dir))
+	_, err = NewKVStore(ctx, dir, &Config{})
+	require.ErrorContains(t, "found databases of several backends", err)
+	migrated, err := NewKVStore(ctx, dir, &Config{Backend: backend.LevelDB})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, migrated.Close())
+	})
+	assert.Equal(t, backend.LevelDB, migrated.Backend())
+	for _, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, migrated.HasBlock(ctx, r))
+	}
+	_, roots, err := migrated.BlockRootsBySlot(ctx, blks[len(blks)-1].Block().Slot())
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{root}, roots)
+	assert.Equal(t, true, migrated.HasState(ctx, root))
+
+	migratedStats, err := migrated.BucketStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(stats), len(migratedStats))
+	for i := range stats {
+		assert.Equal(t, stats[i].Keys, migratedStats[i].Keys, "Unexpected key count in bucket %s", stats[i].Name)
+	}
+}
+
+func TestNewKVStore_OtherBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{Backend: backend.LevelDB})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// An existing database is opened with its backend by default.
+	db, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, backend.LevelDB, db.Backend())
+	require.NoError(t, db.Close())
+
+	_, err = NewKVStore(ctx, dir, &Config{Backend: backend.Bolt})
+	require.ErrorContains(t, "uses the leveldb backend, migrate it to the bolt backend", err)
+}
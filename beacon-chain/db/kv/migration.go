@@ -3,17 +3,18 @@ package kv
 import (
 	"context"
 
-	bolt "go.etcd.io/bbolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(context.Context, *bolt.DB) error
+type migration func(context.Context, backend.DB) error
 
 var migrations = []migration{
 	migrateArchivedIndex,
 	migrateBlockSlotIndex,
 	migrateStateValidators,
+	migrateBlindedBeaconBlocks,
 }
 
 // RunMigrations defined in the migrations array.
@@ -5,15 +5,15 @@ import (
 	"context"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 )
 
 var migrationArchivedIndex0Key = []byte("archive_index_0")
 
-func migrateArchivedIndex(ctx context.Context, db *bolt.DB) error {
-	if updateErr := db.Update(func(tx *bolt.Tx) error {
+func migrateArchivedIndex(ctx context.Context, db backend.DB) error {
+	if updateErr := db.Update(func(tx backend.Tx) error {
 		mb := tx.Bucket(migrationsBucket)
 		if b := mb.Get(migrationArchivedIndex0Key); bytes.Equal(b, migrationCompleted) {
 			return nil // Migration already completed.
@@ -0,0 +1,109 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/monitoring/progress"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+const blindedBlocksMigrationBatchSize = 100
+
+var migrationBlindedBeaconBlocksKey = []byte("migration_blinded_beacon_blocks")
+
+// migrateBlindedBeaconBlocks replaces the execution payload of the full Bellatrix blocks in
+// the database with their payload header, when --enable-only-blinded-beacon-blocks is set.
+func migrateBlindedBeaconBlocks(ctx context.Context, db backend.DB) error {
+	migrateDB := false
+	if err := db.View(func(tx backend.Tx) error {
+		mb := tx.Bucket(migrationsBucket)
+		completed := bytes.Equal(mb.Get(migrationBlindedBeaconBlocksKey), migrationCompleted)
+		if !features.Get().EnableOnlyBlindedBeaconBlocks {
+			if completed {
+				log.Warning("Beacon blocks were previously migrated to blinded blocks. These blocks stay blinded and " +
+					"are rebuilt from the execution client on read, while new blocks are saved in full.")
+			}
+			return nil
+		}
+		migrateDB = !completed
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !migrateDB {
+		return nil
+	}
+
+	log.Infof("Performing a one-time migration of %s to blinded beacon blocks. It may take a few minutes", blocksBucket)
+
+	// Only block roots are 32 bytes long keys in the blocks bucket.
+	var keys [][]byte
+	if err := db.View(func(tx backend.Tx) error {
+		return tx.Bucket(blocksBucket).ForEach(func(k, _ []byte) error {
+			if len(k) == hashLength {
+				keys = append(keys, bytesutil.SafeCopyBytes(k))
+			}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	bar := progress.InitializeProgressBar(len(keys), "Migrating beacon blocks to blinded beacon blocks.")
+	for i := 0; i < len(keys); i += blindedBlocksMigrationBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := i + blindedBlocksMigrationBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := db.Update(func(tx backend.Tx) error {
+			bkt := tx.Bucket(blocksBucket)
+			for _, k := range keys[i:end] {
+				enc, err := snappy.Decode(nil, bkt.Get(k))
+				if err != nil {
+					return err
+				}
+				if !hasBellatrixKey(enc) {
+					continue
+				}
+				rawBlock := &ethpb.SignedBeaconBlockBellatrix{}
+				if err := rawBlock.UnmarshalSSZ(enc[len(bellatrixKey):]); err != nil {
+					return errors.Wrapf(err, "could not unmarshal block %#x", k)
+				}
+				blindedBlock, err := blindedBellatrixBlock(rawBlock)
+				if err != nil {
+					return errors.Wrapf(err, "could not blind block %#x", k)
+				}
+				obj, err := blindedBlock.MarshalSSZ()
+				if err != nil {
+					return err
+				}
+				if err := bkt.Put(k, snappy.Encode(nil, append(bellatrixBlindKey, obj...))); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := bar.Add(end - i); err != nil {
+			return err
+		}
+	}
+
+	if err := db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(migrationsBucket).Put(migrationBlindedBeaconBlocksKey, migrationCompleted)
+	}); err != nil {
+		return err
+	}
+	log.Infof("Migration done for bucket %s.", blocksBucket)
+	return nil
+}
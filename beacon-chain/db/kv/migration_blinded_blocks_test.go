@@ -0,0 +1,86 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func Test_migrateBlindedBeaconBlocks(t *testing.T) {
+	tests := []struct {
+		name        string
+		enabled     bool
+		wantVersion int
+		wantDone    bool
+	}{
+		{
+			name:        "flag disabled, blocks are not migrated",
+			wantVersion: version.Bellatrix,
+		},
+		{
+			name:        "flag enabled, bellatrix blocks are blinded",
+			enabled:     true,
+			wantVersion: version.BellatrixBlind,
+			wantDone:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := setupDB(t)
+			b := util.NewBeaconBlockBellatrix()
+			b.Block.Slot = 3
+			b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte("hash"), 32)
+			b.Block.Body.ExecutionPayload.Transactions = [][]byte{[]byte("tx")}
+			bellatrixBlk, err := wrapper.WrappedBellatrixSignedBeaconBlock(b)
+			require.NoError(t, err)
+			altairBlk, err := wrapper.WrappedAltairSignedBeaconBlock(util.NewBeaconBlockAltair())
+			require.NoError(t, err)
+			require.NoError(t, db.SaveBlocks(ctx, []block.SignedBeaconBlock{bellatrixBlk, altairBlk}))
+			bellatrixRoot, err := bellatrixBlk.Block().HashTreeRoot()
+			require.NoError(t, err)
+			altairRoot, err := altairBlk.Block().HashTreeRoot()
+			require.NoError(t, err)
+			require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Root: bellatrixRoot[:], Slot: 3}))
+			require.NoError(t, db.SaveHeadBlockRoot(ctx, bellatrixRoot))
+
+			resetCfg := features.InitWithReset(&features.Flags{
+				EnableOnlyBlindedBeaconBlocks: tt.enabled,
+			})
+			defer resetCfg()
+			require.NoError(t, migrateBlindedBeaconBlocks(ctx, db.db))
+			// Running the migration again is a no-op.
+			require.NoError(t, migrateBlindedBeaconBlocks(ctx, db.db))
+
+			db.blockCache.Clear()
+			got, err := db.Block(ctx, bellatrixRoot)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVersion, got.Version())
+			gotRoot, err := got.Block().HashTreeRoot()
+			require.NoError(t, err)
+			assert.Equal(t, bellatrixRoot, gotRoot)
+			got, err = db.Block(ctx, altairRoot)
+			require.NoError(t, err)
+			assert.Equal(t, version.Altair, got.Version())
+			head, err := db.HeadBlock(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVersion, head.Version())
+
+			require.NoError(t, db.db.View(func(tx backend.Tx) error {
+				done := tx.Bucket(migrationsBucket).Get(migrationBlindedBeaconBlocksKey)
+				assert.Equal(t, tt.wantDone, done != nil)
+				return nil
+			}))
+		})
+	}
+}
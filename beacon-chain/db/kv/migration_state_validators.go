@@ -8,19 +8,19 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/snappy"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/monitoring/progress"
 	v1alpha1 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 )
 
 const batchSize = 10
 
 var migrationStateValidatorsKey = []byte("migration_state_validator")
 
-func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
+func migrateStateValidators(ctx context.Context, db backend.DB) error {
 	migrateDB := false
-	if updateErr := db.View(func(tx *bolt.Tx) error {
+	if updateErr := db.View(func(tx backend.Tx) error {
 		mb := tx.Bucket(migrationsBucket)
 		// feature flag is not enabled
 		// - migration is complete, don't migrate the DB but warn that this will work as if the flag is enabled.
@@ -58,7 +58,7 @@ func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
 
 	// get all the keys to migrate
 	var keys [][]byte
-	if err := db.Update(func(tx *bolt.Tx) error {
+	if err := db.Update(func(tx backend.Tx) error {
 		stateBkt := tx.Bucket(stateBucket)
 		if stateBkt == nil {
 			return nil
@@ -79,7 +79,7 @@ func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
 
 	batchNo := 0
 	for batchIndex := 0; batchIndex < len(keys); batchIndex += batchSize {
-		if err := db.Update(func(tx *bolt.Tx) error {
+		if err := db.Update(func(tx backend.Tx) error {
 			//create the source and destination buckets
 			stateBkt := tx.Bucket(stateBucket)
 			if stateBkt == nil {
@@ -178,7 +178,7 @@ func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
 	}
 
 	// set the migration entry to done
-	if err := db.Update(func(tx *bolt.Tx) error {
+	if err := db.Update(func(tx backend.Tx) error {
 		mb := tx.Bucket(migrationsBucket)
 		if mb == nil {
 			return nil
@@ -191,7 +191,7 @@ func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
 	return nil
 }
 
-func stateBucketKeys(stateBucket *bolt.Bucket) ([][]byte, error) {
+func stateBucketKeys(stateBucket backend.Bucket) ([][]byte, error) {
 	var keys [][]byte
 	if err := stateBucket.ForEach(func(pubKey, v []byte) error {
 		keys = append(keys, pubKey)
@@ -202,7 +202,7 @@ func stateBucketKeys(stateBucket *bolt.Bucket) ([][]byte, error) {
 	return keys, nil
 }
 
-func insertValidatorHashes(ctx context.Context, validators []*v1alpha1.Validator, valBkt *bolt.Bucket) ([]byte, error) {
+func insertValidatorHashes(ctx context.Context, validators []*v1alpha1.Validator, valBkt backend.Bucket) ([]byte, error) {
 	// move all the validators in this state registry out to a new bucket.
 	var validatorKeys []byte
 	for _, val := range validators {
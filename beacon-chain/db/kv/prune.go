@@ -0,0 +1,241 @@
+package kv
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const pruneHistoryBatchSize = 256
+
+// pruneHistoryMaxSlots bounds how far the origin of the history moves forward in a single pruning, so that
+// enabling the retention window on a node with a long history does not delete it all at once.
+var pruneHistoryMaxSlots = types.Slot(8192)
+
+// historyRootBuckets are the buckets keyed by block root which are cleared for pruned blocks.
+var historyRootBuckets = [][]byte{
+	blocksBucket,
+	stateBucket,
+	stateSummaryBucket,
+	blockRootValidatorHashesBucket,
+	lightClientBootstrapsBucket,
+	blockParentRootIndicesBucket,
+	finalizedBlockRootsIndexBucket,
+	attestationHeadBlockRootBucket,
+	attestationSourceRootIndicesBucket,
+	attestationTargetRootIndicesBucket,
+}
+
+// HistoryPrunedSlot returns the slot of the lowest block kept by the last history pruning.
+// Blocks and states below that slot, except for genesis, were deleted from the db.
+// It returns 0 if history was never pruned.
+func (s *Store) HistoryPrunedSlot(ctx context.Context) (types.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HistoryPrunedSlot")
+	defer span.End()
+
+	var slot types.Slot
+	err := s.db.View(func(tx backend.Tx) error {
+		if enc := tx.Bucket(chainMetadataBucket).Get(historyPrunedSlotKey); enc != nil {
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// prunedHistory lists what PruneHistory deletes from the db.
+type prunedHistory struct {
+	originRoot     []byte
+	originSlot     types.Slot
+	roots          [][]byte
+	blockSlotKeys  [][]byte
+	stateSlotKeys  [][]byte
+	stateDiffKeys  [][]byte
+	epochIndexKeys map[string][][]byte
+	attRoots       [][]byte
+}
+
+// PruneHistory deletes the blocks, states and indices older than the history retention window
+// behind the finalized epoch. History is kept from the lowest finalized block in the window which
+// has a saved state, so that the state of every kept slot can still be regenerated. The origin block
+// root is moved forward to that block. The genesis block and state are always kept.
+//
+// A single call prunes at most pruneHistoryMaxSlots slots past the previous pruning, the rest of the
+// history outside of the window is pruned by the following calls.
+func (s *Store) PruneHistory(ctx context.Context, finalizedEpoch types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	if s.historyRetentionEpochs == 0 || finalizedEpoch <= s.historyRetentionEpochs {
+		return nil
+	}
+	pruneSlot, err := slots.EpochStart(finalizedEpoch - s.historyRetentionEpochs)
+	if err != nil {
+		return err
+	}
+	prunedSlot, err := s.HistoryPrunedSlot(ctx)
+	if err != nil {
+		return err
+	}
+	if pruneSlot > prunedSlot+pruneHistoryMaxSlots {
+		pruneSlot = prunedSlot + pruneHistoryMaxSlots
+	}
+	pruneEpoch := slots.ToEpoch(pruneSlot)
+
+	h := &prunedHistory{epochIndexKeys: make(map[string][][]byte)}
+	if err := s.db.View(func(tx backend.Tx) error {
+		h.originRoot, h.originSlot = lowestFinalizedStateAtOrAbove(tx, pruneSlot)
+		if h.originRoot == nil || h.originSlot <= prunedSlot {
+			return nil
+		}
+		genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		seen := make(map[string]bool)
+		collect := func(bkt []byte) [][]byte {
+			var keys [][]byte
+			c := tx.Bucket(bkt).Cursor()
+			for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(1)); k != nil; k, v = c.Next() {
+				if bytesutil.BytesToSlotBigEndian(k) >= h.originSlot {
+					break
+				}
+				keys = append(keys, bytesutil.SafeCopyBytes(k))
+				for i := 0; i+hashLength <= len(v); i += hashLength {
+					r := v[i : i+hashLength]
+					if seen[string(r)] || bytesutil.ToBytes32(r) == bytesutil.ToBytes32(genesisRoot) {
+						continue
+					}
+					seen[string(r)] = true
+					h.roots = append(h.roots, bytesutil.SafeCopyBytes(r))
+				}
+			}
+			return keys
+		}
+		h.blockSlotKeys = collect(blockSlotIndicesBucket)
+		h.stateSlotKeys = collect(stateSlotIndicesBucket)
+		c := tx.Bucket(stateDiffBucket).Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToSlotBigEndian(k) < h.originSlot; k, _ = c.Next() {
+			h.stateDiffKeys = append(h.stateDiffKeys, bytesutil.SafeCopyBytes(k))
+		}
+
+		// Attestation epoch indices are keyed by the little endian epoch.
+		for _, bkt := range [][]byte{attestationSourceEpochIndicesBucket, attestationTargetEpochIndicesBucket} {
+			if err := tx.Bucket(bkt).ForEach(func(k, v []byte) error {
+				if len(k) != 8 || types.Epoch(bytesutil.FromBytes8(k)) >= pruneEpoch {
+					return nil
+				}
+				h.epochIndexKeys[string(bkt)] = append(h.epochIndexKeys[string(bkt)], bytesutil.SafeCopyBytes(k))
+				if string(bkt) == string(attestationTargetEpochIndicesBucket) {
+					for i := 0; i+hashLength <= len(v); i += hashLength {
+						h.attRoots = append(h.attRoots, bytesutil.SafeCopyBytes(v[i:i+hashLength]))
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if h.originRoot == nil || h.originSlot <= prunedSlot {
+		return nil
+	}
+
+	for _, r := range h.roots {
+		s.blockCache.Del(string(r))
+		s.stateSummaryCache.delete(bytesutil.ToBytes32(r))
+	}
+	if err := s.deleteInBatches(ctx, h.roots, historyRootBuckets...); err != nil {
+		return err
+	}
+	if err := s.deleteInBatches(ctx, h.blockSlotKeys, blockSlotIndicesBucket); err != nil {
+		return err
+	}
+	if err := s.deleteInBatches(ctx, h.stateSlotKeys, stateSlotIndicesBucket); err != nil {
+		return err
+	}
+	if err := s.deleteInBatches(ctx, h.stateDiffKeys, stateDiffBucket); err != nil {
+		return err
+	}
+	for bkt, keys := range h.epochIndexKeys {
+		if err := s.deleteInBatches(ctx, keys, []byte(bkt)); err != nil {
+			return err
+		}
+	}
+	if err := s.deleteInBatches(ctx, h.attRoots, attestationsBucket); err != nil {
+		return err
+	}
+
+	if err := s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		if err := bkt.Put(originBlockRootKey, h.originRoot); err != nil {
+			return err
+		}
+		// Backfill resumes from the new origin if the lowest block it saved was pruned.
+		if backfillRoot := bkt.Get(backfillBlockRootKey); backfillRoot != nil && bkt.Get(backfillRoot) == nil {
+			if err := bkt.Put(backfillBlockRootKey, h.originRoot); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(chainMetadataBucket).Put(historyPrunedSlotKey, bytesutil.SlotToBytesBigEndian(h.originSlot))
+	}); err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":   h.originSlot,
+		"blocks": len(h.roots),
+	}).Info("Pruned history older than the retention window")
+	return nil
+}
+
+// lowestFinalizedStateAtOrAbove returns the root and slot of the lowest finalized block at or above
+// the given slot which has both its block and state saved in the db.
+func lowestFinalizedStateAtOrAbove(tx backend.Tx, slot types.Slot) ([]byte, types.Slot) {
+	finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+	blks := tx.Bucket(blocksBucket)
+	states := tx.Bucket(stateBucket)
+	c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot)); k != nil; k, v = c.Next() {
+		for i := 0; i+hashLength <= len(v); i += hashLength {
+			r := v[i : i+hashLength]
+			if finalized.Get(r) != nil && blks.Get(r) != nil && states.Get(r) != nil {
+				return bytesutil.SafeCopyBytes(r), bytesutil.BytesToSlotBigEndian(k)
+			}
+		}
+	}
+	return nil, 0
+}
+
+// deleteInBatches deletes the keys from each of the buckets, committing every pruneHistoryBatchSize keys
+// so that the db lock is not held for the whole deletion.
+func (s *Store) deleteInBatches(ctx context.Context, keys [][]byte, buckets ...[]byte) error {
+	for i := 0; i < len(keys); i += pruneHistoryBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := i + pruneHistoryBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := s.db.Update(func(tx backend.Tx) error {
+			for _, bkt := range buckets {
+				b := tx.Bucket(bkt)
+				for _, k := range keys[i:end] {
+					if err := b.Delete(k); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
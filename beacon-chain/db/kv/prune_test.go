@@ -0,0 +1,184 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// setupHistory saves a genesis block and state followed by a chain of blocks, and returns the chain.
+func setupHistory(t *testing.T, db *Store, n uint64) ([32]byte, []block.SignedBeaconBlock) {
+	ctx := context.Background()
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+
+	blks := makeBlocks(t, 0, n, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	return genesisRoot, blks
+}
+
+func saveStateAtBlock(t *testing.T, db *Store, blk block.SignedBeaconBlock) [32]byte {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(blk.Block().Slot()))
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(context.Background(), st, root))
+	return root
+}
+
+func TestStore_PruneHistory(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), &Config{HistoryRetentionEpochs: 2})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	genesisRoot, blks := setupHistory(t, db, slotsPerEpoch*6)
+	// blks[i] is at slot i+1.
+	prunedStateRoot := saveStateAtBlock(t, db, blks[slotsPerEpoch-1])
+	originRoot := saveStateAtBlock(t, db, blks[slotsPerEpoch*3-1])
+	finalizedRoot := saveStateAtBlock(t, db, blks[slotsPerEpoch*4-1])
+	backfillRoot, err := blks[0].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, backfillRoot))
+	for _, epoch := range []uint64{1, 3} {
+		require.NoError(t, db.SaveStateDiff(ctx, types.Slot(slotsPerEpoch*epoch), &ethpb.BeaconStateDiff{BaseRoot: genesisRoot[:]}))
+	}
+	require.NoError(t, db.SaveLightClientBootstrap(ctx, prunedStateRoot, util.NewLightClientBootstrap(types.Slot(slotsPerEpoch))))
+	require.NoError(t, db.SaveLightClientBootstrap(ctx, originRoot, util.NewLightClientBootstrap(types.Slot(slotsPerEpoch*3))))
+
+	// Saving the finalized checkpoint does not prune by itself.
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 4, Root: finalizedRoot[:]}))
+	assert.Equal(t, true, db.HasState(ctx, prunedStateRoot))
+
+	// Finalizing epoch 4 keeps history from epoch 2, starting at the first finalized state in the window.
+	require.NoError(t, db.PruneHistory(ctx, 4))
+
+	originSlot := types.Slot(slotsPerEpoch * 3)
+	prunedSlot, err := db.HistoryPrunedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originSlot, prunedSlot)
+	root, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, root)
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, root)
+
+	for _, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, b.Block().Slot() >= originSlot, db.HasBlock(ctx, r), "Unexpected block at slot %d", b.Block().Slot())
+	}
+	found, _, err := db.BlocksBySlot(ctx, originSlot-1)
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+	assert.Equal(t, false, db.HasState(ctx, prunedStateRoot))
+	assert.Equal(t, false, db.HasArchivedPoint(ctx, types.Slot(slotsPerEpoch)))
+	assert.Equal(t, true, db.HasState(ctx, originRoot))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, originRoot))
+	assert.Equal(t, false, db.HasStateDiff(ctx, types.Slot(slotsPerEpoch)))
+	assert.Equal(t, true, db.HasStateDiff(ctx, originSlot))
+	bootstrap, err := db.LightClientBootstrap(ctx, prunedStateRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.LightClientBootstrap)(nil), bootstrap)
+	bootstrap, err = db.LightClientBootstrap(ctx, originRoot)
+	require.NoError(t, err)
+	assert.NotNil(t, bootstrap)
+
+	// Genesis is never pruned.
+	assert.Equal(t, true, db.HasBlock(ctx, genesisRoot))
+	assert.Equal(t, true, db.HasState(ctx, genesisRoot))
+	_, err = db.GenesisBlock(ctx)
+	require.NoError(t, err)
+}
+
+func TestStore_PruneHistory_NoStateInWindow(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), &Config{HistoryRetentionEpochs: 1})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	_, blks := setupHistory(t, db, slotsPerEpoch*4)
+	lowRoot := saveStateAtBlock(t, db, blks[slotsPerEpoch-1])
+	finalizedRoot, err := blks[slotsPerEpoch*3-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: types.Slot(slotsPerEpoch * 3), Root: finalizedRoot[:]}))
+
+	// Without a state to regenerate from in the window, nothing is pruned.
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: finalizedRoot[:]}))
+	require.NoError(t, db.PruneHistory(ctx, 3))
+	prunedSlot, err := db.HistoryPrunedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), prunedSlot)
+	assert.Equal(t, true, db.HasState(ctx, lowRoot))
+	assert.Equal(t, true, db.HasBlock(ctx, lowRoot))
+}
+
+func TestStore_PruneHistory_Disabled(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	db := setupDB(t)
+
+	_, blks := setupHistory(t, db, slotsPerEpoch*4)
+	lowRoot := saveStateAtBlock(t, db, blks[slotsPerEpoch-1])
+	finalizedRoot := saveStateAtBlock(t, db, blks[slotsPerEpoch*3-1])
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: finalizedRoot[:]}))
+	require.NoError(t, db.PruneHistory(ctx, 3))
+
+	prunedSlot, err := db.HistoryPrunedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), prunedSlot)
+	assert.Equal(t, true, db.HasBlock(ctx, lowRoot))
+	_, err = db.OriginBlockRoot(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStore_PruneHistory_LimitedPerRun(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), &Config{HistoryRetentionEpochs: 1})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	defer func(maxSlots types.Slot) {
+		pruneHistoryMaxSlots = maxSlots
+	}(pruneHistoryMaxSlots)
+	pruneHistoryMaxSlots = types.Slot(slotsPerEpoch)
+
+	_, blks := setupHistory(t, db, slotsPerEpoch*5)
+	roots := make([][32]byte, 5)
+	for i := range roots {
+		roots[i] = saveStateAtBlock(t, db, blks[slotsPerEpoch*uint64(i+1)-1])
+	}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 5, Root: roots[4][:]}))
+
+	// Each run moves the origin forward by at most one epoch, until the retention window is reached.
+	for i, wanted := range []int{0, 1, 2, 3, 3} {
+		require.NoError(t, db.PruneHistory(ctx, 5))
+		root, err := db.OriginBlockRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, roots[wanted], root, "Unexpected origin after run %d", i)
+	}
+}
@@ -0,0 +1,52 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_RegistrationByValidatorID(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	ids := []types.ValidatorIndex{0, 1, 2}
+	regs := make([]*ethpb.ValidatorRegistrationV1, len(ids))
+	for i := range regs {
+		regs[i] = &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: bytesutil.PadTo([]byte{byte(i)}, 20),
+			GasLimit:     30000000,
+			Timestamp:    uint64(1650000000 + i),
+			Pubkey:       bytesutil.PadTo([]byte{byte(i)}, 48),
+		}
+	}
+	require.NoError(t, db.SaveRegistrationsByValidatorIDs(ctx, ids, regs))
+	for i, id := range ids {
+		reg, err := db.RegistrationByValidatorID(ctx, id)
+		require.NoError(t, err)
+		assert.DeepEqual(t, regs[i], reg)
+	}
+
+	// Registering again replaces the previous registration.
+	newReg := &ethpb.ValidatorRegistrationV1{
+		FeeRecipient: bytesutil.PadTo([]byte{'e'}, 20),
+		GasLimit:     35000000,
+		Timestamp:    1660000000,
+		Pubkey:       bytesutil.PadTo([]byte{1}, 48),
+	}
+	require.NoError(t, db.SaveRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{1}, []*ethpb.ValidatorRegistrationV1{newReg}))
+	reg, err := db.RegistrationByValidatorID(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, newReg, reg)
+
+	_, err = db.RegistrationByValidatorID(ctx, 3)
+	require.ErrorIs(t, err, ErrNotFoundRegistration)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = db.SaveRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{5}, []*ethpb.ValidatorRegistrationV1{})
+	require.ErrorContains(t, "do not match in length", err)
+}
@@ -19,6 +19,14 @@ var (
 	powchainBucket          = []byte("powchain")
 	stateValidatorsBucket   = []byte("state-validators")
 	validatedTips           = []byte("validated-synced-tips")
+	feeRecipientBucket      = []byte("fee-recipient")
+	registrationBucket      = []byte("registration")
+	stateDiffBucket         = []byte("state-diff")
+	hotStateRootsBucket     = []byte("hot-state-roots")
+
+	// Light client buckets.
+	lightClientUpdatesBucket    = []byte("light-client-updates")
+	lightClientBootstrapsBucket = []byte("light-client-bootstraps")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -44,13 +52,17 @@ var (
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	historyPrunedSlotKey      = []byte("history-pruned-slot")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
-	altairKey    = []byte("altair")
-	bellatrixKey = []byte("merge")
+	altairKey         = []byte("altair")
+	bellatrixKey      = []byte("merge")
+	bellatrixBlindKey = []byte("blind-bellatrix")
 	// block root included in the beacon state used by weak subjectivity initial sync
 	originBlockRootKey = []byte("origin-block-root")
+	// root of the lowest block written by backfill, which walks backwards from the origin block
+	backfillBlockRootKey = []byte("backfill-block-root")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -63,3 +75,40 @@ var (
 	// Migrations
 	migrationsBucket = []byte("migrations")
 )
+
+// schemaBuckets are the buckets created when the database is opened.
+var schemaBuckets = [][]byte{
+	attestationsBucket,
+	blocksBucket,
+	stateBucket,
+	proposerSlashingsBucket,
+	attesterSlashingsBucket,
+	voluntaryExitsBucket,
+	chainMetadataBucket,
+	checkpointBucket,
+	powchainBucket,
+	stateSummaryBucket,
+	stateValidatorsBucket,
+	validatedTips,
+	feeRecipientBucket,
+	registrationBucket,
+	stateDiffBucket,
+	hotStateRootsBucket,
+	lightClientUpdatesBucket,
+	lightClientBootstrapsBucket,
+	// Indices buckets.
+	attestationHeadBlockRootBucket,
+	attestationSourceRootIndicesBucket,
+	attestationSourceEpochIndicesBucket,
+	attestationTargetRootIndicesBucket,
+	attestationTargetEpochIndicesBucket,
+	blockSlotIndicesBucket,
+	stateSlotIndicesBucket,
+	blockParentRootIndicesBucket,
+	finalizedBlockRootsIndexBucket,
+	blockRootValidatorHashesBucket,
+	// State management service bucket.
+	newStateServiceCompatibleBucket,
+	// Migrations
+	migrationsBucket,
+}
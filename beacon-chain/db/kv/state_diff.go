@@ -0,0 +1,63 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff saves the difference between the state at the given slot and its base state.
+func (s *Store) SaveStateDiff(ctx context.Context, slot types.Slot, diff *ethpb.BeaconStateDiff) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	enc, err := encode(ctx, diff)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(stateDiffBucket).Put(bytesutil.SlotToBytesBigEndian(slot), enc)
+	})
+}
+
+// StateDiff returns the difference saved for the state at the given slot, or nil if there is none.
+func (s *Store) StateDiff(ctx context.Context, slot types.Slot) (*ethpb.BeaconStateDiff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var enc []byte
+	if err := s.db.View(func(tx backend.Tx) error {
+		enc = tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		return nil, nil
+	}
+	diff := &ethpb.BeaconStateDiff{}
+	if err := decode(ctx, enc, diff); err != nil {
+		return nil, errors.Wrapf(err, "could not decode state diff at slot %d", slot)
+	}
+	return diff, nil
+}
+
+// HasStateDiff returns true if a state difference is saved for the given slot.
+func (s *Store) HasStateDiff(ctx context.Context, slot types.Slot) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+
+	var exists bool
+	if err := s.db.View(func(tx backend.Tx) error {
+		exists = tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot)) != nil
+		return nil
+	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
+		panic(err)
+	}
+	return exists
+}
@@ -0,0 +1,35 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_StateDiff(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slot := types.Slot(64)
+	assert.Equal(t, false, db.HasStateDiff(ctx, slot))
+	diff, err := db.StateDiff(ctx, slot)
+	require.NoError(t, err)
+	assert.Equal(t, true, diff == nil)
+
+	wanted := &ethpb.BeaconStateDiff{
+		BaseRoot: bytesutil.PadTo([]byte{'a'}, 32),
+		Version:  1,
+		State:    []byte{'b'},
+		Balances: []byte{1, 2, 3},
+	}
+	require.NoError(t, db.SaveStateDiff(ctx, slot, wanted))
+	assert.Equal(t, true, db.HasStateDiff(ctx, slot))
+	assert.Equal(t, false, db.HasStateDiff(ctx, slot+1))
+	diff, err = db.StateDiff(ctx, slot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, diff)
+}
@@ -3,9 +3,9 @@ package kv
 import (
 	"context"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -46,7 +46,7 @@ func (s *Store) StateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.St
 		return s.stateSummaryCache.get(blockRoot), nil
 	}
 	var enc []byte
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx backend.Tx) error {
 		enc = tx.Bucket(stateSummaryBucket).Get(blockRoot[:])
 		return nil
 	}); err != nil {
@@ -68,7 +68,7 @@ func (s *Store) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
 	defer span.End()
 
 	var hasSummary bool
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx backend.Tx) error {
 		hasSummary = s.hasStateSummaryBytes(tx, blockRoot)
 		return nil
 	}); err != nil {
@@ -77,7 +77,7 @@ func (s *Store) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
 	return hasSummary
 }
 
-func (s *Store) hasStateSummaryBytes(tx *bolt.Tx, blockRoot [32]byte) bool {
+func (s *Store) hasStateSummaryBytes(tx backend.Tx, blockRoot [32]byte) bool {
 	if s.stateSummaryCache.has(blockRoot) {
 		return true
 	}
@@ -96,7 +96,7 @@ func (s *Store) saveCachedStateSummariesDB(ctx context.Context) error {
 		}
 		encs[i] = enc
 	}
-	if err := s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.db.Update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(stateSummaryBucket)
 		for i, s := range summaries {
 			if err := bucket.Put(s.Root, encs[i]); err != nil {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -18,7 +19,6 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
-	bolt "go.etcd.io/bbolt"
 )
 
 func TestState_CanSaveRetrieve(t *testing.T) {
@@ -74,7 +74,7 @@ func TestState_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "saved state with validators and retrieved state are not matching")
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r[:])
 		require.NotEqual(t, 0, len(data))
@@ -83,7 +83,7 @@ func TestState_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -126,7 +126,7 @@ func TestStateAltair_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "saved state with validators and retrieved state are not matching")
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r[:])
 		require.NotEqual(t, 0, len(data))
@@ -135,7 +135,7 @@ func TestStateAltair_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -190,7 +190,7 @@ func TestState_CanSaveRetrieveValidatorEntriesFromCache(t *testing.T) {
 	}
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -236,7 +236,7 @@ func TestState_CanSaveRetrieveValidatorEntriesWithoutCache(t *testing.T) {
 	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "saved state with validators and retrieved state are not matching")
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r[:])
 		require.NotEqual(t, 0, len(data))
@@ -245,7 +245,7 @@ func TestState_CanSaveRetrieveValidatorEntriesWithoutCache(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -308,7 +308,7 @@ func TestState_DeleteState(t *testing.T) {
 	}
 
 	// check if the index of the first state is deleted.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r1[:])
 		require.Equal(t, 0, len(data))
@@ -317,7 +317,7 @@ func TestState_DeleteState(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r2[:])
 		require.NotEqual(t, 0, len(data))
@@ -326,7 +326,7 @@ func TestState_DeleteState(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -809,7 +809,7 @@ func TestStateBellatrix_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "saved state with validators and retrieved state are not matching")
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r[:])
 		require.NotEqual(t, 0, len(data))
@@ -818,7 +818,7 @@ func TestStateBellatrix_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
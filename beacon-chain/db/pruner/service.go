@@ -0,0 +1,103 @@
+// Package pruner deletes the history older than the retention window from the beacon db. Pruning runs in
+// the background whenever a new checkpoint is finalized, so that it never delays block processing.
+package pruner
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/runtime"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+// Config to set up the pruner service.
+type Config struct {
+	DB            db.NoHeadAccessDatabase
+	StateNotifier statefeed.Notifier
+}
+
+// Service prunes the history of the beacon db behind each new finalized checkpoint.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService configures the pruner service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start listening to finalized checkpoints and pruning behind them.
+func (s *Service) Start() {
+	finalized := make(chan types.Epoch, 1)
+	go s.pruneRoutine(finalized)
+	go s.finalizedRoutine(finalized)
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// finalizedRoutine forwards the epoch of each finalized checkpoint to the pruning routine. Only the latest
+// epoch is kept while a pruning is in progress, as pruning behind it covers the earlier ones.
+func (s *Service) finalizedRoutine(finalized chan types.Epoch) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.FinalizedCheckpoint {
+				continue
+			}
+			data, ok := event.Data.(*ethpbv1.EventFinalizedCheckpoint)
+			if !ok {
+				log.Error("Event feed data is not type *ethpb.EventFinalizedCheckpoint")
+				continue
+			}
+			select {
+			case <-finalized:
+			default:
+			}
+			finalized <- data.Epoch
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state events")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// pruneRoutine prunes the history behind each finalized epoch it receives. The db bounds the work of a
+// single pruning, so a long history is pruned over several finalized checkpoints.
+func (s *Service) pruneRoutine(finalized <-chan types.Epoch) {
+	for {
+		select {
+		case epoch := <-finalized:
+			if err := s.cfg.DB.PruneHistory(s.ctx, epoch); err != nil {
+				log.WithError(err).Error("Could not prune history older than the retention window")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
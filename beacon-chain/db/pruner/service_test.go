@@ -0,0 +1,85 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+type mockPruneDB struct {
+	db.NoHeadAccessDatabase
+	pruned chan types.Epoch
+}
+
+func (m *mockPruneDB) PruneHistory(_ context.Context, finalizedEpoch types.Epoch) error {
+	m.pruned <- finalizedEpoch
+	return nil
+}
+
+func TestService_PrunesOnFinalizedCheckpoint(t *testing.T) {
+	d := &mockPruneDB{pruned: make(chan types.Epoch)}
+	notifier := &mock.MockStateNotifier{}
+	s := NewService(context.Background(), &Config{DB: d, StateNotifier: notifier})
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	// Wait for the service to subscribe to the state feed.
+	for notifier.StateFeed().Send(&feed.Event{Type: statefeed.Synced}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	notifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &ethpbv1.EventFinalizedCheckpoint{Epoch: 5},
+	})
+	select {
+	case epoch := <-d.pruned:
+		assert.Equal(t, types.Epoch(5), epoch)
+	case <-time.After(5 * time.Second):
+		t.Fatal("History was not pruned")
+	}
+}
+
+func TestService_KeepsLatestFinalizedEpoch(t *testing.T) {
+	d := &mockPruneDB{pruned: make(chan types.Epoch)}
+	notifier := &mock.MockStateNotifier{}
+	s := NewService(context.Background(), &Config{DB: d, StateNotifier: notifier})
+	finalized := make(chan types.Epoch, 1)
+	go s.finalizedRoutine(finalized)
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	for notifier.StateFeed().Send(&feed.Event{Type: statefeed.Synced}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// Without a pruning in progress to consume them, only the latest epoch is kept.
+	for _, epoch := range []types.Epoch{3, 4, 5} {
+		notifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.FinalizedCheckpoint,
+			Data: &ethpbv1.EventFinalizedCheckpoint{Epoch: epoch},
+		})
+	}
+	// The state channel of the routine holds a single event, so the second of these sends only returns
+	// once the routine has taken the first one, which means it is done forwarding epoch 5.
+	for i := 0; i < 2; i++ {
+		notifier.StateFeed().Send(&feed.Event{Type: statefeed.Synced})
+	}
+	go s.pruneRoutine(finalized)
+	select {
+	case epoch := <-d.pruned:
+		assert.Equal(t, types.Epoch(5), epoch)
+	case <-time.After(5 * time.Second):
+		t.Fatal("History was not pruned")
+	}
+}
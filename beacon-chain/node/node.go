@@ -21,9 +21,13 @@ import (
 	apigateway "github.com/prysmaticlabs/prysm/api/gateway"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/checkpoint"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
@@ -40,10 +44,12 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
@@ -139,12 +145,12 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		opFeed:                  new(event.Feed),
 		attestationPool:         attestations.NewPool(),
 		exitPool:                voluntaryexits.NewPool(),
-		slashingsPool:           slashings.NewPool(),
 		syncCommitteePool:       synccommittee.NewPool(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 	}
+	beacon.slashingsPool = slashings.NewPool(slashings.WithOperationNotifier(beacon))
 
 	for _, opt := range opts {
 		if err := opt(beacon); err != nil {
@@ -165,8 +171,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Starting Fork Choice")
+	beacon.startForkChoice()
+
 	log.Debugln("Starting State Gen")
-	if err := beacon.startStateGen(); err != nil {
+	if err := beacon.startStateGen(cliCtx); err != nil {
 		return nil, err
 	}
 
@@ -190,9 +199,6 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
-	log.Debugln("Starting Fork Choice")
-	beacon.startForkChoice()
-
 	log.Debugln("Registering Blockchain Service")
 	if err := beacon.registerBlockchainService(); err != nil {
 		return nil, err
@@ -208,11 +214,26 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
 	}
 
+	log.Debugln("Registering Builder Service")
+	if err := beacon.registerBuilderService(); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering RPC Service")
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
@@ -303,6 +324,9 @@ func (b *BeaconNode) Close() {
 
 	log.Info("Stopping beacon node")
 	b.services.StopAll()
+	if b.stateGen != nil {
+		b.stateGen.Stop()
+	}
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
@@ -328,7 +352,9 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
 	d, err := db.NewDB(b.ctx, dbPath, &kv.Config{
-		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:                backend.Kind(cliCtx.String(cmd.DBBackendFlag.Name)),
+		InitialMMapSize:        cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		HistoryRetentionEpochs: types.Epoch(cliCtx.Uint64(flags.HistoryRetentionEpochs.Name)),
 	})
 	if err != nil {
 		return err
@@ -352,7 +378,9 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 			return errors.Wrap(err, "could not clear database")
 		}
 		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
-			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			Backend:                backend.Kind(cliCtx.String(cmd.DBBackendFlag.Name)),
+			InitialMMapSize:        cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			HistoryRetentionEpochs: types.Epoch(cliCtx.Uint64(flags.HistoryRetentionEpochs.Name)),
 		})
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
@@ -394,6 +422,15 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	if err := b.db.EnsureEmbeddedGenesis(b.ctx); err != nil {
 		return err
 	}
+	if cliCtx.IsSet(flags.CheckpointSyncURL.Name) {
+		initializer, err := checkpoint.NewAPIInitializer(cliCtx.String(flags.CheckpointSyncURL.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not configure checkpoint sync")
+		}
+		if err := initializer.Initialize(b.ctx, b.db); err != nil {
+			return errors.Wrap(err, "could not initialize database from checkpoint sync url")
+		}
+	}
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
 		return err
@@ -461,8 +498,17 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 	return nil
 }
 
-func (b *BeaconNode) startStateGen() error {
-	b.stateGen = stategen.New(b.db)
+func (b *BeaconNode) startStateGen(cliCtx *cli.Context) error {
+	b.stateGen = stategen.New(
+		b.db,
+		stategen.WithHotStateCacheBudget(cliCtx.Uint64(flags.HotStateCacheSize.Name)<<20),
+		stategen.WithEpochBoundaryStateCacheBudget(cliCtx.Uint64(flags.EpochBoundaryStateCacheSize.Name)<<20),
+		stategen.WithCanonicalChecker(b.forkChoiceStore),
+		stategen.WithSaveHotStateToDBPolicy(
+			types.Epoch(cliCtx.Uint64(flags.SaveHotStatesFinalityLag.Name)),
+			types.Epoch(cliCtx.Uint64(flags.SaveHotStatesInterval.Name)),
+		),
+	)
 
 	cp, err := b.db.FinalizedCheckpoint(b.ctx)
 	if err != nil {
@@ -510,6 +556,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
 		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
 		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
+		QUICPort:          cliCtx.Uint(cmd.P2PQUICPort.Name),
 		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
 		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
@@ -643,6 +690,7 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
+		regularsync.WithExecutionPayloadReconstructor(web3Service.EngineAPIClient()),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -663,6 +711,37 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		DB:          b.db,
+		P2P:         b.fetchP2P(),
+		Chain:       chainService,
+		InitialSync: initSync,
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerPrunerService() error {
+	if b.cliCtx.Uint64(flags.HistoryRetentionEpochs.Name) == 0 {
+		return nil
+	}
+	ps := pruner.NewService(b.ctx, &pruner.Config{
+		DB:            b.db,
+		StateNotifier: b,
+	})
+	return b.services.RegisterService(ps)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -693,6 +772,14 @@ func (b *BeaconNode) registerSlasherService() error {
 	return b.services.RegisterService(slasherSrv)
 }
 
+func (b *BeaconNode) registerBuilderService() error {
+	bs, err := builder.NewService(b.ctx, builder.WithBuilderEndpoint(b.cliCtx.String(flags.MevRelayEndpoint.Name)))
+	if err != nil {
+		return errors.Wrap(err, "could not register builder service")
+	}
+	return b.services.RegisterService(bs)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -709,6 +796,16 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var backfillService *backfill.Service
+	if err := b.services.FetchService(&backfillService); err != nil {
+		return err
+	}
+
+	var builderService *builder.Service
+	if err := b.services.FetchService(&builderService); err != nil {
+		return err
+	}
+
 	var slasherService *slasher.Service
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
@@ -747,6 +844,10 @@ func (b *BeaconNode) registerRPCService() error {
 	}
 
 	p2pService := b.fetchP2P()
+	var peerAdmin *p2p.Service
+	if err := b.services.FetchService(&peerAdmin); err != nil {
+		return err
+	}
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		Host:                    host,
 		Port:                    port,
@@ -758,12 +859,14 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		PeerAdmin:               peerAdmin,
 		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
 		CanonicalFetcher:        chainService,
 		ForkFetcher:             chainService,
 		FinalizationFetcher:     chainService,
+		LightClientFetcher:      chainService,
 		BlockReceiver:           chainService,
 		AttestationReceiver:     chainService,
 		GenesisTimeFetcher:      chainService,
@@ -778,6 +881,7 @@ func (b *BeaconNode) registerRPCService() error {
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
+		BackfillService:         backfillService,
 		DepositFetcher:          depositFetcher,
 		PendingDepositFetcher:   b.depositCache,
 		BlockNotifier:           b,
@@ -787,6 +891,14 @@ func (b *BeaconNode) registerRPCService() error {
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		MaxMsgSize:              maxMsgSize,
 		ExecutionEngineCaller:   web3Service.EngineAPIClient(),
+		BlockBuilder:            builderService,
+		LivenessFetcher:         chainService,
+		HistoricalStatesConfig: &statefetcher.HistoricalStatesConfig{
+			Concurrency: b.cliCtx.Int(flags.HistoricalStateConcurrency.Name),
+			QueueSize:   b.cliCtx.Int(flags.HistoricalStateQueueSize.Name),
+			CacheSize:   b.cliCtx.Int(flags.HistoricalStateCacheSize.Name),
+			RetryAfter:  statefetcher.DefaultHistoricalStateRetryAfter,
+		},
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -8,6 +8,8 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -18,13 +20,28 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// PoolOption is a functional option for the slashings pool.
+type PoolOption func(*Pool)
+
+// WithOperationNotifier sets the notifier on which the pool sends a ProposerSlashingReceived or
+// AttesterSlashingReceived event for every slashing it inserts.
+func WithOperationNotifier(n operation.Notifier) PoolOption {
+	return func(p *Pool) {
+		p.operationNotifier = n
+	}
+}
+
 // NewPool returns an initialized attester slashing and proposer slashing pool.
-func NewPool() *Pool {
-	return &Pool{
+func NewPool(opts ...PoolOption) *Pool {
+	p := &Pool{
 		pendingProposerSlashing: make([]*ethpb.ProposerSlashing, 0),
 		pendingAttesterSlashing: make([]*PendingAttesterSlashing, 0),
 		included:                make(map[types.ValidatorIndex]bool),
 	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
 }
 
 // PendingAttesterSlashings returns attester slashings that are able to be included into a block.
@@ -114,11 +131,29 @@ func (p *Pool) PendingProposerSlashings(ctx context.Context, state state.ReadOnl
 }
 
 // InsertAttesterSlashing into the pool. This method is a no-op if the attester slashing already exists in the pool,
-// has been included into a block recently, or the validator is already exited.
+// has been included into a block recently, or the validator is already exited. An AttesterSlashingReceived event is
+// sent once the slashing is in the pool.
 func (p *Pool) InsertAttesterSlashing(
 	ctx context.Context,
 	state state.ReadOnlyBeaconState,
 	slashing *ethpb.AttesterSlashing,
+) error {
+	if err := p.insertAttesterSlashing(ctx, state, slashing); err != nil {
+		return err
+	}
+	p.notify(&feed.Event{
+		Type: operation.AttesterSlashingReceived,
+		Data: &operation.AttesterSlashingReceivedData{
+			AttesterSlashing: slashing,
+		},
+	})
+	return nil
+}
+
+func (p *Pool) insertAttesterSlashing(
+	ctx context.Context,
+	state state.ReadOnlyBeaconState,
+	slashing *ethpb.AttesterSlashing,
 ) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -180,10 +215,28 @@ func (p *Pool) InsertAttesterSlashing(
 
 // InsertProposerSlashing into the pool. This method is a no-op if the pending slashing already exists,
 // has been included recently, the validator is already exited, or the validator was already slashed.
+// A ProposerSlashingReceived event is sent once the slashing is in the pool.
 func (p *Pool) InsertProposerSlashing(
 	ctx context.Context,
 	state state.ReadOnlyBeaconState,
 	slashing *ethpb.ProposerSlashing,
+) error {
+	if err := p.insertProposerSlashing(ctx, state, slashing); err != nil {
+		return err
+	}
+	p.notify(&feed.Event{
+		Type: operation.ProposerSlashingReceived,
+		Data: &operation.ProposerSlashingReceivedData{
+			ProposerSlashing: slashing,
+		},
+	})
+	return nil
+}
+
+func (p *Pool) insertProposerSlashing(
+	ctx context.Context,
+	state state.ReadOnlyBeaconState,
+	slashing *ethpb.ProposerSlashing,
 ) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -287,3 +340,12 @@ func (p *Pool) validatorSlashingPreconditionCheck(
 	}
 	return true, nil
 }
+
+// notify sends the event on the operation feed, if the pool was given a notifier. It must not be called
+// while holding the pool lock, as sending blocks until every subscriber received the event.
+func (p *Pool) notify(event *feed.Event) {
+	if p.operationNotifier == nil {
+		return
+	}
+	p.operationNotifier.OperationFeed().Send(event)
+}
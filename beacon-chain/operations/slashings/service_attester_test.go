@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
@@ -325,6 +328,26 @@ func TestPool_InsertAttesterSlashing_SigFailsVerify_ClearPool(t *testing.T) {
 	assert.Equal(t, 1, len(p.pendingAttesterSlashing))
 }
 
+func TestPool_InsertAttesterSlashing_SendsEvent(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	sl, err := util.GenerateAttesterSlashingForValidator(beaconState, privKeys[1], 1)
+	require.NoError(t, err)
+	notifier := &mock.MockOperationNotifier{}
+	opChannel := make(chan *feed.Event, 2)
+	sub := notifier.OperationFeed().Subscribe(opChannel)
+	defer sub.Unsubscribe()
+	p := NewPool(WithOperationNotifier(notifier))
+
+	require.NoError(t, p.InsertAttesterSlashing(context.Background(), beaconState, sl))
+	require.ErrorContains(t, "could not slash any", p.InsertAttesterSlashing(context.Background(), beaconState, sl))
+	require.Equal(t, 1, len(opChannel), "Expected a single event for the inserted slashing")
+	e := <-opChannel
+	assert.Equal(t, feed.EventType(operation.AttesterSlashingReceived), e.Type)
+	data, ok := e.Data.(*operation.AttesterSlashingReceivedData)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, sl, data.AttesterSlashing)
+}
+
 func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 	type fields struct {
 		pending  []*PendingAttesterSlashing
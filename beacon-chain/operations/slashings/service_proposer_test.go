@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -205,6 +208,26 @@ func TestPool_InsertProposerSlashing_SigFailsVerify_ClearPool(t *testing.T) {
 	assert.Equal(t, 1, len(p.pendingProposerSlashing))
 }
 
+func TestPool_InsertProposerSlashing_SendsEvent(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	sl, err := util.GenerateProposerSlashingForValidator(beaconState, privKeys[1], 1)
+	require.NoError(t, err)
+	notifier := &mock.MockOperationNotifier{}
+	opChannel := make(chan *feed.Event, 2)
+	sub := notifier.OperationFeed().Subscribe(opChannel)
+	defer sub.Unsubscribe()
+	p := NewPool(WithOperationNotifier(notifier))
+
+	require.NoError(t, p.InsertProposerSlashing(context.Background(), beaconState, sl))
+	require.ErrorContains(t, "already exists", p.InsertProposerSlashing(context.Background(), beaconState, sl))
+	require.Equal(t, 1, len(opChannel), "Expected a single event for the inserted slashing")
+	e := <-opChannel
+	assert.Equal(t, feed.EventType(operation.ProposerSlashingReceived), e.Type)
+	data, ok := e.Data.(*operation.ProposerSlashingReceivedData)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, sl, data.ProposerSlashing)
+}
+
 func TestPool_MarkIncludedProposerSlashing(t *testing.T) {
 	type fields struct {
 		pending  []*ethpb.ProposerSlashing
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
@@ -39,6 +40,7 @@ type Pool struct {
 	pendingProposerSlashing []*ethpb.ProposerSlashing
 	pendingAttesterSlashing []*PendingAttesterSlashing
 	included                map[types.ValidatorIndex]bool
+	operationNotifier       operation.Notifier
 }
 
 // PendingAttesterSlashing represents an attester slashing in the operation pool.
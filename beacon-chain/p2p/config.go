@@ -23,6 +23,7 @@ type Config struct {
 	MetaDataDir         string
 	TCPPort             uint
 	UDPPort             uint
+	QUICPort            uint
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
@@ -25,8 +25,8 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	return !s.peers.IsBanned(pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
@@ -36,6 +36,9 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 	if s.peers.IsBad(pid) {
 		return false
 	}
+	if s.isIPBanned(m) {
+		return false
+	}
 	return filterConnections(s.addrFilter, m)
 }
 
@@ -49,6 +52,11 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
+	if s.isIPBanned(n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned ip range"}).Trace("Not accepting inbound dial")
+		return false
+	}
 	if s.isPeerAtLimit(true /* inbound */) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
@@ -59,8 +67,9 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (_ *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
-	return true
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+	// Disallow banned peers, whose ID is only known once the connection is secured.
+	return !s.peers.IsBanned(pid)
 }
 
 // InterceptUpgraded tests whether a fully capable connection is allowed.
@@ -33,6 +33,12 @@ type Listener interface {
 	LocalNode() *enode.LocalNode
 }
 
+// quicProtocol is the "quic" ENR entry, which holds the UDP port of the libp2p QUIC transport.
+type quicProtocol uint16
+
+// ENRKey implements `enr.Entry`.
+func (quicProtocol) ENRKey() string { return "quic" }
+
 // RefreshENR uses an epoch to refresh the enr entry for our node
 // with the tracked committee ids for the epoch, allowing our node
 // to be dynamically discoverable by others given our tracked committee ids.
@@ -109,7 +115,7 @@ func (s *Service) listenForNewNodes() {
 			break
 		}
 		node := iterator.Node()
-		peerInfo, _, err := convertToAddrInfo(node)
+		peerInfo, _, err := convertToAddrInfo(node, s.cfg.QUICPort != 0)
 		if err != nil {
 			log.WithError(err).Error("Could not convert to peer info")
 			continue
@@ -166,6 +172,7 @@ func (s *Service) createListener(
 		ipAddr,
 		int(s.cfg.UDPPort),
 		int(s.cfg.TCPPort),
+		int(s.cfg.QUICPort),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create local node")
@@ -214,7 +221,7 @@ func (s *Service) createListener(
 func (s *Service) createLocalNode(
 	privKey *ecdsa.PrivateKey,
 	ipAddr net.IP,
-	udpPort, tcpPort int,
+	udpPort, tcpPort, quicPort int,
 ) (*enode.LocalNode, error) {
 	db, err := enode.OpenDB("")
 	if err != nil {
@@ -228,6 +235,9 @@ func (s *Service) createLocalNode(
 	localNode.Set(ipEntry)
 	localNode.Set(udpEntry)
 	localNode.Set(tcpEntry)
+	if quicPort != 0 {
+		localNode.Set(quicProtocol(quicPort))
+	}
 	localNode.SetFallbackIP(ipAddr)
 	localNode.SetFallbackUDP(udpPort)
 
@@ -279,7 +289,7 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 		}
 		return false
 	}
-	peerData, multiAddr, err := convertToAddrInfo(node)
+	peerData, multiAddr, err := convertToAddrInfo(node, s.cfg.QUICPort != 0)
 	if err != nil {
 		log.WithError(err).Debug("Could not convert to peer data")
 		return false
@@ -360,13 +370,23 @@ func parseGenericAddrs(addrs []string) (enodeString, multiAddrString []string) {
 	return enodeString, multiAddrString
 }
 
-func convertToMultiAddr(nodes []*enode.Node) []ma.Multiaddr {
+// convertToMultiAddr returns the TCP multiaddresses of nodes, preceded by their QUIC multiaddresses
+// when withQUIC is set and the nodes advertise QUIC.
+func convertToMultiAddr(nodes []*enode.Node, withQUIC bool) []ma.Multiaddr {
 	var multiAddrs []ma.Multiaddr
 	for _, node := range nodes {
 		// ignore nodes with no ip address stored
 		if node.IP() == nil {
 			continue
 		}
+		if withQUIC {
+			multiAddr, err := convertToQUICMultiAddr(node)
+			if err == nil {
+				multiAddrs = append(multiAddrs, multiAddr)
+			} else if !enr.IsNotFound(err) {
+				log.WithError(err).Error("Could not convert to QUIC multiAddr")
+			}
+		}
 		multiAddr, err := convertToSingleMultiAddr(node)
 		if err != nil {
 			log.WithError(err).Error("Could not convert to multiAddr")
@@ -377,7 +397,10 @@ func convertToMultiAddr(nodes []*enode.Node) []ma.Multiaddr {
 	return multiAddrs
 }
 
-func convertToAddrInfo(node *enode.Node) (*peer.AddrInfo, ma.Multiaddr, error) {
+// convertToAddrInfo returns the address info to dial a node and its preferred multiaddress. When
+// withQUIC is set and the node advertises QUIC, its QUIC multiaddress is preferred and dialed first,
+// with TCP as a fallback.
+func convertToAddrInfo(node *enode.Node, withQUIC bool) (*peer.AddrInfo, ma.Multiaddr, error) {
 	multiAddr, err := convertToSingleMultiAddr(node)
 	if err != nil {
 		return nil, nil, err
@@ -386,7 +409,22 @@ func convertToAddrInfo(node *enode.Node) (*peer.AddrInfo, ma.Multiaddr, error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	return info, multiAddr, nil
+	if !withQUIC {
+		return info, multiAddr, nil
+	}
+	quicAddr, err := convertToQUICMultiAddr(node)
+	if err != nil {
+		if !enr.IsNotFound(err) {
+			log.WithError(err).Debug("Could not convert to QUIC multiAddr")
+		}
+		return info, multiAddr, nil
+	}
+	quicInfo, err := peer.AddrInfoFromP2pAddr(quicAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	info.Addrs = append(quicInfo.Addrs, info.Addrs...)
+	return info, quicAddr, nil
 }
 
 func convertToSingleMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
@@ -399,6 +437,22 @@ func convertToSingleMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
 	return multiAddressBuilderWithID(node.IP().String(), "tcp", uint(node.TCP()), id)
 }
 
+// convertToQUICMultiAddr returns the QUIC multiaddress of a node, or an error satisfying
+// enr.IsNotFound if the node does not advertise QUIC.
+func convertToQUICMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
+	var quicPort quicProtocol
+	if err := node.Load(&quicPort); err != nil {
+		return nil, err
+	}
+	pubkey := node.Pubkey()
+	assertedKey := convertToInterfacePubkey(pubkey)
+	id, err := peer.IDFromPublicKey(assertedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get peer id")
+	}
+	return multiAddressBuilderWithID(node.IP().String(), "quic", uint(quicPort), id)
+}
+
 func convertToUdpMultiAddr(node *enode.Node) ([]ma.Multiaddr, error) {
 	pubkey := node.Pubkey()
 	assertedKey := convertToInterfacePubkey(pubkey)
@@ -141,9 +141,9 @@ func TestMultiAddrsConversion_InvalidIPAddr(t *testing.T) {
 		genesisTime:           time.Now(),
 		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
 	}
-	node, err := s.createLocalNode(pkey, addr, 0, 0)
+	node, err := s.createLocalNode(pkey, addr, 0, 0, 0)
 	require.NoError(t, err)
-	multiAddr := convertToMultiAddr([]*enode.Node{node.Node()})
+	multiAddr := convertToMultiAddr([]*enode.Node{node.Node()}, false)
 	assert.Equal(t, 0, len(multiAddr), "Invalid ip address converted successfully")
 }
 
@@ -162,7 +162,7 @@ func TestMultiAddrConversion_OK(t *testing.T) {
 	require.NoError(t, err)
 	defer listener.Close()
 
-	_ = convertToMultiAddr([]*enode.Node{listener.Self()})
+	_ = convertToMultiAddr([]*enode.Node{listener.Self()}, false)
 	require.LogsDoNotContain(t, hook, "Node doesn't have an ip4 address")
 	require.LogsDoNotContain(t, hook, "Invalid port, the tcp port of the node is a reserved port")
 	require.LogsDoNotContain(t, hook, "Could not get multiaddr")
@@ -290,6 +290,40 @@ func TestUDPMultiAddress(t *testing.T) {
 	assert.Equal(t, true, strings.Contains(multiAddresses[0].String(), "udp"))
 }
 
+func TestConvertToAddrInfo_QUIC(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+	localNode, err := s.createLocalNode(pkey, ipAddr, 3000, 3001, 3002)
+	require.NoError(t, err)
+	node := localNode.Node()
+
+	info, multiAddr, err := convertToAddrInfo(node, true)
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.Contains(multiAddr.String(), "/udp/3002/quic/"), "QUIC address is not preferred")
+	require.Equal(t, 2, len(info.Addrs))
+	assert.Equal(t, true, strings.HasSuffix(info.Addrs[0].String(), "/udp/3002/quic"))
+	assert.Equal(t, true, strings.HasSuffix(info.Addrs[1].String(), "/tcp/3001"))
+	assert.Equal(t, 2, len(convertToMultiAddr([]*enode.Node{node}, true)))
+
+	// QUIC is not used when it is disabled locally.
+	info, multiAddr, err = convertToAddrInfo(node, false)
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.Contains(multiAddr.String(), "/tcp/3001/"))
+	assert.Equal(t, 1, len(info.Addrs))
+	assert.Equal(t, 1, len(convertToMultiAddr([]*enode.Node{node}, false)))
+
+	// Nor when the node does not advertise it.
+	localNode, err = s.createLocalNode(pkey, ipAddr, 3000, 3001, 0)
+	require.NoError(t, err)
+	info, multiAddr, err = convertToAddrInfo(localNode.Node(), true)
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.Contains(multiAddr.String(), "/tcp/3001/"))
+	assert.Equal(t, 1, len(info.Addrs))
+}
+
 func TestMultipleDiscoveryAddresses(t *testing.T) {
 	db, err := enode.OpenDB(t.TempDir())
 	require.NoError(t, err)
@@ -10,9 +10,13 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/sirupsen/logrus"
 )
 
@@ -41,6 +45,9 @@ const (
 	// voluntaryExitWeight specifies the scoring weight that we apply to
 	// our voluntary exit topic.
 	voluntaryExitWeight = 0.05
+	// lightClientUpdateWeight specifies the scoring weight that we apply to
+	// each of our light client update topics.
+	lightClientUpdateWeight = 0.05
 
 	// maxInMeshScore describes the max score a peer can attain from being in the mesh.
 	maxInMeshScore = 10
@@ -53,6 +60,10 @@ const (
 
 	// dampeningFactor reduces the amount by which the various thresholds and caps are created.
 	dampeningFactor = 90
+
+	// maxRateDeviation bounds how far the observed message rate of a topic may deviate from
+	// the rate expected from the active validator count, when deriving its score parameters.
+	maxRateDeviation = 4
 )
 
 var (
@@ -103,25 +114,113 @@ func (s *Service) topicScoreParams(topic string) (*pubsub.TopicScoreParams, erro
 	case strings.Contains(topic, GossipBlockMessage):
 		return defaultBlockTopicParams(), nil
 	case strings.Contains(topic, GossipAggregateAndProofMessage):
-		return defaultAggregateTopicParams(activeValidators), nil
+		aggPerSlot := s.messageRate(topic, float64(aggregatorsPerSlot(activeValidators)))
+		return aggregateTopicParams(aggPerSlot), nil
 	case strings.Contains(topic, GossipAttestationMessage):
-		return defaultAggregateSubnetTopicParams(activeValidators), nil
+		numPerSlot := s.messageRate(topic, float64(attestationsPerSubnetSlot(activeValidators)))
+		return aggregateSubnetTopicParams(activeValidators, numPerSlot), nil
 	case strings.Contains(topic, GossipSyncCommitteeMessage):
-		return defaultSyncSubnetTopicParams(activeValidators), nil
+		numPerSlot := s.messageRate(topic, float64(syncMessagesPerSubnetSlot(activeValidators)))
+		return syncSubnetTopicParams(numPerSlot), nil
 	case strings.Contains(topic, GossipContributionAndProofMessage):
-		return defaultSyncContributionTopicParams(), nil
+		aggPerSlot := s.messageRate(topic, float64(syncAggregatorsPerSlot()))
+		return syncContributionTopicParams(aggPerSlot), nil
 	case strings.Contains(topic, GossipExitMessage):
 		return defaultVoluntaryExitTopicParams(), nil
 	case strings.Contains(topic, GossipProposerSlashingMessage):
 		return defaultProposerSlashingTopicParams(), nil
 	case strings.Contains(topic, GossipAttesterSlashingMessage):
 		return defaultAttesterSlashingTopicParams(), nil
+	case strings.Contains(topic, GossipLightClientFinalityUpdateMessage),
+		strings.Contains(topic, GossipLightClientOptimisticUpdateMessage):
+		return defaultLightClientUpdateTopicParams(), nil
 	default:
 		return nil, errors.Errorf("unrecognized topic provided for parameter registration: %s", topic)
 	}
 }
 
+// messageRate returns the number of messages per slot to derive the score parameters of a topic
+// from. This is the rate observed on the topic over the last epoch, bounded to within a factor of
+// maxRateDeviation of the expected rate, or the expected rate if none was observed.
+func (s *Service) messageRate(topic string, expected float64) float64 {
+	if s.gossipRates == nil {
+		return expected
+	}
+	observed, ok := s.gossipRates.rate(topic)
+	if !ok || observed == 0 {
+		return expected
+	}
+	return math.Min(math.Max(observed, expected/maxRateDeviation), expected*maxRateDeviation)
+}
+
+// A background routine which recomputes the score parameters of the subscribed
+// topics whenever the head of the chain enters a new epoch, with the number of
+// validators active in that epoch.
+func (s *Service) gossipScoringWatcher() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.ActiveValidatorCount {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.ActiveValidatorCountData)
+			if !ok {
+				log.Errorf("Received wrong data over state feed: %v", event.Data)
+				continue
+			}
+			s.activeValidatorLock.Lock()
+			s.activeValidatorCount = data.Count
+			s.activeValidatorLock.Unlock()
+			s.refreshTopicScoreParams()
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state feed")
+			return
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		}
+	}
+}
+
+// refreshTopicScoreParams recomputes the score parameters of all subscribed topics from the
+// current active validator count and the message rates observed since the last refresh.
+func (s *Service) refreshTopicScoreParams() {
+	s.gossipRates.rotate(prysmTime.Now())
+	topics := s.pubsub.GetTopics()
+	if len(topics) == 0 {
+		return
+	}
+	for _, topic := range topics {
+		s.joinedTopicsLock.Lock()
+		topicHandle, ok := s.joinedTopics[topic]
+		s.joinedTopicsLock.Unlock()
+		if !ok {
+			continue
+		}
+		scoringParams, err := s.topicScoreParams(topic)
+		if err != nil {
+			log.WithError(err).WithField("topic", topic).Error("Could not compute topic score parameters")
+			continue
+		}
+		if scoringParams == nil {
+			continue
+		}
+		if err := topicHandle.SetScoreParams(scoringParams); err != nil {
+			log.WithError(err).WithField("topic", topic).Error("Could not update topic score parameters")
+			continue
+		}
+		logGossipParameters(topic, scoringParams)
+	}
+}
+
+// retrieveActiveValidators returns the active validator count of the latest head epoch, or
+// computes it from the last archived state until the head enters a new epoch.
 func (s *Service) retrieveActiveValidators() (uint64, error) {
+	s.activeValidatorLock.Lock()
+	defer s.activeValidatorLock.Unlock()
 	if s.activeValidatorCount != 0 {
 		return s.activeValidatorCount, nil
 	}
@@ -193,14 +292,17 @@ func defaultBlockTopicParams() *pubsub.TopicScoreParams {
 
 func defaultAggregateTopicParams(activeValidators uint64) *pubsub.TopicScoreParams {
 	// Determine the expected message rate for the particular gossip topic.
-	aggPerSlot := aggregatorsPerSlot(activeValidators)
-	firstMessageCap, err := decayLimit(scoreDecay(1*oneEpochDuration()), float64(aggPerSlot*2/gossipSubD))
+	return aggregateTopicParams(float64(aggregatorsPerSlot(activeValidators)))
+}
+
+func aggregateTopicParams(aggPerSlot float64) *pubsub.TopicScoreParams {
+	firstMessageCap, err := decayLimit(scoreDecay(1*oneEpochDuration()), math.Floor(aggPerSlot*2/gossipSubD))
 	if err != nil {
 		log.Warnf("skipping initializing topic scoring: %v", err)
 		return nil
 	}
 	firstMessageWeight := maxFirstDeliveryScore / firstMessageCap
-	meshThreshold, err := decayThreshold(scoreDecay(1*oneEpochDuration()), float64(aggPerSlot)/dampeningFactor)
+	meshThreshold, err := decayThreshold(scoreDecay(1*oneEpochDuration()), aggPerSlot/dampeningFactor)
 	if err != nil {
 		log.Warnf("skipping initializing topic scoring: %v", err)
 		return nil
@@ -235,14 +337,17 @@ func defaultAggregateTopicParams(activeValidators uint64) *pubsub.TopicScorePara
 
 func defaultSyncContributionTopicParams() *pubsub.TopicScoreParams {
 	// Determine the expected message rate for the particular gossip topic.
-	aggPerSlot := params.BeaconConfig().SyncCommitteeSubnetCount * params.BeaconConfig().TargetAggregatorsPerSyncSubcommittee
-	firstMessageCap, err := decayLimit(scoreDecay(1*oneEpochDuration()), float64(aggPerSlot*2/gossipSubD))
+	return syncContributionTopicParams(float64(syncAggregatorsPerSlot()))
+}
+
+func syncContributionTopicParams(aggPerSlot float64) *pubsub.TopicScoreParams {
+	firstMessageCap, err := decayLimit(scoreDecay(1*oneEpochDuration()), math.Floor(aggPerSlot*2/gossipSubD))
 	if err != nil {
 		log.Warnf("skipping initializing topic scoring: %v", err)
 		return nil
 	}
 	firstMessageWeight := maxFirstDeliveryScore / firstMessageCap
-	meshThreshold, err := decayThreshold(scoreDecay(1*oneEpochDuration()), float64(aggPerSlot)/dampeningFactor)
+	meshThreshold, err := decayThreshold(scoreDecay(1*oneEpochDuration()), aggPerSlot/dampeningFactor)
 	if err != nil {
 		log.Warnf("skipping initializing topic scoring: %v", err)
 		return nil
@@ -276,17 +381,19 @@ func defaultSyncContributionTopicParams() *pubsub.TopicScoreParams {
 }
 
 func defaultAggregateSubnetTopicParams(activeValidators uint64) *pubsub.TopicScoreParams {
-	subnetCount := params.BeaconNetworkConfig().AttestationSubnetCount
-	// Get weight for each specific subnet.
-	topicWeight := attestationTotalWeight / float64(subnetCount)
-	subnetWeight := activeValidators / subnetCount
-	if subnetWeight == 0 {
+	if activeValidators/params.BeaconNetworkConfig().AttestationSubnetCount == 0 {
 		log.Warn("Subnet weight is 0, skipping initializing topic scoring")
 		return nil
 	}
 	// Determine the amount of validators expected in a subnet in a single slot.
-	numPerSlot := time.Duration(subnetWeight / uint64(params.BeaconConfig().SlotsPerEpoch))
-	if numPerSlot == 0 {
+	return aggregateSubnetTopicParams(activeValidators, float64(attestationsPerSubnetSlot(activeValidators)))
+}
+
+func aggregateSubnetTopicParams(activeValidators uint64, numPerSlot float64) *pubsub.TopicScoreParams {
+	subnetCount := params.BeaconNetworkConfig().AttestationSubnetCount
+	// Get weight for each specific subnet.
+	topicWeight := attestationTotalWeight / float64(subnetCount)
+	if numPerSlot < 1 {
 		log.Warn("numPerSlot is 0, skipping initializing topic scoring")
 		return nil
 	}
@@ -298,20 +405,20 @@ func defaultAggregateSubnetTopicParams(activeValidators uint64) *pubsub.TopicSco
 		firstDecay = 4
 		meshDecay = 16
 	}
-	rate := numPerSlot * 2 / gossipSubD
+	rate := math.Floor(numPerSlot * 2 / gossipSubD)
 	if rate == 0 {
 		log.Warn("rate is 0, skipping initializing topic scoring")
 		return nil
 	}
 	// Determine expected first deliveries based on the message rate.
-	firstMessageCap, err := decayLimit(scoreDecay(firstDecay*oneEpochDuration()), float64(rate))
+	firstMessageCap, err := decayLimit(scoreDecay(firstDecay*oneEpochDuration()), rate)
 	if err != nil {
 		log.Warnf("skipping initializing topic scoring: %v", err)
 		return nil
 	}
 	firstMessageWeight := maxFirstDeliveryScore / firstMessageCap
 	// Determine expected mesh deliveries based on message rate applied with a dampening factor.
-	meshThreshold, err := decayThreshold(scoreDecay(meshDecay*oneEpochDuration()), float64(numPerSlot)/dampeningFactor)
+	meshThreshold, err := decayThreshold(scoreDecay(meshDecay*oneEpochDuration()), numPerSlot/dampeningFactor)
 	if err != nil {
 		log.Warnf("skipping initializing topic scoring: %v", err)
 		return nil
@@ -345,36 +452,34 @@ func defaultAggregateSubnetTopicParams(activeValidators uint64) *pubsub.TopicSco
 }
 
 func defaultSyncSubnetTopicParams(activeValidators uint64) *pubsub.TopicScoreParams {
+	return syncSubnetTopicParams(float64(syncMessagesPerSubnetSlot(activeValidators)))
+}
+
+func syncSubnetTopicParams(subnetWeight float64) *pubsub.TopicScoreParams {
 	subnetCount := params.BeaconConfig().SyncCommitteeSubnetCount
 	// Get weight for each specific subnet.
 	topicWeight := syncCommitteesTotalWeight / float64(subnetCount)
-	syncComSize := params.BeaconConfig().SyncCommitteeSize
-	// Set the max as the sync committee size
-	if activeValidators > syncComSize {
-		activeValidators = syncComSize
-	}
-	subnetWeight := activeValidators / subnetCount
-	if subnetWeight == 0 {
+	if subnetWeight < 1 {
 		log.Warn("Subnet weight is 0, skipping initializing topic scoring")
 		return nil
 	}
 	firstDecay := time.Duration(1)
 	meshDecay := time.Duration(4)
 
-	rate := subnetWeight * 2 / gossipSubD
+	rate := math.Floor(subnetWeight * 2 / gossipSubD)
 	if rate == 0 {
 		log.Warn("rate is 0, skipping initializing topic scoring")
 		return nil
 	}
 	// Determine expected first deliveries based on the message rate.
-	firstMessageCap, err := decayLimit(scoreDecay(firstDecay*oneEpochDuration()), float64(rate))
+	firstMessageCap, err := decayLimit(scoreDecay(firstDecay*oneEpochDuration()), rate)
 	if err != nil {
 		log.WithError(err).Warn("Skipping initializing topic scoring")
 		return nil
 	}
 	firstMessageWeight := maxFirstDeliveryScore / firstMessageCap
 	// Determine expected mesh deliveries based on message rate applied with a dampening factor.
-	meshThreshold, err := decayThreshold(scoreDecay(meshDecay*oneEpochDuration()), float64(subnetWeight)/dampeningFactor)
+	meshThreshold, err := decayThreshold(scoreDecay(meshDecay*oneEpochDuration()), subnetWeight/dampeningFactor)
 	if err != nil {
 		log.WithError(err).Warn("Skipping initializing topic scoring")
 		return nil
@@ -473,6 +578,28 @@ func defaultVoluntaryExitTopicParams() *pubsub.TopicScoreParams {
 	}
 }
 
+func defaultLightClientUpdateTopicParams() *pubsub.TopicScoreParams {
+	return &pubsub.TopicScoreParams{
+		TopicWeight:                     lightClientUpdateWeight,
+		TimeInMeshWeight:                maxInMeshScore / inMeshCap(),
+		TimeInMeshQuantum:               inMeshTime(),
+		TimeInMeshCap:                   inMeshCap(),
+		FirstMessageDeliveriesWeight:    2,
+		FirstMessageDeliveriesDecay:     scoreDecay(oneHundredEpochs),
+		FirstMessageDeliveriesCap:       5,
+		MeshMessageDeliveriesWeight:     0,
+		MeshMessageDeliveriesDecay:      0,
+		MeshMessageDeliveriesCap:        0,
+		MeshMessageDeliveriesThreshold:  0,
+		MeshMessageDeliveriesWindow:     0,
+		MeshMessageDeliveriesActivation: 0,
+		MeshFailurePenaltyWeight:        0,
+		MeshFailurePenaltyDecay:         0,
+		InvalidMessageDeliveriesWeight:  -2000,
+		InvalidMessageDeliveriesDecay:   scoreDecay(invalidDecayPeriod),
+	}
+}
+
 func oneSlotDuration() time.Duration {
 	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
 }
@@ -522,6 +649,26 @@ func aggregatorsPerSlot(activeValidators uint64) uint64 {
 	return totalAggs
 }
 
+// Determines the amount of attestations expected in an attestation subnet in a single slot.
+func attestationsPerSubnetSlot(activeValidators uint64) uint64 {
+	subnetWeight := activeValidators / params.BeaconNetworkConfig().AttestationSubnetCount
+	return subnetWeight / uint64(params.BeaconConfig().SlotsPerEpoch)
+}
+
+// Determines the amount of sync committee messages expected in a sync subnet in a single slot.
+func syncMessagesPerSubnetSlot(activeValidators uint64) uint64 {
+	// Set the max as the sync committee size
+	if activeValidators > params.BeaconConfig().SyncCommitteeSize {
+		activeValidators = params.BeaconConfig().SyncCommitteeSize
+	}
+	return activeValidators / params.BeaconConfig().SyncCommitteeSubnetCount
+}
+
+// Determines the amount of sync committee contributions expected in a single slot.
+func syncAggregatorsPerSlot() uint64 {
+	return params.BeaconConfig().SyncCommitteeSubnetCount * params.BeaconConfig().TargetAggregatorsPerSyncSubcommittee
+}
+
 // provides the relevant score by the provided weight and threshold.
 func scoreByWeight(weight, threshold float64) float64 {
 	return maxScore() / (weight * threshold * threshold)
@@ -532,6 +679,9 @@ func maxScore() float64 {
 	totalWeight := beaconBlockWeight + aggregateWeight + syncContributionWeight +
 		attestationTotalWeight + syncCommitteesTotalWeight + attesterSlashingWeight +
 		proposerSlashingWeight + voluntaryExitWeight
+	if features.Get().EnableLightClientServer {
+		totalWeight += 2 * lightClientUpdateWeight
+	}
 	return (maxInMeshScore + maxFirstDeliveryScore) * totalWeight
 }
 
@@ -1,16 +1,27 @@
 package p2p
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
 )
 
 func TestCorrect_ActiveValidatorsCount(t *testing.T) {
@@ -76,3 +87,135 @@ func TestLoggingParameters(_ *testing.T) {
 	logGossipParameters("testing", defaultProposerSlashingTopicParams())
 	logGossipParameters("testing", defaultVoluntaryExitTopicParams())
 }
+
+func TestService_RefreshTopicScoreParams(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig()
+	cfg.ConfigName = "test"
+	params.OverrideBeaconConfig(cfg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db := dbutil.SetupDB(t)
+	s := &Service{
+		ctx:           ctx,
+		cfg:           &Config{DB: db},
+		stateNotifier: &mock.MockStateNotifier{},
+		joinedTopics:  make(map[string]*pubsub.Topic),
+		gossipRates:   newGossipRateTracker(),
+	}
+	var err error
+	s.pubsub, err = pubsub.NewGossipSub(ctx, newLocalHost(t, nil),
+		pubsub.WithPeerScore(peerScoringParams()),
+		pubsub.WithRawTracer(s.gossipRates),
+	)
+	require.NoError(t, err)
+
+	newValidator := func() *ethpb.Validator {
+		return &ethpb.Validator{
+			PublicKey:             make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	bState, err := util.NewBeaconState(func(state *ethpb.BeaconState) error {
+		state.Validators = make([]*ethpb.Validator, 16384)
+		for i := range state.Validators {
+			state.Validators[i] = newValidator()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisData(ctx, bState))
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+
+	topic := fmt.Sprintf(AttestationSubnetTopicFormat, [4]byte{1, 2, 3, 4}, 1) + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+	topicHandle, err := s.JoinTopic(topic)
+	require.NoError(t, err)
+	_, err = topicHandle.Subscribe()
+	require.NoError(t, err)
+	scoringParams, err := s.topicScoreParams(topic)
+	require.NoError(t, err)
+	assert.DeepEqual(t, defaultAggregateSubnetTopicParams(16384), scoringParams)
+
+	// The head enters an epoch with more active validators, and twice the expected attestations are seen on the
+	// subnet over an epoch.
+	s.gossipRates.windowStart = prysmTime.Now().Add(-oneEpochDuration())
+	s.gossipRates.joined[topic] = s.gossipRates.windowStart
+	s.gossipRates.counts[topic] = 2 * 8 * uint64(params.BeaconConfig().SlotsPerEpoch)
+	go s.gossipScoringWatcher()
+	// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+	for sent := 0; sent == 0; {
+		sent = s.stateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.ActiveValidatorCount,
+			Data: &statefeed.ActiveValidatorCountData{Epoch: 10, Count: 16384 + 8192},
+		})
+	}
+	var rate float64
+	ok := false
+	for i := 0; i < 100 && !ok; i++ {
+		time.Sleep(10 * time.Millisecond)
+		rate, ok = s.gossipRates.rate(topic)
+	}
+	require.Equal(t, true, ok)
+	activeValidators, err := s.retrieveActiveValidators()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16384+8192), activeValidators)
+	assert.Equal(t, true, rate > 15.9 && rate <= 16, "Unexpected observed rate %f", rate)
+	scoringParams, err = s.topicScoreParams(topic)
+	require.NoError(t, err)
+	assert.DeepEqual(t, aggregateSubnetTopicParams(16384+8192, rate), scoringParams)
+}
+
+func TestGossipScoring_Simulation(t *testing.T) {
+	scoreParams, thresholds := peerScoringParams()
+	// All simulated nodes share the loopback address.
+	_, loopback, err := net.ParseCIDR("127.0.0.0/8")
+	require.NoError(t, err)
+	scoreParams.IPColocationFactorWhitelist = []*net.IPNet{loopback}
+
+	const activeValidators = 100000
+	attTopic := fmt.Sprintf(AttestationSubnetTopicFormat, [4]byte{1, 2, 3, 4}, 1) + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+	aggTopic := fmt.Sprintf(AggregateAndProofSubnetTopicFormat, [4]byte{1, 2, 3, 4}) + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+	gossipParams := pubsubGossipParam()
+	sim := testp2p.NewGossipSimulation(t, &testp2p.GossipSimConfig{
+		Nodes:        6,
+		GossipParams: &gossipParams,
+		ScoreParams:  scoreParams,
+		Thresholds:   thresholds,
+		TopicParams: map[string]*pubsub.TopicScoreParams{
+			attTopic: defaultAggregateSubnetTopicParams(activeValidators),
+			aggTopic: defaultAggregateTopicParams(activeValidators),
+		},
+		Validator: func(_ string, data []byte) bool {
+			return !bytes.HasPrefix(data, []byte("spam"))
+		},
+	})
+
+	// The last node spams invalid attestations, while the others publish valid attestations and aggregates.
+	const spammer = 5
+	var msgs []testp2p.GossipMessage
+	for i := 0; i < 20; i++ {
+		for node := 0; node < spammer; node++ {
+			msgs = append(msgs, testp2p.GossipMessage{From: node, Topic: attTopic, Data: []byte(fmt.Sprintf("attestation %d %d", node, i))})
+		}
+		msgs = append(msgs, testp2p.GossipMessage{From: i % spammer, Topic: aggTopic, Data: []byte(fmt.Sprintf("aggregate %d", i))})
+		msgs = append(msgs, testp2p.GossipMessage{From: spammer, Topic: attTopic, Data: []byte(fmt.Sprintf("spam %d", i))})
+	}
+	sim.Replay(msgs, time.Millisecond)
+	sim.AwaitScores()
+
+	for observer := 0; observer < spammer; observer++ {
+		for target := 0; target < spammer; target++ {
+			if target == observer {
+				continue
+			}
+			score, ok := sim.Score(observer, target)
+			require.Equal(t, true, ok, "Node %d did not score node %d", observer, target)
+			assert.Equal(t, true, score > 0, "Node %d scored honest node %d at %f", observer, target, score)
+		}
+		score, _ := sim.Score(observer, spammer)
+		assert.Equal(t, true, sim.Graylisted(observer, spammer), "Node %d scored spamming node at %f", observer, score)
+	}
+}
@@ -0,0 +1,119 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+)
+
+var _ pubsub.RawTracer = (*gossipRateTracker)(nil)
+
+// gossipRateTracker is a pubsub tracer which tallies the messages delivered on each
+// topic, so that the topic score parameters can follow the message rates observed
+// on the network rather than only the rates expected from the active validator count.
+type gossipRateTracker struct {
+	lock        sync.Mutex
+	windowStart time.Time
+	joined      map[string]time.Time
+	counts      map[string]uint64
+	rates       map[string]float64
+}
+
+func newGossipRateTracker() *gossipRateTracker {
+	return &gossipRateTracker{
+		windowStart: prysmTime.Now(),
+		joined:      make(map[string]time.Time),
+		counts:      make(map[string]uint64),
+		rates:       make(map[string]float64),
+	}
+}
+
+// rotate closes the current window and computes the message rate, per slot, of every
+// topic which was joined for the whole window.
+func (g *gossipRateTracker) rotate(now time.Time) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.rates = make(map[string]float64, len(g.joined))
+	slotsElapsed := float64(now.Sub(g.windowStart)) / float64(oneSlotDuration())
+	if slotsElapsed >= 1 {
+		for topic, joinedAt := range g.joined {
+			if joinedAt.After(g.windowStart) {
+				continue
+			}
+			g.rates[topic] = float64(g.counts[topic]) / slotsElapsed
+		}
+	}
+	g.counts = make(map[string]uint64, len(g.joined))
+	g.windowStart = now
+}
+
+// rate returns the message rate, per slot, observed on a topic in the last full window.
+func (g *gossipRateTracker) rate(topic string) (float64, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	r, ok := g.rates[topic]
+	return r, ok
+}
+
+// Join records when a topic is joined, so that partial windows are not used as rates.
+func (g *gossipRateTracker) Join(topic string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.joined[topic] = prysmTime.Now()
+}
+
+// Leave stops tracking a topic.
+func (g *gossipRateTracker) Leave(topic string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	delete(g.joined, topic)
+	delete(g.counts, topic)
+	delete(g.rates, topic)
+}
+
+// DeliverMessage counts a message which passed validation.
+func (g *gossipRateTracker) DeliverMessage(msg *pubsub.Message) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.counts[msg.GetTopic()]++
+}
+
+// AddPeer is a no-op.
+func (_ *gossipRateTracker) AddPeer(_ peer.ID, _ protocol.ID) {}
+
+// RemovePeer is a no-op.
+func (_ *gossipRateTracker) RemovePeer(_ peer.ID) {}
+
+// Graft is a no-op.
+func (_ *gossipRateTracker) Graft(_ peer.ID, _ string) {}
+
+// Prune is a no-op.
+func (_ *gossipRateTracker) Prune(_ peer.ID, _ string) {}
+
+// ValidateMessage is a no-op.
+func (_ *gossipRateTracker) ValidateMessage(_ *pubsub.Message) {}
+
+// RejectMessage is a no-op.
+func (_ *gossipRateTracker) RejectMessage(_ *pubsub.Message, _ string) {}
+
+// DuplicateMessage is a no-op.
+func (_ *gossipRateTracker) DuplicateMessage(_ *pubsub.Message) {}
+
+// ThrottlePeer is a no-op.
+func (_ *gossipRateTracker) ThrottlePeer(_ peer.ID) {}
+
+// RecvRPC is a no-op.
+func (_ *gossipRateTracker) RecvRPC(_ *pubsub.RPC) {}
+
+// SendRPC is a no-op.
+func (_ *gossipRateTracker) SendRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// DropRPC is a no-op.
+func (_ *gossipRateTracker) DropRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// UndeliverableMessage is a no-op.
+func (_ *gossipRateTracker) UndeliverableMessage(_ *pubsub.Message) {}
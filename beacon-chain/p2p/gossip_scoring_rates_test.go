@@ -0,0 +1,62 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func TestGossipRateTracker_Rates(t *testing.T) {
+	g := newGossipRateTracker()
+	start := g.windowStart
+	fullTopic, newTopic := "full", "new"
+	g.joined[fullTopic] = start.Add(-time.Second)
+	g.joined[newTopic] = start.Add(time.Second)
+	deliver := func(topic string, n int) {
+		for i := 0; i < n; i++ {
+			g.DeliverMessage(&pubsub.Message{Message: &pubsubpb.Message{Topic: &topic}})
+		}
+	}
+
+	deliver(fullTopic, 64)
+	deliver(newTopic, 64)
+	g.rotate(start.Add(2 * oneSlotDuration()))
+	rate, ok := g.rate(fullTopic)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, float64(32), rate)
+	_, ok = g.rate(newTopic)
+	assert.Equal(t, false, ok, "Wanted no rate for a topic joined during the window")
+
+	// The next window only counts the messages delivered since the rotation.
+	deliver(newTopic, int(params.BeaconConfig().SlotsPerEpoch))
+	g.rotate(start.Add(2*oneSlotDuration() + oneEpochDuration()))
+	rate, ok = g.rate(newTopic)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, float64(1), rate)
+	rate, ok = g.rate(fullTopic)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, float64(0), rate)
+
+	g.Leave(fullTopic)
+	_, ok = g.rate(fullTopic)
+	assert.Equal(t, false, ok)
+}
+
+func TestService_MessageRate(t *testing.T) {
+	s := &Service{gossipRates: newGossipRateTracker()}
+	topic := "topic"
+	assert.Equal(t, float64(100), s.messageRate(topic, 100), "Wanted the expected rate without observations")
+
+	s.gossipRates.rates[topic] = 150
+	assert.Equal(t, float64(150), s.messageRate(topic, 100))
+	s.gossipRates.rates[topic] = 1000
+	assert.Equal(t, float64(100*maxRateDeviation), s.messageRate(topic, 100))
+	s.gossipRates.rates[topic] = 1
+	assert.Equal(t, float64(100)/maxRateDeviation, s.messageRate(topic, 100))
+	s.gossipRates.rates[topic] = 0
+	assert.Equal(t, float64(100), s.messageRate(topic, 100))
+}
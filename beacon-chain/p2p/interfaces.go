@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"net"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/connmgr"
@@ -81,6 +82,19 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// PeerAdmin manages the trusted peers and the peer bans of the node at runtime.
+type PeerAdmin interface {
+	AddTrustedPeer(addr multiaddr.Multiaddr) error
+	RemoveTrustedPeer(pid peer.ID) error
+	TrustedPeers() []peer.AddrInfo
+	BanPeer(pid peer.ID) error
+	UnbanPeer(pid peer.ID) error
+	BannedPeers() []peer.ID
+	BanIPRange(ipNet *net.IPNet) error
+	UnbanIPRange(ipNet *net.IPNet) error
+	BannedIPRanges() []*net.IPNet
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/peer"
 	noise "github.com/libp2p/go-libp2p-noise"
+	quic "github.com/libp2p/go-libp2p-quic-transport"
 	"github.com/libp2p/go-tcp-transport"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -17,18 +18,24 @@ import (
 // buildOptions for the libp2p host.
 func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Option {
 	cfg := s.cfg
-	listen, err := multiAddressBuilder(ip.String(), cfg.TCPPort)
-	if err != nil {
-		log.Fatalf("Failed to p2p listen: %v", err)
-	}
+	listenIP := ip.String()
 	if cfg.LocalIP != "" {
 		if net.ParseIP(cfg.LocalIP) == nil {
 			log.Fatalf("Invalid local ip provided: %s", cfg.LocalIP)
 		}
-		listen, err = multiAddressBuilder(cfg.LocalIP, cfg.TCPPort)
+		listenIP = cfg.LocalIP
+	}
+	listen, err := multiAddressBuilder(listenIP, cfg.TCPPort)
+	if err != nil {
+		log.Fatalf("Failed to p2p listen: %v", err)
+	}
+	listenAddrs := []ma.Multiaddr{listen}
+	if cfg.QUICPort != 0 {
+		quicListen, err := quicMultiAddressBuilder(listenIP, cfg.QUICPort)
 		if err != nil {
 			log.Fatalf("Failed to p2p listen: %v", err)
 		}
+		listenAddrs = append(listenAddrs, quicListen)
 	}
 	ifaceKey := convertToInterfacePrivkey(priKey)
 	id, err := peer.IDFromPublicKey(ifaceKey.GetPublic())
@@ -39,11 +46,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 
 	options := []libp2p.Option{
 		privKeyOption(priKey),
-		libp2p.ListenAddrs(listen),
+		libp2p.ListenAddrs(listenAddrs...),
 		libp2p.UserAgent(version.BuildData()),
 		libp2p.ConnectionGater(s),
 		libp2p.Transport(tcp.NewTCPTransport),
 	}
+	if cfg.QUICPort != 0 {
+		options = append(options, libp2p.Transport(quic.NewTransport))
+	}
 
 	options = append(options, libp2p.Security(noise.ID, noise.New))
 
@@ -64,6 +74,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if cfg.QUICPort != 0 {
+				external, err := quicMultiAddressBuilder(cfg.HostAddress, cfg.QUICPort)
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, external)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -75,6 +93,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if cfg.QUICPort != 0 {
+				external, err := ma.NewMultiaddr(fmt.Sprintf("/dns4/%s/udp/%d/quic", cfg.HostDNS, cfg.QUICPort))
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, external)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -94,6 +120,17 @@ func multiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
 	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/tcp/%d", ipAddr, port))
 }
 
+func quicMultiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
+	parsedIP := net.ParseIP(ipAddr)
+	if parsedIP.To4() == nil && parsedIP.To16() == nil {
+		return nil, errors.Errorf("invalid ip address provided: %s", ipAddr)
+	}
+	if parsedIP.To4() != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/udp/%d/quic", ipAddr, port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/udp/%d/quic", ipAddr, port))
+}
+
 func multiAddressBuilderWithID(ipAddr, protocol string, port uint, id peer.ID) (ma.Multiaddr, error) {
 	parsedIP := net.ParseIP(ipAddr)
 	if parsedIP.To4() == nil && parsedIP.To16() == nil {
@@ -102,10 +139,15 @@ func multiAddressBuilderWithID(ipAddr, protocol string, port uint, id peer.ID) (
 	if id.String() == "" {
 		return nil, errors.New("empty peer id given")
 	}
+	// QUIC runs over UDP.
+	transport := fmt.Sprintf("%s/%d", protocol, port)
+	if protocol == "quic" {
+		transport = fmt.Sprintf("udp/%d/quic", port)
+	}
 	if parsedIP.To4() != nil {
-		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/%s/%d/p2p/%s", ipAddr, protocol, port, id.String()))
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/%s/p2p/%s", ipAddr, transport, id.String()))
 	}
-	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/%s/%d/p2p/%s", ipAddr, protocol, port, id.String()))
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/%s/p2p/%s", ipAddr, transport, id.String()))
 }
 
 // Adds a private key to the libp2p option if the option was provided.
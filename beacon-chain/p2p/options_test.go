@@ -1,16 +1,25 @@
 package p2p
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"io/ioutil"
 	"net"
+	"strings"
 	"testing"
 
 	gethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	quic "github.com/libp2p/go-libp2p-quic-transport"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -70,3 +79,43 @@ func TestIPV6Support(t *testing.T) {
 		t.Error("Multiaddress did not have ipv6 protocol")
 	}
 }
+
+func TestBuildOptions_QUIC(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	_, pkey2 := createAddrAndPrivKey(t)
+	s := &Service{
+		cfg:       &Config{TCPPort: 2100, QUICPort: 2101, MaxPeers: 30},
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	h1, err := libp2p.New(s.buildOptions(ipAddr, pkey)...)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h1.Close())
+	}()
+	var listensQUIC bool
+	for _, addr := range h1.Network().ListenAddresses() {
+		if strings.HasSuffix(addr.String(), "/udp/2101/quic") {
+			listensQUIC = true
+		}
+	}
+	assert.Equal(t, true, listensQUIC, "Host does not listen on QUIC")
+
+	listen, err := quicMultiAddressBuilder(ipAddr.String(), 2102)
+	require.NoError(t, err)
+	h2, err := libp2p.New(privKeyOption(pkey2), libp2p.ListenAddrs(listen), libp2p.Transport(quic.NewTransport))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h2.Close())
+	}()
+	require.NoError(t, h1.Connect(context.Background(), peer.AddrInfo{ID: h2.ID(), Addrs: []ma.Multiaddr{listen}}))
+	conns := h1.Network().ConnsToPeer(h2.ID())
+	require.Equal(t, 1, len(conns))
+	assert.Equal(t, true, strings.HasSuffix(conns[0].RemoteMultiaddr().String(), "/quic"))
+}
@@ -0,0 +1,294 @@
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"path"
+	"sort"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/io/file"
+)
+
+// peerStorePath is the file in the data directory that trusted peers
+// and bans are persisted to, so they survive restarts.
+const peerStorePath = "peerstore.json"
+
+// ErrUnknownPeerEntry is returned when removing a trusted peer, a peer ban or
+// an IP range ban that does not exist.
+var ErrUnknownPeerEntry = errors.New("no such trusted peer or ban")
+
+// peerStore is the persisted form of the peers and IP ranges managed at runtime.
+type peerStore struct {
+	TrustedPeers   []string `json:"trusted_peers"`
+	BannedPeers    []string `json:"banned_peers"`
+	BannedIPRanges []string `json:"banned_ip_ranges"`
+}
+
+// AddTrustedPeer adds a peer, given as a multiaddress ending in its peer ID, to the trusted peers.
+// Trusted peers are never pruned or scored down, and are reconnected to whenever the connection
+// is lost. Trusting a banned peer lifts its ban.
+func (s *Service) AddTrustedPeer(addr multiaddr.Multiaddr) error {
+	info, err := peer.AddrInfoFromP2pAddr(addr)
+	if err != nil {
+		return errors.Wrap(err, "could not parse peer address")
+	}
+	s.peerAdminLock.Lock()
+	defer s.peerAdminLock.Unlock()
+	s.trustedPeers[info.ID] = *info
+	delete(s.bannedPeers, info.ID)
+	s.peers.SetTrusted(info.ID, true)
+	s.peers.SetBanned(info.ID, false)
+	if s.started {
+		go func() {
+			if err := connectWithTimeout(s.ctx, s.host, info); err != nil {
+				log.WithError(err).WithField("peer", info.ID).Debug("Could not connect to trusted peer")
+			}
+		}()
+	}
+	return s.savePeerStore()
+}
+
+// RemoveTrustedPeer removes a peer from the trusted peers. The peer is not disconnected.
+func (s *Service) RemoveTrustedPeer(pid peer.ID) error {
+	s.peerAdminLock.Lock()
+	defer s.peerAdminLock.Unlock()
+	if _, ok := s.trustedPeers[pid]; !ok {
+		return ErrUnknownPeerEntry
+	}
+	delete(s.trustedPeers, pid)
+	s.peers.SetTrusted(pid, false)
+	return s.savePeerStore()
+}
+
+// TrustedPeers returns the trusted peers, ordered by peer ID.
+func (s *Service) TrustedPeers() []peer.AddrInfo {
+	s.peerAdminLock.RLock()
+	defer s.peerAdminLock.RUnlock()
+	infos := make([]peer.AddrInfo, 0, len(s.trustedPeers))
+	for _, info := range s.trustedPeers {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// BanPeer bans a peer ID and disconnects from it. Banning a trusted peer
+// removes it from the trusted peers.
+func (s *Service) BanPeer(pid peer.ID) error {
+	s.peerAdminLock.Lock()
+	defer s.peerAdminLock.Unlock()
+	s.bannedPeers[pid] = true
+	delete(s.trustedPeers, pid)
+	s.peers.SetBanned(pid, true)
+	s.peers.SetTrusted(pid, false)
+	if err := s.savePeerStore(); err != nil {
+		return err
+	}
+	if err := s.Disconnect(pid); err != nil {
+		log.WithError(err).WithField("peer", pid).Debug("Could not disconnect from banned peer")
+	}
+	return nil
+}
+
+// UnbanPeer lifts the ban of a peer ID.
+func (s *Service) UnbanPeer(pid peer.ID) error {
+	s.peerAdminLock.Lock()
+	defer s.peerAdminLock.Unlock()
+	if !s.bannedPeers[pid] {
+		return ErrUnknownPeerEntry
+	}
+	delete(s.bannedPeers, pid)
+	s.peers.SetBanned(pid, false)
+	return s.savePeerStore()
+}
+
+// BannedPeers returns the banned peer IDs, in ascending order.
+func (s *Service) BannedPeers() []peer.ID {
+	s.peerAdminLock.RLock()
+	defer s.peerAdminLock.RUnlock()
+	pids := make([]peer.ID, 0, len(s.bannedPeers))
+	for pid := range s.bannedPeers {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i] < pids[j]
+	})
+	return pids
+}
+
+// BanIPRange bans an IP range and disconnects from all peers connected from it.
+// Unlike peer ID bans, IP range bans also apply to trusted peers.
+func (s *Service) BanIPRange(ipNet *net.IPNet) error {
+	s.peerAdminLock.Lock()
+	defer s.peerAdminLock.Unlock()
+	if indexOfIPRange(s.bannedIPRanges, ipNet) < 0 {
+		s.bannedIPRanges = append(s.bannedIPRanges, ipNet)
+	}
+	if err := s.savePeerStore(); err != nil {
+		return err
+	}
+	for _, conn := range s.host.Network().Conns() {
+		ip, err := manet.ToIP(conn.RemoteMultiaddr())
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+		if err := conn.Close(); err != nil {
+			log.WithError(err).WithField("peer", conn.RemotePeer()).Debug("Could not close connection from banned IP range")
+		}
+	}
+	return nil
+}
+
+// UnbanIPRange lifts the ban of an IP range.
+func (s *Service) UnbanIPRange(ipNet *net.IPNet) error {
+	s.peerAdminLock.Lock()
+	defer s.peerAdminLock.Unlock()
+	i := indexOfIPRange(s.bannedIPRanges, ipNet)
+	if i < 0 {
+		return ErrUnknownPeerEntry
+	}
+	s.bannedIPRanges = append(s.bannedIPRanges[:i], s.bannedIPRanges[i+1:]...)
+	return s.savePeerStore()
+}
+
+// BannedIPRanges returns the banned IP ranges, in the order they were banned.
+func (s *Service) BannedIPRanges() []*net.IPNet {
+	s.peerAdminLock.RLock()
+	defer s.peerAdminLock.RUnlock()
+	ranges := make([]*net.IPNet, len(s.bannedIPRanges))
+	copy(ranges, s.bannedIPRanges)
+	return ranges
+}
+
+// isIPBanned checks whether the IP of a multiaddress is in a banned IP range.
+func (s *Service) isIPBanned(addr multiaddr.Multiaddr) bool {
+	s.peerAdminLock.RLock()
+	defer s.peerAdminLock.RUnlock()
+	if len(s.bannedIPRanges) == 0 {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	for _, ipNet := range s.bannedIPRanges {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureTrustedPeerConnections reconnects to the trusted peers we are not connected to.
+func (s *Service) ensureTrustedPeerConnections() {
+	for _, info := range s.TrustedPeers() {
+		if len(s.host.Network().ConnsToPeer(info.ID)) > 0 {
+			continue
+		}
+		info := info
+		if err := connectWithTimeout(s.ctx, s.host, &info); err != nil {
+			log.WithField("peer", info.ID).WithField("addrs", info.Addrs).WithError(err).Debug("Failed to reconnect to trusted peer")
+		}
+	}
+}
+
+// loadPeerStore initializes the trusted peers and bans from the peer store file
+// in the data directory, if there is one.
+func (s *Service) loadPeerStore() error {
+	s.peerAdminLock.Lock()
+	defer s.peerAdminLock.Unlock()
+	s.trustedPeers = make(map[peer.ID]peer.AddrInfo)
+	s.bannedPeers = make(map[peer.ID]bool)
+	s.bannedIPRanges = nil
+
+	storePath := path.Join(s.cfg.DataDir, peerStorePath)
+	if !file.FileExists(storePath) {
+		return nil
+	}
+	enc, err := ioutil.ReadFile(storePath) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not read peer store")
+	}
+	store := &peerStore{}
+	if err := json.Unmarshal(enc, store); err != nil {
+		return errors.Wrap(err, "could not unmarshal peer store")
+	}
+	for _, addr := range store.TrustedPeers {
+		info, err := peer.AddrInfoFromString(addr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid trusted peer %s in peer store", addr)
+		}
+		s.trustedPeers[info.ID] = *info
+		s.peers.SetTrusted(info.ID, true)
+	}
+	for _, id := range store.BannedPeers {
+		pid, err := peer.Decode(id)
+		if err != nil {
+			return errors.Wrapf(err, "invalid banned peer %s in peer store", id)
+		}
+		s.bannedPeers[pid] = true
+		s.peers.SetBanned(pid, true)
+	}
+	for _, cidr := range store.BannedIPRanges {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid banned IP range %s in peer store", cidr)
+		}
+		s.bannedIPRanges = append(s.bannedIPRanges, ipNet)
+	}
+	return nil
+}
+
+// savePeerStore writes the trusted peers and bans to the peer store file.
+// Important: it is assumed that the peer admin lock is held when calling this method.
+func (s *Service) savePeerStore() error {
+	store := &peerStore{
+		TrustedPeers:   make([]string, 0, len(s.trustedPeers)),
+		BannedPeers:    make([]string, 0, len(s.bannedPeers)),
+		BannedIPRanges: make([]string, 0, len(s.bannedIPRanges)),
+	}
+	for _, info := range s.trustedPeers {
+		addrs, err := peer.AddrInfoToP2pAddrs(&info)
+		if err != nil {
+			return err
+		}
+		if len(addrs) == 0 {
+			continue
+		}
+		store.TrustedPeers = append(store.TrustedPeers, addrs[0].String())
+	}
+	for pid := range s.bannedPeers {
+		store.BannedPeers = append(store.BannedPeers, pid.String())
+	}
+	for _, ipNet := range s.bannedIPRanges {
+		store.BannedIPRanges = append(store.BannedIPRanges, ipNet.String())
+	}
+	sort.Strings(store.TrustedPeers)
+	sort.Strings(store.BannedPeers)
+
+	enc, err := json.MarshalIndent(store, "", "  ")
+	if err != nil {
+		return err
+	}
+	storePath := path.Join(s.cfg.DataDir, peerStorePath)
+	if err := file.WriteFile(storePath, enc); err != nil {
+		return errors.Wrap(err, "could not write peer store")
+	}
+	return nil
+}
+
+func indexOfIPRange(ranges []*net.IPNet, ipNet *net.IPNet) int {
+	for i, r := range ranges {
+		if r.String() == ipNet.String() {
+			return i
+		}
+	}
+	return -1
+}
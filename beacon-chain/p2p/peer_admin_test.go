@@ -0,0 +1,139 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func newPeerAdminService(t *testing.T, dataDir string) *Service {
+	s := &Service{
+		ctx:       context.Background(),
+		cfg:       &Config{DataDir: dataDir},
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+	}
+	s.peers = peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	require.NoError(t, s.loadPeerStore())
+	return s
+}
+
+func newLocalHost(t *testing.T, gater *Service) host.Host {
+	opts := []libp2p.Option{libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0")}
+	if gater != nil {
+		opts = append(opts, libp2p.ConnectionGater(gater))
+	}
+	h, err := libp2p.New(opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.Close())
+	})
+	return h
+}
+
+func TestService_PeerStore_Persisted(t *testing.T) {
+	dataDir := t.TempDir()
+	s := newPeerAdminService(t, dataDir)
+	s.host = newLocalHost(t, s)
+	trusted := newLocalHost(t, nil)
+	banned := newLocalHost(t, nil)
+
+	trustedAddr, err := ma.NewMultiaddr(trusted.Addrs()[0].String() + "/p2p/" + trusted.ID().String())
+	require.NoError(t, err)
+	require.NoError(t, s.AddTrustedPeer(trustedAddr))
+	require.NoError(t, s.BanPeer(banned.ID()))
+	_, ipNet, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	require.NoError(t, s.BanIPRange(ipNet))
+	assert.Equal(t, true, s.peers.IsTrusted(trusted.ID()))
+	assert.Equal(t, true, s.peers.IsBanned(banned.ID()))
+
+	// A restarted service picks up the trusted peers and bans.
+	restarted := newPeerAdminService(t, dataDir)
+	require.Equal(t, 1, len(restarted.TrustedPeers()))
+	assert.Equal(t, trusted.ID(), restarted.TrustedPeers()[0].ID)
+	assert.DeepEqual(t, trusted.Addrs()[:1], restarted.TrustedPeers()[0].Addrs)
+	assert.DeepEqual(t, []peer.ID{banned.ID()}, restarted.BannedPeers())
+	assert.DeepEqual(t, []*net.IPNet{ipNet}, restarted.BannedIPRanges())
+	assert.Equal(t, true, restarted.peers.IsTrusted(trusted.ID()))
+	assert.Equal(t, true, restarted.peers.IsBanned(banned.ID()))
+
+	require.NoError(t, s.RemoveTrustedPeer(trusted.ID()))
+	require.NoError(t, s.UnbanPeer(banned.ID()))
+	require.NoError(t, s.UnbanIPRange(ipNet))
+	assert.ErrorContains(t, ErrUnknownPeerEntry.Error(), s.RemoveTrustedPeer(trusted.ID()))
+	assert.ErrorContains(t, ErrUnknownPeerEntry.Error(), s.UnbanPeer(banned.ID()))
+	assert.ErrorContains(t, ErrUnknownPeerEntry.Error(), s.UnbanIPRange(ipNet))
+
+	restarted = newPeerAdminService(t, dataDir)
+	assert.Equal(t, 0, len(restarted.TrustedPeers()))
+	assert.Equal(t, 0, len(restarted.BannedPeers()))
+	assert.Equal(t, 0, len(restarted.BannedIPRanges()))
+}
+
+func TestService_BanPeer_LiftsTrust(t *testing.T) {
+	s := newPeerAdminService(t, t.TempDir())
+	s.host = newLocalHost(t, s)
+	remote := newLocalHost(t, nil)
+
+	addr, err := ma.NewMultiaddr(remote.Addrs()[0].String() + "/p2p/" + remote.ID().String())
+	require.NoError(t, err)
+	require.NoError(t, s.AddTrustedPeer(addr))
+	require.NoError(t, s.BanPeer(remote.ID()))
+	assert.Equal(t, 0, len(s.TrustedPeers()))
+	assert.Equal(t, false, s.peers.IsTrusted(remote.ID()))
+	assert.Equal(t, true, s.peers.IsBad(remote.ID()))
+
+	// Trusting the peer again lifts the ban.
+	require.NoError(t, s.AddTrustedPeer(addr))
+	assert.Equal(t, 0, len(s.BannedPeers()))
+	assert.Equal(t, false, s.peers.IsBanned(remote.ID()))
+}
+
+func TestService_Bans_EnforcedByConnectionGater(t *testing.T) {
+	s := newPeerAdminService(t, t.TempDir())
+	s.cfg.MaxPeers = 30
+	s.host = newLocalHost(t, s)
+	remote := newLocalHost(t, nil)
+	local := peer.AddrInfo{ID: s.host.ID(), Addrs: s.host.Addrs()}
+	remoteInfo := peer.AddrInfo{ID: remote.ID(), Addrs: remote.Addrs()}
+
+	t.Run("banned peer", func(t *testing.T) {
+		require.NoError(t, s.BanPeer(remote.ID()))
+		assert.Equal(t, false, s.InterceptPeerDial(remote.ID()))
+		assert.NotNil(t, remote.Connect(context.Background(), local), "Wanted inbound connection to fail")
+		assert.NotNil(t, s.host.Connect(context.Background(), remoteInfo), "Wanted outbound connection to fail")
+
+		require.NoError(t, s.UnbanPeer(remote.ID()))
+		assert.Equal(t, true, s.InterceptPeerDial(remote.ID()))
+		require.NoError(t, s.host.Connect(context.Background(), remoteInfo))
+	})
+
+	t.Run("banned IP range", func(t *testing.T) {
+		_, ipNet, err := net.ParseCIDR("127.0.0.0/8")
+		require.NoError(t, err)
+		require.NoError(t, s.BanIPRange(ipNet))
+		assert.Equal(t, 0, len(s.host.Network().ConnsToPeer(remote.ID())), "Wanted connections from banned range to be closed")
+		assert.Equal(t, false, s.InterceptAddrDial(remote.ID(), remote.Addrs()[0]))
+		assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: remote.Addrs()[0]}))
+
+		require.NoError(t, s.UnbanIPRange(ipNet))
+		assert.Equal(t, true, s.InterceptAddrDial(remote.ID(), remote.Addrs()[0]))
+		assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: remote.Addrs()[0]}))
+	})
+}
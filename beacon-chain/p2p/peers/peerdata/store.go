@@ -38,19 +38,22 @@ type StoreConfig struct {
 // the mutex when accessing data.
 type Store struct {
 	sync.RWMutex
-	ctx    context.Context
-	config *StoreConfig
-	peers  map[peer.ID]*PeerData
+	ctx     context.Context
+	config  *StoreConfig
+	peers   map[peer.ID]*PeerData
+	trusted map[peer.ID]bool
+	banned  map[peer.ID]bool
 }
 
 // PeerData aggregates protocol and application level info about a single peer.
 type PeerData struct {
 	// Network related data.
-	Address       ma.Multiaddr
-	Direction     network.Direction
-	ConnState     PeerConnectionState
-	Enr           *enr.Record
-	NextValidTime time.Time
+	Address        ma.Multiaddr
+	Direction      network.Direction
+	ConnState      PeerConnectionState
+	Enr            *enr.Record
+	NextValidTime  time.Time
+	ConnectedSince time.Time
 	// Chain related data.
 	MetaData                  metadata.Metadata
 	ChainState                *ethpb.Status
@@ -69,9 +72,11 @@ type PeerData struct {
 // NewStore creates new peer data store.
 func NewStore(ctx context.Context, config *StoreConfig) *Store {
 	return &Store{
-		ctx:    ctx,
-		config: config,
-		peers:  make(map[peer.ID]*PeerData),
+		ctx:     ctx,
+		config:  config,
+		peers:   make(map[peer.ID]*PeerData),
+		trusted: make(map[peer.ID]bool),
+		banned:  make(map[peer.ID]bool),
 	}
 }
 
@@ -111,6 +116,39 @@ func (s *Store) Peers() map[peer.ID]*PeerData {
 	return s.peers
 }
 
+// SetTrustedPeer marks or unmarks a given peer as trusted. Trust is kept apart from peer data,
+// so that it is not lost when a disconnected peer is pruned.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) SetTrustedPeer(pid peer.ID, trusted bool) {
+	if trusted {
+		s.trusted[pid] = true
+		return
+	}
+	delete(s.trusted, pid)
+}
+
+// IsTrustedPeer checks whether a given peer is trusted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) IsTrustedPeer(pid peer.ID) bool {
+	return s.trusted[pid]
+}
+
+// SetBannedPeer marks or unmarks a given peer as banned.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) SetBannedPeer(pid peer.ID, banned bool) {
+	if banned {
+		s.banned[pid] = true
+		return
+	}
+	delete(s.banned, pid)
+}
+
+// IsBannedPeer checks whether a given peer is banned.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) IsBannedPeer(pid peer.ID) bool {
+	return s.banned[pid]
+}
+
 // Config exposes store configuration params.
 func (s *Store) Config() *StoreConfig {
 	return s.config
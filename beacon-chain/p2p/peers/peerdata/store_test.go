@@ -80,3 +80,31 @@ func TestStore_PeerDataGetOrCreate(t *testing.T) {
 	assert.Equal(t, uint64(0), peerData.ProcessedBlocks)
 	require.Equal(t, 1, len(store.Peers()))
 }
+
+func TestStore_TrustedAndBannedPeers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := peerdata.NewStore(ctx, &peerdata.StoreConfig{
+		MaxPeers: 12,
+	})
+	pid := peer.ID("00001")
+	assert.Equal(t, false, store.IsTrustedPeer(pid))
+	assert.Equal(t, false, store.IsBannedPeer(pid))
+
+	store.SetTrustedPeer(pid, true)
+	store.SetBannedPeer(pid, true)
+	assert.Equal(t, true, store.IsTrustedPeer(pid))
+	assert.Equal(t, true, store.IsBannedPeer(pid))
+
+	// Trust and bans outlive the peer data.
+	store.PeerDataGetOrCreate(pid)
+	store.DeletePeerData(pid)
+	assert.Equal(t, true, store.IsTrustedPeer(pid))
+	assert.Equal(t, true, store.IsBannedPeer(pid))
+
+	store.SetTrustedPeer(pid, false)
+	store.SetBannedPeer(pid, false)
+	assert.Equal(t, false, store.IsTrustedPeer(pid))
+	assert.Equal(t, false, store.IsBannedPeer(pid))
+}
@@ -120,6 +120,10 @@ func (s *Service) ScoreNoLock(pid peer.ID) float64 {
 	score += s.scorers.blockProviderScorer.score(pid) * s.scorerWeight(s.scorers.blockProviderScorer)
 	score += s.scorers.peerStatusScorer.score(pid) * s.scorerWeight(s.scorers.peerStatusScorer)
 	score += s.scorers.gossipScorer.score(pid) * s.scorerWeight(s.scorers.gossipScorer)
+	// Trusted peers are never scored down.
+	if s.store.IsTrustedPeer(pid) && score < 0 {
+		score = 0
+	}
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
@@ -132,6 +136,12 @@ func (s *Service) IsBadPeer(pid peer.ID) bool {
 
 // IsBadPeerNoLock is a lock-free version of IsBadPeer.
 func (s *Service) IsBadPeerNoLock(pid peer.ID) bool {
+	if s.store.IsTrustedPeer(pid) {
+		return false
+	}
+	if s.store.IsBannedPeer(pid) {
+		return true
+	}
 	if s.scorers.badResponsesScorer.isBadPeer(pid) {
 		return true
 	}
@@ -42,6 +42,7 @@ import (
 	"github.com/prysmaticlabs/prysm/crypto/rand"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/metadata"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
 )
@@ -82,6 +83,8 @@ type Status struct {
 	store     *peerdata.Store
 	ipTracker map[string]uint64
 	rand      *rand.Rand
+	// minPeersPerSubnet is the amount of peers per subnet that are kept when pruning.
+	minPeersPerSubnet int
 }
 
 // StatusConfig represents peer status service params.
@@ -90,6 +93,8 @@ type StatusConfig struct {
 	PeerLimit int
 	// ScorerParams holds peer scorer configuration params.
 	ScorerParams *scorers.Config
+	// MinPeersPerSubnet specifies the amount of peers per attestation and sync subnet that are kept when pruning.
+	MinPeersPerSubnet int
 }
 
 // NewStatus creates a new status entity.
@@ -104,7 +109,8 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		ipTracker: map[string]uint64{},
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
-		rand: rand.NewDeterministicGenerator(),
+		rand:              rand.NewDeterministicGenerator(),
+		minPeersPerSubnet: config.MinPeersPerSubnet,
 	}
 }
 
@@ -297,9 +303,36 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionSt
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	if state == PeerConnected && peerData.ConnState != PeerConnected {
+		peerData.ConnectedSince = prysmTime.Now()
+	}
 	peerData.ConnState = state
 }
 
+// ConnectedSince gets the time at which the given remote peer was last connected.
+// This will error if the peer does not exist.
+func (p *Status) ConnectedSince(pid peer.ID) (time.Time, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return peerData.ConnectedSince, nil
+	}
+	return time.Time{}, peerdata.ErrPeerUnknown
+}
+
+// Subnets retrieves the attestation and sync committee subnets the peer advertises in its metadata.
+func (p *Status) Subnets(pid peer.ID) (attSubnets, syncSubnets []uint64, err error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		attSubnets, syncSubnets = subnetsFromMetadata(peerData.MetaData)
+		return attSubnets, syncSubnets, nil
+	}
+	return nil, nil, peerdata.ErrPeerUnknown
+}
+
 // ConnectionState gets the connection state of the given remote peer.
 // This will error if the peer does not exist.
 func (p *Status) ConnectionState(pid peer.ID) (peerdata.PeerConnectionState, error) {
@@ -334,9 +367,42 @@ func (p *Status) IsBad(pid peer.ID) bool {
 
 // isBad is the lock-free version of IsBad.
 func (p *Status) isBad(pid peer.ID) bool {
+	if p.store.IsTrustedPeer(pid) {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
 }
 
+// SetTrusted marks or unmarks a peer as trusted. Trusted peers are never considered bad,
+// scored below zero or selected for pruning.
+func (p *Status) SetTrusted(pid peer.ID, trusted bool) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.SetTrustedPeer(pid, trusted)
+}
+
+// IsTrusted states if the peer is trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.IsTrustedPeer(pid)
+}
+
+// SetBanned marks or unmarks a peer as banned. Banned peers are always considered bad,
+// unless they are also trusted.
+func (p *Status) SetBanned(pid peer.ID, banned bool) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.SetBannedPeer(pid, banned)
+}
+
+// IsBanned states if the peer is banned.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.IsBannedPeer(pid)
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -739,53 +805,82 @@ func (p *Status) BestNonFinalized(minPeers int, ourHeadEpoch types.Epoch) (types
 	return targetEpoch, potentialPIDs
 }
 
-// PeersToPrune selects the most sutiable inbound peers
-// to disconnect the host peer from. As of this moment
-// the pruning relies on simple heuristics such as
-// bad response count. In the future scoring will be used
-// to determine the most suitable peers to take out.
+// PeersToPrune selects the most suitable connected peers to disconnect the host
+// peer from when it is above its peer limits. Peers are ranked by their score,
+// then by the number of attestation and sync subnets they cover, then by how
+// recently they connected, so that the lowest scored, least useful and newest
+// peers go first. Inbound peers are pruned first while above the inbound limit,
+// and outbound peers are only pruned while above their share of the peer limit.
+// Peers that are among the last MinPeersPerSubnet peers of any of their subnets
+// are kept, as are trusted peers.
 func (p *Status) PeersToPrune() []peer.ID {
 	if !features.Get().EnablePeerScorer {
 		return p.deprecatedPeersToPrune()
 	}
-	connLimit := p.ConnectedPeerLimit()
+	connLimit := int(p.ConnectedPeerLimit())
 	inBoundLimit := p.InboundLimit()
 	activePeers := p.Active()
 	numInboundPeers := len(p.InboundConnected())
+	numOutboundPeers := len(p.OutboundConnected())
 	// Exit early if we are still below our max
 	// limit.
-	if len(activePeers) <= int(connLimit) {
+	if len(activePeers) <= connLimit {
 		return []peer.ID{}
 	}
-	p.store.Lock()
-	defer p.store.Unlock()
+	p.store.RLock()
+	defer p.store.RUnlock()
 
 	type peerResp struct {
-		pid   peer.ID
-		score float64
-	}
+		pid            peer.ID
+		score          float64
+		inbound        bool
+		connectedSince time.Time
+		attSubnets     []uint64
+		syncSubnets    []uint64
+	}
+	attSubnetPeers := make(map[uint64]int)
+	syncSubnetPeers := make(map[uint64]int)
 	peersToPrune := make([]*peerResp, 0)
-	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
-			peersToPrune = append(peersToPrune, &peerResp{
-				pid:   pid,
-				score: p.scorers.ScoreNoLock(pid),
-			})
+		if peerData.ConnState != PeerConnected {
+			continue
+		}
+		attSubnets, syncSubnets := subnetsFromMetadata(peerData.MetaData)
+		for _, idx := range attSubnets {
+			attSubnetPeers[idx]++
+		}
+		for _, idx := range syncSubnets {
+			syncSubnetPeers[idx]++
 		}
+		if p.store.IsTrustedPeer(pid) {
+			continue
+		}
+		peersToPrune = append(peersToPrune, &peerResp{
+			pid:            pid,
+			score:          p.scorers.ScoreNoLock(pid),
+			inbound:        peerData.Direction == network.DirInbound,
+			connectedSince: peerData.ConnectedSince,
+			attSubnets:     attSubnets,
+			syncSubnets:    syncSubnets,
+		})
 	}
 
-	// Sort in ascending order to favour pruning peers with a
-	// lower score.
 	sort.Slice(peersToPrune, func(i, j int) bool {
-		return peersToPrune[i].score < peersToPrune[j].score
+		a, b := peersToPrune[i], peersToPrune[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		aSubnets := len(a.attSubnets) + len(a.syncSubnets)
+		bSubnets := len(b.attSubnets) + len(b.syncSubnets)
+		if aSubnets != bSubnets {
+			return aSubnets < bSubnets
+		}
+		return a.connectedSince.After(b.connectedSince)
 	})
 
 	// Determine amount of peers to prune using our
 	// max connection limit.
-	amountToPrune := len(activePeers) - int(connLimit)
-
+	amountToPrune := len(activePeers) - connLimit
 	// Also check for inbound peers above our limit.
 	excessInbound := 0
 	if numInboundPeers > inBoundLimit {
@@ -796,12 +891,60 @@ func (p *Status) PeersToPrune() []peer.ID {
 	if excessInbound > amountToPrune {
 		amountToPrune = excessInbound
 	}
-	if amountToPrune < len(peersToPrune) {
-		peersToPrune = peersToPrune[:amountToPrune]
+	excessOutbound := numOutboundPeers - (connLimit - inBoundLimit)
+
+	// A peer is kept if removing it would leave one of its subnets with
+	// fewer than the minimum amount of peers.
+	neededForSubnet := func(pr *peerResp) bool {
+		for _, idx := range pr.attSubnets {
+			if attSubnetPeers[idx] <= p.minPeersPerSubnet {
+				return true
+			}
+		}
+		for _, idx := range pr.syncSubnets {
+			if syncSubnetPeers[idx] <= p.minPeersPerSubnet {
+				return true
+			}
+		}
+		return false
 	}
-	ids := make([]peer.ID, 0, len(peersToPrune))
-	for _, pr := range peersToPrune {
+	ids := make([]peer.ID, 0, amountToPrune)
+	pruned := make(map[peer.ID]bool)
+	prune := func(pr *peerResp) {
+		for _, idx := range pr.attSubnets {
+			attSubnetPeers[idx]--
+		}
+		for _, idx := range pr.syncSubnets {
+			syncSubnetPeers[idx]--
+		}
+		pruned[pr.pid] = true
 		ids = append(ids, pr.pid)
+		if pr.inbound {
+			excessInbound--
+		} else {
+			excessOutbound--
+		}
+	}
+	// Bring inbound peers back under their limit first.
+	for _, pr := range peersToPrune {
+		if excessInbound <= 0 || len(ids) >= amountToPrune {
+			break
+		}
+		if pr.inbound && !neededForSubnet(pr) {
+			prune(pr)
+		}
+	}
+	for _, pr := range peersToPrune {
+		if len(ids) >= amountToPrune {
+			break
+		}
+		if pruned[pr.pid] || neededForSubnet(pr) {
+			continue
+		}
+		if !pr.inbound && excessOutbound <= 0 {
+			continue
+		}
+		prune(pr)
 	}
 	return ids
 }
@@ -829,7 +972,8 @@ func (p *Status) deprecatedPeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound &&
+			!p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -970,6 +1114,25 @@ func sameIP(firstAddr, secondAddr ma.Multiaddr) bool {
 	return firstIP.Equal(secondIP)
 }
 
+// subnetsFromMetadata returns the attestation and sync subnets a peer advertises in its metadata.
+func subnetsFromMetadata(md metadata.Metadata) (attSubnets, syncSubnets []uint64) {
+	if md == nil || md.IsNil() {
+		return nil, nil
+	}
+	if md.AttnetsBitfield() != nil {
+		attSubnets = indicesFromBitfield(md.AttnetsBitfield())
+	}
+	if md.Version() == version.Altair && md.MetadataObjV1().Syncnets != nil {
+		syncNets := md.MetadataObjV1().Syncnets
+		for i := uint64(0); i < syncNets.Len(); i++ {
+			if syncNets.BitAt(i) {
+				syncSubnets = append(syncSubnets, i)
+			}
+		}
+	}
+	return attSubnets, syncSubnets
+}
+
 func indicesFromBitfield(bitV bitfield.Bitvector64) []uint64 {
 	committeeIdxs := make([]uint64, 0, bitV.Count())
 	for i := uint64(0); i < 64; i++ {
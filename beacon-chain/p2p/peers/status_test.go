@@ -755,6 +755,135 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestPrunePeers_SubnetAware(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnablePeerScorer: true,
+	})
+	defer resetCfg()
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:         30,
+		MinPeersPerSubnet: 2,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 10,
+			},
+		},
+	})
+	connected := peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED)
+	outbound := make([]peer.ID, 10)
+	for i := range outbound {
+		outbound[i] = createPeer(t, p, nil, network.DirOutbound, connected)
+	}
+	for i := 0; i < 20; i++ {
+		createPeer(t, p, nil, network.DirInbound, connected)
+	}
+	// Peers that are the only ones on their subnets, which would be pruned first by score.
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(5, true)
+	protected := make([]peer.ID, 0, 3)
+	for i := 0; i < 2; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, connected)
+		p.SetMetadata(pid, wrapper.WrappedMetadataV0(&pb.MetaDataV0{Attnets: attnets}))
+		protected = append(protected, pid)
+	}
+	pid := createPeer(t, p, nil, network.DirInbound, connected)
+	p.SetMetadata(pid, wrapper.WrappedMetadataV1(&pb.MetaDataV1{
+		Attnets:  bitfield.NewBitvector64(),
+		Syncnets: bitfield.Bitvector4{0x02},
+	}))
+	protected = append(protected, pid)
+	trusted := createPeer(t, p, nil, network.DirInbound, connected)
+	p.SetTrusted(trusted, true)
+	for _, pid := range append(protected, trusted, outbound[0], outbound[1]) {
+		p.Scorers().BadResponsesScorer().Increment(pid)
+	}
+	attSubnets, syncSubnets, err := p.Subnets(protected[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{5}, attSubnets)
+	assert.Equal(t, 0, len(syncSubnets))
+	_, syncSubnets, err = p.Subnets(protected[2])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1}, syncSubnets)
+
+	// 34 active peers, of which 24 inbound, are 4 above the limit.
+	peersToPrune := p.PeersToPrune()
+	require.Equal(t, 4, len(peersToPrune))
+	pruned := make(map[peer.ID]bool)
+	numInbound := 0
+	for _, pid := range peersToPrune {
+		pruned[pid] = true
+		dir, err := p.Direction(pid)
+		require.NoError(t, err)
+		if dir == network.DirInbound {
+			numInbound++
+		}
+	}
+	for _, pid := range append(protected, trusted) {
+		assert.Equal(t, false, pruned[pid], "Unexpected pruning of protected peer")
+	}
+	// The lowest scored outbound peers are pruned, after inbound peers are back under their limit.
+	assert.Equal(t, true, pruned[outbound[0]])
+	assert.Equal(t, true, pruned[outbound[1]])
+	assert.Equal(t, true, numInbound >= 1)
+}
+
+func TestStatus_TrustedAndBannedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	for i := 0; i < 15; i++ {
+		createPeer(t, p, nil, network.DirOutbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	var untrusted peer.ID
+	for i := 0; i < 18; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		if i == 0 {
+			untrusted = pid
+			continue
+		}
+		p.SetTrusted(pid, true)
+	}
+	// Trusted peers are never selected for pruning.
+	peersToPrune := p.PeersToPrune()
+	require.Equal(t, 3, len(peersToPrune))
+	prunedUntrusted := false
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, p.IsTrusted(pid), "Unexpected pruning of trusted peer")
+		prunedUntrusted = prunedUntrusted || pid == untrusted
+	}
+	assert.Equal(t, true, prunedUntrusted)
+
+	// Trusted peers are neither bad nor scored down.
+	trusted := p.InboundConnected()[0]
+	if trusted == untrusted {
+		trusted = p.InboundConnected()[1]
+	}
+	p.Scorers().BadResponsesScorer().Increment(trusted)
+	p.Scorers().BadResponsesScorer().Increment(untrusted)
+	assert.Equal(t, false, p.IsBad(trusted))
+	assert.Equal(t, true, p.IsBad(untrusted))
+	assert.Equal(t, 0.0, p.Scorers().Score(trusted))
+	assert.Equal(t, true, p.Scorers().Score(untrusted) < 0)
+
+	// Banned peers are bad, unless trusted.
+	banned := createPeer(t, p, nil, network.DirOutbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	assert.Equal(t, false, p.IsBad(banned))
+	p.SetBanned(banned, true)
+	assert.Equal(t, true, p.IsBanned(banned))
+	assert.Equal(t, true, p.IsBad(banned))
+	p.SetTrusted(banned, true)
+	assert.Equal(t, false, p.IsBad(banned))
+	p.SetTrusted(banned, false)
+	p.SetBanned(banned, false)
+	assert.Equal(t, false, p.IsTrusted(banned))
+	assert.Equal(t, false, p.IsBad(banned))
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
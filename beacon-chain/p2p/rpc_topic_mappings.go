@@ -37,6 +37,18 @@ const PingMessageName = "/ping"
 // MetadataMessageName specifies the name for the metadata message topic.
 const MetadataMessageName = "/metadata"
 
+// LightClientBootstrapMessageName specifies the name for the light client bootstrap message topic.
+const LightClientBootstrapMessageName = "/light_client_bootstrap"
+
+// LightClientUpdatesByRangeMessageName specifies the name for the light client updates by range message topic.
+const LightClientUpdatesByRangeMessageName = "/light_client_updates_by_range"
+
+// LightClientFinalityUpdateMessageName specifies the name for the light client finality update message topic.
+const LightClientFinalityUpdateMessageName = "/light_client_finality_update"
+
+// LightClientOptimisticUpdateMessageName specifies the name for the light client optimistic update message topic.
+const LightClientOptimisticUpdateMessageName = "/light_client_optimistic_update"
+
 const (
 	// V1 RPC Topics
 	// RPCStatusTopicV1 defines the v1 topic for the status rpc method.
@@ -51,6 +63,14 @@ const (
 	RPCPingTopicV1 = protocolPrefix + PingMessageName + SchemaVersionV1
 	// RPCMetaDataTopicV1 defines the v1 topic for the metadata rpc method.
 	RPCMetaDataTopicV1 = protocolPrefix + MetadataMessageName + SchemaVersionV1
+	// RPCLightClientBootstrapTopicV1 defines the v1 topic for the light client bootstrap rpc method.
+	RPCLightClientBootstrapTopicV1 = protocolPrefix + LightClientBootstrapMessageName + SchemaVersionV1
+	// RPCLightClientUpdatesByRangeTopicV1 defines the v1 topic for the light client updates by range rpc method.
+	RPCLightClientUpdatesByRangeTopicV1 = protocolPrefix + LightClientUpdatesByRangeMessageName + SchemaVersionV1
+	// RPCLightClientFinalityUpdateTopicV1 defines the v1 topic for the light client finality update rpc method.
+	RPCLightClientFinalityUpdateTopicV1 = protocolPrefix + LightClientFinalityUpdateMessageName + SchemaVersionV1
+	// RPCLightClientOptimisticUpdateTopicV1 defines the v1 topic for the light client optimistic update rpc method.
+	RPCLightClientOptimisticUpdateTopicV1 = protocolPrefix + LightClientOptimisticUpdateMessageName + SchemaVersionV1
 
 	// V2 RPC Topics
 	// RPCBlocksByRangeTopicV2 defines v2 the topic for the blocks by range rpc method.
@@ -83,6 +103,13 @@ var RPCTopicMappings = map[string]interface{}{
 	// RPC Metadata Message
 	RPCMetaDataTopicV1: new(interface{}),
 	RPCMetaDataTopicV2: new(interface{}),
+	// RPC Light Client Bootstrap Message
+	RPCLightClientBootstrapTopicV1: new(p2ptypes.LightClientBootstrapReq),
+	// RPC Light Client Updates By Range Message
+	RPCLightClientUpdatesByRangeTopicV1: new(pb.LightClientUpdatesByRangeRequest),
+	// RPC Light Client Finality and Optimistic Update Messages
+	RPCLightClientFinalityUpdateTopicV1:   new(interface{}),
+	RPCLightClientOptimisticUpdateTopicV1: new(interface{}),
 }
 
 // Maps all registered protocol prefixes.
@@ -93,12 +120,16 @@ var protocolMapping = map[string]bool{
 // Maps all the protocol message names for the different rpc
 // topics.
 var messageMapping = map[string]bool{
-	StatusMessageName:              true,
-	GoodbyeMessageName:             true,
-	BeaconBlocksByRangeMessageName: true,
-	BeaconBlocksByRootsMessageName: true,
-	PingMessageName:                true,
-	MetadataMessageName:            true,
+	StatusMessageName:                      true,
+	GoodbyeMessageName:                     true,
+	BeaconBlocksByRangeMessageName:         true,
+	BeaconBlocksByRootsMessageName:         true,
+	PingMessageName:                        true,
+	MetadataMessageName:                    true,
+	LightClientBootstrapMessageName:        true,
+	LightClientUpdatesByRangeMessageName:   true,
+	LightClientFinalityUpdateMessageName:   true,
+	LightClientOptimisticUpdateMessageName: true,
 }
 
 // Maps all the RPC messages which are to updated in altair.
@@ -108,6 +139,15 @@ var altairMapping = map[string]bool{
 	MetadataMessageName:            true,
 }
 
+// Maps all the RPC messages which respond with the context of the payload from
+// their first schema version.
+var contextMapping = map[string]bool{
+	LightClientBootstrapMessageName:        true,
+	LightClientUpdatesByRangeMessageName:   true,
+	LightClientFinalityUpdateMessageName:   true,
+	LightClientOptimisticUpdateMessageName: true,
+}
+
 var versionMapping = map[string]bool{
 	SchemaVersionV1: true,
 	SchemaVersionV2: true,
@@ -222,6 +262,12 @@ func (r RPCTopic) Version() string {
 	return version
 }
 
+// HasContext returns true if the rpc topic responds with context bytes regardless of
+// its schema version.
+func (r RPCTopic) HasContext() bool {
+	return contextMapping[r.MessageType()]
+}
+
 // TopicFromMessage constructs the rpc topic from the provided message
 // type and epoch.
 func TopicFromMessage(msg string, epoch types.Epoch) (string, error) {
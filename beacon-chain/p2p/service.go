@@ -6,6 +6,7 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"net"
 	"sync"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/metadata"
 	"github.com/prysmaticlabs/prysm/runtime"
@@ -84,6 +86,12 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	activeValidatorLock   sync.Mutex
+	gossipRates           *gossipRateTracker
+	trustedPeers          map[peer.ID]peer.AddrInfo
+	bannedPeers           map[peer.ID]bool
+	bannedIPRanges        []*net.IPNet
+	peerAdminLock         sync.RWMutex
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -101,6 +109,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		gossipRates:   newGossipRateTracker(),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -149,6 +158,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pubsub.WithValidateQueueSize(pubsubQueueSize),
 		pubsub.WithPeerScore(peerScoringParams()),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
+		pubsub.WithRawTracer(s.gossipRates),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
 	}
 	// Set the pubsub global parameters that we require.
@@ -165,7 +175,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	s.pubsub = gs
 
 	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
-		PeerLimit: int(s.cfg.MaxPeers),
+		PeerLimit:         int(s.cfg.MaxPeers),
+		MinPeersPerSubnet: flags.Get().MinimumPeersPerSubnet,
 		ScorerParams: &scorers.Config{
 			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
 				Threshold:     maxBadResponses,
@@ -173,6 +184,10 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			},
 		},
 	})
+	if err := s.loadPeerStore(); err != nil {
+		log.WithError(err).Error("Failed to load peer store")
+		return nil, err
+	}
 
 	// Initialize Data maps.
 	types.InitializeDataMaps()
@@ -242,6 +257,7 @@ func (s *Service) Start() {
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
+	async.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, s.ensureTrustedPeerConnections)
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, func() {
@@ -271,6 +287,7 @@ func (s *Service) Start() {
 		logExternalDNSAddr(s.host.ID(), p2pHostDNS, p2pTCPPort)
 	}
 	go s.forkWatcher()
+	go s.gossipScoringWatcher()
 }
 
 // Stop the p2p service and terminate all peer connections.
@@ -485,7 +502,7 @@ func (s *Service) connectToBootnodes() error {
 		}
 		nodes = append(nodes, bootNode)
 	}
-	multiAddresses := convertToMultiAddr(nodes)
+	multiAddresses := convertToMultiAddr(nodes, s.cfg.QUICPort != 0)
 	s.connectWithAllPeers(multiAddresses)
 	return nil
 }
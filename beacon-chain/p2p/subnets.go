@@ -70,7 +70,7 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 		}
 		nodes := enode.ReadNodes(iterator, int(params.BeaconNetworkConfig().MinimumPeersInSubnetSearch))
 		for _, node := range nodes {
-			info, _, err := convertToAddrInfo(node)
+			info, _, err := convertToAddrInfo(node, s.cfg.QUICPort != 0)
 			if err != nil {
 				continue
 			}
@@ -0,0 +1,364 @@
+package testing
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// scoreInspectPeriod is how often the simulated nodes report the scores of their peers.
+const scoreInspectPeriod = 100 * time.Millisecond
+
+// pubsubQueueSize is the size of the validation and outbound message queues of the simulated nodes.
+// It matches the beacon node's, so that messages replayed in bursts are not dropped.
+const pubsubQueueSize = 600
+
+// simulationTimeout bounds how long the simulation waits for the nodes to reach a state.
+const simulationTimeout = 10 * time.Second
+
+// GossipSimConfig configures a gossip simulation.
+type GossipSimConfig struct {
+	// Nodes is the number of nodes in the simulation.
+	Nodes int
+	// GossipParams are the gossipsub parameters of every node, or the libp2p defaults if nil.
+	GossipParams *pubsub.GossipSubParams
+	// ScoreParams and Thresholds are the peer score parameters of every node. Note that all nodes
+	// share the loopback address, which counts towards the IP colocation factor.
+	ScoreParams *pubsub.PeerScoreParams
+	Thresholds  *pubsub.PeerScoreThresholds
+	// TopicParams are the topics every node subscribes to, with their score parameters.
+	TopicParams map[string]*pubsub.TopicScoreParams
+	// Validator validates the messages a node receives from its peers. Messages published by
+	// a node itself are always accepted by it, so any node can publish invalid messages.
+	Validator func(topic string, data []byte) bool
+}
+
+// GossipMessage is a message published by a node of a gossip simulation.
+type GossipMessage struct {
+	From  int
+	Topic string
+	Data  []byte
+}
+
+// GossipSimulation is an in-process network of gossipsub nodes scoring each other, used to
+// replay message patterns and check the scores the nodes end up giving each other.
+type GossipSimulation struct {
+	t          *testing.T
+	thresholds *pubsub.PeerScoreThresholds
+	nodes      []*gossipSimNode
+}
+
+type gossipSimNode struct {
+	host        host.Host
+	pubsub      *pubsub.PubSub
+	topics      map[string]*pubsub.Topic
+	lock        sync.RWMutex
+	mesh        map[string]map[peer.ID]bool
+	scores      map[peer.ID]float64
+	inspections uint64
+	validating  int
+	lastMessage time.Time
+}
+
+// NewGossipSimulation creates the nodes of a gossip simulation, connects every node to all others
+// and subscribes them to the configured topics. It returns once the mesh of every node is formed
+// on every topic. Nodes publish their messages to all their peers rather than to their mesh, so
+// that a misbehaving node keeps reaching the peers which pruned it, as it would on the network.
+func NewGossipSimulation(t *testing.T, cfg *GossipSimConfig) *GossipSimulation {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	gossipParams := pubsub.DefaultGossipSubParams()
+	if cfg.GossipParams != nil {
+		gossipParams = *cfg.GossipParams
+	}
+	sim := &GossipSimulation{
+		t:          t,
+		thresholds: cfg.Thresholds,
+		nodes:      make([]*gossipSimNode, cfg.Nodes),
+	}
+	for i := range sim.nodes {
+		h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+		if err != nil {
+			t.Fatalf("Failed to create host: %v", err)
+		}
+		t.Cleanup(func() {
+			if err := h.Close(); err != nil {
+				t.Log(err)
+			}
+		})
+		node := &gossipSimNode{
+			host:   h,
+			topics: make(map[string]*pubsub.Topic),
+			mesh:   make(map[string]map[peer.ID]bool),
+			scores: make(map[peer.ID]float64),
+		}
+		// Every router keeps its topic parameters in the score parameters, so each needs its own copy.
+		scoreParams := *cfg.ScoreParams
+		scoreParams.Topics = make(map[string]*pubsub.TopicScoreParams)
+		ps, err := pubsub.NewGossipSub(ctx, h,
+			pubsub.WithGossipSubParams(gossipParams),
+			pubsub.WithFloodPublish(true),
+			pubsub.WithPeerScore(&scoreParams, cfg.Thresholds),
+			pubsub.WithPeerScoreInspect(node.inspect, scoreInspectPeriod),
+			pubsub.WithPeerOutboundQueueSize(pubsubQueueSize),
+			pubsub.WithValidateQueueSize(pubsubQueueSize),
+			pubsub.WithRawTracer(node),
+		)
+		if err != nil {
+			t.Fatalf("Failed to create gossipsub: %v", err)
+		}
+		node.pubsub = ps
+		sim.nodes[i] = node
+	}
+	for _, node := range sim.nodes {
+		for topic, params := range cfg.TopicParams {
+			node.subscribe(ctx, t, topic, params, cfg.Validator)
+		}
+	}
+	for i, a := range sim.nodes {
+		for _, b := range sim.nodes[i+1:] {
+			if err := connect(a.host, b.host); err != nil {
+				t.Fatalf("Failed to connect simulated nodes: %v", err)
+			}
+		}
+	}
+
+	meshSize := len(sim.nodes) - 1
+	if meshSize > gossipParams.Dlo {
+		meshSize = gossipParams.Dlo
+	}
+	deadline := time.Now().Add(simulationTimeout)
+	for topic := range cfg.TopicParams {
+		for _, node := range sim.nodes {
+			for node.meshSize(topic) < meshSize {
+				if time.Now().After(deadline) {
+					t.Fatalf("Simulated nodes did not form a mesh on topic %s", topic)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+	}
+	return sim
+}
+
+func (n *gossipSimNode) subscribe(
+	ctx context.Context,
+	t *testing.T,
+	topic string,
+	params *pubsub.TopicScoreParams,
+	validator func(topic string, data []byte) bool,
+) {
+	if validator != nil {
+		err := n.pubsub.RegisterTopicValidator(topic, func(_ context.Context, pid peer.ID, msg *pubsub.Message) bool {
+			return pid == n.host.ID() || validator(topic, msg.Data)
+		})
+		if err != nil {
+			t.Fatalf("Failed to register topic validator: %v", err)
+		}
+	}
+	topicHandle, err := n.pubsub.Join(topic)
+	if err != nil {
+		t.Fatalf("Failed to join topic: %v", err)
+	}
+	if err := topicHandle.SetScoreParams(params); err != nil {
+		t.Fatalf("Failed to set topic score parameters: %v", err)
+	}
+	sub, err := topicHandle.Subscribe()
+	if err != nil {
+		t.Fatalf("Failed to subscribe to topic: %v", err)
+	}
+	n.topics[topic] = topicHandle
+	// Drain the subscription, so that deliveries are never dropped.
+	go func() {
+		for {
+			if _, err := sub.Next(ctx); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+func (n *gossipSimNode) inspect(scores map[peer.ID]float64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.scores = scores
+	n.inspections++
+}
+
+// settled returns whether the node has no message in validation and has not seen any message
+// for a few score inspection periods, which leaves time for messages in flight to arrive.
+func (n *gossipSimNode) settled() bool {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.validating <= 0 && time.Since(n.lastMessage) > 2*scoreInspectPeriod
+}
+
+func (n *gossipSimNode) meshSize(topic string) int {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return len(n.mesh[topic])
+}
+
+// Publish publishes a message from the node at index from.
+func (s *GossipSimulation) Publish(from int, topic string, data []byte) {
+	if err := s.nodes[from].topics[topic].Publish(context.Background(), data); err != nil {
+		s.t.Fatalf("Failed to publish message: %v", err)
+	}
+}
+
+// Replay publishes the messages in order, pausing for the interval after each of them.
+func (s *GossipSimulation) Replay(msgs []GossipMessage, interval time.Duration) {
+	for _, msg := range msgs {
+		s.Publish(msg.From, msg.Topic, msg.Data)
+		time.Sleep(interval)
+	}
+}
+
+// AwaitScores waits until every node has processed all the messages it received and has then
+// reported the scores of its peers at least twice more, so that the scores reflect all messages
+// published before the call.
+func (s *GossipSimulation) AwaitScores() {
+	deadline := time.Now().Add(simulationTimeout)
+	for _, node := range s.nodes {
+		for !node.settled() {
+			if time.Now().After(deadline) {
+				s.t.Fatal("Simulated nodes did not process their messages")
+			}
+			time.Sleep(scoreInspectPeriod / 4)
+		}
+	}
+	start := make([]uint64, len(s.nodes))
+	for i, node := range s.nodes {
+		node.lock.RLock()
+		start[i] = node.inspections
+		node.lock.RUnlock()
+	}
+	for i, node := range s.nodes {
+		for {
+			node.lock.RLock()
+			inspections := node.inspections
+			node.lock.RUnlock()
+			if inspections >= start[i]+2 {
+				break
+			}
+			if time.Now().After(deadline) {
+				s.t.Fatal("Simulated nodes did not report peer scores")
+			}
+			time.Sleep(scoreInspectPeriod / 4)
+		}
+	}
+}
+
+// Score returns the last reported score given by the node at index observer to the node at
+// index target, and whether the observer has scored it at all.
+func (s *GossipSimulation) Score(observer, target int) (float64, bool) {
+	node := s.nodes[observer]
+	node.lock.RLock()
+	defer node.lock.RUnlock()
+	score, ok := node.scores[s.nodes[target].host.ID()]
+	return score, ok
+}
+
+// Graylisted returns whether the node at index observer graylists the node at index target,
+// ignoring all of its messages.
+func (s *GossipSimulation) Graylisted(observer, target int) bool {
+	score, ok := s.Score(observer, target)
+	return ok && score < s.thresholds.GraylistThreshold
+}
+
+// PeerID returns the peer ID of the node at the given index.
+func (s *GossipSimulation) PeerID(i int) peer.ID {
+	return s.nodes[i].host.ID()
+}
+
+// Graft tracks the mesh of the node.
+func (n *gossipSimNode) Graft(p peer.ID, topic string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.mesh[topic] == nil {
+		n.mesh[topic] = make(map[peer.ID]bool)
+	}
+	n.mesh[topic][p] = true
+}
+
+// Prune tracks the mesh of the node.
+func (n *gossipSimNode) Prune(p peer.ID, topic string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	delete(n.mesh[topic], p)
+}
+
+// RemovePeer tracks the mesh of the node.
+func (n *gossipSimNode) RemovePeer(p peer.ID) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for _, peers := range n.mesh {
+		delete(peers, p)
+	}
+}
+
+// AddPeer is a no-op.
+func (_ *gossipSimNode) AddPeer(_ peer.ID, _ protocol.ID) {}
+
+// Join is a no-op.
+func (_ *gossipSimNode) Join(_ string) {}
+
+// Leave is a no-op.
+func (_ *gossipSimNode) Leave(_ string) {}
+
+// ValidateMessage tracks the messages in validation.
+func (n *gossipSimNode) ValidateMessage(_ *pubsub.Message) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.validating++
+	n.lastMessage = time.Now()
+}
+
+// DeliverMessage tracks the messages in validation.
+func (n *gossipSimNode) DeliverMessage(_ *pubsub.Message) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.validating--
+	n.lastMessage = time.Now()
+}
+
+// RejectMessage tracks the messages in validation. Only messages rejected by validators were
+// counted by ValidateMessage.
+func (n *gossipSimNode) RejectMessage(_ *pubsub.Message, reason string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	switch reason {
+	case pubsub.RejectValidationFailed, pubsub.RejectValidationIgnored, pubsub.RejectValidationThrottled:
+		n.validating--
+	}
+	n.lastMessage = time.Now()
+}
+
+// DuplicateMessage tracks the time of the last message.
+func (n *gossipSimNode) DuplicateMessage(_ *pubsub.Message) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.lastMessage = time.Now()
+}
+
+// ThrottlePeer is a no-op.
+func (_ *gossipSimNode) ThrottlePeer(_ peer.ID) {}
+
+// RecvRPC is a no-op.
+func (_ *gossipSimNode) RecvRPC(_ *pubsub.RPC) {}
+
+// SendRPC is a no-op.
+func (_ *gossipSimNode) SendRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// DropRPC is a no-op.
+func (_ *gossipSimNode) DropRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// UndeliverableMessage is a no-op.
+func (_ *gossipSimNode) UndeliverableMessage(_ *pubsub.Message) {}
@@ -0,0 +1,86 @@
+package testing
+
+import (
+	"errors"
+	"net"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// MockPeerAdmin is mock of the PeerAdmin interface.
+type MockPeerAdmin struct {
+	Trusted []peer.AddrInfo
+	Banned  []peer.ID
+	Ranges  []*net.IPNet
+}
+
+// AddTrustedPeer .
+func (m *MockPeerAdmin) AddTrustedPeer(addr multiaddr.Multiaddr) error {
+	info, err := peer.AddrInfoFromP2pAddr(addr)
+	if err != nil {
+		return err
+	}
+	m.Trusted = append(m.Trusted, *info)
+	return nil
+}
+
+// RemoveTrustedPeer .
+func (m *MockPeerAdmin) RemoveTrustedPeer(pid peer.ID) error {
+	for i, info := range m.Trusted {
+		if info.ID == pid {
+			m.Trusted = append(m.Trusted[:i], m.Trusted[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("unknown peer")
+}
+
+// TrustedPeers .
+func (m *MockPeerAdmin) TrustedPeers() []peer.AddrInfo {
+	return m.Trusted
+}
+
+// BanPeer .
+func (m *MockPeerAdmin) BanPeer(pid peer.ID) error {
+	m.Banned = append(m.Banned, pid)
+	return nil
+}
+
+// UnbanPeer .
+func (m *MockPeerAdmin) UnbanPeer(pid peer.ID) error {
+	for i, banned := range m.Banned {
+		if banned == pid {
+			m.Banned = append(m.Banned[:i], m.Banned[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("unknown peer")
+}
+
+// BannedPeers .
+func (m *MockPeerAdmin) BannedPeers() []peer.ID {
+	return m.Banned
+}
+
+// BanIPRange .
+func (m *MockPeerAdmin) BanIPRange(ipNet *net.IPNet) error {
+	m.Ranges = append(m.Ranges, ipNet)
+	return nil
+}
+
+// UnbanIPRange .
+func (m *MockPeerAdmin) UnbanIPRange(ipNet *net.IPNet) error {
+	for i, r := range m.Ranges {
+		if r.String() == ipNet.String() {
+			m.Ranges = append(m.Ranges[:i], m.Ranges[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("unknown IP range")
+}
+
+// BannedIPRanges .
+func (m *MockPeerAdmin) BannedIPRanges() []*net.IPNet {
+	return m.Ranges
+}
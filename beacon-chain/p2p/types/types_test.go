@@ -41,6 +41,7 @@ func TestErrorResponse_Limit(t *testing.T) {
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestBlocksByRootReq(t)
 	roundTripTestErrorMessage(t)
+	roundTripTestLightClientBootstrapReq(t)
 }
 
 func roundTripTestBlocksByRootReq(t *testing.T) {
@@ -71,6 +72,19 @@ func roundTripTestErrorMessage(t *testing.T) {
 	assert.DeepEqual(t, []byte(newVal), errMsg)
 }
 
+func roundTripTestLightClientBootstrapReq(t *testing.T) {
+	req := LightClientBootstrapReq{'a', 'b', 'c'}
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, rootLength, len(marshalledObj))
+	newVal := LightClientBootstrapReq{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepEqual(t, req, newVal)
+	require.ErrorContains(t, "incorrect byte size", newVal.UnmarshalSSZ(marshalledObj[1:]))
+}
+
 func TestSSZBytes_HashTreeRoot(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -6,6 +6,7 @@ package v1
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -13,10 +14,16 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
 const (
@@ -56,6 +63,19 @@ type Caller interface {
 	) error
 	LatestExecutionBlock(ctx context.Context) (*pb.ExecutionBlock, error)
 	ExecutionBlockByHash(ctx context.Context, hash common.Hash) (*pb.ExecutionBlock, error)
+	ExecutionBlockByHashWithTxs(ctx context.Context, hash common.Hash) (*pb.ExecutionBlock, error)
+	ExecutionPayloadReconstructor
+}
+
+// ExecutionPayloadReconstructor rebuilds full beacon blocks from blocks which were stored
+// with only their execution payload header.
+type ExecutionPayloadReconstructor interface {
+	ReconstructFullBellatrixBlock(
+		ctx context.Context, blindedBlock block.SignedBeaconBlock,
+	) (block.SignedBeaconBlock, error)
+	ReconstructFullBellatrixBlockBatch(
+		ctx context.Context, blks []block.SignedBeaconBlock,
+	) ([]block.SignedBeaconBlock, error)
 }
 
 // Client defines a new engine API client for the Prysm consensus node
@@ -213,6 +233,192 @@ func (c *Client) ExecutionBlockByHash(ctx context.Context, hash common.Hash) (*p
 	return result, handleRPCError(err)
 }
 
+// ExecutionBlockByHashWithTxs fetches an execution engine block by hash, including the
+// full transaction objects, by calling eth_getBlockByHash via JSON-RPC. The transactions of
+// the returned block are in their binary encoding, as they appear in an execution payload.
+func (c *Client) ExecutionBlockByHashWithTxs(ctx context.Context, hash common.Hash) (*pb.ExecutionBlock, error) {
+	var result json.RawMessage
+	err := c.rpc.CallContext(ctx, &result, ExecutionBlockByHashMethod, hash, true /* full transaction objects */)
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
+	return decodeExecutionBlockWithTxs(result)
+}
+
+// ReconstructFullBellatrixBlock rebuilds a full Bellatrix beacon block from a blinded one, by
+// fetching the transactions of its execution payload from the execution node.
+func (c *Client) ReconstructFullBellatrixBlock(
+	ctx context.Context, blindedBlock block.SignedBeaconBlock,
+) (block.SignedBeaconBlock, error) {
+	if blindedBlock.Version() != version.BellatrixBlind {
+		return nil, errors.Errorf("can only reconstruct a full block from a blinded block, got %s", version.String(blindedBlock.Version()))
+	}
+	blks, err := c.ReconstructFullBellatrixBlockBatch(ctx, []block.SignedBeaconBlock{blindedBlock})
+	if err != nil {
+		return nil, err
+	}
+	return blks[0], nil
+}
+
+// ReconstructFullBellatrixBlockBatch rebuilds the full Bellatrix beacon blocks of the blinded blocks
+// in the list, by fetching the transactions of their execution payloads from the execution node in a
+// single batch request. Blocks which are not blinded are returned as is.
+func (c *Client) ReconstructFullBellatrixBlockBatch(
+	ctx context.Context, blks []block.SignedBeaconBlock,
+) ([]block.SignedBeaconBlock, error) {
+	blinded := make([]*ethpb.SignedBlindedBeaconBlockBellatrix, len(blks))
+	txs := make([][][]byte, len(blks))
+	var elems []rpc.BatchElem
+	var elemIndices []int
+	for i, b := range blks {
+		if b == nil || b.IsNil() || b.Version() != version.BellatrixBlind {
+			continue
+		}
+		blk, err := b.PbBlindedBellatrixBlock()
+		if err != nil {
+			return nil, err
+		}
+		blinded[i] = blk
+		header := blk.Block.Body.ExecutionPayloadHeader
+		// Blocks from before the merge carry an empty payload, which has no transactions.
+		if bytes.Equal(header.BlockHash, make([]byte, len(header.BlockHash))) {
+			txs[i] = make([][]byte, 0)
+			continue
+		}
+		elems = append(elems, rpc.BatchElem{
+			Method: ExecutionBlockByHashMethod,
+			Args:   []interface{}{common.BytesToHash(header.BlockHash), true /* full transaction objects */},
+			Result: &json.RawMessage{},
+		})
+		elemIndices = append(elemIndices, i)
+	}
+	if len(elems) > 0 {
+		if err := c.rpc.BatchCallContext(ctx, elems); err != nil {
+			return nil, handleRPCError(err)
+		}
+	}
+	for j, elem := range elems {
+		header := blinded[elemIndices[j]].Block.Body.ExecutionPayloadHeader
+		if elem.Error != nil {
+			return nil, errors.Wrapf(handleRPCError(elem.Error), "could not fetch execution block %#x", header.BlockHash)
+		}
+		result, ok := elem.Result.(*json.RawMessage)
+		if !ok {
+			return nil, errors.Errorf("unexpected result type %T", elem.Result)
+		}
+		executionBlock, err := decodeExecutionBlockWithTxs(*result)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch execution block %#x", header.BlockHash)
+		}
+		if !bytes.Equal(executionBlock.Hash, header.BlockHash) {
+			return nil, errors.Errorf("execution block hash %#x does not match payload header block hash %#x", executionBlock.Hash, header.BlockHash)
+		}
+		txs[elemIndices[j]] = executionBlock.Transactions
+	}
+
+	full := make([]block.SignedBeaconBlock, len(blks))
+	for i, blk := range blinded {
+		if blk == nil {
+			full[i] = blks[i]
+			continue
+		}
+		b, err := fullBellatrixBlock(blk, txs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not reconstruct full block at slot %d", blk.Block.Slot)
+		}
+		full[i] = b
+	}
+	return full, nil
+}
+
+// fullBellatrixBlock rebuilds a full Bellatrix beacon block from a blinded one and the transactions
+// of its execution payload.
+func fullBellatrixBlock(blk *ethpb.SignedBlindedBeaconBlockBellatrix, txs [][]byte) (block.SignedBeaconBlock, error) {
+	header := blk.Block.Body.ExecutionPayloadHeader
+	txRoot, err := ssz.TransactionsRoot(txs)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(txRoot[:], header.TransactionsRoot) {
+		return nil, errors.Errorf("transactions root %#x does not match payload header transactions root %#x", txRoot, header.TransactionsRoot)
+	}
+	body := blk.Block.Body
+	return wrapper.WrappedBellatrixSignedBeaconBlock(&ethpb.SignedBeaconBlockBellatrix{
+		Block: &ethpb.BeaconBlockBellatrix{
+			Slot:          blk.Block.Slot,
+			ProposerIndex: blk.Block.ProposerIndex,
+			ParentRoot:    blk.Block.ParentRoot,
+			StateRoot:     blk.Block.StateRoot,
+			Body: &ethpb.BeaconBlockBodyBellatrix{
+				RandaoReveal:      body.RandaoReveal,
+				Eth1Data:          body.Eth1Data,
+				Graffiti:          body.Graffiti,
+				ProposerSlashings: body.ProposerSlashings,
+				AttesterSlashings: body.AttesterSlashings,
+				Attestations:      body.Attestations,
+				Deposits:          body.Deposits,
+				VoluntaryExits:    body.VoluntaryExits,
+				SyncAggregate:     body.SyncAggregate,
+				ExecutionPayload: &pb.ExecutionPayload{
+					ParentHash:    header.ParentHash,
+					FeeRecipient:  header.FeeRecipient,
+					StateRoot:     header.StateRoot,
+					ReceiptsRoot:  header.ReceiptRoot,
+					LogsBloom:     header.LogsBloom,
+					PrevRandao:    header.PrevRandao,
+					BlockNumber:   header.BlockNumber,
+					GasLimit:      header.GasLimit,
+					GasUsed:       header.GasUsed,
+					Timestamp:     header.Timestamp,
+					ExtraData:     header.ExtraData,
+					BaseFeePerGas: header.BaseFeePerGas,
+					BlockHash:     header.BlockHash,
+					Transactions:  txs,
+				},
+			},
+		},
+		Signature: blk.Signature,
+	})
+}
+
+// Decodes an execution block received with full transaction objects, replacing the
+// transaction objects with their binary encoding.
+func decodeExecutionBlockWithTxs(enc []byte) (*pb.ExecutionBlock, error) {
+	if len(enc) == 0 {
+		return nil, ErrNilResponse
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, ErrNilResponse
+	}
+	var txs []*gethTypes.Transaction
+	if txsEnc, ok := fields["transactions"]; ok {
+		if err := json.Unmarshal(txsEnc, &txs); err != nil {
+			return nil, errors.Wrap(err, "could not decode transactions")
+		}
+		delete(fields, "transactions")
+	}
+	headerEnc, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	blk := &pb.ExecutionBlock{}
+	if err := blk.UnmarshalJSON(headerEnc); err != nil {
+		return nil, err
+	}
+	blk.Transactions = make([][]byte, len(txs))
+	for i, tx := range txs {
+		blk.Transactions[i], err = tx.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encode transaction %d", i)
+		}
+	}
+	return blk, nil
+}
+
 // Handles errors received from the RPC server according to the specification.
 func handleRPCError(err error) error {
 	if err == nil {
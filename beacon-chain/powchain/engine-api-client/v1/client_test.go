@@ -12,15 +12,23 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1/mocks"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/encoding/ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -519,6 +527,213 @@ func (c *dataError) ErrorData() interface{} {
 	return c.data
 }
 
+func TestClient_ExecutionBlockByHashWithTxs(t *testing.T) {
+	ctx := context.Background()
+	header, ok := fixtures()["ExecutionBlock"].(*pb.ExecutionBlock)
+	require.Equal(t, true, ok)
+	tx := testTransaction(1)
+	wantTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	client := &Client{rpc: newBlockWithTxsServer(t, []*pb.ExecutionBlock{header}, [][]*gethTypes.Transaction{{tx}})}
+	resp, err := client.ExecutionBlockByHashWithTxs(ctx, common.BytesToHash(header.Hash))
+	require.NoError(t, err)
+	require.DeepEqual(t, [][]byte{wantTx}, resp.Transactions)
+	resp.Transactions = header.Transactions
+	require.DeepEqual(t, header, resp)
+
+	client = &Client{rpc: newBlockWithTxsServer(t, nil, nil)}
+	_, err = client.ExecutionBlockByHashWithTxs(ctx, common.BytesToHash(header.Hash))
+	require.ErrorIs(t, err, ErrNilResponse)
+}
+
+func TestClient_ReconstructFullBellatrixBlock(t *testing.T) {
+	ctx := context.Background()
+	txs := make([][]byte, 2)
+	for i := range txs {
+		enc, err := testTransaction(uint64(i)).MarshalBinary()
+		require.NoError(t, err)
+		txs[i] = enc
+	}
+	txRoot, err := ssz.TransactionsRoot(txs)
+	require.NoError(t, err)
+	blockHash := bytesutil.PadTo([]byte("hash"), fieldparams.RootLength)
+	executionBlock := &pb.ExecutionBlock{
+		Number:        []byte{1},
+		Hash:          blockHash,
+		Difficulty:    []byte{1},
+		Size:          []byte{1},
+		BaseFeePerGas: []byte{1},
+	}
+
+	t.Run("not a blinded block", func(t *testing.T) {
+		blk, err := wrapper.WrappedBellatrixSignedBeaconBlock(util.NewBeaconBlockBellatrix())
+		require.NoError(t, err)
+		_, err = (&Client{}).ReconstructFullBellatrixBlock(ctx, blk)
+		require.ErrorContains(t, "can only reconstruct a full block from a blinded block", err)
+	})
+	t.Run("pre-merge block", func(t *testing.T) {
+		full := util.NewBeaconBlockBellatrix()
+		header, err := blocks.PayloadToHeader(full.Block.Body.ExecutionPayload)
+		require.NoError(t, err)
+		blinded := util.NewBlindedBeaconBlockBellatrix()
+		blinded.Block.Body.ExecutionPayloadHeader = header
+		blindedBlk, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(blinded)
+		require.NoError(t, err)
+
+		// The execution node is not called for blocks without a payload.
+		got, err := (&Client{}).ReconstructFullBellatrixBlock(ctx, blindedBlk)
+		require.NoError(t, err)
+		gotRoot, err := got.Block().HashTreeRoot()
+		require.NoError(t, err)
+		wantRoot, err := full.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, wantRoot, gotRoot)
+	})
+	t.Run("post-merge block", func(t *testing.T) {
+		blinded := util.NewBlindedBeaconBlockBellatrix()
+		blinded.Block.Slot = 5
+		blinded.Block.Body.ExecutionPayloadHeader.BlockHash = blockHash
+		blinded.Block.Body.ExecutionPayloadHeader.BlockNumber = 1
+		blinded.Block.Body.ExecutionPayloadHeader.TransactionsRoot = txRoot[:]
+		blindedBlk, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(blinded)
+		require.NoError(t, err)
+		gethTxs := []*gethTypes.Transaction{testTransaction(0), testTransaction(1)}
+		client := &Client{rpc: newBlockWithTxsServer(t, []*pb.ExecutionBlock{executionBlock}, [][]*gethTypes.Transaction{gethTxs})}
+
+		got, err := client.ReconstructFullBellatrixBlock(ctx, blindedBlk)
+		require.NoError(t, err)
+		require.Equal(t, version.Bellatrix, got.Version())
+		payload, err := got.Block().Body().ExecutionPayload()
+		require.NoError(t, err)
+		require.DeepEqual(t, txs, payload.Transactions)
+		require.Equal(t, uint64(1), payload.BlockNumber)
+		gotRoot, err := got.Block().HashTreeRoot()
+		require.NoError(t, err)
+		wantRoot, err := blinded.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, wantRoot, gotRoot)
+		require.DeepEqual(t, blinded.Signature, got.Signature())
+
+		// Transactions which do not match the payload header are rejected.
+		client = &Client{rpc: newBlockWithTxsServer(t, []*pb.ExecutionBlock{executionBlock}, [][]*gethTypes.Transaction{gethTxs[:1]})}
+		_, err = client.ReconstructFullBellatrixBlock(ctx, blindedBlk)
+		require.ErrorContains(t, "does not match payload header transactions root", err)
+	})
+}
+
+func TestClient_ReconstructFullBellatrixBlockBatch(t *testing.T) {
+	ctx := context.Background()
+	executionBlocks := make([]*pb.ExecutionBlock, 2)
+	gethTxs := make([][]*gethTypes.Transaction, 2)
+	var blks []block.SignedBeaconBlock
+	for i := range executionBlocks {
+		gethTxs[i] = []*gethTypes.Transaction{testTransaction(uint64(i))}
+		enc, err := gethTxs[i][0].MarshalBinary()
+		require.NoError(t, err)
+		txRoot, err := ssz.TransactionsRoot([][]byte{enc})
+		require.NoError(t, err)
+		blockHash := bytesutil.PadTo([]byte{byte(i + 1)}, fieldparams.RootLength)
+		executionBlocks[i] = &pb.ExecutionBlock{
+			Number:        []byte{1},
+			Hash:          blockHash,
+			Difficulty:    []byte{1},
+			Size:          []byte{1},
+			BaseFeePerGas: []byte{1},
+		}
+		blinded := util.NewBlindedBeaconBlockBellatrix()
+		blinded.Block.Slot = types.Slot(i)
+		blinded.Block.Body.ExecutionPayloadHeader.BlockHash = blockHash
+		blinded.Block.Body.ExecutionPayloadHeader.TransactionsRoot = txRoot[:]
+		blindedBlk, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(blinded)
+		require.NoError(t, err)
+		blks = append(blks, blindedBlk)
+	}
+	// Blocks which are not blinded are returned as is.
+	altairBlk, err := wrapper.WrappedAltairSignedBeaconBlock(util.NewBeaconBlockAltair())
+	require.NoError(t, err)
+	blks = append(blks, altairBlk)
+
+	rpcClient, requests := newCountingBlockWithTxsServer(t, executionBlocks, gethTxs)
+	client := &Client{rpc: rpcClient}
+	got, err := client.ReconstructFullBellatrixBlockBatch(ctx, blks)
+	require.NoError(t, err)
+	require.Equal(t, 1, *requests)
+	require.Equal(t, len(blks), len(got))
+	for i := range executionBlocks {
+		require.Equal(t, version.Bellatrix, got[i].Version())
+		gotRoot, err := got[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		wantRoot, err := blks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, wantRoot, gotRoot)
+	}
+	require.Equal(t, altairBlk, got[2])
+
+	// An execution block unknown to the execution node fails the batch.
+	client = &Client{rpc: newBlockWithTxsServer(t, executionBlocks[:1], gethTxs[:1])}
+	_, err = client.ReconstructFullBellatrixBlockBatch(ctx, blks)
+	require.ErrorIs(t, err, ErrNilResponse)
+}
+
+func testTransaction(nonce uint64) *gethTypes.Transaction {
+	to := common.BytesToAddress([]byte("to"))
+	return gethTypes.NewTx(&gethTypes.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		To:       &to,
+		Value:    big.NewInt(1),
+	})
+}
+
+// blockWithTxsService answers eth_getBlockByHash requests for full transaction objects.
+type blockWithTxsService struct {
+	blocks map[common.Hash]map[string]interface{}
+}
+
+// GetBlockByHash --
+func (s *blockWithTxsService) GetBlockByHash(hash common.Hash, fullTxs bool) (map[string]interface{}, error) {
+	if !fullTxs {
+		return nil, errors.New("full transaction objects were not requested")
+	}
+	return s.blocks[hash], nil
+}
+
+// newBlockWithTxsServer returns a client to a JSON-RPC server which answers eth_getBlockByHash
+// requests for full transaction objects with the given blocks and their transactions.
+func newBlockWithTxsServer(t *testing.T, blks []*pb.ExecutionBlock, txs [][]*gethTypes.Transaction) *rpc.Client {
+	rpcClient, _ := newCountingBlockWithTxsServer(t, blks, txs)
+	return rpcClient
+}
+
+// newCountingBlockWithTxsServer is like newBlockWithTxsServer, and also returns the number of
+// HTTP requests received by the server.
+func newCountingBlockWithTxsServer(t *testing.T, blks []*pb.ExecutionBlock, txs [][]*gethTypes.Transaction) (*rpc.Client, *int) {
+	service := &blockWithTxsService{blocks: make(map[common.Hash]map[string]interface{})}
+	for i, blk := range blks {
+		blkEnc, err := json.Marshal(blk)
+		require.NoError(t, err)
+		fields := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(blkEnc, &fields))
+		fields["transactions"] = txs[i]
+		service.blocks[common.BytesToHash(blk.Hash)] = fields
+	}
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("eth", service))
+	t.Cleanup(rpcServer.Stop)
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		rpcServer.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+	return rpcClient, &requests
+}
+
 func Test_handleRPCError(t *testing.T) {
 	got := handleRPCError(nil)
 	require.Equal(t, true, got == nil)
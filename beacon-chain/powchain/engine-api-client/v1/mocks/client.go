@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
 // EngineClient --
@@ -20,6 +22,7 @@ type EngineClient struct {
 	ErrExecBlockByHash    error
 	ErrForkchoiceUpdated  error
 	BlockByHashMap        map[[32]byte]*pb.ExecutionBlock
+	ReconstructedBlocks   map[[32]byte]block.SignedBeaconBlock
 }
 
 // NewPayload --
@@ -57,3 +60,42 @@ func (e *EngineClient) ExecutionBlockByHash(_ context.Context, h common.Hash) (*
 	}
 	return b, e.ErrExecBlockByHash
 }
+
+// ExecutionBlockByHashWithTxs --
+func (e *EngineClient) ExecutionBlockByHashWithTxs(ctx context.Context, h common.Hash) (*pb.ExecutionBlock, error) {
+	return e.ExecutionBlockByHash(ctx, h)
+}
+
+// ReconstructFullBellatrixBlock --
+func (e *EngineClient) ReconstructFullBellatrixBlock(
+	_ context.Context, blindedBlock block.SignedBeaconBlock,
+) (block.SignedBeaconBlock, error) {
+	r, err := blindedBlock.Block().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	b, ok := e.ReconstructedBlocks[r]
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+	return b, nil
+}
+
+// ReconstructFullBellatrixBlockBatch --
+func (e *EngineClient) ReconstructFullBellatrixBlockBatch(
+	ctx context.Context, blks []block.SignedBeaconBlock,
+) ([]block.SignedBeaconBlock, error) {
+	full := make([]block.SignedBeaconBlock, len(blks))
+	for i, b := range blks {
+		if b == nil || b.IsNil() || b.Version() != version.BellatrixBlind {
+			full[i] = b
+			continue
+		}
+		fullBlk, err := e.ReconstructFullBellatrixBlock(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		full[i] = fullBlk
+	}
+	return full, nil
+}
@@ -27,7 +27,7 @@ func handleGetBeaconStateSSZ(m *apimiddleware.ApiProxyMiddleware, endpoint apimi
 	config := sszConfig{
 		sszPath:      "/eth/v1/debug/beacon/states/{state_id}/ssz",
 		fileName:     "beacon_state.ssz",
-		responseJson: &beaconStateSSZResponseJson{},
+		responseJson: &BeaconStateSSZResponseJson{},
 	}
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
@@ -36,7 +36,7 @@ func handleGetBeaconBlockSSZ(m *apimiddleware.ApiProxyMiddleware, endpoint apimi
 	config := sszConfig{
 		sszPath:      "/eth/v1/beacon/blocks/{block_id}/ssz",
 		fileName:     "beacon_block.ssz",
-		responseJson: &blockSSZResponseJson{},
+		responseJson: &BlockSSZResponseJson{},
 	}
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
@@ -45,7 +45,7 @@ func handleGetBeaconStateSSZV2(m *apimiddleware.ApiProxyMiddleware, endpoint api
 	config := sszConfig{
 		sszPath:      "/eth/v2/debug/beacon/states/{state_id}/ssz",
 		fileName:     "beacon_state.ssz",
-		responseJson: &beaconStateSSZResponseV2Json{},
+		responseJson: &BeaconStateSSZResponseV2Json{},
 	}
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
@@ -54,7 +54,7 @@ func handleGetBeaconBlockSSZV2(m *apimiddleware.ApiProxyMiddleware, endpoint api
 	config := sszConfig{
 		sszPath:      "/eth/v2/beacon/blocks/{block_id}/ssz",
 		fileName:     "beacon_block.ssz",
-		responseJson: &blockSSZResponseV2Json{},
+		responseJson: &BlockSSZResponseV2Json{},
 	}
 	return handleGetSSZ(m, endpoint, w, req, config)
 }
@@ -219,16 +219,16 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 
 			switch string(msg.Event) {
 			case events.HeadTopic:
-				data = &eventHeadJson{}
+				data = &EventHeadJson{}
 			case events.BlockTopic:
-				data = &receivedBlockDataJson{}
+				data = &ReceivedBlockDataJson{}
 			case events.AttestationTopic:
-				data = &attestationJson{}
+				data = &AttestationJson{}
 
 				// Data received in the event does not fit the expected event stream output.
 				// We extract the underlying attestation from event data
 				// and assign the attestation back to event data for further processing.
-				eventData := &aggregatedAttReceivedDataJson{}
+				eventData := &AggregatedAttReceivedDataJson{}
 				if err := json.Unmarshal(msg.Data, eventData); err != nil {
 					return apimiddleware.InternalServerError(err)
 				}
@@ -238,15 +238,23 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				}
 				msg.Data = attData
 			case events.VoluntaryExitTopic:
-				data = &signedVoluntaryExitJson{}
+				data = &SignedVoluntaryExitJson{}
 			case events.FinalizedCheckpointTopic:
-				data = &eventFinalizedCheckpointJson{}
+				data = &EventFinalizedCheckpointJson{}
 			case events.ChainReorgTopic:
-				data = &eventChainReorgJson{}
+				data = &EventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
-				data = &signedContributionAndProofJson{}
+				data = &SignedContributionAndProofJson{}
+			case events.PayloadAttributesTopic:
+				data = &EventPayloadAttributesJson{}
+			case events.BlockValidityTopic:
+				data = &EventBlockValidityJson{}
+			case events.ProposerSlashingTopic:
+				data = &ProposerSlashingJson{}
+			case events.AttesterSlashingTopic:
+				data = &AttesterSlashingJson{}
 			case "error":
-				data = &eventErrorJson{}
+				data = &EventErrorJson{}
 			default:
 				return &apimiddleware.DefaultErrorJson{
 					Message: fmt.Sprintf("Event type '%s' not supported", string(msg.Event)),
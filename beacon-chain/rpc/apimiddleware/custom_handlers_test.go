@@ -181,7 +181,7 @@ func TestReceiveEvents(t *testing.T) {
 
 	go func() {
 		base64Val := "Zm9v"
-		data := &eventFinalizedCheckpointJson{
+		data := &EventFinalizedCheckpointJson{
 			Block: base64Val,
 			State: base64Val,
 			Epoch: "1",
@@ -230,7 +230,7 @@ func TestReceiveEvents_TrailingSpace(t *testing.T) {
 
 	go func() {
 		base64Val := "Zm9v"
-		data := &eventFinalizedCheckpointJson{
+		data := &EventFinalizedCheckpointJson{
 			Block: base64Val,
 			State: base64Val,
 			Epoch: "1",
@@ -254,9 +254,42 @@ data: {"block":"0x666f6f","state":"0x666f6f","epoch":"1"}
 `, w.Body.String())
 }
 
+func TestReceiveEvents_BlockValidity(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		data := &EventBlockValidityJson{
+			Slot:  "1",
+			Block: "Zm9v",
+			Valid: true,
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.BlockValidityTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	assert.Equal(t, true, errJson == nil)
+	assert.Equal(t, `event: block_validity
+data: {"slot":"1","block":"0x666f6f","valid":true}
+
+`, w.Body.String())
+}
+
 func TestWriteEvent(t *testing.T) {
 	base64Val := "Zm9v"
-	data := &eventFinalizedCheckpointJson{
+	data := &EventFinalizedCheckpointJson{
 		Block: base64Val,
 		State: base64Val,
 		Epoch: "1",
@@ -270,7 +303,7 @@ func TestWriteEvent(t *testing.T) {
 	w := httptest.NewRecorder()
 	w.Body = &bytes.Buffer{}
 
-	errJson := writeEvent(msg, w, &eventFinalizedCheckpointJson{})
+	errJson := writeEvent(msg, w, &EventFinalizedCheckpointJson{})
 	require.Equal(t, true, errJson == nil)
 	written := w.Body.String()
 	assert.Equal(t, "event: test_event\ndata: {\"block\":\"0x666f6f\",\"state\":\"0x666f6f\",\"epoch\":\"1\"}\n\n", written)
@@ -22,9 +22,9 @@ import (
 func TestWrapAttestationArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitAttestationRequestJson{},
+			PostRequest: &SubmitAttestationRequestJson{},
 		}
-		unwrappedAtts := []*attestationJson{{AggregationBits: "1010"}}
+		unwrappedAtts := []*AttestationJson{{AggregationBits: "1010"}}
 		unwrappedAttsJson, err := json.Marshal(unwrappedAtts)
 		require.NoError(t, err)
 
@@ -36,7 +36,7 @@ func TestWrapAttestationArray(t *testing.T) {
 		runDefault, errJson := wrapAttestationsArray(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		wrappedAtts := &submitAttestationRequestJson{}
+		wrappedAtts := &SubmitAttestationRequestJson{}
 		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedAtts))
 		require.Equal(t, 1, len(wrappedAtts.Data), "wrong number of wrapped items")
 		assert.Equal(t, "1010", wrappedAtts.Data[0].AggregationBits)
@@ -44,7 +44,7 @@ func TestWrapAttestationArray(t *testing.T) {
 
 	t.Run("invalid_body", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitAttestationRequestJson{},
+			PostRequest: &SubmitAttestationRequestJson{},
 		}
 		var body bytes.Buffer
 		_, err := body.Write([]byte("invalid"))
@@ -62,7 +62,7 @@ func TestWrapAttestationArray(t *testing.T) {
 func TestWrapValidatorIndicesArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &dutiesRequestJson{},
+			PostRequest: &DutiesRequestJson{},
 		}
 		unwrappedIndices := []string{"1", "2"}
 		unwrappedIndicesJson, err := json.Marshal(unwrappedIndices)
@@ -76,7 +76,7 @@ func TestWrapValidatorIndicesArray(t *testing.T) {
 		runDefault, errJson := wrapValidatorIndicesArray(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		wrappedIndices := &dutiesRequestJson{}
+		wrappedIndices := &DutiesRequestJson{}
 		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedIndices))
 		require.Equal(t, 2, len(wrappedIndices.Index), "wrong number of wrapped items")
 		assert.Equal(t, "1", wrappedIndices.Index[0])
@@ -85,7 +85,7 @@ func TestWrapValidatorIndicesArray(t *testing.T) {
 
 	t.Run("invalid_body", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &dutiesRequestJson{},
+			PostRequest: &DutiesRequestJson{},
 		}
 		var body bytes.Buffer
 		_, err := body.Write([]byte("invalid"))
@@ -103,9 +103,9 @@ func TestWrapValidatorIndicesArray(t *testing.T) {
 func TestWrapSignedAggregateAndProofArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitAggregateAndProofsRequestJson{},
+			PostRequest: &SubmitAggregateAndProofsRequestJson{},
 		}
-		unwrappedAggs := []*signedAggregateAttestationAndProofJson{{Signature: "sig"}}
+		unwrappedAggs := []*SignedAggregateAttestationAndProofJson{{Signature: "sig"}}
 		unwrappedAggsJson, err := json.Marshal(unwrappedAggs)
 		require.NoError(t, err)
 
@@ -117,7 +117,7 @@ func TestWrapSignedAggregateAndProofArray(t *testing.T) {
 		runDefault, errJson := wrapSignedAggregateAndProofArray(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		wrappedAggs := &submitAggregateAndProofsRequestJson{}
+		wrappedAggs := &SubmitAggregateAndProofsRequestJson{}
 		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedAggs))
 		require.Equal(t, 1, len(wrappedAggs.Data), "wrong number of wrapped items")
 		assert.Equal(t, "sig", wrappedAggs.Data[0].Signature)
@@ -125,7 +125,7 @@ func TestWrapSignedAggregateAndProofArray(t *testing.T) {
 
 	t.Run("invalid_body", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitAggregateAndProofsRequestJson{},
+			PostRequest: &SubmitAggregateAndProofsRequestJson{},
 		}
 		var body bytes.Buffer
 		_, err := body.Write([]byte("invalid"))
@@ -143,9 +143,9 @@ func TestWrapSignedAggregateAndProofArray(t *testing.T) {
 func TestWrapBeaconCommitteeSubscriptionsArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+			PostRequest: &SubmitBeaconCommitteeSubscriptionsRequestJson{},
 		}
-		unwrappedSubs := []*beaconCommitteeSubscribeJson{{
+		unwrappedSubs := []*BeaconCommitteeSubscribeJson{{
 			ValidatorIndex:   "1",
 			CommitteeIndex:   "1",
 			CommitteesAtSlot: "1",
@@ -163,7 +163,7 @@ func TestWrapBeaconCommitteeSubscriptionsArray(t *testing.T) {
 		runDefault, errJson := wrapBeaconCommitteeSubscriptionsArray(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		wrappedSubs := &submitBeaconCommitteeSubscriptionsRequestJson{}
+		wrappedSubs := &SubmitBeaconCommitteeSubscriptionsRequestJson{}
 		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedSubs))
 		require.Equal(t, 1, len(wrappedSubs.Data), "wrong number of wrapped items")
 		assert.Equal(t, "1", wrappedSubs.Data[0].ValidatorIndex)
@@ -175,7 +175,7 @@ func TestWrapBeaconCommitteeSubscriptionsArray(t *testing.T) {
 
 	t.Run("invalid_body", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitBeaconCommitteeSubscriptionsRequestJson{},
+			PostRequest: &SubmitBeaconCommitteeSubscriptionsRequestJson{},
 		}
 		var body bytes.Buffer
 		_, err := body.Write([]byte("invalid"))
@@ -193,9 +193,9 @@ func TestWrapBeaconCommitteeSubscriptionsArray(t *testing.T) {
 func TestWrapSyncCommitteeSubscriptionsArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitSyncCommitteeSubscriptionRequestJson{},
+			PostRequest: &SubmitSyncCommitteeSubscriptionRequestJson{},
 		}
-		unwrappedSubs := []*syncCommitteeSubscriptionJson{
+		unwrappedSubs := []*SyncCommitteeSubscriptionJson{
 			{
 				ValidatorIndex:       "1",
 				SyncCommitteeIndices: []string{"1", "2"},
@@ -218,7 +218,7 @@ func TestWrapSyncCommitteeSubscriptionsArray(t *testing.T) {
 		runDefault, errJson := wrapSyncCommitteeSubscriptionsArray(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		wrappedSubs := &submitSyncCommitteeSubscriptionRequestJson{}
+		wrappedSubs := &SubmitSyncCommitteeSubscriptionRequestJson{}
 		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedSubs))
 		require.Equal(t, 2, len(wrappedSubs.Data), "wrong number of wrapped items")
 		assert.Equal(t, "1", wrappedSubs.Data[0].ValidatorIndex)
@@ -230,7 +230,7 @@ func TestWrapSyncCommitteeSubscriptionsArray(t *testing.T) {
 
 	t.Run("invalid_body", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitSyncCommitteeSubscriptionRequestJson{},
+			PostRequest: &SubmitSyncCommitteeSubscriptionRequestJson{},
 		}
 		var body bytes.Buffer
 		_, err := body.Write([]byte("invalid"))
@@ -248,9 +248,9 @@ func TestWrapSyncCommitteeSubscriptionsArray(t *testing.T) {
 func TestWrapSyncCommitteeSignaturesArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitSyncCommitteeSignaturesRequestJson{},
+			PostRequest: &SubmitSyncCommitteeSignaturesRequestJson{},
 		}
-		unwrappedSigs := []*syncCommitteeMessageJson{{
+		unwrappedSigs := []*SyncCommitteeMessageJson{{
 			Slot:            "1",
 			BeaconBlockRoot: "root",
 			ValidatorIndex:  "1",
@@ -267,7 +267,7 @@ func TestWrapSyncCommitteeSignaturesArray(t *testing.T) {
 		runDefault, errJson := wrapSyncCommitteeSignaturesArray(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		wrappedSigs := &submitSyncCommitteeSignaturesRequestJson{}
+		wrappedSigs := &SubmitSyncCommitteeSignaturesRequestJson{}
 		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedSigs))
 		require.Equal(t, 1, len(wrappedSigs.Data), "wrong number of wrapped items")
 		assert.Equal(t, "1", wrappedSigs.Data[0].Slot)
@@ -278,7 +278,7 @@ func TestWrapSyncCommitteeSignaturesArray(t *testing.T) {
 
 	t.Run("invalid_body", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitSyncCommitteeSignaturesRequestJson{},
+			PostRequest: &SubmitSyncCommitteeSignaturesRequestJson{},
 		}
 		var body bytes.Buffer
 		_, err := body.Write([]byte("invalid"))
@@ -296,13 +296,13 @@ func TestWrapSyncCommitteeSignaturesArray(t *testing.T) {
 func TestWrapSignedContributionAndProofsArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitContributionAndProofsRequestJson{},
+			PostRequest: &SubmitContributionAndProofsRequestJson{},
 		}
-		unwrapped := []*signedContributionAndProofJson{
+		unwrapped := []*SignedContributionAndProofJson{
 			{
-				Message: &contributionAndProofJson{
+				Message: &ContributionAndProofJson{
 					AggregatorIndex: "1",
-					Contribution: &syncCommitteeContributionJson{
+					Contribution: &SyncCommitteeContributionJson{
 						Slot:              "1",
 						BeaconBlockRoot:   "root",
 						SubcommitteeIndex: "1",
@@ -314,7 +314,7 @@ func TestWrapSignedContributionAndProofsArray(t *testing.T) {
 				Signature: "sig",
 			},
 			{
-				Message:   &contributionAndProofJson{},
+				Message:   &ContributionAndProofJson{},
 				Signature: "sig",
 			},
 		}
@@ -329,7 +329,7 @@ func TestWrapSignedContributionAndProofsArray(t *testing.T) {
 		runDefault, errJson := wrapSignedContributionAndProofsArray(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		wrapped := &submitContributionAndProofsRequestJson{}
+		wrapped := &SubmitContributionAndProofsRequestJson{}
 		require.NoError(t, json.NewDecoder(request.Body).Decode(wrapped))
 		require.Equal(t, 2, len(wrapped.Data), "wrong number of wrapped items")
 		assert.Equal(t, "sig", wrapped.Data[0].Signature)
@@ -347,7 +347,7 @@ func TestWrapSignedContributionAndProofsArray(t *testing.T) {
 
 	t.Run("invalid_body", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &submitContributionAndProofsRequestJson{},
+			PostRequest: &SubmitContributionAndProofsRequestJson{},
 		}
 		var body bytes.Buffer
 		_, err := body.Write([]byte("invalid"))
@@ -380,7 +380,7 @@ func TestSetInitialPublishBlockPostRequest(t *testing.T) {
 		runDefault, errJson := setInitialPublishBlockPostRequest(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		assert.Equal(t, reflect.TypeOf(signedBeaconBlockContainerJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
+		assert.Equal(t, reflect.TypeOf(SignedBeaconBlockContainerJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
 	})
 	t.Run("Altair", func(t *testing.T) {
 		slot, err := slots.EpochStart(params.BeaconConfig().AltairForkEpoch)
@@ -395,16 +395,16 @@ func TestSetInitialPublishBlockPostRequest(t *testing.T) {
 		runDefault, errJson := setInitialPublishBlockPostRequest(endpoint, nil, request)
 		require.Equal(t, true, errJson == nil)
 		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
-		assert.Equal(t, reflect.TypeOf(signedBeaconBlockAltairContainerJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
+		assert.Equal(t, reflect.TypeOf(SignedBeaconBlockAltairContainerJson{}).Name(), reflect.Indirect(reflect.ValueOf(endpoint.PostRequest)).Type().Name())
 	})
 }
 
 func TestPreparePublishedBlock(t *testing.T) {
 	t.Run("Phase 0", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &signedBeaconBlockContainerJson{
-				Message: &beaconBlockJson{
-					Body: &beaconBlockBodyJson{},
+			PostRequest: &SignedBeaconBlockContainerJson{
+				Message: &BeaconBlockJson{
+					Body: &BeaconBlockBodyJson{},
 				},
 			},
 		}
@@ -416,9 +416,9 @@ func TestPreparePublishedBlock(t *testing.T) {
 
 	t.Run("Altair", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
-			PostRequest: &signedBeaconBlockAltairContainerJson{
-				Message: &beaconBlockAltairJson{
-					Body: &beaconBlockBodyAltairJson{},
+			PostRequest: &SignedBeaconBlockAltairContainerJson{
+				Message: &BeaconBlockAltairJson{
+					Body: &BeaconBlockBodyAltairJson{},
 				},
 			},
 		}
@@ -451,7 +451,7 @@ func TestPrepareValidatorAggregates(t *testing.T) {
 	bodyJson, err := json.Marshal(body)
 	require.NoError(t, err)
 
-	container := &syncCommitteesResponseJson{}
+	container := &SyncCommitteesResponseJson{}
 	runDefault, errJson := prepareValidatorAggregates(bodyJson, container)
 	require.Equal(t, nil, errJson)
 	require.Equal(t, apimiddleware.RunDefault(false), runDefault)
@@ -461,15 +461,15 @@ func TestPrepareValidatorAggregates(t *testing.T) {
 
 func TestSerializeV2Block(t *testing.T) {
 	t.Run("Phase 0", func(t *testing.T) {
-		response := &blockV2ResponseJson{
+		response := &BlockV2ResponseJson{
 			Version: ethpbv2.Version_PHASE0.String(),
-			Data: &signedBeaconBlockContainerV2Json{
-				Phase0Block: &beaconBlockJson{
+			Data: &SignedBeaconBlockContainerV2Json{
+				Phase0Block: &BeaconBlockJson{
 					Slot:          "1",
 					ProposerIndex: "1",
 					ParentRoot:    "root",
 					StateRoot:     "root",
-					Body:          &beaconBlockBodyJson{},
+					Body:          &BeaconBlockBodyJson{},
 				},
 				AltairBlock: nil,
 				Signature:   "sig",
@@ -492,16 +492,16 @@ func TestSerializeV2Block(t *testing.T) {
 	})
 
 	t.Run("Altair", func(t *testing.T) {
-		response := &blockV2ResponseJson{
+		response := &BlockV2ResponseJson{
 			Version: ethpbv2.Version_ALTAIR.String(),
-			Data: &signedBeaconBlockContainerV2Json{
+			Data: &SignedBeaconBlockContainerV2Json{
 				Phase0Block: nil,
-				AltairBlock: &beaconBlockAltairJson{
+				AltairBlock: &BeaconBlockAltairJson{
 					Slot:          "1",
 					ProposerIndex: "1",
 					ParentRoot:    "root",
 					StateRoot:     "root",
-					Body:          &beaconBlockBodyAltairJson{},
+					Body:          &BeaconBlockBodyAltairJson{},
 				},
 				Signature: "sig",
 			},
@@ -532,7 +532,7 @@ func TestSerializeV2Block(t *testing.T) {
 	})
 
 	t.Run("unsupported block version", func(t *testing.T) {
-		response := &blockV2ResponseJson{
+		response := &BlockV2ResponseJson{
 			Version: "unsupported",
 		}
 		runDefault, j, errJson := serializeV2Block(response)
@@ -545,10 +545,10 @@ func TestSerializeV2Block(t *testing.T) {
 
 func TestSerializeV2State(t *testing.T) {
 	t.Run("Phase 0", func(t *testing.T) {
-		response := &beaconStateV2ResponseJson{
+		response := &BeaconStateV2ResponseJson{
 			Version: ethpbv2.Version_PHASE0.String(),
-			Data: &beaconStateContainerV2Json{
-				Phase0State: &beaconStateJson{},
+			Data: &BeaconStateContainerV2Json{
+				Phase0State: &BeaconStateJson{},
 				AltairState: nil,
 			},
 		}
@@ -560,11 +560,11 @@ func TestSerializeV2State(t *testing.T) {
 	})
 
 	t.Run("Altair", func(t *testing.T) {
-		response := &beaconStateV2ResponseJson{
+		response := &BeaconStateV2ResponseJson{
 			Version: ethpbv2.Version_ALTAIR.String(),
-			Data: &beaconStateContainerV2Json{
+			Data: &BeaconStateContainerV2Json{
 				Phase0State: nil,
-				AltairState: &beaconStateAltairJson{},
+				AltairState: &BeaconStateAltairJson{},
 			},
 		}
 		runDefault, j, errJson := serializeV2State(response)
@@ -575,11 +575,11 @@ func TestSerializeV2State(t *testing.T) {
 	})
 
 	t.Run("Bellatrix", func(t *testing.T) {
-		response := &beaconStateV2ResponseJson{
+		response := &BeaconStateV2ResponseJson{
 			Version: ethpbv2.Version_BELLATRIX.String(),
-			Data: &beaconStateContainerV2Json{
+			Data: &BeaconStateContainerV2Json{
 				Phase0State:    nil,
-				BellatrixState: &beaconStateBellatrixJson{},
+				BellatrixState: &BeaconStateBellatrixJson{},
 			},
 		}
 		runDefault, j, errJson := serializeV2State(response)
@@ -598,7 +598,7 @@ func TestSerializeV2State(t *testing.T) {
 	})
 
 	t.Run("unsupported state version", func(t *testing.T) {
-		response := &beaconStateV2ResponseJson{
+		response := &BeaconStateV2ResponseJson{
 			Version: "unsupported",
 		}
 		runDefault, j, errJson := serializeV2State(response)
@@ -611,15 +611,15 @@ func TestSerializeV2State(t *testing.T) {
 
 func TestSerializeProduceV2Block(t *testing.T) {
 	t.Run("Phase 0", func(t *testing.T) {
-		response := &produceBlockResponseV2Json{
+		response := &ProduceBlockResponseV2Json{
 			Version: ethpbv2.Version_PHASE0.String(),
-			Data: &beaconBlockContainerV2Json{
-				Phase0Block: &beaconBlockJson{
+			Data: &BeaconBlockContainerV2Json{
+				Phase0Block: &BeaconBlockJson{
 					Slot:          "1",
 					ProposerIndex: "1",
 					ParentRoot:    "root",
 					StateRoot:     "root",
-					Body:          &beaconBlockBodyJson{},
+					Body:          &BeaconBlockBodyJson{},
 				},
 				AltairBlock: nil,
 			},
@@ -641,16 +641,16 @@ func TestSerializeProduceV2Block(t *testing.T) {
 	})
 
 	t.Run("Altair", func(t *testing.T) {
-		response := &produceBlockResponseV2Json{
+		response := &ProduceBlockResponseV2Json{
 			Version: ethpbv2.Version_ALTAIR.String(),
-			Data: &beaconBlockContainerV2Json{
+			Data: &BeaconBlockContainerV2Json{
 				Phase0Block: nil,
-				AltairBlock: &beaconBlockAltairJson{
+				AltairBlock: &BeaconBlockAltairJson{
 					Slot:          "1",
 					ProposerIndex: "1",
 					ParentRoot:    "root",
 					StateRoot:     "root",
-					Body:          &beaconBlockBodyAltairJson{},
+					Body:          &BeaconBlockBodyAltairJson{},
 				},
 			},
 		}
@@ -680,7 +680,7 @@ func TestSerializeProduceV2Block(t *testing.T) {
 	})
 
 	t.Run("unsupported block version", func(t *testing.T) {
-		response := &produceBlockResponseV2Json{
+		response := &ProduceBlockResponseV2Json{
 			Version: "unsupported",
 		}
 		runDefault, j, errJson := serializeProducedV2Block(response)
@@ -7,250 +7,273 @@ import (
 	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 )
 
-// genesisResponseJson is used in /beacon/genesis API endpoint.
-type genesisResponseJson struct {
-	Data *genesisResponse_GenesisJson `json:"data"`
+// GenesisResponseJson is used in /beacon/genesis API endpoint.
+type GenesisResponseJson struct {
+	Data *GenesisResponse_GenesisJson `json:"data"`
 }
 
-// genesisResponse_GenesisJson is used in /beacon/genesis API endpoint.
-type genesisResponse_GenesisJson struct {
+// GenesisResponse_GenesisJson is used in /beacon/genesis API endpoint.
+type GenesisResponse_GenesisJson struct {
 	GenesisTime           string `json:"genesis_time" time:"true"`
 	GenesisValidatorsRoot string `json:"genesis_validators_root" hex:"true"`
 	GenesisForkVersion    string `json:"genesis_fork_version" hex:"true"`
 }
 
-// stateRootResponseJson is used in /beacon/states/{state_id}/root API endpoint.
-type stateRootResponseJson struct {
-	Data *stateRootResponse_StateRootJson `json:"data"`
+// StateRootResponseJson is used in /beacon/states/{state_id}/root API endpoint.
+type StateRootResponseJson struct {
+	Data *StateRootResponse_StateRootJson `json:"data"`
 }
 
-// stateRootResponse_StateRootJson is used in /beacon/states/{state_id}/root API endpoint.
-type stateRootResponse_StateRootJson struct {
+// StateRootResponse_StateRootJson is used in /beacon/states/{state_id}/root API endpoint.
+type StateRootResponse_StateRootJson struct {
 	StateRoot string `json:"root" hex:"true"`
 }
 
-// stateForkResponseJson is used in /beacon/states/{state_id}/fork API endpoint.
-type stateForkResponseJson struct {
-	Data *forkJson `json:"data"`
+// StateForkResponseJson is used in /beacon/states/{state_id}/fork API endpoint.
+type StateForkResponseJson struct {
+	Data *ForkJson `json:"data"`
 }
 
-// stateFinalityCheckpointResponseJson is used in /beacon/states/{state_id}/finality_checkpoints API endpoint.
-type stateFinalityCheckpointResponseJson struct {
-	Data *stateFinalityCheckpointResponse_StateFinalityCheckpointJson `json:"data"`
+// StateFinalityCheckpointResponseJson is used in /beacon/states/{state_id}/finality_checkpoints API endpoint.
+type StateFinalityCheckpointResponseJson struct {
+	Data *StateFinalityCheckpointResponse_StateFinalityCheckpointJson `json:"data"`
 }
 
-// stateFinalityCheckpointResponse_StateFinalityCheckpointJson is used in /beacon/states/{state_id}/finality_checkpoints API endpoint.
-type stateFinalityCheckpointResponse_StateFinalityCheckpointJson struct {
-	PreviousJustified *checkpointJson `json:"previous_justified"`
-	CurrentJustified  *checkpointJson `json:"current_justified"`
-	Finalized         *checkpointJson `json:"finalized"`
+// StateFinalityCheckpointResponse_StateFinalityCheckpointJson is used in /beacon/states/{state_id}/finality_checkpoints API endpoint.
+type StateFinalityCheckpointResponse_StateFinalityCheckpointJson struct {
+	PreviousJustified *CheckpointJson `json:"previous_justified"`
+	CurrentJustified  *CheckpointJson `json:"current_justified"`
+	Finalized         *CheckpointJson `json:"finalized"`
 }
 
-// stateValidatorResponseJson is used in /beacon/states/{state_id}/validators API endpoint.
-type stateValidatorsResponseJson struct {
-	Data []*validatorContainerJson `json:"data"`
+// StateValidatorResponseJson is used in /beacon/states/{state_id}/validators API endpoint.
+type StateValidatorsResponseJson struct {
+	Data []*ValidatorContainerJson `json:"data"`
 }
 
-// stateValidatorResponseJson is used in /beacon/states/{state_id}/validators/{validator_id} API endpoint.
-type stateValidatorResponseJson struct {
-	Data *validatorContainerJson `json:"data"`
+// StateValidatorResponseJson is used in /beacon/states/{state_id}/validators/{validator_id} API endpoint.
+type StateValidatorResponseJson struct {
+	Data *ValidatorContainerJson `json:"data"`
 }
 
-// validatorBalancesResponseJson is used in /beacon/states/{state_id}/validator_balances API endpoint.
-type validatorBalancesResponseJson struct {
-	Data []*validatorBalanceJson `json:"data"`
+// ValidatorBalancesResponseJson is used in /beacon/states/{state_id}/validator_balances API endpoint.
+type ValidatorBalancesResponseJson struct {
+	Data []*ValidatorBalanceJson `json:"data"`
 }
 
-// stateCommitteesResponseJson is used in /beacon/states/{state_id}/committees API endpoint.
-type stateCommitteesResponseJson struct {
-	Data []*committeeJson `json:"data"`
+// StateCommitteesResponseJson is used in /beacon/states/{state_id}/committees API endpoint.
+type StateCommitteesResponseJson struct {
+	Data []*CommitteeJson `json:"data"`
 }
 
-// syncCommitteesResponseJson is used in /beacon/states/{state_id}/sync_committees API endpoint.
-type syncCommitteesResponseJson struct {
-	Data *syncCommitteeValidatorsJson `json:"data"`
+// SyncCommitteesResponseJson is used in /beacon/states/{state_id}/sync_committees API endpoint.
+type SyncCommitteesResponseJson struct {
+	Data *SyncCommitteeValidatorsJson `json:"data"`
 }
 
-// blockHeadersResponseJson is used in /beacon/headers API endpoint.
-type blockHeadersResponseJson struct {
-	Data []*blockHeaderContainerJson `json:"data"`
+// BlockHeadersResponseJson is used in /beacon/headers API endpoint.
+type BlockHeadersResponseJson struct {
+	Data []*BlockHeaderContainerJson `json:"data"`
 }
 
-// blockHeaderResponseJson is used in /beacon/headers/{block_id} API endpoint.
-type blockHeaderResponseJson struct {
-	Data *blockHeaderContainerJson `json:"data"`
+// BlockHeaderResponseJson is used in /beacon/headers/{block_id} API endpoint.
+type BlockHeaderResponseJson struct {
+	Data *BlockHeaderContainerJson `json:"data"`
 }
 
-// blockResponseJson is used in /beacon/blocks/{block_id} API endpoint.
-type blockResponseJson struct {
-	Data *signedBeaconBlockContainerJson `json:"data"`
+// BlockResponseJson is used in /beacon/blocks/{block_id} API endpoint.
+type BlockResponseJson struct {
+	Data *SignedBeaconBlockContainerJson `json:"data"`
 }
 
-// blockV2ResponseJson is used in /v2/beacon/blocks/{block_id} API endpoint.
-type blockV2ResponseJson struct {
+// BlockV2ResponseJson is used in /v2/beacon/blocks/{block_id} API endpoint.
+type BlockV2ResponseJson struct {
 	Version string                            `json:"version" enum:"true"`
-	Data    *signedBeaconBlockContainerV2Json `json:"data"`
+	Data    *SignedBeaconBlockContainerV2Json `json:"data"`
 }
 
-// blockRootResponseJson is used in /beacon/blocks/{block_id}/root API endpoint.
-type blockRootResponseJson struct {
-	Data *blockRootContainerJson `json:"data"`
+// BlockRootResponseJson is used in /beacon/blocks/{block_id}/root API endpoint.
+type BlockRootResponseJson struct {
+	Data *BlockRootContainerJson `json:"data"`
 }
 
-// blockAttestationsResponseJson is used in /beacon/blocks/{block_id}/attestations API endpoint.
-type blockAttestationsResponseJson struct {
-	Data []*attestationJson `json:"data"`
+// BlockAttestationsResponseJson is used in /beacon/blocks/{block_id}/attestations API endpoint.
+type BlockAttestationsResponseJson struct {
+	Data []*AttestationJson `json:"data"`
 }
 
-// attestationsPoolResponseJson is used in /beacon/pool/attestations GET API endpoint.
-type attestationsPoolResponseJson struct {
-	Data []*attestationJson `json:"data"`
+// LightClientBootstrapResponseJson is used in /beacon/light_client/bootstrap/{block_root} API endpoint.
+type LightClientBootstrapResponseJson struct {
+	Version string                    `json:"version" enum:"true"`
+	Data    *LightClientBootstrapJson `json:"data"`
 }
 
-// submitAttestationRequestJson is used in /beacon/pool/attestations POST API endpoint.
-type submitAttestationRequestJson struct {
-	Data []*attestationJson `json:"data"`
+// LightClientUpdatesByRangeResponseJson is used in /beacon/light_client/updates API endpoint.
+type LightClientUpdatesByRangeResponseJson struct {
+	Data []*LightClientUpdateWithVersionJson `json:"data"`
 }
 
-// attesterSlashingsPoolResponseJson is used in /beacon/pool/attester_slashings API endpoint.
-type attesterSlashingsPoolResponseJson struct {
-	Data []*attesterSlashingJson `json:"data"`
+// LightClientFinalityUpdateResponseJson is used in /beacon/light_client/finality_update API endpoint.
+type LightClientFinalityUpdateResponseJson struct {
+	Version string                         `json:"version" enum:"true"`
+	Data    *LightClientFinalityUpdateJson `json:"data"`
 }
 
-// proposerSlashingsPoolResponseJson is used in /beacon/pool/proposer_slashings API endpoint.
-type proposerSlashingsPoolResponseJson struct {
-	Data []*proposerSlashingJson `json:"data"`
+// LightClientOptimisticUpdateResponseJson is used in /beacon/light_client/optimistic_update API endpoint.
+type LightClientOptimisticUpdateResponseJson struct {
+	Version string                           `json:"version" enum:"true"`
+	Data    *LightClientOptimisticUpdateJson `json:"data"`
 }
 
-// voluntaryExitsPoolResponseJson is used in /beacon/pool/voluntary_exits API endpoint.
-type voluntaryExitsPoolResponseJson struct {
-	Data []*signedVoluntaryExitJson `json:"data"`
+// AttestationsPoolResponseJson is used in /beacon/pool/attestations GET API endpoint.
+type AttestationsPoolResponseJson struct {
+	Data []*AttestationJson `json:"data"`
 }
 
-// submitSyncCommitteeSignaturesRequestJson is used in /beacon/pool/sync_committees API endpoint.
-type submitSyncCommitteeSignaturesRequestJson struct {
-	Data []*syncCommitteeMessageJson `json:"data"`
+// SubmitAttestationRequestJson is used in /beacon/pool/attestations POST API endpoint.
+type SubmitAttestationRequestJson struct {
+	Data []*AttestationJson `json:"data"`
 }
 
-// identityResponseJson is used in /node/identity API endpoint.
-type identityResponseJson struct {
-	Data *identityJson `json:"data"`
+// AttesterSlashingsPoolResponseJson is used in /beacon/pool/attester_slashings API endpoint.
+type AttesterSlashingsPoolResponseJson struct {
+	Data []*AttesterSlashingJson `json:"data"`
 }
 
-// peersResponseJson is used in /node/peers API endpoint.
-type peersResponseJson struct {
-	Data []*peerJson `json:"data"`
+// ProposerSlashingsPoolResponseJson is used in /beacon/pool/proposer_slashings API endpoint.
+type ProposerSlashingsPoolResponseJson struct {
+	Data []*ProposerSlashingJson `json:"data"`
 }
 
-// peerResponseJson is used in /node/peers/{peer_id} API endpoint.
-type peerResponseJson struct {
-	Data *peerJson `json:"data"`
+// VoluntaryExitsPoolResponseJson is used in /beacon/pool/voluntary_exits API endpoint.
+type VoluntaryExitsPoolResponseJson struct {
+	Data []*SignedVoluntaryExitJson `json:"data"`
 }
 
-// peerCountResponseJson is used in /node/peer_count API endpoint.
-type peerCountResponseJson struct {
-	Data peerCountResponse_PeerCountJson `json:"data"`
+// SubmitSyncCommitteeSignaturesRequestJson is used in /beacon/pool/sync_committees API endpoint.
+type SubmitSyncCommitteeSignaturesRequestJson struct {
+	Data []*SyncCommitteeMessageJson `json:"data"`
 }
 
-// peerCountResponse_PeerCountJson is used in /node/peer_count API endpoint.
-type peerCountResponse_PeerCountJson struct {
+// IdentityResponseJson is used in /node/identity API endpoint.
+type IdentityResponseJson struct {
+	Data *IdentityJson `json:"data"`
+}
+
+// PeersResponseJson is used in /node/peers API endpoint.
+type PeersResponseJson struct {
+	Data []*PeerJson `json:"data"`
+}
+
+// PeerResponseJson is used in /node/peers/{peer_id} API endpoint.
+type PeerResponseJson struct {
+	Data *PeerJson `json:"data"`
+}
+
+// PeerCountResponseJson is used in /node/peer_count API endpoint.
+type PeerCountResponseJson struct {
+	Data PeerCountResponse_PeerCountJson `json:"data"`
+}
+
+// PeerCountResponse_PeerCountJson is used in /node/peer_count API endpoint.
+type PeerCountResponse_PeerCountJson struct {
 	Disconnected  string `json:"disconnected"`
 	Connecting    string `json:"connecting"`
 	Connected     string `json:"connected"`
 	Disconnecting string `json:"disconnecting"`
 }
 
-// versionResponseJson is used in /node/version API endpoint.
-type versionResponseJson struct {
-	Data *versionJson `json:"data"`
+// VersionResponseJson is used in /node/version API endpoint.
+type VersionResponseJson struct {
+	Data *VersionJson `json:"data"`
 }
 
-// syncingResponseJson is used in /node/syncing API endpoint.
-type syncingResponseJson struct {
-	Data *syncInfoJson `json:"data"`
+// SyncingResponseJson is used in /node/syncing API endpoint.
+type SyncingResponseJson struct {
+	Data *SyncInfoJson `json:"data"`
 }
 
-// beaconStateResponseJson is used in /debug/beacon/states/{state_id} API endpoint.
-type beaconStateResponseJson struct {
-	Data *beaconStateJson `json:"data"`
+// BeaconStateResponseJson is used in /debug/beacon/states/{state_id} API endpoint.
+type BeaconStateResponseJson struct {
+	Data *BeaconStateJson `json:"data"`
 }
 
-// beaconStateV2ResponseJson is used in /v2/debug/beacon/states/{state_id} API endpoint.
-type beaconStateV2ResponseJson struct {
+// BeaconStateV2ResponseJson is used in /v2/debug/beacon/states/{state_id} API endpoint.
+type BeaconStateV2ResponseJson struct {
 	Version string                      `json:"version" enum:"true"`
-	Data    *beaconStateContainerV2Json `json:"data"`
+	Data    *BeaconStateContainerV2Json `json:"data"`
 }
 
-// forkChoiceHeadsResponseJson is used in /debug/beacon/heads API endpoint.
-type forkChoiceHeadsResponseJson struct {
-	Data []*forkChoiceHeadJson `json:"data"`
+// ForkChoiceHeadsResponseJson is used in /debug/beacon/heads API endpoint.
+type ForkChoiceHeadsResponseJson struct {
+	Data []*ForkChoiceHeadJson `json:"data"`
 }
 
-// forkScheduleResponseJson is used in /config/fork_schedule API endpoint.
-type forkScheduleResponseJson struct {
-	Data []*forkJson `json:"data"`
+// ForkScheduleResponseJson is used in /config/fork_schedule API endpoint.
+type ForkScheduleResponseJson struct {
+	Data []*ForkJson `json:"data"`
 }
 
-// depositContractResponseJson is used in /config/deposit_contract API endpoint.
-type depositContractResponseJson struct {
-	Data *depositContractJson `json:"data"`
+// DepositContractResponseJson is used in /config/deposit_contract API endpoint.
+type DepositContractResponseJson struct {
+	Data *DepositContractJson `json:"data"`
 }
 
-// specResponseJson is used in /config/spec API endpoint.
-type specResponseJson struct {
+// SpecResponseJson is used in /config/spec API endpoint.
+type SpecResponseJson struct {
 	Data interface{} `json:"data"`
 }
 
-// dutiesRequestJson is used in several duties-related API endpoints.
-type dutiesRequestJson struct {
+// DutiesRequestJson is used in several duties-related API endpoints, as well as the liveness endpoint.
+type DutiesRequestJson struct {
 	Index []string `json:"index"`
 }
 
-// attesterDutiesResponseJson is used in /validator/duties/attester/{epoch} API endpoint.
-type attesterDutiesResponseJson struct {
+// AttesterDutiesResponseJson is used in /validator/duties/attester/{epoch} API endpoint.
+type AttesterDutiesResponseJson struct {
 	DependentRoot string              `json:"dependent_root" hex:"true"`
-	Data          []*attesterDutyJson `json:"data"`
+	Data          []*AttesterDutyJson `json:"data"`
 }
 
-// proposerDutiesResponseJson is used in /validator/duties/proposer/{epoch} API endpoint.
-type proposerDutiesResponseJson struct {
+// ProposerDutiesResponseJson is used in /validator/duties/proposer/{epoch} API endpoint.
+type ProposerDutiesResponseJson struct {
 	DependentRoot string              `json:"dependent_root" hex:"true"`
-	Data          []*proposerDutyJson `json:"data"`
+	Data          []*ProposerDutyJson `json:"data"`
 }
 
-// syncCommitteeDutiesResponseJson is used in /validator/duties/sync/{epoch} API endpoint.
-type syncCommitteeDutiesResponseJson struct {
-	Data []*syncCommitteeDuty `json:"data"`
+// SyncCommitteeDutiesResponseJson is used in /validator/duties/sync/{epoch} API endpoint.
+type SyncCommitteeDutiesResponseJson struct {
+	Data []*SyncCommitteeDuty `json:"data"`
 }
 
-// produceBlockResponseJson is used in /validator/blocks/{slot} API endpoint.
-type produceBlockResponseJson struct {
-	Data *beaconBlockJson `json:"data"`
+// ProduceBlockResponseJson is used in /validator/blocks/{slot} API endpoint.
+type ProduceBlockResponseJson struct {
+	Data *BeaconBlockJson `json:"data"`
 }
 
-// produceBlockResponseV2Json is used in /v2/validator/blocks/{slot} API endpoint.
-type produceBlockResponseV2Json struct {
+// ProduceBlockResponseV2Json is used in /v2/validator/blocks/{slot} API endpoint.
+type ProduceBlockResponseV2Json struct {
 	Version string                      `json:"version"`
-	Data    *beaconBlockContainerV2Json `json:"data"`
+	Data    *BeaconBlockContainerV2Json `json:"data"`
 }
 
-// produceAttestationDataResponseJson is used in /validator/attestation_data API endpoint.
-type produceAttestationDataResponseJson struct {
-	Data *attestationDataJson `json:"data"`
+// ProduceAttestationDataResponseJson is used in /validator/attestation_data API endpoint.
+type ProduceAttestationDataResponseJson struct {
+	Data *AttestationDataJson `json:"data"`
 }
 
-// aggregateAttestationResponseJson is used in /validator/aggregate_attestation API endpoint.
-type aggregateAttestationResponseJson struct {
-	Data *attestationJson `json:"data"`
+// AggregateAttestationResponseJson is used in /validator/aggregate_attestation API endpoint.
+type AggregateAttestationResponseJson struct {
+	Data *AttestationJson `json:"data"`
 }
 
-// submitBeaconCommitteeSubscriptionsRequestJson is used in /validator/beacon_committee_subscriptions API endpoint.
-type submitBeaconCommitteeSubscriptionsRequestJson struct {
-	Data []*beaconCommitteeSubscribeJson `json:"data"`
+// SubmitBeaconCommitteeSubscriptionsRequestJson is used in /validator/beacon_committee_subscriptions API endpoint.
+type SubmitBeaconCommitteeSubscriptionsRequestJson struct {
+	Data []*BeaconCommitteeSubscribeJson `json:"data"`
 }
 
-// beaconCommitteeSubscribeJson is used in /validator/beacon_committee_subscriptions API endpoint.
-type beaconCommitteeSubscribeJson struct {
+// BeaconCommitteeSubscribeJson is used in /validator/beacon_committee_subscriptions API endpoint.
+type BeaconCommitteeSubscribeJson struct {
 	ValidatorIndex   string `json:"validator_index"`
 	CommitteeIndex   string `json:"committee_index"`
 	CommitteesAtSlot string `json:"committees_at_slot"`
@@ -258,141 +281,146 @@ type beaconCommitteeSubscribeJson struct {
 	IsAggregator     bool   `json:"is_aggregator"`
 }
 
-// submitBeaconCommitteeSubscriptionsRequestJson is used in /validator/sync_committee_subscriptions API endpoint.
-type submitSyncCommitteeSubscriptionRequestJson struct {
-	Data []*syncCommitteeSubscriptionJson `json:"data"`
+// SubmitBeaconCommitteeSubscriptionsRequestJson is used in /validator/sync_committee_subscriptions API endpoint.
+type SubmitSyncCommitteeSubscriptionRequestJson struct {
+	Data []*SyncCommitteeSubscriptionJson `json:"data"`
 }
 
-// syncCommitteeSubscriptionJson is used in /validator/sync_committee_subscriptions API endpoint.
-type syncCommitteeSubscriptionJson struct {
+// SyncCommitteeSubscriptionJson is used in /validator/sync_committee_subscriptions API endpoint.
+type SyncCommitteeSubscriptionJson struct {
 	ValidatorIndex       string   `json:"validator_index"`
 	SyncCommitteeIndices []string `json:"sync_committee_indices"`
 	UntilEpoch           string   `json:"until_epoch"`
 }
 
-// submitAggregateAndProofsRequestJson is used in /validator/aggregate_and_proofs API endpoint.
-type submitAggregateAndProofsRequestJson struct {
-	Data []*signedAggregateAttestationAndProofJson `json:"data"`
+// SubmitAggregateAndProofsRequestJson is used in /validator/aggregate_and_proofs API endpoint.
+type SubmitAggregateAndProofsRequestJson struct {
+	Data []*SignedAggregateAttestationAndProofJson `json:"data"`
 }
 
-// produceSyncCommitteeContributionResponseJson is used in /validator/sync_committee_contribution API endpoint.
-type produceSyncCommitteeContributionResponseJson struct {
-	Data *syncCommitteeContributionJson `json:"data"`
+// ProduceSyncCommitteeContributionResponseJson is used in /validator/sync_committee_contribution API endpoint.
+type ProduceSyncCommitteeContributionResponseJson struct {
+	Data *SyncCommitteeContributionJson `json:"data"`
 }
 
-// submitContributionAndProofsRequestJson is used in /validator/contribution_and_proofs API endpoint.
-type submitContributionAndProofsRequestJson struct {
-	Data []*signedContributionAndProofJson `json:"data"`
+// SubmitContributionAndProofsRequestJson is used in /validator/contribution_and_proofs API endpoint.
+type SubmitContributionAndProofsRequestJson struct {
+	Data []*SignedContributionAndProofJson `json:"data"`
+}
+
+// LivenessResponseJson is used in /validator/liveness/{epoch} API endpoint.
+type LivenessResponseJson struct {
+	Data []*LivenessJson `json:"data"`
 }
 
 //----------------
 // Reusable types.
 //----------------
 
-type checkpointJson struct {
+type CheckpointJson struct {
 	Epoch string `json:"epoch"`
 	Root  string `json:"root" hex:"true"`
 }
 
-type blockRootContainerJson struct {
+type BlockRootContainerJson struct {
 	Root string `json:"root" hex:"true"`
 }
 
-type signedBeaconBlockContainerJson struct {
-	Message   *beaconBlockJson `json:"message"`
+type SignedBeaconBlockContainerJson struct {
+	Message   *BeaconBlockJson `json:"message"`
 	Signature string           `json:"signature" hex:"true"`
 }
 
-type beaconBlockJson struct {
+type BeaconBlockJson struct {
 	Slot          string               `json:"slot"`
 	ProposerIndex string               `json:"proposer_index"`
 	ParentRoot    string               `json:"parent_root" hex:"true"`
 	StateRoot     string               `json:"state_root" hex:"true"`
-	Body          *beaconBlockBodyJson `json:"body"`
+	Body          *BeaconBlockBodyJson `json:"body"`
 }
 
-type beaconBlockBodyJson struct {
+type BeaconBlockBodyJson struct {
 	RandaoReveal      string                     `json:"randao_reveal" hex:"true"`
-	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Eth1Data          *Eth1DataJson              `json:"eth1_data"`
 	Graffiti          string                     `json:"graffiti" hex:"true"`
-	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
-	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
-	Attestations      []*attestationJson         `json:"attestations"`
-	Deposits          []*depositJson             `json:"deposits"`
-	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
+	ProposerSlashings []*ProposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*AttestationJson         `json:"attestations"`
+	Deposits          []*DepositJson             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExitJson `json:"voluntary_exits"`
 }
 
-type signedBeaconBlockContainerV2Json struct {
-	Phase0Block    *beaconBlockJson          `json:"phase0_block"`
-	AltairBlock    *beaconBlockAltairJson    `json:"altair_block"`
-	BellatrixBlock *beaconBlockBellatrixJson `json:"bellatrix_block"`
+type SignedBeaconBlockContainerV2Json struct {
+	Phase0Block    *BeaconBlockJson          `json:"phase0_block"`
+	AltairBlock    *BeaconBlockAltairJson    `json:"altair_block"`
+	BellatrixBlock *BeaconBlockBellatrixJson `json:"bellatrix_block"`
 	Signature      string                    `json:"signature" hex:"true"`
 }
 
-type beaconBlockContainerV2Json struct {
-	Phase0Block    *beaconBlockJson          `json:"phase0_block"`
-	AltairBlock    *beaconBlockAltairJson    `json:"altair_block"`
-	BellatrixBlock *beaconBlockBellatrixJson `json:"bellatrix_block"`
+type BeaconBlockContainerV2Json struct {
+	Phase0Block    *BeaconBlockJson          `json:"phase0_block"`
+	AltairBlock    *BeaconBlockAltairJson    `json:"altair_block"`
+	BellatrixBlock *BeaconBlockBellatrixJson `json:"bellatrix_block"`
 }
 
-type signedBeaconBlockAltairContainerJson struct {
-	Message   *beaconBlockAltairJson `json:"message"`
+type SignedBeaconBlockAltairContainerJson struct {
+	Message   *BeaconBlockAltairJson `json:"message"`
 	Signature string                 `json:"signature" hex:"true"`
 }
 
-type signedBeaconBlockBellatrixContainerJson struct {
-	Message   *beaconBlockBellatrixJson `json:"message"`
+type SignedBeaconBlockBellatrixContainerJson struct {
+	Message   *BeaconBlockBellatrixJson `json:"message"`
 	Signature string                    `json:"signature" hex:"true"`
 }
 
-type beaconBlockAltairJson struct {
+type BeaconBlockAltairJson struct {
 	Slot          string                     `json:"slot"`
 	ProposerIndex string                     `json:"proposer_index"`
 	ParentRoot    string                     `json:"parent_root" hex:"true"`
 	StateRoot     string                     `json:"state_root" hex:"true"`
-	Body          *beaconBlockBodyAltairJson `json:"body"`
+	Body          *BeaconBlockBodyAltairJson `json:"body"`
 }
 
-type beaconBlockBellatrixJson struct {
+type BeaconBlockBellatrixJson struct {
 	Slot          string                        `json:"slot"`
 	ProposerIndex string                        `json:"proposer_index"`
 	ParentRoot    string                        `json:"parent_root" hex:"true"`
 	StateRoot     string                        `json:"state_root" hex:"true"`
-	Body          *beaconBlockBodyBellatrixJson `json:"body"`
+	Body          *BeaconBlockBodyBellatrixJson `json:"body"`
 }
 
-type beaconBlockBodyAltairJson struct {
+type BeaconBlockBodyAltairJson struct {
 	RandaoReveal      string                     `json:"randao_reveal" hex:"true"`
-	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Eth1Data          *Eth1DataJson              `json:"eth1_data"`
 	Graffiti          string                     `json:"graffiti" hex:"true"`
-	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
-	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
-	Attestations      []*attestationJson         `json:"attestations"`
-	Deposits          []*depositJson             `json:"deposits"`
-	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
-	SyncAggregate     *syncAggregateJson         `json:"sync_aggregate"`
+	ProposerSlashings []*ProposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*AttestationJson         `json:"attestations"`
+	Deposits          []*DepositJson             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExitJson `json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregateJson         `json:"sync_aggregate"`
 }
 
-type beaconBlockBodyBellatrixJson struct {
+type BeaconBlockBodyBellatrixJson struct {
 	RandaoReveal      string                     `json:"randao_reveal" hex:"true"`
-	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Eth1Data          *Eth1DataJson              `json:"eth1_data"`
 	Graffiti          string                     `json:"graffiti" hex:"true"`
-	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
-	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
-	Attestations      []*attestationJson         `json:"attestations"`
-	Deposits          []*depositJson             `json:"deposits"`
-	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
-	SyncAggregate     *syncAggregateJson         `json:"sync_aggregate"`
-	ExecutionPayload  *executionPayloadJson      `json:"execution_payload"`
+	ProposerSlashings []*ProposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*AttestationJson         `json:"attestations"`
+	Deposits          []*DepositJson             `json:"deposits"`
+	VoluntaryExits    []*SignedVoluntaryExitJson `json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregateJson         `json:"sync_aggregate"`
+	ExecutionPayload  *ExecutionPayloadJson      `json:"execution_payload"`
 }
 
-type executionPayloadJson struct {
+type ExecutionPayloadJson struct {
 	ParentHash    string   `json:"parent_hash" hex:"true"`
-	CoinBase      string   `json:"coinbase" hex:"true"`
+	FeeRecipient  string   `json:"fee_recipient" hex:"true"`
 	StateRoot     string   `json:"state_root" hex:"true"`
-	ReceiptRoot   string   `json:"receipt_root" hex:"true"`
+	ReceiptsRoot  string   `json:"receipts_root" hex:"true"`
 	LogsBloom     string   `json:"logs_bloom" hex:"true"`
-	Random        string   `json:"random" hex:"true"`
+	PrevRandao    string   `json:"prev_randao" hex:"true"`
 	BlockNumber   string   `json:"block_number"`
 	GasLimit      string   `json:"gas_limit"`
 	GasUsed       string   `json:"gas_used"`
@@ -403,13 +431,13 @@ type executionPayloadJson struct {
 	Transactions  []string `json:"transactions" hex:"true"`
 }
 
-type executionPayloadHeaderJson struct {
+type ExecutionPayloadHeaderJson struct {
 	ParentHash       string `json:"parent_hash" hex:"true"`
-	CoinBase         string `json:"coinbase" hex:"true"`
+	FeeRecipient     string `json:"fee_recipient" hex:"true"`
 	StateRoot        string `json:"state_root" hex:"true"`
 	ReceiptRoot      string `json:"receipt_root" hex:"true"`
 	LogsBloom        string `json:"logs_bloom" hex:"true"`
-	Random           string `json:"random" hex:"true"`
+	PrevRandao       string `json:"prev_randao" hex:"true"`
 	BlockNumber      string `json:"block_number"`
 	GasLimit         string `json:"gas_limit"`
 	GasUsed          string `json:"gas_used"`
@@ -420,28 +448,28 @@ type executionPayloadHeaderJson struct {
 	TransactionsRoot string `json:"transactions_root" hex:"true"`
 }
 
-type syncAggregateJson struct {
+type SyncAggregateJson struct {
 	SyncCommitteeBits      string `json:"sync_committee_bits" hex:"true"`
 	SyncCommitteeSignature string `json:"sync_committee_signature" hex:"true"`
 }
 
-type blockHeaderContainerJson struct {
+type BlockHeaderContainerJson struct {
 	Root      string                          `json:"root" hex:"true"`
 	Canonical bool                            `json:"canonical"`
-	Header    *beaconBlockHeaderContainerJson `json:"header"`
+	Header    *BeaconBlockHeaderContainerJson `json:"header"`
 }
 
-type beaconBlockHeaderContainerJson struct {
-	Message   *beaconBlockHeaderJson `json:"message"`
+type BeaconBlockHeaderContainerJson struct {
+	Message   *BeaconBlockHeaderJson `json:"message"`
 	Signature string                 `json:"signature" hex:"true"`
 }
 
-type signedBeaconBlockHeaderJson struct {
-	Header    *beaconBlockHeaderJson `json:"message"`
+type SignedBeaconBlockHeaderJson struct {
+	Header    *BeaconBlockHeaderJson `json:"message"`
 	Signature string                 `json:"signature" hex:"true"`
 }
 
-type beaconBlockHeaderJson struct {
+type BeaconBlockHeaderJson struct {
 	Slot          string `json:"slot"`
 	ProposerIndex string `json:"proposer_index"`
 	ParentRoot    string `json:"parent_root" hex:"true"`
@@ -449,85 +477,85 @@ type beaconBlockHeaderJson struct {
 	BodyRoot      string `json:"body_root" hex:"true"`
 }
 
-type eth1DataJson struct {
+type Eth1DataJson struct {
 	DepositRoot  string `json:"deposit_root" hex:"true"`
 	DepositCount string `json:"deposit_count"`
 	BlockHash    string `json:"block_hash" hex:"true"`
 }
 
-type proposerSlashingJson struct {
-	Header_1 *signedBeaconBlockHeaderJson `json:"signed_header_1"`
-	Header_2 *signedBeaconBlockHeaderJson `json:"signed_header_2"`
+type ProposerSlashingJson struct {
+	Header_1 *SignedBeaconBlockHeaderJson `json:"signed_header_1"`
+	Header_2 *SignedBeaconBlockHeaderJson `json:"signed_header_2"`
 }
 
-type attesterSlashingJson struct {
-	Attestation_1 *indexedAttestationJson `json:"attestation_1"`
-	Attestation_2 *indexedAttestationJson `json:"attestation_2"`
+type AttesterSlashingJson struct {
+	Attestation_1 *IndexedAttestationJson `json:"attestation_1"`
+	Attestation_2 *IndexedAttestationJson `json:"attestation_2"`
 }
 
-type indexedAttestationJson struct {
+type IndexedAttestationJson struct {
 	AttestingIndices []string             `json:"attesting_indices"`
-	Data             *attestationDataJson `json:"data"`
+	Data             *AttestationDataJson `json:"data"`
 	Signature        string               `json:"signature" hex:"true"`
 }
 
-type attestationJson struct {
+type AttestationJson struct {
 	AggregationBits string               `json:"aggregation_bits" hex:"true"`
-	Data            *attestationDataJson `json:"data"`
+	Data            *AttestationDataJson `json:"data"`
 	Signature       string               `json:"signature" hex:"true"`
 }
 
-type attestationDataJson struct {
+type AttestationDataJson struct {
 	Slot            string          `json:"slot"`
 	CommitteeIndex  string          `json:"index"`
 	BeaconBlockRoot string          `json:"beacon_block_root" hex:"true"`
-	Source          *checkpointJson `json:"source"`
-	Target          *checkpointJson `json:"target"`
+	Source          *CheckpointJson `json:"source"`
+	Target          *CheckpointJson `json:"target"`
 }
 
-type depositJson struct {
+type DepositJson struct {
 	Proof []string          `json:"proof" hex:"true"`
-	Data  *deposit_DataJson `json:"data"`
+	Data  *Deposit_DataJson `json:"data"`
 }
 
-type deposit_DataJson struct {
+type Deposit_DataJson struct {
 	PublicKey             string `json:"pubkey" hex:"true"`
 	WithdrawalCredentials string `json:"withdrawal_credentials" hex:"true"`
 	Amount                string `json:"amount"`
 	Signature             string `json:"signature" hex:"true"`
 }
 
-type signedVoluntaryExitJson struct {
-	Exit      *voluntaryExitJson `json:"message"`
+type SignedVoluntaryExitJson struct {
+	Exit      *VoluntaryExitJson `json:"message"`
 	Signature string             `json:"signature" hex:"true"`
 }
 
-type voluntaryExitJson struct {
+type VoluntaryExitJson struct {
 	Epoch          string `json:"epoch"`
 	ValidatorIndex string `json:"validator_index"`
 }
 
-type syncCommitteeMessageJson struct {
+type SyncCommitteeMessageJson struct {
 	Slot            string `json:"slot"`
 	BeaconBlockRoot string `json:"beacon_block_root" hex:"true"`
 	ValidatorIndex  string `json:"validator_index"`
 	Signature       string `json:"signature" hex:"true"`
 }
 
-type identityJson struct {
+type IdentityJson struct {
 	PeerId             string        `json:"peer_id"`
 	Enr                string        `json:"enr"`
 	P2PAddresses       []string      `json:"p2p_addresses"`
 	DiscoveryAddresses []string      `json:"discovery_addresses"`
-	Metadata           *metadataJson `json:"metadata"`
+	Metadata           *MetadataJson `json:"metadata"`
 }
 
-type metadataJson struct {
+type MetadataJson struct {
 	SeqNumber string `json:"seq_number"`
 	Attnets   string `json:"attnets" hex:"true"`
 }
 
-type peerJson struct {
+type PeerJson struct {
 	PeerId    string `json:"peer_id"`
 	Enr       string `json:"enr"`
 	Address   string `json:"last_seen_p2p_address"`
@@ -535,109 +563,109 @@ type peerJson struct {
 	Direction string `json:"direction" enum:"true"`
 }
 
-type versionJson struct {
+type VersionJson struct {
 	Version string `json:"version"`
 }
 
-type beaconStateJson struct {
+type BeaconStateJson struct {
 	GenesisTime                 string                    `json:"genesis_time"`
 	GenesisValidatorsRoot       string                    `json:"genesis_validators_root" hex:"true"`
 	Slot                        string                    `json:"slot"`
-	Fork                        *forkJson                 `json:"fork"`
-	LatestBlockHeader           *beaconBlockHeaderJson    `json:"latest_block_header"`
+	Fork                        *ForkJson                 `json:"fork"`
+	LatestBlockHeader           *BeaconBlockHeaderJson    `json:"latest_block_header"`
 	BlockRoots                  []string                  `json:"block_roots" hex:"true"`
 	StateRoots                  []string                  `json:"state_roots" hex:"true"`
 	HistoricalRoots             []string                  `json:"historical_roots" hex:"true"`
-	Eth1Data                    *eth1DataJson             `json:"eth1_data"`
-	Eth1DataVotes               []*eth1DataJson           `json:"eth1_data_votes"`
+	Eth1Data                    *Eth1DataJson             `json:"eth1_data"`
+	Eth1DataVotes               []*Eth1DataJson           `json:"eth1_data_votes"`
 	Eth1DepositIndex            string                    `json:"eth1_deposit_index"`
-	Validators                  []*validatorJson          `json:"validators"`
+	Validators                  []*ValidatorJson          `json:"validators"`
 	Balances                    []string                  `json:"balances"`
 	RandaoMixes                 []string                  `json:"randao_mixes" hex:"true"`
 	Slashings                   []string                  `json:"slashings"`
-	PreviousEpochAttestations   []*pendingAttestationJson `json:"previous_epoch_attestations"`
-	CurrentEpochAttestations    []*pendingAttestationJson `json:"current_epoch_attestations"`
+	PreviousEpochAttestations   []*PendingAttestationJson `json:"previous_epoch_attestations"`
+	CurrentEpochAttestations    []*PendingAttestationJson `json:"current_epoch_attestations"`
 	JustificationBits           string                    `json:"justification_bits" hex:"true"`
-	PreviousJustifiedCheckpoint *checkpointJson           `json:"previous_justified_checkpoint"`
-	CurrentJustifiedCheckpoint  *checkpointJson           `json:"current_justified_checkpoint"`
-	FinalizedCheckpoint         *checkpointJson           `json:"finalized_checkpoint"`
+	PreviousJustifiedCheckpoint *CheckpointJson           `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint  *CheckpointJson           `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint         *CheckpointJson           `json:"finalized_checkpoint"`
 }
 
-type beaconStateAltairJson struct {
+type BeaconStateAltairJson struct {
 	GenesisTime                 string                 `json:"genesis_time"`
 	GenesisValidatorsRoot       string                 `json:"genesis_validators_root" hex:"true"`
 	Slot                        string                 `json:"slot"`
-	Fork                        *forkJson              `json:"fork"`
-	LatestBlockHeader           *beaconBlockHeaderJson `json:"latest_block_header"`
+	Fork                        *ForkJson              `json:"fork"`
+	LatestBlockHeader           *BeaconBlockHeaderJson `json:"latest_block_header"`
 	BlockRoots                  []string               `json:"block_roots" hex:"true"`
 	StateRoots                  []string               `json:"state_roots" hex:"true"`
 	HistoricalRoots             []string               `json:"historical_roots" hex:"true"`
-	Eth1Data                    *eth1DataJson          `json:"eth1_data"`
-	Eth1DataVotes               []*eth1DataJson        `json:"eth1_data_votes"`
+	Eth1Data                    *Eth1DataJson          `json:"eth1_data"`
+	Eth1DataVotes               []*Eth1DataJson        `json:"eth1_data_votes"`
 	Eth1DepositIndex            string                 `json:"eth1_deposit_index"`
-	Validators                  []*validatorJson       `json:"validators"`
+	Validators                  []*ValidatorJson       `json:"validators"`
 	Balances                    []string               `json:"balances"`
 	RandaoMixes                 []string               `json:"randao_mixes" hex:"true"`
 	Slashings                   []string               `json:"slashings"`
 	PreviousEpochParticipation  EpochParticipation     `json:"previous_epoch_participation"`
 	CurrentEpochParticipation   EpochParticipation     `json:"current_epoch_participation"`
 	JustificationBits           string                 `json:"justification_bits" hex:"true"`
-	PreviousJustifiedCheckpoint *checkpointJson        `json:"previous_justified_checkpoint"`
-	CurrentJustifiedCheckpoint  *checkpointJson        `json:"current_justified_checkpoint"`
-	FinalizedCheckpoint         *checkpointJson        `json:"finalized_checkpoint"`
+	PreviousJustifiedCheckpoint *CheckpointJson        `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint  *CheckpointJson        `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint         *CheckpointJson        `json:"finalized_checkpoint"`
 	InactivityScores            []string               `json:"inactivity_scores"`
-	CurrentSyncCommittee        *syncCommitteeJson     `json:"current_sync_committee"`
-	NextSyncCommittee           *syncCommitteeJson     `json:"next_sync_committee"`
+	CurrentSyncCommittee        *SyncCommitteeJson     `json:"current_sync_committee"`
+	NextSyncCommittee           *SyncCommitteeJson     `json:"next_sync_committee"`
 }
 
-type beaconStateBellatrixJson struct {
+type BeaconStateBellatrixJson struct {
 	GenesisTime                  string                      `json:"genesis_time"`
 	GenesisValidatorsRoot        string                      `json:"genesis_validators_root" hex:"true"`
 	Slot                         string                      `json:"slot"`
-	Fork                         *forkJson                   `json:"fork"`
-	LatestBlockHeader            *beaconBlockHeaderJson      `json:"latest_block_header"`
+	Fork                         *ForkJson                   `json:"fork"`
+	LatestBlockHeader            *BeaconBlockHeaderJson      `json:"latest_block_header"`
 	BlockRoots                   []string                    `json:"block_roots" hex:"true"`
 	StateRoots                   []string                    `json:"state_roots" hex:"true"`
 	HistoricalRoots              []string                    `json:"historical_roots" hex:"true"`
-	Eth1Data                     *eth1DataJson               `json:"eth1_data"`
-	Eth1DataVotes                []*eth1DataJson             `json:"eth1_data_votes"`
+	Eth1Data                     *Eth1DataJson               `json:"eth1_data"`
+	Eth1DataVotes                []*Eth1DataJson             `json:"eth1_data_votes"`
 	Eth1DepositIndex             string                      `json:"eth1_deposit_index"`
-	Validators                   []*validatorJson            `json:"validators"`
+	Validators                   []*ValidatorJson            `json:"validators"`
 	Balances                     []string                    `json:"balances"`
 	RandaoMixes                  []string                    `json:"randao_mixes" hex:"true"`
 	Slashings                    []string                    `json:"slashings"`
 	PreviousEpochParticipation   EpochParticipation          `json:"previous_epoch_participation"`
 	CurrentEpochParticipation    EpochParticipation          `json:"current_epoch_participation"`
 	JustificationBits            string                      `json:"justification_bits" hex:"true"`
-	PreviousJustifiedCheckpoint  *checkpointJson             `json:"previous_justified_checkpoint"`
-	CurrentJustifiedCheckpoint   *checkpointJson             `json:"current_justified_checkpoint"`
-	FinalizedCheckpoint          *checkpointJson             `json:"finalized_checkpoint"`
+	PreviousJustifiedCheckpoint  *CheckpointJson             `json:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint   *CheckpointJson             `json:"current_justified_checkpoint"`
+	FinalizedCheckpoint          *CheckpointJson             `json:"finalized_checkpoint"`
 	InactivityScores             []string                    `json:"inactivity_scores"`
-	CurrentSyncCommittee         *syncCommitteeJson          `json:"current_sync_committee"`
-	NextSyncCommittee            *syncCommitteeJson          `json:"next_sync_committee"`
-	LatestExecutionPayloadHeader *executionPayloadHeaderJson `json:"latest_execution_payload_header"`
+	CurrentSyncCommittee         *SyncCommitteeJson          `json:"current_sync_committee"`
+	NextSyncCommittee            *SyncCommitteeJson          `json:"next_sync_committee"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeaderJson `json:"latest_execution_payload_header"`
 }
 
-type beaconStateContainerV2Json struct {
-	Phase0State    *beaconStateJson          `json:"phase0_state"`
-	AltairState    *beaconStateAltairJson    `json:"altair_state"`
-	BellatrixState *beaconStateBellatrixJson `json:"bellatrix_state"`
+type BeaconStateContainerV2Json struct {
+	Phase0State    *BeaconStateJson          `json:"phase0_state"`
+	AltairState    *BeaconStateAltairJson    `json:"altair_state"`
+	BellatrixState *BeaconStateBellatrixJson `json:"bellatrix_state"`
 }
 
-type forkJson struct {
+type ForkJson struct {
 	PreviousVersion string `json:"previous_version" hex:"true"`
 	CurrentVersion  string `json:"current_version" hex:"true"`
 	Epoch           string `json:"epoch"`
 }
 
-type validatorContainerJson struct {
+type ValidatorContainerJson struct {
 	Index     string         `json:"index"`
 	Balance   string         `json:"balance"`
 	Status    string         `json:"status" enum:"true"`
-	Validator *validatorJson `json:"validator"`
+	Validator *ValidatorJson `json:"validator"`
 }
 
-type validatorJson struct {
+type ValidatorJson struct {
 	PublicKey                  string `json:"pubkey" hex:"true"`
 	WithdrawalCredentials      string `json:"withdrawal_credentials" hex:"true"`
 	EffectiveBalance           string `json:"effective_balance"`
@@ -648,51 +676,88 @@ type validatorJson struct {
 	WithdrawableEpoch          string `json:"withdrawable_epoch"`
 }
 
-type validatorBalanceJson struct {
+type ValidatorBalanceJson struct {
 	Index   string `json:"index"`
 	Balance string `json:"balance"`
 }
 
-type committeeJson struct {
+type CommitteeJson struct {
 	Index      string   `json:"index"`
 	Slot       string   `json:"slot"`
 	Validators []string `json:"validators"`
 }
 
-type syncCommitteeJson struct {
+type SyncCommitteeJson struct {
 	Pubkeys         []string `json:"pubkeys" hex:"true"`
 	AggregatePubkey string   `json:"aggregate_pubkey" hex:"true"`
 }
 
-type syncCommitteeValidatorsJson struct {
+type LightClientBootstrapJson struct {
+	Header                     *BeaconBlockHeaderJson `json:"header"`
+	CurrentSyncCommittee       *SyncCommitteeJson     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string               `json:"current_sync_committee_branch" hex:"true"`
+}
+
+type LightClientUpdateWithVersionJson struct {
+	Version string                 `json:"version" enum:"true"`
+	Data    *LightClientUpdateJson `json:"data"`
+}
+
+type LightClientUpdateJson struct {
+	AttestedHeader          *BeaconBlockHeaderJson `json:"attested_header"`
+	NextSyncCommittee       *SyncCommitteeJson     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string               `json:"next_sync_committee_branch" hex:"true"`
+	FinalizedHeader         *BeaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch          []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate           *SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot           string                 `json:"signature_slot"`
+}
+
+type LightClientFinalityUpdateJson struct {
+	AttestedHeader  *BeaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *BeaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate   *SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                 `json:"signature_slot"`
+}
+
+type LightClientOptimisticUpdateJson struct {
+	AttestedHeader *BeaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                 `json:"signature_slot"`
+}
+
+type SyncCommitteeValidatorsJson struct {
 	Validators          []string   `json:"validators"`
 	ValidatorAggregates [][]string `json:"validator_aggregates"`
 }
 
-type pendingAttestationJson struct {
+type PendingAttestationJson struct {
 	AggregationBits string               `json:"aggregation_bits" hex:"true"`
-	Data            *attestationDataJson `json:"data"`
+	Data            *AttestationDataJson `json:"data"`
 	InclusionDelay  string               `json:"inclusion_delay"`
 	ProposerIndex   string               `json:"proposer_index"`
 }
 
-type forkChoiceHeadJson struct {
+type ForkChoiceHeadJson struct {
 	Root string `json:"root" hex:"true"`
 	Slot string `json:"slot"`
 }
 
-type depositContractJson struct {
+type DepositContractJson struct {
 	ChainId string `json:"chain_id"`
 	Address string `json:"address"`
 }
 
-type syncInfoJson struct {
-	HeadSlot     string `json:"head_slot"`
-	SyncDistance string `json:"sync_distance"`
-	IsSyncing    bool   `json:"is_syncing"`
+type SyncInfoJson struct {
+	HeadSlot         string `json:"head_slot"`
+	SyncDistance     string `json:"sync_distance"`
+	IsSyncing        bool   `json:"is_syncing"`
+	IsBackfilling    bool   `json:"is_backfilling"`
+	BackfillDistance string `json:"backfill_distance"`
 }
 
-type attesterDutyJson struct {
+type AttesterDutyJson struct {
 	Pubkey                  string `json:"pubkey" hex:"true"`
 	ValidatorIndex          string `json:"validator_index"`
 	CommitteeIndex          string `json:"committee_index"`
@@ -702,41 +767,47 @@ type attesterDutyJson struct {
 	Slot                    string `json:"slot"`
 }
 
-type proposerDutyJson struct {
+type ProposerDutyJson struct {
 	Pubkey         string `json:"pubkey" hex:"true"`
 	ValidatorIndex string `json:"validator_index"`
 	Slot           string `json:"slot"`
 }
 
-type syncCommitteeDuty struct {
+type SyncCommitteeDuty struct {
 	Pubkey                        string   `json:"pubkey" hex:"true"`
 	ValidatorIndex                string   `json:"validator_index"`
 	ValidatorSyncCommitteeIndices []string `json:"validator_sync_committee_indices"`
 }
 
-type signedAggregateAttestationAndProofJson struct {
-	Message   *aggregateAttestationAndProofJson `json:"message"`
+type LivenessJson struct {
+	Index  string `json:"index"`
+	Epoch  string `json:"epoch"`
+	IsLive bool   `json:"is_live"`
+}
+
+type SignedAggregateAttestationAndProofJson struct {
+	Message   *AggregateAttestationAndProofJson `json:"message"`
 	Signature string                            `json:"signature" hex:"true"`
 }
 
-type aggregateAttestationAndProofJson struct {
+type AggregateAttestationAndProofJson struct {
 	AggregatorIndex string           `json:"aggregator_index"`
-	Aggregate       *attestationJson `json:"aggregate"`
+	Aggregate       *AttestationJson `json:"aggregate"`
 	SelectionProof  string           `json:"selection_proof" hex:"true"`
 }
 
-type signedContributionAndProofJson struct {
-	Message   *contributionAndProofJson `json:"message"`
+type SignedContributionAndProofJson struct {
+	Message   *ContributionAndProofJson `json:"message"`
 	Signature string                    `json:"signature" hex:"true"`
 }
 
-type contributionAndProofJson struct {
+type ContributionAndProofJson struct {
 	AggregatorIndex string                         `json:"aggregator_index"`
-	Contribution    *syncCommitteeContributionJson `json:"contribution"`
+	Contribution    *SyncCommitteeContributionJson `json:"contribution"`
 	SelectionProof  string                         `json:"selection_proof" hex:"true"`
 }
 
-type syncCommitteeContributionJson struct {
+type SyncCommitteeContributionJson struct {
 	Slot              string `json:"slot"`
 	BeaconBlockRoot   string `json:"beacon_block_root" hex:"true"`
 	SubcommitteeIndex string `json:"subcommittee_index"`
@@ -754,57 +825,57 @@ type sszResponseJson interface {
 	SSZData() string
 }
 
-// blockSSZResponseJson is used in /beacon/blocks/{block_id} API endpoint.
-type blockSSZResponseJson struct {
+// BlockSSZResponseJson is used in /beacon/blocks/{block_id} API endpoint.
+type BlockSSZResponseJson struct {
 	Data string `json:"data"`
 }
 
-func (ssz *blockSSZResponseJson) SSZData() string {
+func (ssz *BlockSSZResponseJson) SSZData() string {
 	return ssz.Data
 }
 
-func (*blockSSZResponseJson) SSZVersion() string {
+func (*BlockSSZResponseJson) SSZVersion() string {
 	return strings.ToLower(ethpbv2.Version_PHASE0.String())
 }
 
-// blockSSZResponseV2Json is used in /v2/beacon/blocks/{block_id} API endpoint.
-type blockSSZResponseV2Json struct {
+// BlockSSZResponseV2Json is used in /v2/beacon/blocks/{block_id} API endpoint.
+type BlockSSZResponseV2Json struct {
 	Version string `json:"version"`
 	Data    string `json:"data"`
 }
 
-func (ssz *blockSSZResponseV2Json) SSZData() string {
+func (ssz *BlockSSZResponseV2Json) SSZData() string {
 	return ssz.Data
 }
 
-func (ssz *blockSSZResponseV2Json) SSZVersion() string {
+func (ssz *BlockSSZResponseV2Json) SSZVersion() string {
 	return ssz.Version
 }
 
-// beaconStateSSZResponseJson is used in /debug/beacon/states/{state_id} API endpoint.
-type beaconStateSSZResponseJson struct {
+// BeaconStateSSZResponseJson is used in /debug/beacon/states/{state_id} API endpoint.
+type BeaconStateSSZResponseJson struct {
 	Data string `json:"data"`
 }
 
-func (ssz *beaconStateSSZResponseJson) SSZData() string {
+func (ssz *BeaconStateSSZResponseJson) SSZData() string {
 	return ssz.Data
 }
 
-func (*beaconStateSSZResponseJson) SSZVersion() string {
+func (*BeaconStateSSZResponseJson) SSZVersion() string {
 	return strings.ToLower(ethpbv2.Version_PHASE0.String())
 }
 
-// beaconStateSSZResponseV2Json is used in /v2/debug/beacon/states/{state_id} API endpoint.
-type beaconStateSSZResponseV2Json struct {
+// BeaconStateSSZResponseV2Json is used in /v2/debug/beacon/states/{state_id} API endpoint.
+type BeaconStateSSZResponseV2Json struct {
 	Version string `json:"version"`
 	Data    string `json:"data"`
 }
 
-func (ssz *beaconStateSSZResponseV2Json) SSZData() string {
+func (ssz *BeaconStateSSZResponseV2Json) SSZData() string {
 	return ssz.Data
 }
 
-func (ssz *beaconStateSSZResponseV2Json) SSZVersion() string {
+func (ssz *BeaconStateSSZResponseV2Json) SSZVersion() string {
 	return ssz.Version
 }
 
@@ -812,7 +883,7 @@ func (ssz *beaconStateSSZResponseV2Json) SSZVersion() string {
 // Events.
 // ---------------
 
-type eventHeadJson struct {
+type EventHeadJson struct {
 	Slot                      string `json:"slot"`
 	Block                     string `json:"block" hex:"true"`
 	State                     string `json:"state" hex:"true"`
@@ -821,22 +892,22 @@ type eventHeadJson struct {
 	CurrentDutyDependentRoot  string `json:"current_duty_dependent_root" hex:"true"`
 }
 
-type receivedBlockDataJson struct {
+type ReceivedBlockDataJson struct {
 	Slot  string `json:"slot"`
 	Block string `json:"block" hex:"true"`
 }
 
-type aggregatedAttReceivedDataJson struct {
-	Aggregate *attestationJson `json:"aggregate"`
+type AggregatedAttReceivedDataJson struct {
+	Aggregate *AttestationJson `json:"aggregate"`
 }
 
-type eventFinalizedCheckpointJson struct {
+type EventFinalizedCheckpointJson struct {
 	Block string `json:"block" hex:"true"`
 	State string `json:"state" hex:"true"`
 	Epoch string `json:"epoch"`
 }
 
-type eventChainReorgJson struct {
+type EventChainReorgJson struct {
 	Slot         string `json:"slot"`
 	Depth        string `json:"depth"`
 	OldHeadBlock string `json:"old_head_block" hex:"true"`
@@ -846,33 +917,53 @@ type eventChainReorgJson struct {
 	Epoch        string `json:"epoch"`
 }
 
+type EventPayloadAttributesJson struct {
+	ProposalSlot      string                 `json:"proposal_slot"`
+	ProposerIndex     string                 `json:"proposer_index"`
+	ParentBlockRoot   string                 `json:"parent_block_root" hex:"true"`
+	ParentBlockHash   string                 `json:"parent_block_hash" hex:"true"`
+	PayloadAttributes *PayloadAttributesJson `json:"payload_attributes"`
+}
+
+type PayloadAttributesJson struct {
+	Timestamp             string `json:"timestamp"`
+	PrevRandao            string `json:"prev_randao" hex:"true"`
+	SuggestedFeeRecipient string `json:"suggested_fee_recipient" hex:"true"`
+}
+
+type EventBlockValidityJson struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block" hex:"true"`
+	Valid bool   `json:"valid"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
 
-// indexedVerificationFailureErrorJson is a JSON representation of the error returned when verifying an indexed object.
-type indexedVerificationFailureErrorJson struct {
+// IndexedVerificationFailureErrorJson is a JSON representation of the error returned when verifying an indexed object.
+type IndexedVerificationFailureErrorJson struct {
 	apimiddleware.DefaultErrorJson
-	Failures []*singleIndexedVerificationFailureJson `json:"failures"`
+	Failures []*SingleIndexedVerificationFailureJson `json:"failures"`
 }
 
-// singleIndexedVerificationFailureJson is a JSON representation of a an issue when verifying a single indexed object e.g. an item in an array.
-type singleIndexedVerificationFailureJson struct {
+// SingleIndexedVerificationFailureJson is a JSON representation of a an issue when verifying a single indexed object e.g. an item in an array.
+type SingleIndexedVerificationFailureJson struct {
 	Index   int    `json:"index"`
 	Message string `json:"message"`
 }
 
-type nodeSyncDetailsErrorJson struct {
+type NodeSyncDetailsErrorJson struct {
 	apimiddleware.DefaultErrorJson
-	SyncDetails syncDetails `json:"sync_details"`
+	SyncDetails SyncDetails `json:"sync_details"`
 }
 
-type eventErrorJson struct {
+type EventErrorJson struct {
 	StatusCode int    `json:"status_code"`
 	Message    string `json:"message"`
 }
 
-type syncDetails struct {
+type SyncDetails struct {
 	HeadSlot     string `json:"head_slot"`
 	SyncDistance string `json:"sync_distance"`
 	IsSyncing    bool   `json:"is_syncing"`
@@ -18,6 +18,7 @@ import (
 	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -41,6 +42,23 @@ func (e *blockIdParseError) Error() string {
 	return e.message
 }
 
+// blockPrunedError represents an error scenario where the blocks of a slot were pruned from the database.
+type blockPrunedError struct {
+	message string
+}
+
+// newBlockPrunedError creates a new error instance.
+func newBlockPrunedError(slot, prunedSlot types.Slot) blockPrunedError {
+	return blockPrunedError{
+		message: fmt.Sprintf("blocks at slot %d were pruned, history is only kept from slot %d", slot, prunedSlot),
+	}
+}
+
+// Error returns the underlying error message.
+func (e *blockPrunedError) Error() string {
+	return e.message
+}
+
 // GetBlockHeader retrieves block header for given block id.
 func (bs *Server) GetBlockHeader(ctx context.Context, req *ethpbv1.BlockRequest) (*ethpbv1.BlockHeaderResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon.GetBlockHeader")
@@ -109,6 +127,11 @@ func (bs *Server) ListBlockHeaders(ctx context.Context, req *ethpbv1.BlockHeader
 		}
 	}
 	if len(blks) == 0 {
+		if req.Slot != nil {
+			if err := bs.checkBlocksPruned(ctx, *req.Slot); err != nil {
+				return nil, handleGetBlockError(nil, err)
+			}
+		}
 		return nil, status.Error(codes.NotFound, "Could not find requested blocks")
 	}
 
@@ -279,6 +302,16 @@ func (bs *Server) GetBlockV2(ctx context.Context, req *ethpbv2.BlockRequestV2) (
 	if err != nil {
 		return nil, err
 	}
+	// Blocks stored with only their execution payload header are served in full.
+	if blk.Version() == version.BellatrixBlind {
+		if bs.ExecutionPayloadReconstructor == nil {
+			return nil, status.Error(codes.Unavailable, "No execution client to reconstruct the full block with")
+		}
+		blk, err = bs.ExecutionPayloadReconstructor.ReconstructFullBellatrixBlock(ctx, blk)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not reconstruct full block: %v", err)
+		}
+	}
 	if phase0Blk != nil {
 		v1Blk, err := migration.SignedBeaconBlock(blk)
 		if err != nil {
@@ -424,6 +457,9 @@ func (bs *Server) GetBlockRoot(ctx context.Context, req *ethpbv1.BlockRequest) (
 			}
 
 			if !hasRoots {
+				if err := bs.checkBlocksPruned(ctx, types.Slot(slot)); err != nil {
+					return nil, handleGetBlockError(nil, err)
+				}
 				return nil, status.Error(codes.NotFound, "Could not find any blocks with given slot")
 			}
 			root = roots[0][:]
@@ -544,7 +580,7 @@ func (bs *Server) blockFromBlockID(ctx context.Context, blockId []byte) (block.S
 
 			numBlks := len(blks)
 			if numBlks == 0 {
-				return nil, nil
+				return nil, bs.checkBlocksPruned(ctx, types.Slot(slot))
 			}
 			blk = blks[0]
 			if numBlks == 1 {
@@ -566,9 +602,16 @@ func (bs *Server) blockFromBlockID(ctx context.Context, blockId []byte) (block.S
 }
 
 func handleGetBlockError(blk block.SignedBeaconBlock, err error) error {
+	// Errors which were already handled keep their status code.
+	if st, ok := status.FromError(err); ok && st.Code() != codes.OK {
+		return err
+	}
 	if invalidBlockIdErr, ok := err.(*blockIdParseError); ok {
 		return status.Errorf(codes.InvalidArgument, "Invalid block ID: %v", invalidBlockIdErr)
 	}
+	if prunedErr, ok := err.(*blockPrunedError); ok {
+		return status.Errorf(codes.OutOfRange, "Block pruned: %v", prunedErr)
+	}
 	if err != nil {
 		return status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
 	}
@@ -577,3 +620,16 @@ func handleGetBlockError(blk block.SignedBeaconBlock, err error) error {
 	}
 	return nil
 }
+
+// checkBlocksPruned returns a blockPrunedError if the blocks at the given slot were pruned from the database.
+func (bs *Server) checkBlocksPruned(ctx context.Context, slot types.Slot) error {
+	prunedSlot, err := bs.BeaconDB.HistoryPrunedSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get history pruned slot")
+	}
+	if slot > 0 && slot < prunedSlot {
+		prunedErr := newBlockPrunedError(slot, prunedSlot)
+		return &prunedErr
+	}
+	return nil
+}
@@ -8,8 +8,11 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockEngine "github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1/mocks"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
@@ -20,6 +23,8 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func fillDBTestBlocks(ctx context.Context, t *testing.T, beaconDB db.Database) (*ethpbalpha.SignedBeaconBlock, []*ethpbalpha.BeaconBlockContainer) {
@@ -865,6 +870,36 @@ func TestServer_GetBlockV2(t *testing.T) {
 			})
 		}
 	})
+	t.Run("Bellatrix blinded", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableOnlyBlindedBeaconBlocks: true})
+		defer resetCfg()
+		beaconDB := dbTest.SetupDB(t)
+		ctx := context.Background()
+
+		b := util.NewBeaconBlockBellatrix()
+		b.Block.Slot = 30
+		b.Block.Body.ExecutionPayload.Transactions = [][]byte{[]byte("tx")}
+		full, err := wrapper.WrappedBellatrixSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, full))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+
+		bs := &Server{BeaconDB: beaconDB, ChainInfoFetcher: &mock.ChainService{}}
+		_, err = bs.GetBlockV2(ctx, &ethpbv2.BlockRequestV2{BlockId: root[:]})
+		require.ErrorContains(t, "No execution client to reconstruct the full block with", err)
+
+		bs.ExecutionPayloadReconstructor = &mockEngine.EngineClient{
+			ReconstructedBlocks: map[[32]byte]block.SignedBeaconBlock{root: full},
+		}
+		blk, err := bs.GetBlockV2(ctx, &ethpbv2.BlockRequestV2{BlockId: root[:]})
+		require.NoError(t, err)
+		v2Block, err := migration.V1Alpha1BeaconBlockBellatrixToV2(b.Block)
+		require.NoError(t, err)
+		got, ok := blk.Data.Message.(*ethpbv2.SignedBeaconBlockContainerV2_BellatrixBlock)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, v2Block, got.BellatrixBlock)
+	})
 }
 
 func TestServer_GetBlockSSZ(t *testing.T) {
@@ -1077,6 +1112,41 @@ func TestServer_GetBlockRoot(t *testing.T) {
 	}
 }
 
+func TestServer_GetBlock_Pruned(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{HistoryRetentionEpochs: 1})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, beaconDB.Close())
+	})
+	genesis := bytesutil.ToBytes32([]byte("genesis"))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesis))
+	parent := genesis
+	for _, slot := range []types.Slot{32, 64} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		parent, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(64))
+	require.NoError(t, beaconDB.SaveState(ctx, st, parent))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpbalpha.Checkpoint{Epoch: 2, Root: parent[:]}))
+	require.NoError(t, beaconDB.PruneHistory(ctx, 2))
+
+	bs := &Server{BeaconDB: beaconDB}
+	_, err = bs.GetBlockV2(ctx, &ethpbv2.BlockRequestV2{BlockId: []byte("32")})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	assert.ErrorContains(t, "blocks at slot 32 were pruned, history is only kept from slot 64", err)
+	_, err = bs.GetBlockRoot(ctx, &ethpbv1.BlockRequest{BlockId: []byte("32")})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = bs.GetBlockRoot(ctx, &ethpbv1.BlockRequest{BlockId: []byte("65")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 func TestServer_ListBlockAttestations(t *testing.T) {
 	t.Run("Phase 0", func(t *testing.T) {
 		beaconDB := dbTest.SetupDB(t)
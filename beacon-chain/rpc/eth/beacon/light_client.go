@@ -0,0 +1,120 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLightClientBootstrap retrieves the light client bootstrap of the requested block root.
+func (bs *Server) GetLightClientBootstrap(ctx context.Context, req *ethpbv2.LightClientBootstrapRequest) (*ethpbv2.LightClientBootstrapResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetLightClientBootstrap")
+	defer span.End()
+
+	if len(req.BlockRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid block root length %d", len(req.BlockRoot))
+	}
+	bootstrap, err := bs.BeaconDB.LightClientBootstrap(ctx, bytesutil.ToBytes32(req.BlockRoot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get light client bootstrap: %v", err)
+	}
+	if bootstrap == nil {
+		return nil, status.Errorf(codes.NotFound, "No light client bootstrap found for block root %#x", req.BlockRoot)
+	}
+	v2Bootstrap, err := migration.V1Alpha1LightClientBootstrapToV2(bootstrap)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert light client bootstrap: %v", err)
+	}
+	return &ethpbv2.LightClientBootstrapResponse{
+		Version: lightClientVersion(bootstrap.Header.Slot),
+		Data:    v2Bootstrap,
+	}, nil
+}
+
+// GetLightClientUpdatesByRange retrieves the best light client update of each sync committee period
+// in the requested range, stopping at the first period without an update.
+func (bs *Server) GetLightClientUpdatesByRange(ctx context.Context, req *ethpbv2.LightClientUpdatesByRangeRequest) (*ethpbv2.LightClientUpdatesByRangeResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetLightClientUpdatesByRange")
+	defer span.End()
+
+	if req.Count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Count must be greater than 0")
+	}
+	count := req.Count
+	if maxCount := params.BeaconNetworkConfig().MaxRequestLightClientUpdates; count > maxCount {
+		count = maxCount
+	}
+	updates, err := bs.BeaconDB.LightClientUpdates(ctx, req.StartPeriod, count)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get light client updates: %v", err)
+	}
+	resp := &ethpbv2.LightClientUpdatesByRangeResponse{
+		Data: make([]*ethpbv2.LightClientUpdateWithVersion, len(updates)),
+	}
+	for i, update := range updates {
+		v2Update, err := migration.V1Alpha1LightClientUpdateToV2(update)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert light client update: %v", err)
+		}
+		resp.Data[i] = &ethpbv2.LightClientUpdateWithVersion{
+			Version: lightClientVersion(update.AttestedHeader.Slot),
+			Data:    v2Update,
+		}
+	}
+	return resp, nil
+}
+
+// GetLightClientFinalityUpdate retrieves the latest light client finality update known to the node.
+func (bs *Server) GetLightClientFinalityUpdate(ctx context.Context, _ *empty.Empty) (*ethpbv2.LightClientFinalityUpdateWithVersion, error) {
+	_, span := trace.StartSpan(ctx, "beacon.GetLightClientFinalityUpdate")
+	defer span.End()
+
+	update := bs.LightClientFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		return nil, status.Error(codes.NotFound, "No light client finality update available")
+	}
+	v2Update, err := migration.V1Alpha1LightClientFinalityUpdateToV2(update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert light client finality update: %v", err)
+	}
+	return &ethpbv2.LightClientFinalityUpdateWithVersion{
+		Version: lightClientVersion(update.AttestedHeader.Slot),
+		Data:    v2Update,
+	}, nil
+}
+
+// GetLightClientOptimisticUpdate retrieves the latest light client optimistic update known to the node.
+func (bs *Server) GetLightClientOptimisticUpdate(ctx context.Context, _ *empty.Empty) (*ethpbv2.LightClientOptimisticUpdateWithVersion, error) {
+	_, span := trace.StartSpan(ctx, "beacon.GetLightClientOptimisticUpdate")
+	defer span.End()
+
+	update := bs.LightClientFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		return nil, status.Error(codes.NotFound, "No light client optimistic update available")
+	}
+	v2Update, err := migration.V1Alpha1LightClientOptimisticUpdateToV2(update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert light client optimistic update: %v", err)
+	}
+	return &ethpbv2.LightClientOptimisticUpdateWithVersion{
+		Version: lightClientVersion(update.AttestedHeader.Slot),
+		Data:    v2Update,
+	}, nil
+}
+
+// lightClientVersion returns the fork version of light client data whose header is at the given slot.
+func lightClientVersion(slot types.Slot) ethpbv2.Version {
+	if slots.ToEpoch(slot) >= params.BeaconConfig().BellatrixForkEpoch {
+		return ethpbv2.Version_BELLATRIX
+	}
+	return ethpbv2.Version_ALTAIR
+}
@@ -0,0 +1,114 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestServer_GetLightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	bs := &Server{BeaconDB: beaconDB}
+	root := [32]byte{'a'}
+	bootstrap := util.NewLightClientBootstrap(100)
+	require.NoError(t, beaconDB.SaveLightClientBootstrap(ctx, root, bootstrap))
+
+	resp, err := bs.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: root[:]})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbv2.Version_ALTAIR, resp.Version)
+	assert.Equal(t, types.Slot(100), resp.Data.Header.Slot)
+	assert.DeepEqual(t, bootstrap.Header.StateRoot, resp.Data.Header.StateRoot)
+	assert.DeepEqual(t, bootstrap.CurrentSyncCommittee.Pubkeys, resp.Data.CurrentSyncCommittee.Pubkeys)
+	assert.DeepEqual(t, bootstrap.CurrentSyncCommitteeBranch, resp.Data.CurrentSyncCommitteeBranch)
+
+	_, err = bs.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: make([]byte, 32)})
+	assert.ErrorContains(t, "No light client bootstrap found", err)
+	_, err = bs.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: []byte{'a'}})
+	assert.ErrorContains(t, "Invalid block root length", err)
+}
+
+func TestServer_GetLightClientUpdatesByRange(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	bs := &Server{BeaconDB: beaconDB}
+	slotsPerPeriod := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	for _, period := range []uint64{1, 2, 4} {
+		require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, util.NewLightClientUpdate(types.Slot(period)*slotsPerPeriod)))
+	}
+
+	resp, err := bs.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 1, Count: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, slotsPerPeriod, resp.Data[0].Data.AttestedHeader.Slot)
+	assert.Equal(t, 2*slotsPerPeriod, resp.Data[1].Data.AttestedHeader.Slot)
+	assert.Equal(t, 2*slotsPerPeriod+1, resp.Data[1].Data.SignatureSlot)
+	assert.Equal(t, ethpbv2.Version_ALTAIR, resp.Data[0].Version)
+
+	resp, err = bs.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 1, Count: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+
+	resp, err = bs.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 5, Count: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Data))
+
+	_, err = bs.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 1})
+	assert.ErrorContains(t, "Count must be greater than 0", err)
+}
+
+func TestServer_GetLightClientFinalityUpdate(t *testing.T) {
+	ctx := context.Background()
+	chain := &mock.ChainService{}
+	bs := &Server{LightClientFetcher: chain}
+
+	_, err := bs.GetLightClientFinalityUpdate(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "No light client finality update available", err)
+
+	update := util.NewLightClientUpdate(100)
+	chain.FinalityUpdate = &ethpbalpha.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+	resp, err := bs.GetLightClientFinalityUpdate(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbv2.Version_ALTAIR, resp.Version)
+	assert.Equal(t, types.Slot(100), resp.Data.AttestedHeader.Slot)
+	assert.DeepEqual(t, update.FinalityBranch, resp.Data.FinalityBranch)
+	assert.Equal(t, types.Slot(101), resp.Data.SignatureSlot)
+}
+
+func TestServer_GetLightClientOptimisticUpdate(t *testing.T) {
+	ctx := context.Background()
+	chain := &mock.ChainService{}
+	bs := &Server{LightClientFetcher: chain}
+
+	_, err := bs.GetLightClientOptimisticUpdate(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "No light client optimistic update available", err)
+
+	update := util.NewLightClientUpdate(100)
+	chain.OptimisticUpdate = &ethpbalpha.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+	resp, err := bs.GetLightClientOptimisticUpdate(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbv2.Version_ALTAIR, resp.Version)
+	assert.Equal(t, types.Slot(100), resp.Data.AttestedHeader.Slot)
+	assert.DeepEqual(t, update.SyncAggregate.SyncCommitteeBits, resp.Data.SyncAggregate.SyncCommitteeBits)
+	assert.Equal(t, types.Slot(101), resp.Data.SignatureSlot)
+}
@@ -66,6 +66,8 @@ func (bs *Server) GetStateRoot(ctx context.Context, req *ethpb.StateRequest) (*e
 	if err != nil {
 		if rootNotFoundErr, ok := err.(*statefetcher.StateRootNotFoundError); ok {
 			return nil, status.Errorf(codes.NotFound, "State root not found: %v", rootNotFoundErr)
+		} else if prunedErr, ok := err.(*statefetcher.StatePrunedError); ok {
+			return nil, status.Errorf(codes.OutOfRange, "State pruned: %v", prunedErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
 		}
@@ -91,7 +93,7 @@ func (bs *Server) GetStateFork(ctx context.Context, req *ethpb.StateRequest) (*e
 
 	st, err = bs.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 
 	fork := st.Fork()
@@ -119,8 +121,12 @@ func (bs *Server) GetFinalityCheckpoints(ctx context.Context, req *ethpb.StateRe
 	if err != nil {
 		if stateNotFoundErr, ok := err.(*statefetcher.StateNotFoundError); ok {
 			return nil, status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
+		} else if prunedErr, ok := err.(*statefetcher.StatePrunedError); ok {
+			return nil, status.Errorf(codes.OutOfRange, "State pruned: %v", prunedErr)
 		} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+		} else if _, ok := err.(*statefetcher.StatesOverloadedError); ok {
+			return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 		}
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
@@ -147,14 +153,14 @@ func (bs *Server) stateFromRequest(ctx context.Context, req *stateRequest) (stat
 		}
 		st, err := bs.StateFetcher.State(ctx, []byte(strconv.FormatUint(uint64(slot), 10)))
 		if err != nil {
-			return nil, helpers.PrepareStateFetchGRPCError(err)
+			return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 		}
 		return st, nil
 	}
 	var err error
 	st, err := bs.StateFetcher.State(ctx, req.stateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 	return st, nil
 }
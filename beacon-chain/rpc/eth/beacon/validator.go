@@ -44,7 +44,7 @@ func (bs *Server) GetValidator(ctx context.Context, req *ethpb.StateValidatorReq
 
 	st, err := bs.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 	if len(req.ValidatorId) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Validator ID is required")
@@ -66,7 +66,7 @@ func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidators
 
 	st, err := bs.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 
 	valContainers, err := valContainersByRequestIds(st, req.Id)
@@ -116,7 +116,7 @@ func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.Validato
 
 	st, err := bs.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 
 	valContainers, err := valContainersByRequestIds(st, req.Id)
@@ -141,7 +141,7 @@ func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommittees
 
 	st, err := bs.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 
 	epoch := slots.ToEpoch(st.Slot())
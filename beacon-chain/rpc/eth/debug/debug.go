@@ -22,7 +22,7 @@ func (ds *Server) GetBeaconState(ctx context.Context, req *ethpbv1.StateRequest)
 
 	beaconSt, err := ds.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 
 	if beaconSt.Version() != version.Phase0 {
@@ -45,7 +45,7 @@ func (ds *Server) GetBeaconStateSSZ(ctx context.Context, req *ethpbv1.StateReque
 
 	state, err := ds.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 
 	sszState, err := state.MarshalSSZ()
@@ -63,7 +63,7 @@ func (ds *Server) GetBeaconStateV2(ctx context.Context, req *ethpbv2.StateReques
 
 	beaconSt, err := ds.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 	switch beaconSt.Version() {
 	case version.Phase0:
@@ -119,7 +119,7 @@ func (ds *Server) GetBeaconStateSSZV2(ctx context.Context, req *ethpbv2.StateReq
 
 	state, err := ds.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
-		return nil, helpers.PrepareStateFetchGRPCError(err)
+		return nil, helpers.PrepareStateFetchGRPCError(ctx, err)
 	}
 
 	sszState, err := state.MarshalSSZ()
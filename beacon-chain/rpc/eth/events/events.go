@@ -33,6 +33,15 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// PayloadAttributesTopic represents a new payload attributes event topic, sent when the node
+	// asks the execution engine to build a payload for a proposal.
+	PayloadAttributesTopic = "payload_attributes"
+	// BlockValidityTopic represents an optimistic block becoming valid or invalid event topic.
+	BlockValidityTopic = "block_validity"
+	// ProposerSlashingTopic represents a new proposer slashing inserted into the pool event topic.
+	ProposerSlashingTopic = "proposer_slashing"
+	// AttesterSlashingTopic represents a new attester slashing inserted into the pool event topic.
+	AttesterSlashingTopic = "attester_slashing"
 )
 
 var casesHandled = map[string]bool{
@@ -43,6 +52,10 @@ var casesHandled = map[string]bool{
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
+	PayloadAttributesTopic:         true,
+	BlockValidityTopic:             true,
+	ProposerSlashingTopic:          true,
+	AttesterSlashingTopic:          true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -177,6 +190,26 @@ func handleBlockOperationEvents(
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
 		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+	case operation.ProposerSlashingReceived:
+		if _, ok := requestedTopics[ProposerSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.ProposerSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1ProposerSlashingToV1(slashingData.ProposerSlashing)
+		return streamData(stream, ProposerSlashingTopic, v1Data)
+	case operation.AttesterSlashingReceived:
+		if _, ok := requestedTopics[AttesterSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.AttesterSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1AttSlashingToV1(slashingData.AttesterSlashing)
+		return streamData(stream, AttesterSlashingTopic, v1Data)
 	default:
 		return nil
 	}
@@ -213,6 +246,24 @@ func handleStateEvents(
 			return nil
 		}
 		return streamData(stream, ChainReorgTopic, reorg)
+	case statefeed.PayloadAttributes:
+		if _, ok := requestedTopics[PayloadAttributesTopic]; !ok {
+			return nil
+		}
+		attributes, ok := event.Data.(*ethpb.EventPayloadAttributes)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, PayloadAttributesTopic, attributes)
+	case statefeed.BlockValidity:
+		if _, ok := requestedTopics[BlockValidityTopic]; !ok {
+			return nil
+		}
+		validity, ok := event.Data.(*ethpb.EventBlockValidity)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, BlockValidityTopic, validity)
 	default:
 		return nil
 	}
@@ -13,6 +13,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -229,6 +230,81 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(ProposerSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.ProposerSlashing{
+			Header_1: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+				Header: &eth.BeaconBlockHeader{Slot: 1, ProposerIndex: 2},
+			}),
+			Header_2: util.HydrateSignedBeaconHeader(&eth.SignedBeaconBlockHeader{
+				Header: &eth.BeaconBlockHeader{Slot: 1, ProposerIndex: 2, BodyRoot: bytesutil.PadTo([]byte("body"), 32)},
+			}),
+		}
+		wantedSlashing := migration.V1Alpha1ProposerSlashingToV1(wantedSlashingV1alpha1)
+		genericResponse, err := anypb.New(wantedSlashing)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: ProposerSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ProposerSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.ProposerSlashingReceived,
+				Data: &operation.ProposerSlashingReceivedData{
+					ProposerSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(AttesterSlashingTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedSlashingV1alpha1 := &eth.AttesterSlashing{
+			Attestation_1: util.HydrateIndexedAttestation(&eth.IndexedAttestation{
+				AttestingIndices: []uint64{1, 2},
+			}),
+			Attestation_2: util.HydrateIndexedAttestation(&eth.IndexedAttestation{
+				AttestingIndices: []uint64{1, 2},
+				Data:             &eth.AttestationData{Slot: 1},
+			}),
+		}
+		wantedSlashing := migration.V1Alpha1AttSlashingToV1(wantedSlashingV1alpha1)
+		genericResponse, err := anypb.New(wantedSlashing)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: AttesterSlashingTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{AttesterSlashingTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.AttesterSlashingReceived,
+				Data: &operation.AttesterSlashingReceivedData{
+					AttesterSlashing: wantedSlashingV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -329,6 +405,72 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(PayloadAttributesTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedAttributes := &ethpb.EventPayloadAttributes{
+			ProposalSlot:    8,
+			ProposerIndex:   2,
+			ParentBlockRoot: make([]byte, 32),
+			ParentBlockHash: make([]byte, 32),
+			PayloadAttributes: &ethpb.EventPayloadAttributes_PayloadAttributes{
+				Timestamp:             96,
+				PrevRandao:            make([]byte, 32),
+				SuggestedFeeRecipient: make([]byte, 20),
+			},
+		}
+		genericResponse, err := anypb.New(wantedAttributes)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: PayloadAttributesTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{PayloadAttributesTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.PayloadAttributes,
+				Data: wantedAttributes,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(BlockValidityTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedValidity := &ethpb.EventBlockValidity{
+			Slot:  8,
+			Block: make([]byte, 32),
+			Valid: true,
+		}
+		genericResponse, err := anypb.New(wantedValidity)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: BlockValidityTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{BlockValidityTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.BlockValidity,
+				Data: wantedValidity,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
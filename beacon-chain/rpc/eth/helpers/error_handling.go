@@ -1,18 +1,33 @@
 package helpers
 
 import (
+	"context"
+	"math"
+	"strconv"
+
+	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 // PrepareStateFetchGRPCError returns an appropriate gRPC error based on the supplied argument.
-// The argument error should be a result of fetching state.
-func PrepareStateFetchGRPCError(err error) error {
+// The argument error should be a result of fetching state. When the node is overloaded, clients
+// are told when to retry through a header of the call.
+func PrepareStateFetchGRPCError(ctx context.Context, err error) error {
 	if stateNotFoundErr, ok := err.(*statefetcher.StateNotFoundError); ok {
 		return status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
+	} else if prunedErr, ok := err.(*statefetcher.StatePrunedError); ok {
+		return status.Errorf(codes.OutOfRange, "State pruned: %v", prunedErr)
 	} else if parseErr, ok := err.(*statefetcher.StateIdParseError); ok {
 		return status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
+	} else if overloadedErr, ok := err.(*statefetcher.StatesOverloadedError); ok {
+		retryAfter := strconv.Itoa(int(math.Ceil(overloadedErr.RetryAfter.Seconds())))
+		// Failing to set a non-gRPC related header should not change the error of the gRPC call.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(grpcutil.RetryAfterMetadataKey, retryAfter))
+		return status.Errorf(codes.Unavailable, "Node is overloaded: %v", overloadedErr)
 	}
 	return status.Errorf(codes.Internal, "Invalid state ID: %v", err)
 }
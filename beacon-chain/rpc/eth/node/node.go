@@ -266,13 +266,16 @@ func (ns *Server) GetSyncStatus(ctx context.Context, _ *emptypb.Empty) (*ethpb.S
 	defer span.End()
 
 	headSlot := ns.HeadFetcher.HeadSlot()
-	return &ethpb.SyncingResponse{
-		Data: &ethpb.SyncInfo{
-			HeadSlot:     headSlot,
-			SyncDistance: ns.GenesisTimeFetcher.CurrentSlot() - headSlot,
-			IsSyncing:    ns.SyncChecker.Syncing(),
-		},
-	}, nil
+	info := &ethpb.SyncInfo{
+		HeadSlot:     headSlot,
+		SyncDistance: ns.GenesisTimeFetcher.CurrentSlot() - headSlot,
+		IsSyncing:    ns.SyncChecker.Syncing(),
+	}
+	if ns.BackfillChecker != nil {
+		info.IsBackfilling = ns.BackfillChecker.Backfilling()
+		info.BackfillDistance = ns.BackfillChecker.BackfillDistance()
+	}
+	return &ethpb.SyncingResponse{Data: info}, nil
 }
 
 // GetHealth returns node health status in http status codes. Useful for load balancers.
@@ -181,6 +181,42 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, types.Slot(100), resp.Data.HeadSlot)
 	assert.Equal(t, types.Slot(10), resp.Data.SyncDistance)
 	assert.Equal(t, true, resp.Data.IsSyncing)
+	assert.Equal(t, false, resp.Data.IsBackfilling)
+	assert.Equal(t, types.Slot(0), resp.Data.BackfillDistance)
+}
+
+type backfillChecker struct {
+	backfilling bool
+	distance    types.Slot
+}
+
+func (b *backfillChecker) Backfilling() bool {
+	return b.backfilling
+}
+
+func (b *backfillChecker) BackfillDistance() types.Slot {
+	return b.distance
+}
+
+func TestSyncStatus_Backfilling(t *testing.T) {
+	currentSlot := new(types.Slot)
+	*currentSlot = 1000
+	state, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, state.SetSlot(1000))
+	chainService := &mock.ChainService{Slot: currentSlot, State: state}
+
+	s := &Server{
+		HeadFetcher:        chainService,
+		GenesisTimeFetcher: chainService,
+		SyncChecker:        &syncmock.Sync{IsSynced: true},
+		BackfillChecker:    &backfillChecker{backfilling: true, distance: 640},
+	}
+	resp, err := s.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, resp.Data.IsSyncing)
+	assert.Equal(t, true, resp.Data.IsBackfilling)
+	assert.Equal(t, types.Slot(640), resp.Data.BackfillDistance)
 }
 
 func TestGetPeer(t *testing.T) {
@@ -22,4 +22,5 @@ type Server struct {
 	StateFetcher      statefetcher.Fetcher
 	SyncCommitteePool synccommittee.Pool
 	V1Alpha1Server    *v1alpha1validator.Server
+	LivenessFetcher   blockchain.LivenessFetcher
 }
@@ -701,6 +701,51 @@ func (vs *Server) v1BeaconBlock(ctx context.Context, req *ethpbv1.ProduceBlockRe
 	return migration.V1Alpha1ToV1Block(v1alpha1resp)
 }
 
+// GetLiveness indicates whether the given validators were observed by the beacon node attesting
+// or proposing in the given epoch. Only the current and previous epochs are supported.
+func (vs *Server) GetLiveness(ctx context.Context, req *ethpbv1.GetLivenessRequest) (*ethpbv1.GetLivenessResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GetLiveness")
+	defer span.End()
+
+	if err := rpchelpers.ValidateSync(ctx, vs.SyncChecker, vs.HeadFetcher, vs.TimeFetcher); err != nil {
+		// We simply return the error because it's already a gRPC error.
+		return nil, err
+	}
+
+	currentEpoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be greater than current epoch %d", req.Epoch, currentEpoch)
+	}
+	if req.Epoch+1 < currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Request epoch %d can not be older than previous epoch %d", req.Epoch, currentEpoch-1)
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	numVals := types.ValidatorIndex(s.NumValidators())
+	for _, index := range req.Index {
+		if index >= numVals {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", index)
+		}
+	}
+
+	live, err := vs.LivenessFetcher.IsLive(req.Epoch, req.Index)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not determine liveness: %v", err)
+	}
+	data := make([]*ethpbv1.GetLivenessResponse_Liveness, len(req.Index))
+	for i, index := range req.Index {
+		data[i] = &ethpbv1.GetLivenessResponse_Liveness{
+			Index:  index,
+			Epoch:  req.Epoch,
+			IsLive: live[i],
+		}
+	}
+	return &ethpbv1.GetLivenessResponse{Data: data}, nil
+}
+
 func syncCommitteeDutiesLastValidEpoch(currentEpoch types.Epoch) types.Epoch {
 	currentSyncPeriodIndex := currentEpoch / params.BeaconConfig().EpochsPerSyncCommitteePeriod
 	// Return the last epoch of the next sync committee.
@@ -1835,3 +1835,85 @@ func TestSubmitContributionAndProofs(t *testing.T) {
 		require.DeepEqual(t, expectedContributions, savedMsgs)
 	})
 }
+
+type mockLivenessFetcher struct {
+	live map[types.Epoch]map[types.ValidatorIndex]bool
+}
+
+func (m *mockLivenessFetcher) IsLive(epoch types.Epoch, indices []types.ValidatorIndex) ([]bool, error) {
+	if _, ok := m.live[epoch]; !ok {
+		return nil, fmt.Errorf("liveness not known")
+	}
+	live := make([]bool, len(indices))
+	for i, idx := range indices {
+		live[i] = m.live[epoch][idx]
+	}
+	return live, nil
+}
+
+func TestGetLiveness(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 4)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch.Mul(3)
+	chain := &mockChain.ChainService{State: st, Slot: &currentSlot}
+	vs := &Server{
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		LivenessFetcher: &mockLivenessFetcher{live: map[types.Epoch]map[types.ValidatorIndex]bool{
+			2: {0: true},
+			3: {1: true, 2: true},
+		}},
+	}
+
+	t.Run("Current epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 3, Index: []types.ValidatorIndex{0, 1, 3}})
+		require.NoError(t, err)
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, false, resp.Data[0].IsLive)
+		assert.Equal(t, true, resp.Data[1].IsLive)
+		assert.Equal(t, false, resp.Data[2].IsLive)
+		assert.Equal(t, types.ValidatorIndex(1), resp.Data[1].Index)
+		assert.Equal(t, types.Epoch(3), resp.Data[1].Epoch)
+	})
+	t.Run("Previous epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 2, Index: []types.ValidatorIndex{0, 1}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, true, resp.Data[0].IsLive)
+		assert.Equal(t, false, resp.Data[1].IsLive)
+	})
+	t.Run("Future epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 4, Index: []types.ValidatorIndex{0}})
+		assert.ErrorContains(t, "can not be greater than current epoch", err)
+	})
+	t.Run("Old epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 1, Index: []types.ValidatorIndex{0}})
+		assert.ErrorContains(t, "can not be older than previous epoch", err)
+	})
+	t.Run("Liveness not known", func(t *testing.T) {
+		vs := &Server{
+			HeadFetcher:     chain,
+			TimeFetcher:     chain,
+			SyncChecker:     &mockSync.Sync{IsSyncing: false},
+			LivenessFetcher: &mockLivenessFetcher{},
+		}
+		_, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 3, Index: []types.ValidatorIndex{0}})
+		assert.ErrorContains(t, "Could not determine liveness: liveness not known", err)
+	})
+	t.Run("Unknown validator", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 3, Index: []types.ValidatorIndex{0, 4}})
+		assert.ErrorContains(t, "Invalid validator index 4", err)
+	})
+}
+
+func TestGetLiveness_SyncNotReady(t *testing.T) {
+	chainService := &mockChain.ChainService{}
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+		HeadFetcher: chainService,
+		TimeFetcher: chainService,
+	}
+	_, err := vs.GetLiveness(context.Background(), &ethpbv1.GetLivenessRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -164,3 +165,51 @@ func errorToString(err error) string {
 	}
 	return err.Error()
 }
+
+// ListPeerScores returns the score breakdown by scorer of every connected peer, along with
+// the subnets, direction and connection time that are weighed when pruning peers.
+func (ds *Server) ListPeerScores(_ context.Context, _ *empty.Empty) (*ethpb.PeerScoreBreakdowns, error) {
+	peers := ds.PeersFetcher.Peers()
+	scorers := peers.Scorers()
+	breakdowns := make([]*ethpb.PeerScoreBreakdown, 0)
+	for _, pid := range peers.Connected() {
+		dir, err := peers.Direction(pid)
+		if err != nil {
+			// Peer has vanished; nothing to report.
+			continue
+		}
+		pbDirection := ethpb.PeerDirection_UNKNOWN
+		switch dir {
+		case network.DirInbound:
+			pbDirection = ethpb.PeerDirection_INBOUND
+		case network.DirOutbound:
+			pbDirection = ethpb.PeerDirection_OUTBOUND
+		}
+		connectedSince, err := peers.ConnectedSince(pid)
+		if err != nil {
+			continue
+		}
+		attSubnets, syncSubnets, err := peers.Subnets(pid)
+		if err != nil {
+			continue
+		}
+		var connectedSeconds uint64
+		if !connectedSince.IsZero() {
+			connectedSeconds = uint64(prysmTime.Since(connectedSince).Seconds())
+		}
+		breakdowns = append(breakdowns, &ethpb.PeerScoreBreakdown{
+			PeerId:             pid.String(),
+			Direction:          pbDirection,
+			ConnectedSeconds:   connectedSeconds,
+			AttestationSubnets: attSubnets,
+			SyncSubnets:        syncSubnets,
+			Trusted:            peers.IsTrusted(pid),
+			OverallScore:       float32(scorers.Score(pid)),
+			BadResponsesScore:  float32(scorers.BadResponsesScorer().Score(pid)),
+			BlockProviderScore: float32(scorers.BlockProviderScorer().Score(pid)),
+			PeerStatusScore:    float32(scorers.PeerStatusScorer().Score(pid)),
+			GossipScore:        float32(scorers.GossipScorer().Score(pid)),
+		})
+	}
+	return &ethpb.PeerScoreBreakdowns{Breakdowns: breakdowns}, nil
+}
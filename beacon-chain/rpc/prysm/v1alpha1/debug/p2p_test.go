@@ -57,3 +57,29 @@ func TestDebugServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2nd peer to have a multiaddress, instead they have no addresses")
 	}
 }
+
+func TestDebugServer_ListPeerScores(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{
+		PeersFetcher: peersProvider,
+	}
+	peers := peersProvider.Peers()
+	all := peers.All()
+	badPeer := all[0]
+	peers.Scorers().BadResponsesScorer().Increment(badPeer)
+	peers.SetTrusted(all[1], true)
+
+	res, err := ds.ListPeerScores(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Breakdowns))
+	for _, breakdown := range res.Breakdowns {
+		if breakdown.PeerId == badPeer.String() {
+			assert.Equal(t, true, breakdown.BadResponsesScore < 0, "Expected bad responses to lower the score")
+			assert.Equal(t, true, breakdown.OverallScore < 0, "Expected bad responses to lower the score")
+			assert.Equal(t, false, breakdown.Trusted)
+			continue
+		}
+		assert.Equal(t, float32(0), breakdown.BadResponsesScore)
+		assert.Equal(t, true, breakdown.Trusted)
+	}
+}
@@ -0,0 +1,141 @@
+package debug
+
+import (
+	"context"
+	"net"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTrustedPeers returns the multiaddresses of the trusted peers of the host node.
+func (ds *Server) ListTrustedPeers(_ context.Context, _ *empty.Empty) (*ethpb.TrustedPeersResponse, error) {
+	trusted := ds.PeerAdmin.TrustedPeers()
+	addresses := make([]string, 0, len(trusted))
+	for _, info := range trusted {
+		info := info
+		addrs, err := peer.AddrInfoToP2pAddrs(&info)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert trusted peer %s to multiaddresses: %v", info.ID, err)
+		}
+		for _, addr := range addrs {
+			addresses = append(addresses, addr.String())
+		}
+	}
+	return &ethpb.TrustedPeersResponse{Addresses: addresses}, nil
+}
+
+// AddTrustedPeer adds a peer, given as a multiaddress ending in its peer id, to the trusted peers.
+func (ds *Server) AddTrustedPeer(_ context.Context, req *ethpb.TrustedPeerRequest) (*empty.Empty, error) {
+	addr, err := multiaddr.NewMultiaddr(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided multiaddress: %v", err)
+	}
+	if _, err := peer.AddrInfoFromP2pAddr(addr); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Provided multiaddress has no peer id: %v", err)
+	}
+	if err := ds.PeerAdmin.AddTrustedPeer(addr); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not add trusted peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// RemoveTrustedPeer removes the peer defined by the provided peer id from the trusted peers.
+func (ds *Server) RemoveTrustedPeer(_ context.Context, req *ethpb.PeerRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := ds.PeerAdmin.RemoveTrustedPeer(pid); err != nil {
+		return nil, peerAdminError("Could not remove trusted peer", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListPeerBans returns the banned peer ids and IP ranges of the host node.
+func (ds *Server) ListPeerBans(_ context.Context, _ *empty.Empty) (*ethpb.PeerBans, error) {
+	bannedPeers := ds.PeerAdmin.BannedPeers()
+	bannedRanges := ds.PeerAdmin.BannedIPRanges()
+	resp := &ethpb.PeerBans{
+		PeerIds:  make([]string, 0, len(bannedPeers)),
+		IpRanges: make([]string, 0, len(bannedRanges)),
+	}
+	for _, pid := range bannedPeers {
+		resp.PeerIds = append(resp.PeerIds, pid.String())
+	}
+	for _, ipNet := range bannedRanges {
+		resp.IpRanges = append(resp.IpRanges, ipNet.String())
+	}
+	return resp, nil
+}
+
+// BanPeers bans the provided peer ids and IP ranges. Nothing is banned if any of them is invalid.
+func (ds *Server) BanPeers(_ context.Context, req *ethpb.PeerBans) (*empty.Empty, error) {
+	pids, ipNets, err := parsePeerBans(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, pid := range pids {
+		if err := ds.PeerAdmin.BanPeer(pid); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not ban peer %s: %v", pid, err)
+		}
+	}
+	for _, ipNet := range ipNets {
+		if err := ds.PeerAdmin.BanIPRange(ipNet); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not ban IP range %s: %v", ipNet, err)
+		}
+	}
+	return &empty.Empty{}, nil
+}
+
+// UnbanPeers lifts the bans of the provided peer ids and IP ranges.
+func (ds *Server) UnbanPeers(_ context.Context, req *ethpb.PeerBans) (*empty.Empty, error) {
+	pids, ipNets, err := parsePeerBans(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, pid := range pids {
+		if err := ds.PeerAdmin.UnbanPeer(pid); err != nil {
+			return nil, peerAdminError("Could not unban peer "+pid.String(), err)
+		}
+	}
+	for _, ipNet := range ipNets {
+		if err := ds.PeerAdmin.UnbanIPRange(ipNet); err != nil {
+			return nil, peerAdminError("Could not unban IP range "+ipNet.String(), err)
+		}
+	}
+	return &empty.Empty{}, nil
+}
+
+func parsePeerBans(req *ethpb.PeerBans) ([]peer.ID, []*net.IPNet, error) {
+	pids := make([]peer.ID, 0, len(req.PeerIds))
+	for _, id := range req.PeerIds {
+		pid, err := peer.Decode(id)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id %s: %v", id, err)
+		}
+		pids = append(pids, pid)
+	}
+	ipNets := make([]*net.IPNet, 0, len(req.IpRanges))
+	for _, cidr := range req.IpRanges {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided IP range %s: %v", cidr, err)
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+	return pids, ipNets, nil
+}
+
+func peerAdminError(msg string, err error) error {
+	if errors.Is(err, p2p.ErrUnknownPeerEntry) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
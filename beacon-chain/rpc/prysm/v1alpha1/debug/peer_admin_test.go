@@ -0,0 +1,69 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+const (
+	trustedPeerID = "16Uiu2HAkvyYtoQXZNTsthjgLHjEnv7kvwzEmjvsJjWXpbhtqpSUN"
+	bannedPeerID  = "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+)
+
+func TestDebugServer_TrustedPeers(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{PeerAdmin: &mockP2p.MockPeerAdmin{}}
+	address := "/ip4/127.0.0.1/tcp/13000/p2p/" + trustedPeerID
+
+	_, err := ds.AddTrustedPeer(ctx, &ethpb.TrustedPeerRequest{Address: "/ip4/127.0.0.1/tcp/13000"})
+	assert.ErrorContains(t, "Provided multiaddress has no peer id", err)
+	_, err = ds.AddTrustedPeer(ctx, &ethpb.TrustedPeerRequest{Address: address})
+	require.NoError(t, err)
+	res, err := ds.ListTrustedPeers(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{address}, res.Addresses)
+
+	_, err = ds.RemoveTrustedPeer(ctx, &ethpb.PeerRequest{PeerId: "foo"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+	_, err = ds.RemoveTrustedPeer(ctx, &ethpb.PeerRequest{PeerId: trustedPeerID})
+	require.NoError(t, err)
+	res, err = ds.ListTrustedPeers(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Addresses))
+}
+
+func TestDebugServer_PeerBans(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{PeerAdmin: &mockP2p.MockPeerAdmin{}}
+	bans := &ethpb.PeerBans{
+		PeerIds:  []string{bannedPeerID},
+		IpRanges: []string{"10.0.0.0/8"},
+	}
+
+	// Nothing is banned when an entry is invalid.
+	_, err := ds.BanPeers(ctx, &ethpb.PeerBans{PeerIds: bans.PeerIds, IpRanges: []string{"10.0.0.0"}})
+	assert.ErrorContains(t, "Unable to parse provided IP range", err)
+	res, err := ds.ListPeerBans(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.PeerIds))
+
+	_, err = ds.BanPeers(ctx, bans)
+	require.NoError(t, err)
+	res, err = ds.ListPeerBans(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, bans.PeerIds, res.PeerIds)
+	assert.DeepEqual(t, bans.IpRanges, res.IpRanges)
+
+	_, err = ds.UnbanPeers(ctx, bans)
+	require.NoError(t, err)
+	res, err = ds.ListPeerBans(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.PeerIds))
+	assert.Equal(t, 0, len(res.IpRanges))
+}
@@ -30,6 +30,7 @@ type Server struct {
 	HeadFetcher        blockchain.HeadFetcher
 	PeerManager        p2p.PeerManager
 	PeersFetcher       p2p.PeersProvider
+	PeerAdmin          p2p.PeerAdmin
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
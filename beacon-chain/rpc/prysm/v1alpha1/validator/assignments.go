@@ -142,6 +142,16 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not compute next committee assignments: %v", err)
 	}
+	activeValidatorCount, err := helpers.ActiveValidatorCount(ctx, s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	nextActiveValidatorCount, err := helpers.ActiveValidatorCount(ctx, s, req.Epoch+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get next active validator count: %v", err)
+	}
+	committeesAtSlot := helpers.SlotCommitteeCount(activeValidatorCount)
+	nextCommitteesAtSlot := helpers.SlotCommitteeCount(nextActiveValidatorCount)
 
 	validatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
 	nextValidatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
@@ -172,6 +182,7 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 				assignment.Committee = ca.Committee
 				assignment.AttesterSlot = ca.AttesterSlot
 				assignment.CommitteeIndex = ca.CommitteeIndex
+				assignment.CommitteesAtSlot = committeesAtSlot
 			}
 			// Save the next epoch assignments.
 			ca, ok = nextCommitteeAssignments[idx]
@@ -179,6 +190,7 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 				nextAssignment.Committee = ca.Committee
 				nextAssignment.AttesterSlot = ca.AttesterSlot
 				nextAssignment.CommitteeIndex = ca.CommitteeIndex
+				nextAssignment.CommitteesAtSlot = nextCommitteesAtSlot
 			}
 		} else {
 			// If the validator isn't in the beacon state, try finding their deposit to determine their status.
@@ -74,6 +74,9 @@ func TestGetDuties_OK(t *testing.T) {
 		t.Errorf("Assigned slot %d can't be higher than %d",
 			res.CurrentEpochDuties[0].AttesterSlot, bs.Slot()+params.BeaconConfig().SlotsPerEpoch)
 	}
+	committeesAtSlot := helpers.SlotCommitteeCount(uint64(depChainStart))
+	assert.Equal(t, committeesAtSlot, res.CurrentEpochDuties[0].CommitteesAtSlot)
+	assert.Equal(t, committeesAtSlot, res.NextEpochDuties[0].CommitteesAtSlot)
 
 	// Test the last validator in registry.
 	lastValidatorIndex := depChainStart - 1
@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -20,6 +25,7 @@ import (
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // eth1DataNotification is a latch to stop flooding logs with the same warning.
@@ -58,7 +64,7 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 		return nil, status.Errorf(codes.Internal, "Could not fetch Bellatrix beacon block: %v", err)
 	}
 
-	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
+	return blk, nil
 }
 
 // GetBlock is called by a proposer during its assigned slot to request a block to sign
@@ -98,6 +104,15 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 		if err != nil {
 			return nil, status.Error(codes.Internal, "could not wrap Bellatrix beacon block")
 		}
+	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
+		full, err := vs.unblindBuilderBlock(ctx, b.BlindedBellatrix)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not unblind builder block: %v", err)
+		}
+		blk, err = wrapper.WrappedBellatrixSignedBeaconBlock(full)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "could not wrap Bellatrix beacon block")
+		}
 	default:
 		return nil, status.Error(codes.Internal, "block version not supported")
 	}
@@ -115,6 +130,101 @@ func (vs *Server) ProposeBlock(ctx context.Context, rBlk *ethpb.SignedBeaconBloc
 	return vs.proposeGenericBeaconBlock(ctx, blk)
 }
 
+// PrepareBeaconProposer caches and updates the fee recipient for the given proposer.
+func (vs *Server) PrepareBeaconProposer(
+	ctx context.Context, request *ethpb.PrepareBeaconProposerRequest,
+) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.PrepareBeaconProposer")
+	defer span.End()
+	var feeRecipients []common.Address
+	var validatorIndices []types.ValidatorIndex
+	for _, recipientContainer := range request.Recipients {
+		if len(recipientContainer.FeeRecipient) != fieldparams.FeeRecipientLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid fee recipient address: %#x", recipientContainer.FeeRecipient)
+		}
+		feeRecipients = append(feeRecipients, common.BytesToAddress(recipientContainer.FeeRecipient))
+		validatorIndices = append(validatorIndices, recipientContainer.ValidatorIndex)
+	}
+	if err := vs.BeaconDB.SaveFeeRecipientsByValidatorIDs(ctx, validatorIndices, feeRecipients); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save fee recipients: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"validatorIndices": validatorIndices,
+	}).Info("Updated fee recipient addresses for validator indices")
+	return &emptypb.Empty{}, nil
+}
+
+// SubmitValidatorRegistration verifies and caches the builder registrations of the given validators, and
+// forwards them to the external block builder if one is configured. Registrations of validators which are
+// not in the head state yet are ignored. Invalid registrations are skipped without affecting the others,
+// and reported in an InvalidArgument error once the valid ones were processed.
+func (vs *Server) SubmitValidatorRegistration(
+	ctx context.Context, request *ethpb.SignedValidatorRegistrationsV1,
+) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.SubmitValidatorRegistration")
+	defer span.End()
+	if len(request.Messages) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+	st, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil /* fork version */, nil /* genesis val root */)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute builder domain: %v", err)
+	}
+	var validatorIndices []types.ValidatorIndex
+	var registrations []*ethpb.SignedValidatorRegistrationV1
+	var messages []*ethpb.ValidatorRegistrationV1
+	var invalid []string
+	for i, reg := range request.Messages {
+		if err := verifyValidatorRegistration(reg, d); err != nil {
+			log.WithError(err).WithField("index", i).Warn("Skipping invalid validator registration")
+			invalid = append(invalid, fmt.Sprintf("registration %d: %v", i, err))
+			continue
+		}
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(reg.Message.Pubkey))
+		if !ok {
+			continue
+		}
+		validatorIndices = append(validatorIndices, idx)
+		registrations = append(registrations, reg)
+		messages = append(messages, reg.Message)
+	}
+	if len(registrations) > 0 {
+		if err := vs.BeaconDB.SaveRegistrationsByValidatorIDs(ctx, validatorIndices, messages); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not save validator registrations: %v", err)
+		}
+		if vs.BlockBuilder != nil && vs.BlockBuilder.Configured() {
+			if err := vs.BlockBuilder.RegisterValidator(ctx, registrations); err != nil {
+				return nil, status.Errorf(codes.Unavailable, "Could not register validators with the builder: %v", err)
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Skipped %d invalid validator registrations: %s", len(invalid), strings.Join(invalid, "; "))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// verifyValidatorRegistration checks the fields and the signature of a builder registration.
+func verifyValidatorRegistration(reg *ethpb.SignedValidatorRegistrationV1, domain []byte) error {
+	if reg == nil || reg.Message == nil {
+		return errors.New("nil validator registration")
+	}
+	if len(reg.Message.FeeRecipient) != fieldparams.FeeRecipientLength {
+		return fmt.Errorf("invalid fee recipient address %#x", reg.Message.FeeRecipient)
+	}
+	if len(reg.Message.Pubkey) != fieldparams.BLSPubkeyLength {
+		return fmt.Errorf("invalid validator public key %#x", reg.Message.Pubkey)
+	}
+	if err := signing.VerifySigningRoot(reg.Message, reg.Message.Pubkey, reg.Signature, domain); err != nil {
+		return errors.Wrapf(err, "could not verify registration of validator %#x", reg.Message.Pubkey)
+	}
+	return nil
+}
+
 func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk block.SignedBeaconBlock) (*ethpb.ProposeResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.proposeGenericBeaconBlock")
 	defer span.End()
@@ -1,23 +1,55 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition/interop"
-	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/config/params"
-	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
 )
 
-func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlockBellatrix, error) {
+// builderGetHeaderTimeout bounds how long a proposer waits for the builder's bid before falling back
+// to an execution payload built by the local execution client.
+var builderGetHeaderTimeout = time.Second
+
+func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
 	altairBlk, err := vs.buildAltairBeaconBlock(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	registered, err := vs.validatorRegistered(ctx, altairBlk.ProposerIndex)
+	if err != nil {
+		log.WithError(err).WithField("validatorIndex", altairBlk.ProposerIndex).Warn(
+			"Could not determine if validator is registered with the builder, falling back to local execution client")
+	}
+	if registered && vs.BlockBuilder != nil && vs.BlockBuilder.Configured() {
+		blk, err := vs.getBlindedBellatrixBeaconBlock(ctx, altairBlk)
+		if err == nil {
+			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: blk}}, nil
+		}
+		log.WithError(err).WithField("slot", req.Slot).Warn(
+			"Could not get execution payload header from the builder, falling back to local execution client")
+	}
+
+	payload, err := vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex)
+	if err != nil {
+		return nil, err
+	}
+
 	blk := &ethpb.BeaconBlockBellatrix{
 		Slot:          altairBlk.Slot,
 		ProposerIndex: altairBlk.ProposerIndex,
@@ -33,16 +65,7 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 			Deposits:          altairBlk.Body.Deposits,
 			VoluntaryExits:    altairBlk.Body.VoluntaryExits,
 			SyncAggregate:     altairBlk.Body.SyncAggregate,
-			ExecutionPayload: &enginev1.ExecutionPayload{
-				ParentHash:    make([]byte, fieldparams.RootLength),
-				FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
-				StateRoot:     make([]byte, fieldparams.RootLength),
-				ReceiptsRoot:  make([]byte, fieldparams.RootLength),
-				LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
-				PrevRandao:    make([]byte, fieldparams.RootLength),
-				BaseFeePerGas: make([]byte, fieldparams.RootLength),
-				BlockHash:     make([]byte, fieldparams.RootLength),
-			}, // TODO(9853) Insert real execution payload.
+			ExecutionPayload:  payload,
 		},
 	}
 	// Compute state root with the newly constructed block.
@@ -58,5 +81,176 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 		return nil, fmt.Errorf("could not compute state root: %v", err)
 	}
 	blk.StateRoot = stateRoot
+	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
+}
+
+// getBlindedBellatrixBeaconBlock builds a blinded block around the execution payload header of the
+// builder's bid for the slot of the given block.
+func (vs *Server) getBlindedBellatrixBeaconBlock(ctx context.Context, altairBlk *ethpb.BeaconBlockAltair) (*ethpb.BlindedBeaconBlockBellatrix, error) {
+	header, err := vs.getPayloadHeaderFromBuilder(ctx, altairBlk.Slot, altairBlk.ProposerIndex)
+	if err != nil {
+		return nil, err
+	}
+	blk := &ethpb.BlindedBeaconBlockBellatrix{
+		Slot:          altairBlk.Slot,
+		ProposerIndex: altairBlk.ProposerIndex,
+		ParentRoot:    altairBlk.ParentRoot,
+		StateRoot:     params.BeaconConfig().ZeroHash[:],
+		Body: &ethpb.BlindedBeaconBlockBodyBellatrix{
+			RandaoReveal:           altairBlk.Body.RandaoReveal,
+			Eth1Data:               altairBlk.Body.Eth1Data,
+			Graffiti:               altairBlk.Body.Graffiti,
+			ProposerSlashings:      altairBlk.Body.ProposerSlashings,
+			AttesterSlashings:      altairBlk.Body.AttesterSlashings,
+			Attestations:           altairBlk.Body.Attestations,
+			Deposits:               altairBlk.Body.Deposits,
+			VoluntaryExits:         altairBlk.Body.VoluntaryExits,
+			SyncAggregate:          altairBlk.Body.SyncAggregate,
+			ExecutionPayloadHeader: header,
+		},
+	}
+	wsb, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(
+		&ethpb.SignedBlindedBeaconBlockBellatrix{Block: blk, Signature: make([]byte, 96)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := vs.computeStateRoot(ctx, wsb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+	blk.StateRoot = stateRoot
 	return blk, nil
 }
+
+// getPayloadHeaderFromBuilder requests the builder's bid for the given slot and proposer, and checks that the
+// bid is signed by the builder's public key and that the execution payload header it offers builds on top of
+// the current execution head at the slot's time. The builder is only used once the merge transition is complete.
+func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (*ethpb.ExecutionPayloadHeader, error) {
+	st, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st, err = transition.ProcessSlotsIfPossible(ctx, st, slot)
+	if err != nil {
+		return nil, err
+	}
+	mergeComplete, err := blocks.MergeTransitionComplete(st)
+	if err != nil {
+		return nil, err
+	}
+	if !mergeComplete {
+		return nil, errors.New("can't use the builder before the merge transition is complete")
+	}
+	latest, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	pubKey := st.PubkeyAtIndex(idx)
+	t, err := slots.ToTime(st.GenesisTime(), slot)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, builderGetHeaderTimeout)
+	defer cancel()
+	bid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(latest.BlockHash), pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get header from the builder")
+	}
+	if bid == nil || bid.Message == nil || bid.Message.Header == nil {
+		return nil, errors.New("builder returned an empty bid")
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil /* fork version */, nil /* genesis val root */)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute builder domain")
+	}
+	if err := signing.VerifySigningRoot(bid.Message, bid.Message.Pubkey, bid.Signature, d); err != nil {
+		return nil, errors.Wrapf(err, "could not verify bid signature of builder %#x", bid.Message.Pubkey)
+	}
+	header := bid.Message.Header
+	if !bytes.Equal(header.ParentHash, latest.BlockHash) {
+		return nil, fmt.Errorf("builder header parent hash %#x does not match execution head %#x", header.ParentHash, latest.BlockHash)
+	}
+	if header.Timestamp != uint64(t.Unix()) {
+		return nil, fmt.Errorf("builder header timestamp %d does not match slot time %d", header.Timestamp, t.Unix())
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      slot,
+		"blockHash": fmt.Sprintf("%#x", bytesutil.Trunc(header.BlockHash)),
+		"txRoot":    fmt.Sprintf("%#x", bytesutil.Trunc(header.TransactionsRoot)),
+	}).Info("Received execution payload header from the builder")
+	return header, nil
+}
+
+// unblindBuilderBlock exchanges a signed blinded block for its full execution payload with the builder, and
+// returns the signed block carrying that payload. The payload must match the header which was signed over.
+func (vs *Server) unblindBuilderBlock(ctx context.Context, b *ethpb.SignedBlindedBeaconBlockBellatrix) (*ethpb.SignedBeaconBlockBellatrix, error) {
+	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
+		return nil, errors.New("builder is not configured")
+	}
+	if b == nil || b.Block == nil || b.Block.Body == nil || b.Block.Body.ExecutionPayloadHeader == nil {
+		return nil, errors.New("nil blinded block")
+	}
+	payload, err := vs.BlockBuilder.SubmitBlindedBlock(ctx, b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not submit blinded block to the builder")
+	}
+	if payload == nil {
+		return nil, errors.New("builder returned a nil execution payload")
+	}
+	payloadHeader, err := blocks.PayloadToHeader(payload)
+	if err != nil {
+		return nil, err
+	}
+	want, err := b.Block.Body.ExecutionPayloadHeader.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	got, err := payloadHeader.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if want != got {
+		return nil, fmt.Errorf("builder payload header root %#x does not match the signed header root %#x", got, want)
+	}
+	body := b.Block.Body
+	return &ethpb.SignedBeaconBlockBellatrix{
+		Block: &ethpb.BeaconBlockBellatrix{
+			Slot:          b.Block.Slot,
+			ProposerIndex: b.Block.ProposerIndex,
+			ParentRoot:    b.Block.ParentRoot,
+			StateRoot:     b.Block.StateRoot,
+			Body: &ethpb.BeaconBlockBodyBellatrix{
+				RandaoReveal:      body.RandaoReveal,
+				Eth1Data:          body.Eth1Data,
+				Graffiti:          body.Graffiti,
+				ProposerSlashings: body.ProposerSlashings,
+				AttesterSlashings: body.AttesterSlashings,
+				Attestations:      body.Attestations,
+				Deposits:          body.Deposits,
+				VoluntaryExits:    body.VoluntaryExits,
+				SyncAggregate:     body.SyncAggregate,
+				ExecutionPayload:  payload,
+			},
+		},
+		Signature: b.Signature,
+	}, nil
+}
+
+// validatorRegistered returns true if the validator has submitted a builder registration to this node.
+// The builder only builds payloads for registered validators, so unregistered proposers use the local
+// execution client.
+func (vs *Server) validatorRegistered(ctx context.Context, id types.ValidatorIndex) (bool, error) {
+	if vs.BeaconDB == nil {
+		return false, errors.New("nil beacon db")
+	}
+	_, err := vs.BeaconDB.RegistrationByValidatorID(ctx, id)
+	switch {
+	case errors.Is(err, kv.ErrNotFoundRegistration):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
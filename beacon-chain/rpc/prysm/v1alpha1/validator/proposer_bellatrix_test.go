@@ -0,0 +1,173 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/beacon-chain/builder/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestServer_getPayloadHeaderFromBuilder(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 64)
+	blockHash := bytesutil.PadTo([]byte{0x0a}, 32)
+	latest := util.HydrateBlindedBeaconBlockBodyBellatrix(nil).ExecutionPayloadHeader
+	latest.BlockHash = blockHash
+	require.NoError(t, st.SetLatestExecutionPayloadHeader(latest))
+	ts, err := slots.ToTime(st.GenesisTime(), 1)
+	require.NoError(t, err)
+
+	validHeader := func() *ethpb.ExecutionPayloadHeader {
+		h := util.HydrateBlindedBeaconBlockBodyBellatrix(nil).ExecutionPayloadHeader
+		h.ParentHash = blockHash
+		h.Timestamp = uint64(ts.Unix())
+		h.BlockHash = bytesutil.PadTo([]byte{0x0b}, 32)
+		return h
+	}
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	signedBid := func(h *ethpb.ExecutionPayloadHeader) *ethpb.SignedBuilderBid {
+		bid := &ethpb.BuilderBid{Header: h, Value: make([]byte, 32), Pubkey: sk.PublicKey().Marshal()}
+		sr, err := signing.ComputeSigningRoot(bid, d)
+		require.NoError(t, err)
+		return &ethpb.SignedBuilderBid{Message: bid, Signature: sk.Sign(sr[:]).Marshal()}
+	}
+	tests := []struct {
+		name    string
+		builder *builderTest.MockBuilderService
+		err     string
+	}{
+		{
+			name:    "builder error",
+			builder: &builderTest.MockBuilderService{HasConfigured: true, ErrGetHeader: errors.New("relay down")},
+			err:     "relay down",
+		},
+		{
+			name:    "empty bid",
+			builder: &builderTest.MockBuilderService{HasConfigured: true},
+			err:     "builder returned an empty bid",
+		},
+		{
+			name: "invalid signature",
+			builder: &builderTest.MockBuilderService{HasConfigured: true, Bid: func() *ethpb.SignedBuilderBid {
+				bid := signedBid(validHeader())
+				bid.Message.Header.BlockHash = bytesutil.PadTo([]byte{0x0c}, 32)
+				return bid
+			}()},
+			err: "could not verify bid signature",
+		},
+		{
+			name: "wrong parent hash",
+			builder: &builderTest.MockBuilderService{HasConfigured: true, Bid: signedBid(func() *ethpb.ExecutionPayloadHeader {
+				h := validHeader()
+				h.ParentHash = make([]byte, 32)
+				return h
+			}())},
+			err: "does not match execution head",
+		},
+		{
+			name: "wrong timestamp",
+			builder: &builderTest.MockBuilderService{HasConfigured: true, Bid: signedBid(func() *ethpb.ExecutionPayloadHeader {
+				h := validHeader()
+				h.Timestamp = 1
+				return h
+			}())},
+			err: "does not match slot time",
+		},
+		{
+			name:    "happy case",
+			builder: &builderTest.MockBuilderService{HasConfigured: true, Bid: signedBid(validHeader())},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := &Server{
+				HeadFetcher:  &chainMock.ChainService{State: st.Copy()},
+				BlockBuilder: tt.builder,
+			}
+			h, err := vs.getPayloadHeaderFromBuilder(context.Background(), 1, 0)
+			if tt.err != "" {
+				require.ErrorContains(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.DeepEqual(t, validHeader(), h)
+		})
+	}
+}
+
+func TestServer_getPayloadHeaderFromBuilder_MergeNotComplete(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 64)
+	vs := &Server{
+		HeadFetcher:  &chainMock.ChainService{State: st},
+		BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true},
+	}
+	_, err := vs.getPayloadHeaderFromBuilder(context.Background(), 1, 0)
+	require.ErrorContains(t, "before the merge transition is complete", err)
+}
+
+func TestServer_unblindBuilderBlock(t *testing.T) {
+	payload := &enginev1.ExecutionPayload{
+		ParentHash:    bytesutil.PadTo([]byte{0x01}, 32),
+		FeeRecipient:  make([]byte, 20),
+		StateRoot:     make([]byte, 32),
+		ReceiptsRoot:  make([]byte, 32),
+		LogsBloom:     make([]byte, 256),
+		PrevRandao:    make([]byte, 32),
+		BlockNumber:   5,
+		BaseFeePerGas: make([]byte, 32),
+		BlockHash:     bytesutil.PadTo([]byte{0x02}, 32),
+		Transactions:  [][]byte{{0x03}},
+	}
+	header, err := blocks.PayloadToHeader(payload)
+	require.NoError(t, err)
+	sb := util.HydrateSignedBlindedBeaconBlockBellatrix(&ethpb.SignedBlindedBeaconBlockBellatrix{
+		Block: &ethpb.BlindedBeaconBlockBellatrix{
+			Slot: 3,
+			Body: &ethpb.BlindedBeaconBlockBodyBellatrix{ExecutionPayloadHeader: header},
+		},
+		Signature: bytesutil.PadTo([]byte{0x04}, 96),
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		vs := &Server{BlockBuilder: &builderTest.MockBuilderService{}}
+		_, err := vs.unblindBuilderBlock(context.Background(), sb)
+		require.ErrorContains(t, "builder is not configured", err)
+	})
+	t.Run("submit error", func(t *testing.T) {
+		vs := &Server{BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true, ErrSubmitBlindedBlock: errors.New("bad")}}
+		_, err := vs.unblindBuilderBlock(context.Background(), sb)
+		require.ErrorContains(t, "bad", err)
+	})
+	t.Run("mismatched payload", func(t *testing.T) {
+		other, ok := proto.Clone(payload).(*enginev1.ExecutionPayload)
+		require.Equal(t, true, ok)
+		other.BlockNumber = 6
+		vs := &Server{BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true, Payload: other}}
+		_, err := vs.unblindBuilderBlock(context.Background(), sb)
+		require.ErrorContains(t, "does not match the signed header root", err)
+	})
+	t.Run("happy case", func(t *testing.T) {
+		vs := &Server{BlockBuilder: &builderTest.MockBuilderService{HasConfigured: true, Payload: payload}}
+		got, err := vs.unblindBuilderBlock(context.Background(), sb)
+		require.NoError(t, err)
+		require.DeepEqual(t, payload, got.Block.Body.ExecutionPayload)
+		require.DeepEqual(t, sb.Signature, got.Signature)
+		require.Equal(t, sb.Block.Slot, got.Block.Slot)
+		require.DeepEqual(t, sb.Block.Body.RandaoReveal, got.Block.Body.RandaoReveal)
+	})
+}
@@ -10,13 +10,17 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
@@ -24,7 +28,7 @@ import (
 
 // This returns the execution payload of a given slot. The function has full awareness of pre and post merge.
 // The payload is computed given the respected time of merge.
-func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot) (*enginev1.ExecutionPayload, error) {
+func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot, vIdx types.ValidatorIndex) (*enginev1.ExecutionPayload, error) {
 	st, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
 		return nil, err
@@ -81,11 +85,21 @@ func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot) (*en
 	case version.Phase0, version.Altair: // Blocks before Bellatrix don't have execution payloads. Use zeros as the hash.
 		finalizedBlockHash = params.BeaconConfig().ZeroHash[:]
 	default:
-		finalizedPayload, err := finalizedBlock.Block().Body().ExecutionPayload()
+		finalizedBlockHash, err = blocks.ExecutionBlockHash(finalizedBlock.Block().Body())
 		if err != nil {
 			return nil, err
 		}
-		finalizedBlockHash = finalizedPayload.BlockHash
+	}
+
+	feeRecipient := params.BeaconConfig().FeeRecipient
+	recipient, err := vs.BeaconDB.FeeRecipientByValidatorID(ctx, vIdx)
+	switch {
+	case errors.Is(err, kv.ErrNotFoundFeeRecipient):
+		// The validator has not registered a fee recipient, fall back to the one of the beacon node.
+	case err != nil:
+		return nil, errors.Wrap(err, "could not get fee recipient in db")
+	default:
+		feeRecipient = recipient
 	}
 
 	f := &enginev1.ForkchoiceState{
@@ -96,7 +110,7 @@ func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot) (*en
 	p := &enginev1.PayloadAttributes{
 		Timestamp:             uint64(t.Unix()),
 		PrevRandao:            random,
-		SuggestedFeeRecipient: params.BeaconConfig().FeeRecipient.Bytes(),
+		SuggestedFeeRecipient: feeRecipient.Bytes(),
 	}
 	payloadID, _, err := vs.ExecutionEngineCaller.ForkchoiceUpdated(ctx, f, p)
 	if err != nil {
@@ -105,6 +119,24 @@ func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot) (*en
 	if payloadID == nil {
 		return nil, errors.New("nil payload id")
 	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	vs.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.PayloadAttributes,
+		Data: &ethpbv1.EventPayloadAttributes{
+			ProposalSlot:    slot,
+			ProposerIndex:   vIdx,
+			ParentBlockRoot: headRoot,
+			ParentBlockHash: parentHash,
+			PayloadAttributes: &ethpbv1.EventPayloadAttributes_PayloadAttributes{
+				Timestamp:             p.Timestamp,
+				PrevRandao:            p.PrevRandao,
+				SuggestedFeeRecipient: p.SuggestedFeeRecipient,
+			},
+		},
+	})
 	return vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID)
 }
 
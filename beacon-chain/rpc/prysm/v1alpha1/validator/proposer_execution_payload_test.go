@@ -9,6 +9,7 @@ import (
 	"github.com/holiman/uint256"
 	types "github.com/prysmaticlabs/eth2-types"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1/mocks"
 	powtesting "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -79,6 +81,9 @@ func TestServer_getExecutionPayload(t *testing.T) {
 	beaconDB := dbTest.SetupDB(t)
 	require.NoError(t, beaconDB.SaveBlock(context.Background(), b1))
 	require.NoError(t, beaconDB.SaveBlock(context.Background(), b2))
+	feeRecipient := common.BytesToAddress([]byte("a"))
+	headRoot := [32]byte{'h'}
+	require.NoError(t, beaconDB.SaveFeeRecipientsByValidatorIDs(context.Background(), []types.ValidatorIndex{100}, []common.Address{feeRecipient}))
 
 	tests := []struct {
 		name              string
@@ -88,6 +93,7 @@ func TestServer_getExecutionPayload(t *testing.T) {
 		payloadID         *pb.PayloadIDBytes
 		terminalBlockHash common.Hash
 		activationEpoch   types.Epoch
+		validatorIndx     types.ValidatorIndex
 	}{
 		{
 			name:      "transition completed, nil payload id",
@@ -99,6 +105,12 @@ func TestServer_getExecutionPayload(t *testing.T) {
 			st:        transitionSt,
 			payloadID: &pb.PayloadIDBytes{0x1},
 		},
+		{
+			name:          "transition completed, happy case with registered fee recipient",
+			st:            transitionSt,
+			payloadID:     &pb.PayloadIDBytes{0x1},
+			validatorIndx: 100,
+		},
 		{
 			name:          "transition completed, could not prepare payload",
 			st:            transitionSt,
@@ -124,17 +136,32 @@ func TestServer_getExecutionPayload(t *testing.T) {
 			cfg.TerminalBlockHashActivationEpoch = tt.activationEpoch
 			params.OverrideBeaconConfig(cfg)
 
+			notifier := &chainMock.MockStateNotifier{RecordEvents: true}
 			vs := &Server{
 				ExecutionEngineCaller: &mocks.EngineClient{PayloadIDBytes: tt.payloadID, ErrForkchoiceUpdated: tt.forkchoiceErr},
-				HeadFetcher:           &chainMock.ChainService{State: tt.st},
+				HeadFetcher:           &chainMock.ChainService{State: tt.st, Root: headRoot[:]},
 				BeaconDB:              beaconDB,
+				StateNotifier:         notifier,
 			}
-			_, err := vs.getExecutionPayload(context.Background(), tt.st.Slot())
+			_, err := vs.getExecutionPayload(context.Background(), tt.st.Slot(), tt.validatorIndx)
 			if tt.errString != "" {
 				require.ErrorContains(t, tt.errString, err)
 			} else {
 				require.NoError(t, err)
 			}
+			if tt.payloadID != nil && tt.errString == "" {
+				events := notifier.ReceivedEvents()
+				require.Equal(t, 1, len(events))
+				require.Equal(t, statefeed.PayloadAttributes, int(events[0].Type))
+				attrs, ok := events[0].Data.(*ethpbv1.EventPayloadAttributes)
+				require.Equal(t, true, ok)
+				require.Equal(t, tt.st.Slot(), attrs.ProposalSlot)
+				require.Equal(t, tt.validatorIndx, attrs.ProposerIndex)
+				require.DeepEqual(t, headRoot[:], attrs.ParentBlockRoot)
+				if tt.validatorIndx == 100 {
+					require.DeepEqual(t, feeRecipient.Bytes(), attrs.PayloadAttributes.SuggestedFeeRecipient)
+				}
+			}
 		})
 	}
 }
@@ -6,20 +6,25 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/beacon-chain/builder/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	coretime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1/mocks"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -2228,6 +2233,20 @@ func TestProposer_GetBeaconBlock_PostForkEpoch(t *testing.T) {
 	assert.DeepEqual(t, attSlashings, altairBlk.Altair.Body.AttesterSlashings)
 }
 
+// copyHeadChainService returns a copy of the head state, like the blockchain service does, so that
+// advancing the head state to the proposal slot more than once does not mutate the mocked state.
+type copyHeadChainService struct {
+	*mock.ChainService
+}
+
+func (s *copyHeadChainService) HeadState(ctx context.Context) (state.BeaconState, error) {
+	st, err := s.ChainService.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return st.Copy(), nil
+}
+
 func TestProposer_GetBeaconBlock_BellatrixEpoch(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -2287,7 +2306,7 @@ func TestProposer_GetBeaconBlock_BellatrixEpoch(t *testing.T) {
 	require.NoError(t, db.SaveHeadBlockRoot(ctx, blkRoot), "Could not save genesis state")
 
 	proposerServer := &Server{
-		HeadFetcher:       &mock.ChainService{State: beaconState, Root: parentRoot[:], Optimistic: false},
+		HeadFetcher:       &copyHeadChainService{ChainService: &mock.ChainService{State: beaconState, Root: parentRoot[:], Optimistic: false}},
 		TimeFetcher:       &mock.ChainService{Genesis: time.Now()},
 		SyncChecker:       &mockSync.Sync{IsSyncing: false},
 		BlockReceiver:     &mock.ChainService{},
@@ -2300,6 +2319,10 @@ func TestProposer_GetBeaconBlock_BellatrixEpoch(t *testing.T) {
 		ExitPool:          voluntaryexits.NewPool(),
 		StateGen:          stategen.New(db),
 		SyncCommitteePool: synccommittee.NewStore(),
+		BeaconDB:          db,
+		ExecutionEngineCaller: &mocks.EngineClient{
+			ExecutionBlock: &enginev1.ExecutionBlock{TotalDifficulty: "0x1"},
+		},
 	}
 
 	randaoReveal, err := util.RandaoReveal(beaconState, 0, privKeys)
@@ -2493,3 +2516,168 @@ func setupGetBlock(bm *testing.B) (*Server, state.BeaconState, []bls.SecretKey)
 	}
 	return proposerServer, beaconState, privKeys
 }
+
+func TestProposer_PrepareBeaconProposer(t *testing.T) {
+	type args struct {
+		request *ethpb.PrepareBeaconProposerRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr string
+	}{
+		{
+			name: "Happy Path",
+			args: args{
+				request: &ethpb.PrepareBeaconProposerRequest{
+					Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+						{
+							FeeRecipient:   make([]byte, fieldparams.FeeRecipientLength),
+							ValidatorIndex: 1,
+						},
+					},
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name: "invalid fee recipient length",
+			args: args{
+				request: &ethpb.PrepareBeaconProposerRequest{
+					Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+						{
+							FeeRecipient:   make([]byte, fieldparams.BLSPubkeyLength),
+							ValidatorIndex: 1,
+						},
+					},
+				},
+			},
+			wantErr: "Invalid fee recipient address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbutil.SetupDB(t)
+			ctx := context.Background()
+			proposerServer := &Server{BeaconDB: db}
+			_, err := proposerServer.PrepareBeaconProposer(ctx, tt.args.request)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			address, err := proposerServer.BeaconDB.FeeRecipientByValidatorID(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, common.BytesToAddress(tt.args.request.Recipients[0].FeeRecipient), address)
+		})
+	}
+}
+
+func TestProposer_SubmitValidatorRegistration(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := util.DeterministicGenesisState(t, 4)
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	register := func(i int) *ethpb.SignedValidatorRegistrationV1 {
+		msg := &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: bytesutil.PadTo([]byte{byte(i + 1)}, fieldparams.FeeRecipientLength),
+			GasLimit:     30000000,
+			Timestamp:    1650000000,
+			Pubkey:       privKeys[i].PublicKey().Marshal(),
+		}
+		root, err := signing.ComputeSigningRoot(msg, d)
+		require.NoError(t, err)
+		return &ethpb.SignedValidatorRegistrationV1{Message: msg, Signature: privKeys[i].Sign(root[:]).Marshal()}
+	}
+	unknownKey, err := bls.RandKey()
+	require.NoError(t, err)
+
+	t.Run("caches and forwards registrations", func(t *testing.T) {
+		db := dbutil.SetupDB(t)
+		bs := &builderTest.MockBuilderService{HasConfigured: true}
+		proposerServer := &Server{BeaconDB: db, HeadFetcher: &mock.ChainService{State: beaconState}, BlockBuilder: bs}
+		unknown := register(0)
+		unknown.Message.Pubkey = unknownKey.PublicKey().Marshal()
+		root, err := signing.ComputeSigningRoot(unknown.Message, d)
+		require.NoError(t, err)
+		unknown.Signature = unknownKey.Sign(root[:]).Marshal()
+		req := &ethpb.SignedValidatorRegistrationsV1{Messages: []*ethpb.SignedValidatorRegistrationV1{register(1), register(3), unknown}}
+		_, err = proposerServer.SubmitValidatorRegistration(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(bs.Registrations))
+		for i, idx := range []types.ValidatorIndex{1, 3} {
+			reg, err := db.RegistrationByValidatorID(ctx, idx)
+			require.NoError(t, err)
+			require.DeepEqual(t, req.Messages[i].Message, reg)
+		}
+	})
+	t.Run("no builder", func(t *testing.T) {
+		db := dbutil.SetupDB(t)
+		proposerServer := &Server{BeaconDB: db, HeadFetcher: &mock.ChainService{State: beaconState}, BlockBuilder: &builderTest.MockBuilderService{}}
+		req := &ethpb.SignedValidatorRegistrationsV1{Messages: []*ethpb.SignedValidatorRegistrationV1{register(2)}}
+		_, err := proposerServer.SubmitValidatorRegistration(ctx, req)
+		require.NoError(t, err)
+		reg, err := db.RegistrationByValidatorID(ctx, 2)
+		require.NoError(t, err)
+		require.DeepEqual(t, req.Messages[0].Message, reg)
+	})
+	t.Run("builder error", func(t *testing.T) {
+		bs := &builderTest.MockBuilderService{HasConfigured: true, ErrRegisterValidator: errors.New("relay down")}
+		proposerServer := &Server{BeaconDB: dbutil.SetupDB(t), HeadFetcher: &mock.ChainService{State: beaconState}, BlockBuilder: bs}
+		req := &ethpb.SignedValidatorRegistrationsV1{Messages: []*ethpb.SignedValidatorRegistrationV1{register(0)}}
+		_, err := proposerServer.SubmitValidatorRegistration(ctx, req)
+		require.ErrorContains(t, "relay down", err)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		proposerServer := &Server{BeaconDB: dbutil.SetupDB(t), HeadFetcher: &mock.ChainService{State: beaconState}}
+		reg := register(0)
+		reg.Message.GasLimit = 1
+		req := &ethpb.SignedValidatorRegistrationsV1{Messages: []*ethpb.SignedValidatorRegistrationV1{reg}}
+		_, err := proposerServer.SubmitValidatorRegistration(ctx, req)
+		require.ErrorContains(t, "could not verify registration", err)
+	})
+	t.Run("invalid fee recipient length", func(t *testing.T) {
+		proposerServer := &Server{BeaconDB: dbutil.SetupDB(t), HeadFetcher: &mock.ChainService{State: beaconState}}
+		reg := register(0)
+		reg.Message.FeeRecipient = make([]byte, fieldparams.BLSPubkeyLength)
+		req := &ethpb.SignedValidatorRegistrationsV1{Messages: []*ethpb.SignedValidatorRegistrationV1{reg}}
+		_, err := proposerServer.SubmitValidatorRegistration(ctx, req)
+		require.ErrorContains(t, "invalid fee recipient address", err)
+	})
+	t.Run("skips invalid registrations", func(t *testing.T) {
+		db := dbutil.SetupDB(t)
+		bs := &builderTest.MockBuilderService{HasConfigured: true}
+		proposerServer := &Server{BeaconDB: db, HeadFetcher: &mock.ChainService{State: beaconState}, BlockBuilder: bs}
+		bad := register(0)
+		bad.Message.GasLimit = 1
+		req := &ethpb.SignedValidatorRegistrationsV1{Messages: []*ethpb.SignedValidatorRegistrationV1{bad, nil, register(2)}}
+		_, err := proposerServer.SubmitValidatorRegistration(ctx, req)
+		require.ErrorContains(t, "Skipped 2 invalid validator registrations", err)
+		require.Equal(t, 1, len(bs.Registrations))
+		reg, err := db.RegistrationByValidatorID(ctx, 2)
+		require.NoError(t, err)
+		require.DeepEqual(t, req.Messages[2].Message, reg)
+		_, err = db.RegistrationByValidatorID(ctx, 0)
+		require.ErrorIs(t, err, kv.ErrNotFoundRegistration)
+	})
+}
+
+func TestProposer_validatorRegistered(t *testing.T) {
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+	proposerServer := &Server{BeaconDB: db}
+	reg := &ethpb.ValidatorRegistrationV1{
+		FeeRecipient: bytesutil.PadTo([]byte{'a'}, fieldparams.FeeRecipientLength),
+		GasLimit:     30000000,
+		Timestamp:    1650000000,
+		Pubkey:       bytesutil.PadTo([]byte{'b'}, fieldparams.BLSPubkeyLength),
+	}
+	require.NoError(t, db.SaveRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{1}, []*ethpb.ValidatorRegistrationV1{reg}))
+
+	registered, err := proposerServer.validatorRegistered(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, false, registered)
+	registered, err = proposerServer.validatorRegistered(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, true, registered)
+}
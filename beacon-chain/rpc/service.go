@@ -14,6 +14,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
@@ -40,6 +41,7 @@ import (
 	slasherservice "github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/io/logs"
@@ -83,6 +85,7 @@ type Config struct {
 	CanonicalFetcher        blockchain.CanonicalFetcher
 	ForkFetcher             blockchain.ForkFetcher
 	FinalizationFetcher     blockchain.FinalizationFetcher
+	LightClientFetcher      blockchain.LightClientFetcher
 	AttestationReceiver     blockchain.AttestationReceiver
 	BlockReceiver           blockchain.BlockReceiver
 	POWChainService         powchain.Chain
@@ -98,9 +101,11 @@ type Config struct {
 	SlashingChecker         slasherservice.SlashingChecker
 	SyncCommitteeObjectPool synccommittee.Pool
 	SyncService             chainSync.Checker
+	BackfillService         backfill.Checker
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	PeerAdmin               p2p.PeerAdmin
 	MetadataProvider        p2p.MetadataProvider
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
@@ -110,6 +115,9 @@ type Config struct {
 	StateGen                *stategen.State
 	MaxMsgSize              int
 	ExecutionEngineCaller   enginev1.Caller
+	BlockBuilder            builder.BlockBuilder
+	LivenessFetcher         blockchain.LivenessFetcher
+	HistoricalStatesConfig  *statefetcher.HistoricalStatesConfig
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -169,6 +177,12 @@ func (s *Service) Start() {
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
+	// The state providers of the servers share the states being generated, and the limits on their generation.
+	var historicalStates *statefetcher.HistoricalStates
+	if s.cfg.HistoricalStatesConfig != nil {
+		historicalStates = statefetcher.NewHistoricalStates(s.ctx, s.cfg.StateGen, s.cfg.HistoricalStatesConfig)
+	}
+
 	validatorServer := &validatorv1alpha1.Server{
 		Ctx:                    s.ctx,
 		AttestationCache:       cache.NewAttestationCache(),
@@ -195,6 +209,7 @@ func (s *Service) Start() {
 		StateGen:               s.cfg.StateGen,
 		SyncCommitteePool:      s.cfg.SyncCommitteeObjectPool,
 		ExecutionEngineCaller:  s.cfg.ExecutionEngineCaller,
+		BlockBuilder:           s.cfg.BlockBuilder,
 		BeaconDB:               s.cfg.BeaconDB,
 	}
 	validatorServerV1 := &validator.Server{
@@ -205,11 +220,13 @@ func (s *Service) Start() {
 		PeerManager:      s.cfg.PeerManager,
 		Broadcaster:      s.cfg.Broadcaster,
 		V1Alpha1Server:   validatorServer,
+		LivenessFetcher:  s.cfg.LivenessFetcher,
 		StateFetcher: &statefetcher.StateProvider{
 			BeaconDB:           s.cfg.BeaconDB,
 			ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 			StateGenService:    s.cfg.StateGen,
+			HistoricalStates:   historicalStates,
 		},
 		SyncCommitteePool: s.cfg.SyncCommitteeObjectPool,
 	}
@@ -232,6 +249,7 @@ func (s *Service) Start() {
 		BeaconDB:           s.cfg.BeaconDB,
 		Server:             s.grpcServer,
 		SyncChecker:        s.cfg.SyncService,
+		BackfillChecker:    s.cfg.BackfillService,
 		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 		PeersFetcher:       s.cfg.PeersFetcher,
 		PeerManager:        s.cfg.PeerManager,
@@ -276,10 +294,13 @@ func (s *Service) Start() {
 			ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 			StateGenService:    s.cfg.StateGen,
+			HistoricalStates:   historicalStates,
 		},
-		HeadFetcher:             s.cfg.HeadFetcher,
-		VoluntaryExitsPool:      s.cfg.ExitPool,
-		V1Alpha1ValidatorServer: validatorServer,
+		HeadFetcher:                   s.cfg.HeadFetcher,
+		LightClientFetcher:            s.cfg.LightClientFetcher,
+		VoluntaryExitsPool:            s.cfg.ExitPool,
+		V1Alpha1ValidatorServer:       validatorServer,
+		ExecutionPayloadReconstructor: s.cfg.ExecutionEngineCaller,
 	}
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbservice.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
@@ -301,6 +322,7 @@ func (s *Service) Start() {
 			HeadFetcher:        s.cfg.HeadFetcher,
 			PeerManager:        s.cfg.PeerManager,
 			PeersFetcher:       s.cfg.PeersFetcher,
+			PeerAdmin:          s.cfg.PeerAdmin,
 		}
 		debugServerV1 := &debug.Server{
 			BeaconDB:    s.cfg.BeaconDB,
@@ -310,6 +332,7 @@ func (s *Service) Start() {
 				ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 				GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 				StateGenService:    s.cfg.StateGen,
+				HistoricalStates:   historicalStates,
 			},
 		}
 		ethpbv1alpha1.RegisterDebugServer(s.grpcServer, debugServer)
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/time/slots"
 )
 
 // StateIdParseError represents an error scenario where a state ID could not be parsed.
@@ -68,6 +69,23 @@ func (e *StateRootNotFoundError) Error() string {
 	return e.message
 }
 
+// StatePrunedError represents an error scenario where a state was pruned from the database.
+type StatePrunedError struct {
+	message string
+}
+
+// NewStatePrunedError creates a new error instance.
+func NewStatePrunedError(slot, prunedSlot types.Slot) StatePrunedError {
+	return StatePrunedError{
+		message: fmt.Sprintf("state at slot %d was pruned, history is only kept from slot %d", slot, prunedSlot),
+	}
+}
+
+// Error returns the underlying error message.
+func (e *StatePrunedError) Error() string {
+	return e.message
+}
+
 // Fetcher is responsible for retrieving info related with the beacon chain.
 type Fetcher interface {
 	State(ctx context.Context, stateId []byte) (state.BeaconState, error)
@@ -80,6 +98,8 @@ type StateProvider struct {
 	ChainInfoFetcher   blockchain.ChainInfoFetcher
 	GenesisTimeFetcher blockchain.TimeFetcher
 	StateGenService    stategen.StateManager
+	// HistoricalStates generates the states of slots and block roots when set, instead of StateGenService.
+	HistoricalStates *HistoricalStates
 }
 
 // State returns the BeaconState for a given identifier. The identifier can be one of:
@@ -109,15 +129,15 @@ func (p *StateProvider) State(ctx context.Context, stateId []byte) (state.Beacon
 		}
 	case "finalized":
 		checkpoint := p.ChainInfoFetcher.FinalizedCheckpt()
-		s, err = p.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
+		s, err = p.stateByRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
 		if err != nil {
-			return nil, errors.Wrap(err, "could not get finalized state")
+			return nil, wrapStateErr(err, "could not get finalized state")
 		}
 	case "justified":
 		checkpoint := p.ChainInfoFetcher.CurrentJustifiedCheckpt()
-		s, err = p.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
+		s, err = p.stateByRoot(ctx, bytesutil.ToBytes32(checkpoint.Root))
 		if err != nil {
-			return nil, errors.Wrap(err, "could not get justified state")
+			return nil, wrapStateErr(err, "could not get justified state")
 		}
 	default:
 		if len(stateId) == 32 {
@@ -179,7 +199,7 @@ func (p *StateProvider) stateByHex(ctx context.Context, stateId []byte) (state.B
 	for i, root := range headState.StateRoots() {
 		if bytes.Equal(root, stateId) {
 			blockRoot := headState.BlockRoots()[i]
-			return p.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(blockRoot))
+			return p.stateByRoot(ctx, bytesutil.ToBytes32(blockRoot))
 		}
 	}
 
@@ -192,6 +212,20 @@ func (p *StateProvider) stateBySlot(ctx context.Context, slot types.Slot) (state
 	if slot > currentSlot {
 		return nil, errors.New("slot cannot be in the future")
 	}
+	if err := p.checkPruned(ctx, slot); err != nil {
+		return nil, err
+	}
+	if p.HistoricalStates != nil {
+		finalizedSlot, err := slots.EpochStart(p.ChainInfoFetcher.FinalizedCheckpt().Epoch)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get finalized slot")
+		}
+		st, err := p.HistoricalStates.StateBySlot(ctx, slot, slot <= finalizedSlot)
+		if err != nil {
+			return nil, wrapStateErr(err, "could not get state")
+		}
+		return st, nil
+	}
 	state, err := p.StateGenService.StateBySlot(ctx, slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get state")
@@ -199,6 +233,24 @@ func (p *StateProvider) stateBySlot(ctx context.Context, slot types.Slot) (state
 	return state, nil
 }
 
+// stateByRoot returns the post state of a block, through the historical states service when it is set
+// so that concurrent requests for the same root share the state generation.
+func (p *StateProvider) stateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	if p.HistoricalStates != nil {
+		return p.HistoricalStates.StateByRoot(ctx, blockRoot)
+	}
+	return p.StateGenService.StateByRoot(ctx, blockRoot)
+}
+
+// wrapStateErr wraps an error of state generation, except for a StatesOverloadedError which is returned as
+// is for the API to ask the client to retry later.
+func wrapStateErr(err error, message string) error {
+	if _, ok := err.(*StatesOverloadedError); ok {
+		return err
+	}
+	return errors.Wrap(err, message)
+}
+
 func (p *StateProvider) headStateRoot(ctx context.Context) ([]byte, error) {
 	b, err := p.ChainInfoFetcher.HeadBlock(ctx)
 	if err != nil {
@@ -273,6 +325,9 @@ func (p *StateProvider) stateRootBySlot(ctx context.Context, slot types.Slot) ([
 	if slot > currentSlot {
 		return nil, errors.New("slot cannot be in the future")
 	}
+	if err := p.checkPruned(ctx, slot); err != nil {
+		return nil, err
+	}
 	found, blks, err := p.BeaconDB.BlocksBySlot(ctx, slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get blocks")
@@ -288,3 +343,17 @@ func (p *StateProvider) stateRootBySlot(ctx context.Context, slot types.Slot) ([
 	}
 	return blks[0].Block().StateRoot(), nil
 }
+
+// checkPruned returns a StatePrunedError if the history at the given slot was pruned from the database.
+// The genesis state is never pruned.
+func (p *StateProvider) checkPruned(ctx context.Context, slot types.Slot) error {
+	prunedSlot, err := p.BeaconDB.HistoryPrunedSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get history pruned slot")
+	}
+	if slot > 0 && slot < prunedSlot {
+		prunedErr := NewStatePrunedError(slot, prunedSlot)
+		return &prunedErr
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	types "github.com/prysmaticlabs/eth2-types"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -155,6 +157,7 @@ func TestGetState(t *testing.T) {
 		stateGen.StatesBySlot[headSlot] = newBeaconState
 
 		p := StateProvider{
+			BeaconDB:           testDB.SetupDB(t),
 			GenesisTimeFetcher: &chainMock.ChainService{Slot: &headSlot},
 			StateGenService:    stateGen,
 		}
@@ -166,6 +169,55 @@ func TestGetState(t *testing.T) {
 		assert.Equal(t, stateRoot, sRoot)
 	})
 
+	t.Run("slot_historical_states", func(t *testing.T) {
+		stateGen := mockstategen.NewMockService()
+		stateGen.StatesBySlot[headSlot] = newBeaconState
+
+		p := StateProvider{
+			BeaconDB:           testDB.SetupDB(t),
+			ChainInfoFetcher:   &chainMock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1}},
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &headSlot},
+			StateGenService:    stateGen,
+			HistoricalStates:   NewHistoricalStates(context.Background(), stateGen, &HistoricalStatesConfig{Concurrency: 1}),
+		}
+
+		s, err := p.State(ctx, []byte(strconv.FormatUint(uint64(headSlot), 10)))
+		require.NoError(t, err)
+		sRoot, err := s.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, stateRoot, sRoot)
+	})
+
+	t.Run("finalized_historical_states", func(t *testing.T) {
+		stateGen := mockstategen.NewMockService()
+		stateGen.StatesByRoot[bytesutil.ToBytes32([]byte("finalized"))] = newBeaconState
+
+		p := StateProvider{
+			ChainInfoFetcher: &chainMock.ChainService{
+				FinalizedCheckPoint: &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte("finalized"), 32)},
+			},
+			HistoricalStates: NewHistoricalStates(context.Background(), stateGen, &HistoricalStatesConfig{Concurrency: 1}),
+		}
+
+		s, err := p.State(ctx, []byte("finalized"))
+		require.NoError(t, err)
+		sRoot, err := s.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, stateRoot, sRoot)
+	})
+
+	t.Run("slot_pruned", func(t *testing.T) {
+		slot := types.Slot(100)
+		p := StateProvider{
+			BeaconDB:           prunedDB(t),
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &slot},
+		}
+		_, err := p.State(ctx, []byte("40"))
+		require.ErrorContains(t, "state at slot 40 was pruned, history is only kept from slot 64", err)
+		_, ok := err.(*StatePrunedError)
+		assert.Equal(t, true, ok)
+	})
+
 	t.Run("slot_too_big", func(t *testing.T) {
 		p := StateProvider{
 			GenesisTimeFetcher: &chainMock.ChainService{
@@ -351,6 +403,17 @@ func TestGetStateRoot(t *testing.T) {
 		assert.DeepEqual(t, blk.Block.StateRoot, s)
 	})
 
+	t.Run("slot_pruned", func(t *testing.T) {
+		slot := types.Slot(100)
+		p := StateProvider{
+			BeaconDB:           prunedDB(t),
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &slot},
+		}
+		_, err := p.StateRoot(ctx, []byte("40"))
+		_, ok := err.(*StatePrunedError)
+		assert.Equal(t, true, ok)
+	})
+
 	t.Run("slot_too_big", func(t *testing.T) {
 		p := StateProvider{
 			GenesisTimeFetcher: &chainMock.ChainService{
@@ -372,3 +435,36 @@ func TestNewStateNotFoundError(t *testing.T) {
 	e := NewStateNotFoundError(100)
 	assert.Equal(t, "state not found in the last 100 state roots", e.message)
 }
+
+// prunedDB returns a db in which the history below slot 64 was pruned.
+func prunedDB(t *testing.T) db.Database {
+	ctx := context.Background()
+	d, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{HistoryRetentionEpochs: 1})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, d.Close())
+	})
+	genesis := bytesutil.ToBytes32([]byte("genesis"))
+	require.NoError(t, d.SaveGenesisBlockRoot(ctx, genesis))
+	parent := genesis
+	var root [32]byte
+	for _, slot := range []types.Slot{32, 64} {
+		blk := util.NewBeaconBlock()
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		blk.Block.Slot = slot
+		root, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, d.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+		parent = root
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(64))
+	require.NoError(t, d.SaveState(ctx, st, root))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: root[:]}))
+	require.NoError(t, d.PruneHistory(ctx, 2))
+	prunedSlot, err := d.HistoryPrunedSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.Slot(64), prunedSlot)
+	return d
+}
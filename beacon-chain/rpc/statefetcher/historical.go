@@ -0,0 +1,218 @@
+package statefetcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"go.opencensus.io/trace"
+)
+
+var (
+	historicalStateRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "historical_state_requests_total",
+		Help: "The number of requests for the state of a slot or block root, by whether the state was cached, shared with " +
+			"a concurrent request, generated or rejected because too many states were being generated.",
+	}, []string{"result"})
+	historicalStatesPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "historical_states_pending",
+		Help: "The number of states being generated or waiting to be generated.",
+	})
+	historicalStateGenerationTime = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "historical_state_generation_seconds",
+		Help:    "The time taken to generate a state, excluding the time waiting in the queue.",
+		Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120},
+	})
+)
+
+// Default limits of the historical states service.
+const (
+	DefaultHistoricalStateConcurrency = 2
+	DefaultHistoricalStateQueueSize   = 16
+	DefaultHistoricalStateCacheSize   = 8
+	DefaultHistoricalStateRetryAfter  = 12 * time.Second
+)
+
+// StatesOverloadedError represents an error scenario where too many states are already being generated.
+type StatesOverloadedError struct {
+	message string
+	// RetryAfter is how long the client should wait before retrying.
+	RetryAfter time.Duration
+}
+
+// NewStatesOverloadedError creates a new error instance.
+func NewStatesOverloadedError(pending int, retryAfter time.Duration) StatesOverloadedError {
+	return StatesOverloadedError{
+		message:    fmt.Sprintf("%d states are already being generated, retry later", pending),
+		RetryAfter: retryAfter,
+	}
+}
+
+// Error returns the underlying error message.
+func (e *StatesOverloadedError) Error() string {
+	return e.message
+}
+
+// HistoricalStatesConfig limits the work done by the historical states service.
+type HistoricalStatesConfig struct {
+	// Concurrency is the number of states generated at once.
+	Concurrency int
+	// QueueSize is the number of states which wait to be generated. Requests for other states are rejected.
+	QueueSize int
+	// CacheSize is the number of generated states kept which can not change, the states of finalized slots
+	// and of block roots. Zero disables the cache.
+	CacheSize int
+	// RetryAfter is how long clients of rejected requests are asked to wait.
+	RetryAfter time.Duration
+}
+
+// HistoricalStates generates the states of slots and block roots for API requests, which can require
+// replaying a large number of blocks. Concurrent requests for the same slot or block root share one
+// generation, recently generated states which can not change are cached, and the number of states
+// generated at once is limited. Requests are rejected with a StatesOverloadedError when too many states
+// are waiting.
+type HistoricalStates struct {
+	ctx        context.Context
+	stateGen   stategen.StateManager
+	cache      *lru.Cache
+	running    chan struct{}
+	maxPending int
+	retryAfter time.Duration
+	lock       sync.Mutex
+	// pending is keyed by the types.Slot or the [32]byte block root of the requested state.
+	pending map[interface{}]*stateRequest
+}
+
+// stateRequest is the generation of a state, shared by the requests for the same slot or block root.
+type stateRequest struct {
+	done chan struct{}
+	st   state.BeaconState
+	err  error
+}
+
+// NewHistoricalStates creates the historical states service. States are generated with ctx, which cancels
+// the pending generations when it is done.
+func NewHistoricalStates(ctx context.Context, stateGen stategen.StateManager, cfg *HistoricalStatesConfig) *HistoricalStates {
+	concurrency := cfg.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	h := &HistoricalStates{
+		ctx:        ctx,
+		stateGen:   stateGen,
+		running:    make(chan struct{}, concurrency),
+		maxPending: concurrency + cfg.QueueSize,
+		retryAfter: cfg.RetryAfter,
+		pending:    make(map[interface{}]*stateRequest),
+	}
+	if cfg.CacheSize > 0 {
+		h.cache = lruwrpr.New(cfg.CacheSize)
+	}
+	return h
+}
+
+// StateBySlot returns the state of the canonical chain at a slot. States at finalized slots do not
+// change, and are cached.
+func (h *HistoricalStates) StateBySlot(ctx context.Context, slot types.Slot, finalized bool) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "statefetcher.HistoricalStates.StateBySlot")
+	defer span.End()
+
+	return h.state(ctx, slot, finalized, func(ctx context.Context) (state.BeaconState, error) {
+		return h.stateGen.StateBySlot(ctx, slot)
+	})
+}
+
+// StateByRoot returns the post state of a block. The state of a block never changes, and is cached.
+func (h *HistoricalStates) StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "statefetcher.HistoricalStates.StateByRoot")
+	defer span.End()
+
+	return h.state(ctx, blockRoot, true, func(ctx context.Context) (state.BeaconState, error) {
+		return h.stateGen.StateByRoot(ctx, blockRoot)
+	})
+}
+
+// state returns the state of the key from the cache, or waits for its generation with gen. A generation
+// already pending for the key is shared.
+func (h *HistoricalStates) state(
+	ctx context.Context,
+	key interface{},
+	cacheable bool,
+	gen func(context.Context) (state.BeaconState, error),
+) (state.BeaconState, error) {
+	if cacheable && h.cache != nil {
+		if st, ok := h.cache.Get(key); ok {
+			historicalStateRequests.WithLabelValues("cached").Inc()
+			return st.(state.BeaconState).Copy(), nil
+		}
+	}
+
+	h.lock.Lock()
+	req, ok := h.pending[key]
+	if ok {
+		historicalStateRequests.WithLabelValues("shared").Inc()
+	} else {
+		if len(h.pending) >= h.maxPending {
+			pending := len(h.pending)
+			h.lock.Unlock()
+			historicalStateRequests.WithLabelValues("rejected").Inc()
+			overloadedErr := NewStatesOverloadedError(pending, h.retryAfter)
+			return nil, &overloadedErr
+		}
+		historicalStateRequests.WithLabelValues("generated").Inc()
+		req = &stateRequest{done: make(chan struct{})}
+		h.pending[key] = req
+		historicalStatesPending.Set(float64(len(h.pending)))
+		// The state is generated independently of the request, which may be canceled while other
+		// requests wait for the same state.
+		go h.generate(key, cacheable, gen, req)
+	}
+	h.lock.Unlock()
+
+	select {
+	case <-req.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if req.err != nil {
+		return nil, req.err
+	}
+	return req.st.Copy(), nil
+}
+
+func (h *HistoricalStates) generate(
+	key interface{},
+	cacheable bool,
+	gen func(context.Context) (state.BeaconState, error),
+	req *stateRequest,
+) {
+	ctx, span := trace.StartSpan(h.ctx, "statefetcher.HistoricalStates.generate")
+	defer span.End()
+
+	select {
+	case h.running <- struct{}{}:
+		start := time.Now()
+		req.st, req.err = gen(ctx)
+		historicalStateGenerationTime.Observe(time.Since(start).Seconds())
+		<-h.running
+	case <-ctx.Done():
+		req.err = ctx.Err()
+	}
+
+	if req.err == nil && cacheable && h.cache != nil {
+		h.cache.Add(key, req.st)
+	}
+	h.lock.Lock()
+	delete(h.pending, key)
+	historicalStatesPending.Set(float64(len(h.pending)))
+	h.lock.Unlock()
+	close(req.done)
+}
@@ -0,0 +1,188 @@
+package statefetcher
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// blockingStateManager generates states once they are released.
+type blockingStateManager struct {
+	*mockstategen.MockStateManager
+	release   chan struct{}
+	generated int32
+}
+
+func (m *blockingStateManager) StateBySlot(_ context.Context, slot types.Slot) (state.BeaconState, error) {
+	atomic.AddInt32(&m.generated, 1)
+	<-m.release
+	st, err := util.NewBeaconState()
+	if err != nil {
+		return nil, err
+	}
+	return st, st.SetSlot(slot)
+}
+
+func (m *blockingStateManager) StateByRoot(_ context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	atomic.AddInt32(&m.generated, 1)
+	<-m.release
+	st, err := util.NewBeaconState()
+	if err != nil {
+		return nil, err
+	}
+	return st, st.SetSlot(types.Slot(blockRoot[0]))
+}
+
+func newBlockingStateManager() *blockingStateManager {
+	return &blockingStateManager{MockStateManager: mockstategen.NewMockService(), release: make(chan struct{})}
+}
+
+func TestHistoricalStates_SharesConcurrentRequests(t *testing.T) {
+	sg := newBlockingStateManager()
+	h := NewHistoricalStates(context.Background(), sg, &HistoricalStatesConfig{Concurrency: 1, CacheSize: 1})
+	shared := testutil.ToFloat64(historicalStateRequests.WithLabelValues("shared"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			st, err := h.StateBySlot(context.Background(), 10, true)
+			require.NoError(t, err)
+			assert.Equal(t, types.Slot(10), st.Slot())
+		}()
+	}
+	// Wait for every request to wait for the same state.
+	require.NoError(t, waitFor(func() bool {
+		return testutil.ToFloat64(historicalStateRequests.WithLabelValues("shared")) == shared+3
+	}))
+	close(sg.release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sg.generated))
+
+	// The finalized state is cached.
+	st, err := h.StateBySlot(context.Background(), 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), st.Slot())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sg.generated))
+
+	// States which are not finalized are not cached.
+	_, err = h.StateBySlot(context.Background(), 11, false)
+	require.NoError(t, err)
+	_, err = h.StateBySlot(context.Background(), 11, false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&sg.generated))
+}
+
+func TestHistoricalStates_SharesConcurrentRequestsByRoot(t *testing.T) {
+	sg := newBlockingStateManager()
+	h := NewHistoricalStates(context.Background(), sg, &HistoricalStatesConfig{Concurrency: 1, CacheSize: 1})
+	shared := testutil.ToFloat64(historicalStateRequests.WithLabelValues("shared"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			st, err := h.StateByRoot(context.Background(), [32]byte{5})
+			require.NoError(t, err)
+			assert.Equal(t, types.Slot(5), st.Slot())
+		}()
+	}
+	require.NoError(t, waitFor(func() bool {
+		return testutil.ToFloat64(historicalStateRequests.WithLabelValues("shared")) == shared+2
+	}))
+	close(sg.release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sg.generated))
+
+	// The state of a block root is cached, and is not mistaken for the state of a slot.
+	_, err := h.StateByRoot(context.Background(), [32]byte{5})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sg.generated))
+	_, err = h.StateBySlot(context.Background(), 5, true)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sg.generated))
+}
+
+func TestHistoricalStates_Overloaded(t *testing.T) {
+	sg := newBlockingStateManager()
+	h := NewHistoricalStates(context.Background(), sg, &HistoricalStatesConfig{Concurrency: 1, QueueSize: 1, RetryAfter: time.Second})
+
+	results := make(chan error, 2)
+	for _, slot := range []types.Slot{1, 2} {
+		go func(slot types.Slot) {
+			_, err := h.StateBySlot(context.Background(), slot, false)
+			results <- err
+		}(slot)
+	}
+	require.NoError(t, waitFor(func() bool {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		return len(h.pending) == 2
+	}))
+	// Only one state is generated at once, the other one waits.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sg.generated))
+
+	_, err := h.StateBySlot(context.Background(), 3, false)
+	overloadedErr, ok := err.(*StatesOverloadedError)
+	require.Equal(t, true, ok, "Expected a StatesOverloadedError, got %v", err)
+	assert.Equal(t, time.Second, overloadedErr.RetryAfter)
+
+	// Requests for a pending state are still served.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = h.StateBySlot(ctx, 2, false)
+	assert.ErrorContains(t, context.Canceled.Error(), err)
+
+	close(sg.release)
+	require.NoError(t, <-results)
+	require.NoError(t, <-results)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sg.generated))
+}
+
+func TestHistoricalStates_CanceledOnShutdown(t *testing.T) {
+	sg := newBlockingStateManager()
+	ctx, cancel := context.WithCancel(context.Background())
+	h := NewHistoricalStates(ctx, sg, &HistoricalStatesConfig{Concurrency: 1, QueueSize: 1})
+
+	results := make(chan error, 2)
+	for _, slot := range []types.Slot{1, 2} {
+		go func(slot types.Slot) {
+			_, err := h.StateBySlot(context.Background(), slot, false)
+			results <- err
+		}(slot)
+		require.NoError(t, waitFor(func() bool {
+			h.lock.Lock()
+			defer h.lock.Unlock()
+			return len(h.pending) == int(slot)
+		}))
+	}
+
+	// The queued state is no longer generated once the service shuts down.
+	cancel()
+	assert.ErrorContains(t, context.Canceled.Error(), <-results)
+	close(sg.release)
+	require.NoError(t, <-results)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sg.generated))
+}
+
+func waitFor(cond func() bool) error {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return context.DeadlineExceeded
+}
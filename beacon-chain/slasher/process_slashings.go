@@ -72,7 +72,7 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		// Log the slashing event and insert into the beacon node's operations pool.
 		logProposerSlashing(sl)
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, sl); err != nil {
-			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
 		}
 	}
 	return nil
@@ -1,38 +1,15 @@
 package stategen
 
 import (
-	"errors"
-	"strconv"
 	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	"k8s.io/client-go/tools/cache"
 )
 
-var (
-	// maxCacheSize is 8. That means 8 epochs and roughly an hour
-	// of no finality can be endured.
-	maxCacheSize        = uint64(8)
-	errNotSlotRootInfo  = errors.New("not slot root info type")
-	errNotRootStateInfo = errors.New("not root state info type")
-)
-
-// slotRootInfo specifies the slot root info in the epoch boundary state cache.
-type slotRootInfo struct {
-	slot types.Slot
-	root [32]byte
-}
-
-// slotKeyFn takes the string representation of the slot to be used as key
-// to retrieve root.
-func slotKeyFn(obj interface{}) (string, error) {
-	s, ok := obj.(*slotRootInfo)
-	if !ok {
-		return "", errNotSlotRootInfo
-	}
-	return slotToString(s.slot), nil
-}
+// DefaultEpochBoundaryStateCacheBudget is the default estimated memory, in bytes, of the epoch
+// boundary states which are cached. It allows roughly 8 epochs of no finality on mainnet.
+var DefaultEpochBoundaryStateCacheBudget uint64 = 1024 << 20
 
 // rootStateInfo specifies the root state info in the epoch boundary state cache.
 type rootStateInfo struct {
@@ -40,120 +17,78 @@ type rootStateInfo struct {
 	state state.BeaconState
 }
 
-// rootKeyFn takes the string representation of the block root to be used as key
-// to retrieve epoch boundary state.
-func rootKeyFn(obj interface{}) (string, error) {
-	s, ok := obj.(*rootStateInfo)
-	if !ok {
-		return "", errNotRootStateInfo
-	}
-	return string(s.root[:]), nil
-}
-
-// epochBoundaryState struct with two queues by looking up beacon state by slot or root.
+// epochBoundaryState caches epoch boundary states by block root, and indexes them by slot.
 type epochBoundaryState struct {
-	rootStateCache *cache.FIFO
-	slotRootCache  *cache.FIFO
+	rootStateCache *stateLRU
+	slotRoots      map[types.Slot][32]byte
 	lock           sync.RWMutex
 }
 
 // newBoundaryStateCache creates a new block newBoundaryStateCache for storing and accessing epoch boundary states from
-// memory.
-func newBoundaryStateCache() *epochBoundaryState {
-	return &epochBoundaryState{
-		rootStateCache: cache.NewFIFO(rootKeyFn),
-		slotRootCache:  cache.NewFIFO(slotKeyFn),
+// memory, bounded by the estimated memory of its states.
+func newBoundaryStateCache(budget uint64) *epochBoundaryState {
+	e := &epochBoundaryState{
+		rootStateCache: newStateLRU("epoch_boundary", budget),
+		slotRoots:      make(map[types.Slot][32]byte),
 	}
+	e.rootStateCache.onEvict = e.deleteSlotRoot
+	return e
 }
 
 // get epoch boundary state by its block root. Returns copied state in state info object if exists. Otherwise returns nil.
 func (e *epochBoundaryState) getByRoot(r [32]byte) (*rootStateInfo, bool, error) {
-	e.lock.RLock()
-	defer e.lock.RUnlock()
+	e.lock.Lock()
+	defer e.lock.Unlock()
 
 	return e.getByRootLockFree(r)
 }
 
 func (e *epochBoundaryState) getByRootLockFree(r [32]byte) (*rootStateInfo, bool, error) {
-	obj, exists, err := e.rootStateCache.GetByKey(string(r[:]))
-	if err != nil {
-		return nil, false, err
-	}
+	s, exists := e.rootStateCache.get(r)
 	if !exists {
 		return nil, false, nil
 	}
-	s, ok := obj.(*rootStateInfo)
-	if !ok {
-		return nil, false, errNotRootStateInfo
-	}
 
 	return &rootStateInfo{
 		root:  r,
-		state: s.state.Copy(),
+		state: s.Copy(),
 	}, true, nil
 }
 
 // get epoch boundary state by its slot. Returns copied state in state info object if exists. Otherwise returns nil.
 func (e *epochBoundaryState) getBySlot(s types.Slot) (*rootStateInfo, bool, error) {
-	e.lock.RLock()
-	defer e.lock.RUnlock()
+	e.lock.Lock()
+	defer e.lock.Unlock()
 
-	obj, exists, err := e.slotRootCache.GetByKey(slotToString(s))
-	if err != nil {
-		return nil, false, err
-	}
+	r, exists := e.slotRoots[s]
 	if !exists {
 		return nil, false, nil
 	}
-	info, ok := obj.(*slotRootInfo)
-	if !ok {
-		return nil, false, errNotSlotRootInfo
-	}
 
-	return e.getByRootLockFree(info.root)
+	return e.getByRootLockFree(r)
 }
 
-// put adds a state to the epoch boundary state cache. This method also trims the
-// least recently added state info if the cache size has reached the max cache
-// size limit.
+// put adds a state to the epoch boundary state cache. This method also evicts states
+// if the cache has exceeded its memory budget, preferring states which are not canonical.
 func (e *epochBoundaryState) put(r [32]byte, s state.BeaconState) error {
 	e.lock.Lock()
 	defer e.lock.Unlock()
 
-	if err := e.slotRootCache.AddIfNotPresent(&slotRootInfo{
-		slot: s.Slot(),
-		root: r,
-	}); err != nil {
-		return err
+	if e.rootStateCache.has(r) {
+		return nil
 	}
-	if err := e.rootStateCache.AddIfNotPresent(&rootStateInfo{
-		root:  r,
-		state: s.Copy(),
-	}); err != nil {
-		return err
+	if _, ok := e.slotRoots[s.Slot()]; !ok {
+		e.slotRoots[s.Slot()] = r
 	}
-
-	trim(e.rootStateCache, maxCacheSize)
-	trim(e.slotRootCache, maxCacheSize)
+	e.rootStateCache.add(r, s.Copy())
 
 	return nil
 }
 
-// trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize uint64) {
-	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
-		if _, err := queue.Pop(popProcessNoopFunc); err != nil { // This never returns an error, but we'll handle anyway for sanity.
-			panic(err)
-		}
+// deleteSlotRoot removes the slot index of an evicted state, unless the slot has since been
+// indexed to another state.
+func (e *epochBoundaryState) deleteSlotRoot(r [32]byte, s state.BeaconState) {
+	if root, ok := e.slotRoots[s.Slot()]; ok && root == r {
+		delete(e.slotRoots, s.Slot())
 	}
 }
-
-// popProcessNoopFunc is a no-op function that never returns an error.
-func popProcessNoopFunc(_ interface{}) error {
-	return nil
-}
-
-// Converts input uint64 to string. To be used as key for slot to get root.
-func slotToString(s types.Slot) string {
-	return strconv.FormatUint(uint64(s), 10)
-}
@@ -9,18 +9,8 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/util"
 )
 
-func TestEpochBoundaryStateCache_BadSlotKey(t *testing.T) {
-	_, err := slotKeyFn("sushi")
-	assert.ErrorContains(t, errNotSlotRootInfo.Error(), err, "Did not get wanted error")
-}
-
-func TestEpochBoundaryStateCache_BadRootKey(t *testing.T) {
-	_, err := rootKeyFn("noodle")
-	assert.ErrorContains(t, errNotRootStateInfo.Error(), err, "Did not get wanted error")
-}
-
 func TestEpochBoundaryStateCache_CanSave(t *testing.T) {
-	e := newBoundaryStateCache()
+	e := newBoundaryStateCache(DefaultEpochBoundaryStateCacheBudget)
 	s, err := util.NewBeaconState()
 	require.NoError(t, err)
 	require.NoError(t, s.SetSlot(1))
@@ -49,23 +39,39 @@ func TestEpochBoundaryStateCache_CanSave(t *testing.T) {
 }
 
 func TestEpochBoundaryStateCache_CanTrim(t *testing.T) {
-	e := newBoundaryStateCache()
+	base, err := util.NewBeaconState()
+	require.NoError(t, err)
+	// Every state has its own balances, while the other fields are shared.
+	require.NoError(t, base.SetBalances(make([]uint64, 1024)))
+	stateSize := uint64(len(base.Balances())*8 + smallFieldsMemSize)
+	sharedSize := uint64(0)
+	for _, f := range estimateStateFields(base) {
+		sharedSize += f.size
+	}
+	sharedSize -= 1024 * 8
+	maxStates := uint64(8)
+	e := newBoundaryStateCache(sharedSize + maxStates*stateSize)
 	offSet := types.Slot(10)
-	for i := types.Slot(0); i < offSet.Add(maxCacheSize); i++ {
-		s, err := util.NewBeaconState()
-		require.NoError(t, err)
+	for i := types.Slot(0); i < offSet.Add(maxStates); i++ {
+		s := base.Copy()
 		require.NoError(t, s.SetSlot(i))
+		require.NoError(t, s.SetBalances(make([]uint64, 1024)))
 		r := [32]byte{byte(i)}
 		require.NoError(t, e.put(r, s))
 	}
 
-	assert.Equal(t, int(maxCacheSize), len(e.rootStateCache.ListKeys()), "Did not trim to the correct amount")
-	assert.Equal(t, int(maxCacheSize), len(e.slotRootCache.ListKeys()), "Did not trim to the correct amount")
-	for _, l := range e.rootStateCache.List() {
-		i, ok := l.(*rootStateInfo)
-		require.Equal(t, true, ok, "Bad type assertion")
-		if i.state.Slot() < offSet {
+	assert.Equal(t, int(maxStates), e.rootStateCache.len(), "Did not trim to the correct amount")
+	assert.Equal(t, int(maxStates), len(e.slotRoots), "Did not trim to the correct amount")
+	assert.Equal(t, sharedSize+maxStates*stateSize, e.rootStateCache.bytes)
+	for slot := range e.slotRoots {
+		if slot < offSet {
 			t.Error("Did not trim the correct state")
 		}
 	}
+	_, exists, err := e.getBySlot(offSet - 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists, "Should not exist")
+	_, exists, err = e.getBySlot(offSet)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists, "Should exist")
 }
@@ -3,16 +3,15 @@ package stategen
 import (
 	"sync"
 
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 )
 
 var (
-	// hotStateCacheSize defines the max number of hot state this can cache.
-	hotStateCacheSize = 32
+	// DefaultHotStateCacheBudget is the default estimated memory, in bytes, of the hot states
+	// which are cached.
+	DefaultHotStateCacheBudget uint64 = 2048 << 20
 	// Metrics
 	hotStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "hot_state_cache_hit",
@@ -26,27 +25,27 @@ var (
 
 // hotStateCache is used to store the processed beacon state after finalized check point..
 type hotStateCache struct {
-	cache *lru.Cache
+	cache *stateLRU
 	lock  sync.RWMutex
 }
 
-// newHotStateCache initializes the map and underlying cache.
-func newHotStateCache() *hotStateCache {
+// newHotStateCache initializes the underlying cache, bounded by the estimated memory of its states.
+func newHotStateCache(budget uint64) *hotStateCache {
 	return &hotStateCache{
-		cache: lruwrpr.New(hotStateCacheSize),
+		cache: newStateLRU("hot", budget),
 	}
 }
 
 // Get returns a cached response via input block root, if any.
 // The response is copied by default.
 func (c *hotStateCache) get(root [32]byte) state.BeaconState {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	item, exists := c.cache.Get(root)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	item, exists := c.cache.get(root)
 
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
-		return item.(state.BeaconState).Copy()
+		return item.Copy()
 	}
 	hotStateCacheMiss.Inc()
 	return nil
@@ -54,12 +53,12 @@ func (c *hotStateCache) get(root [32]byte) state.BeaconState {
 
 // GetWithoutCopy returns a non-copied cached response via input block root.
 func (c *hotStateCache) getWithoutCopy(root [32]byte) state.BeaconState {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
-	item, exists := c.cache.Get(root)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	item, exists := c.cache.get(root)
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
-		return item.(state.BeaconState)
+		return item
 	}
 	hotStateCacheMiss.Inc()
 	return nil
@@ -69,19 +68,19 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) state.BeaconState {
 func (c *hotStateCache) put(root [32]byte, state state.BeaconState) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	c.cache.Add(root, state)
+	c.cache.add(root, state)
 }
 
 // has returns true if the key exists in the cache.
 func (c *hotStateCache) has(root [32]byte) bool {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
-	return c.cache.Contains(root)
+	return c.cache.has(root)
 }
 
 // delete deletes the key exists in the cache.
 func (c *hotStateCache) delete(root [32]byte) bool {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	return c.cache.Remove(root)
+	return c.cache.remove(root)
 }
@@ -11,7 +11,7 @@ import (
 )
 
 func TestHotStateCache_RoundTrip(t *testing.T) {
-	c := newHotStateCache()
+	c := newHotStateCache(DefaultHotStateCacheBudget)
 	root := [32]byte{'A'}
 	s := c.get(root)
 	assert.Equal(t, state.BeaconState(nil), s)
@@ -14,3 +14,46 @@ var (
 		},
 	)
 )
+
+var (
+	stateCacheBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "state_cache_bytes",
+		Help: "The estimated memory taken by the states of a state cache.",
+	}, []string{"cache"})
+	stateCacheStates = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "state_cache_states",
+		Help: "The number of states in a state cache.",
+	}, []string{"cache"})
+	stateCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_hits_total",
+		Help: "The number of lookups which found a state in a state cache.",
+	}, []string{"cache"})
+	stateCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_misses_total",
+		Help: "The number of lookups which did not find a state in a state cache.",
+	}, []string{"cache"})
+	stateCacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_cache_evictions_total",
+		Help: "The number of states evicted from a state cache to stay within its memory budget, " +
+			"by whether the state was canonical.",
+	}, []string{"cache", "canonical"})
+)
+
+var (
+	saveHotStateDBEnabled = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "save_hot_state_db_enabled",
+		Help: "Whether hot states are being saved to the DB because of a long period of non-finality.",
+	})
+	hotStatesSavedInDB = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hot_states_saved_in_db",
+		Help: "The number of hot states saved to the DB which are deleted once the chain finalizes.",
+	})
+	hotStateDBSaves = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_db_saves_total",
+		Help: "The number of hot states saved to the DB during non-finality.",
+	})
+	hotStateDBDeletes = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_db_deletes_total",
+		Help: "The number of hot states deleted from the DB after the chain finalized again.",
+	})
+)
@@ -6,7 +6,9 @@ import (
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -33,11 +35,27 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	// Start at previous finalized slot, stop at current finalized slot.
 	// If the slot is on archived point, save the state of that slot to the DB.
+	var diffReqs []*stateDiffRequest
 	for slot := oldFSlot; slot < fSlot; slot++ {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 
+		// In between archived points, the state of every epoch start is saved as a difference once
+		// migration is done. The epoch boundary state is used when it is still cached and canonical.
+		if features.Get().EnableStateDiffs && slot%s.slotsPerArchivedPoint != 0 && slots.IsEpochStart(slot) {
+			req := &stateDiffRequest{slot: slot}
+			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+			if err != nil {
+				return fmt.Errorf("could not get epoch boundary state for slot %d", slot)
+			}
+			if exists && s.beaconDB.IsFinalizedBlock(ctx, cached.root) {
+				req.st = cached.state.Copy()
+			}
+			diffReqs = append(diffReqs, req)
+			continue
+		}
+
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
@@ -86,12 +104,16 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				for i := 0; i < len(roots); i++ {
 					if aRoot == roots[i] {
 						s.saveHotStateDB.savedStateRoots = append(roots[:i], roots[i+1:]...)
+						hotStatesSavedInDB.Set(float64(len(s.saveHotStateDB.savedStateRoots)))
 						// There shouldn't be duplicated roots in `savedStateRoots`.
 						// Break here is ok.
 						break
 					}
 				}
 				s.saveHotStateDB.lock.Unlock()
+				if err := s.beaconDB.DeleteHotStateRoots(ctx, [][32]byte{aRoot}); err != nil {
+					return err
+				}
 				continue
 			}
 
@@ -115,5 +137,13 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		s.SaveFinalizedState(fSlot, fRoot, fInfo.state)
 	}
 
+	if len(diffReqs) > 0 && s.ctx.Err() == nil {
+		s.stateDiffs.Add(1)
+		go func() {
+			defer s.stateDiffs.Done()
+			s.saveStateDiffs(s.ctx, diffReqs)
+		}()
+	}
+
 	return nil
 }
@@ -115,6 +115,11 @@ func (_ *MockStateManager) DisableSaveHotStateToDB(_ context.Context) error {
 	panic("implement me")
 }
 
+// UpdateSaveHotStateToDB --
+func (_ *MockStateManager) UpdateSaveHotStateToDB(_ context.Context, _, _ types.Epoch) error {
+	panic("implement me")
+}
+
 // AddStateForRoot --
 func (m *MockStateManager) AddStateForRoot(state state.BeaconState, blockRoot [32]byte) {
 	m.StatesByRoot[blockRoot] = state
@@ -15,11 +15,16 @@ import (
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
 var defaultHotStateDBInterval types.Slot = 128
 
+// DefaultSaveHotStateFinalityLag is the default number of epochs since the finalized checkpoint
+// after which hot states are saved to the DB.
+var DefaultSaveHotStateFinalityLag = types.Epoch(100)
+
 // StateManager represents a management object that handles the internal
 // logic of maintaining both hot and cold states in DB.
 type StateManager interface {
@@ -39,6 +44,7 @@ type StateManager interface {
 	ForceCheckpoint(ctx context.Context, root []byte) error
 	EnableSaveHotStateToDB(_ context.Context)
 	DisableSaveHotStateToDB(ctx context.Context) error
+	UpdateSaveHotStateToDB(ctx context.Context, currentEpoch, finalizedEpoch types.Epoch) error
 }
 
 // State is a concrete implementation of StateManager.
@@ -49,6 +55,10 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	stateDiffLock           sync.Mutex
+	stateDiffs              sync.WaitGroup
+	ctx                     context.Context
+	cancel                  context.CancelFunc
 }
 
 // This tracks the config in the event of long non-finality,
@@ -58,6 +68,7 @@ type saveHotStateDbConfig struct {
 	enabled         bool
 	lock            sync.Mutex
 	duration        types.Slot
+	finalityLag     types.Epoch
 	savedStateRoots [][32]byte
 }
 
@@ -70,18 +81,68 @@ type finalizedInfo struct {
 	lock  sync.RWMutex
 }
 
+// StateGenOption configures the state management object.
+type StateGenOption func(*State)
+
+// WithHotStateCacheBudget bounds the estimated memory of the cached hot states, in bytes.
+func WithHotStateCacheBudget(budget uint64) StateGenOption {
+	return func(s *State) {
+		s.hotStateCache.cache.budget = budget
+	}
+}
+
+// WithEpochBoundaryStateCacheBudget bounds the estimated memory of the cached epoch boundary
+// states, in bytes.
+func WithEpochBoundaryStateCacheBudget(budget uint64) StateGenOption {
+	return func(s *State) {
+		s.epochBoundaryStateCache.rootStateCache.budget = budget
+	}
+}
+
+// WithCanonicalChecker lets the state caches evict states which are not canonical first.
+func WithCanonicalChecker(c CanonicalChecker) StateGenOption {
+	return func(s *State) {
+		s.hotStateCache.cache.canonical = c
+		s.epochBoundaryStateCache.rootStateCache.canonical = c
+	}
+}
+
+// WithSaveHotStateToDBPolicy sets the number of epochs since the finalized checkpoint after which
+// hot states are saved to the DB, and the number of epochs between the saved hot states.
+func WithSaveHotStateToDBPolicy(finalityLag, interval types.Epoch) StateGenOption {
+	return func(s *State) {
+		s.saveHotStateDB.finalityLag = finalityLag
+		s.saveHotStateDB.duration = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(interval))
+	}
+}
+
 // New returns a new state management object.
-func New(beaconDB db.NoHeadAccessDatabase) *State {
-	return &State{
+func New(beaconDB db.NoHeadAccessDatabase, opts ...StateGenOption) *State {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &State{
+		ctx:                     ctx,
+		cancel:                  cancel,
 		beaconDB:                beaconDB,
-		hotStateCache:           newHotStateCache(),
+		hotStateCache:           newHotStateCache(DefaultHotStateCacheBudget),
 		finalizedInfo:           &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
 		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
-		epochBoundaryStateCache: newBoundaryStateCache(),
+		epochBoundaryStateCache: newBoundaryStateCache(DefaultEpochBoundaryStateCacheBudget),
 		saveHotStateDB: &saveHotStateDbConfig{
-			duration: defaultHotStateDBInterval,
+			duration:    defaultHotStateDBInterval,
+			finalityLag: DefaultSaveHotStateFinalityLag,
 		},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Stop cancels the state differences being saved in the background and waits for them to return,
+// so that the DB can be closed.
+func (s *State) Stop() {
+	s.cancel()
+	s.stateDiffs.Wait()
 }
 
 // Resume resumes a new state management object from previously saved finalized check point in DB.
@@ -111,6 +172,24 @@ func (s *State) Resume(ctx context.Context, fState state.BeaconState) (state.Bea
 
 	s.finalizedInfo = &finalizedInfo{slot: fState.Slot(), root: fRoot, state: fState.Copy()}
 
+	// Pick up the hot states saved before the restart, so that they are deleted once the chain finalizes.
+	summaries, err := s.beaconDB.HotStateRoots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(summaries) > 0 {
+		s.saveHotStateDB.lock.Lock()
+		for _, summary := range summaries {
+			s.saveHotStateDB.savedStateRoots = append(s.saveHotStateDB.savedStateRoots, bytesutil.ToBytes32(summary.Root))
+		}
+		hotStatesSavedInDB.Set(float64(len(s.saveHotStateDB.savedStateRoots)))
+		s.saveHotStateDB.lock.Unlock()
+		log.WithFields(logrus.Fields{
+			"count":      len(summaries),
+			"latestSlot": summaries[len(summaries)-1].Slot,
+		}).Info("Found hot states saved in DB")
+	}
+
 	return fState, nil
 }
 
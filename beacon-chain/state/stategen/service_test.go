@@ -6,6 +6,7 @@ import (
 
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -35,3 +36,25 @@ func TestResume(t *testing.T) {
 	assert.Equal(t, service.finalizedInfo.root, root, "Did not get wanted root")
 	assert.NotNil(t, service.finalizedState(), "Wanted a non nil finalized state")
 }
+
+func TestResume_SavedHotStates(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB)
+	b := util.NewBeaconBlock()
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, service.beaconDB.SaveState(ctx, beaconState, root))
+	require.NoError(t, service.beaconDB.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, service.beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: root[:]}))
+	require.NoError(t, service.beaconDB.SaveHotStateRoot(ctx, &ethpb.StateSummary{Slot: 256, Root: bytesutil.PadTo([]byte{'b'}, 32)}))
+	require.NoError(t, service.beaconDB.SaveHotStateRoot(ctx, &ethpb.StateSummary{Slot: 128, Root: bytesutil.PadTo([]byte{'a'}, 32)}))
+
+	_, err = service.Resume(ctx, beaconState)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{{'a'}, {'b'}}, service.saveHotStateDB.savedStateRoots)
+	assert.Equal(t, false, service.saveHotStateDB.enabled)
+}
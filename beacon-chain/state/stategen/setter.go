@@ -2,8 +2,11 @@ package stategen
 
 import (
 	"context"
+	"errors"
 	"math"
 
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -58,7 +61,13 @@ func (s *State) saveStateByRoot(ctx context.Context, blockRoot [32]byte, st stat
 			s.saveHotStateDB.lock.Unlock()
 			return err
 		}
+		if err := s.beaconDB.SaveHotStateRoot(ctx, &ethpb.StateSummary{Slot: st.Slot(), Root: blockRoot[:]}); err != nil {
+			s.saveHotStateDB.lock.Unlock()
+			return err
+		}
 		s.saveHotStateDB.savedStateRoots = append(s.saveHotStateDB.savedStateRoots, blockRoot)
+		hotStateDBSaves.Inc()
+		hotStatesSavedInDB.Set(float64(len(s.saveHotStateDB.savedStateRoots)))
 
 		log.WithFields(logrus.Fields{
 			"slot":                   st.Slot(),
@@ -103,6 +112,7 @@ func (s *State) EnableSaveHotStateToDB(_ context.Context) {
 	}
 
 	s.saveHotStateDB.enabled = true
+	saveHotStateDBEnabled.Set(1)
 
 	log.WithFields(logrus.Fields{
 		"enabled":       s.saveHotStateDB.enabled,
@@ -112,10 +122,11 @@ func (s *State) EnableSaveHotStateToDB(_ context.Context) {
 
 // DisableSaveHotStateToDB exits the mode that saves beacon state to DB for the hot states.
 // This usually gets triggered once there's finality after long duration since finality.
+// The hot states saved to the DB, including the ones saved before a restart, are deleted.
 func (s *State) DisableSaveHotStateToDB(ctx context.Context) error {
 	s.saveHotStateDB.lock.Lock()
 	defer s.saveHotStateDB.lock.Unlock()
-	if !s.saveHotStateDB.enabled {
+	if !s.saveHotStateDB.enabled && len(s.saveHotStateDB.savedStateRoots) == 0 {
 		return nil
 	}
 
@@ -126,10 +137,49 @@ func (s *State) DisableSaveHotStateToDB(ctx context.Context) error {
 
 	// Delete previous saved states in DB as we are turning this mode off.
 	s.saveHotStateDB.enabled = false
-	if err := s.beaconDB.DeleteStates(ctx, s.saveHotStateDB.savedStateRoots); err != nil {
+	saveHotStateDBEnabled.Set(0)
+	roots := s.saveHotStateDB.savedStateRoots
+	for i, r := range roots {
+		if err := s.beaconDB.DeleteState(ctx, r); err != nil {
+			if errors.Is(err, db.ErrDeleteProtectedState) {
+				// The saved state has since become the finalized or head state, which stays in the DB
+				// and is only no longer tracked as a saved hot state.
+				continue
+			}
+			// Keep the states which could not be deleted so that they are deleted on the next attempt.
+			s.saveHotStateDB.savedStateRoots = roots[i:]
+			hotStatesSavedInDB.Set(float64(len(roots) - i))
+			if indexErr := s.beaconDB.DeleteHotStateRoots(ctx, roots[:i]); indexErr != nil {
+				log.WithError(indexErr).Error("Could not delete hot state roots")
+			}
+			return err
+		}
+		hotStateDBDeletes.Inc()
+	}
+	if err := s.beaconDB.DeleteHotStateRoots(ctx, roots); err != nil {
 		return err
 	}
 	s.saveHotStateDB.savedStateRoots = nil
+	hotStatesSavedInDB.Set(0)
 
 	return nil
 }
+
+// UpdateSaveHotStateToDB saves hot states to the DB once the finalized checkpoint lags behind
+// the current epoch by the configured number of epochs, so that long periods of non-finality
+// neither keep every hot state in memory nor require replaying every block after a restart.
+// Once the chain finalizes again, the saved hot states are deleted.
+func (s *State) UpdateSaveHotStateToDB(ctx context.Context, currentEpoch, finalizedEpoch types.Epoch) error {
+	// Prevent `sinceFinality` going underflow.
+	var sinceFinality types.Epoch
+	if currentEpoch > finalizedEpoch {
+		sinceFinality = currentEpoch - finalizedEpoch
+	}
+
+	if sinceFinality >= s.saveHotStateDB.finalityLag {
+		s.EnableSaveHotStateToDB(ctx)
+		return nil
+	}
+
+	return s.DisableSaveHotStateToDB(ctx)
+}
@@ -152,6 +152,11 @@ func TestSaveState_CanSaveHotStateToDB(t *testing.T) {
 	require.LogsContain(t, hook, "Saving hot state to DB")
 	// Should have saved in DB.
 	require.Equal(t, true, beaconDB.HasState(ctx, r))
+	summaries, err := beaconDB.HotStateRoots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(summaries))
+	assert.DeepEqual(t, r[:], summaries[0].Root)
+	assert.Equal(t, defaultHotStateDBInterval, summaries[0].Slot)
 }
 
 func TestEnableSaveHotStateToDB_Enabled(t *testing.T) {
@@ -202,3 +207,65 @@ func TestEnableSaveHotStateToDB_AlreadyDisabled(t *testing.T) {
 	require.LogsDoNotContain(t, hook, "Exiting mode to save hot states in DB")
 	require.Equal(t, false, service.saveHotStateDB.enabled)
 }
+
+func TestDisableSaveHotStateToDB_KeepsHeadState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, WithSaveHotStateToDBPolicy(10, 1))
+	service.EnableSaveHotStateToDB(ctx)
+
+	roots := make([][32]byte, 2)
+	for i := range roots {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(i + 1))
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		beaconState, _ := util.DeterministicGenesisState(t, 32)
+		require.NoError(t, beaconState.SetSlot(b.Block.Slot))
+		require.NoError(t, service.saveStateByRoot(ctx, r, beaconState))
+		roots[i] = r
+	}
+	// The first saved state has since become the head state, which cannot be deleted.
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, roots[0]))
+
+	require.NoError(t, service.DisableSaveHotStateToDB(ctx))
+	require.Equal(t, 0, len(service.saveHotStateDB.savedStateRoots))
+	require.Equal(t, true, beaconDB.HasState(ctx, roots[0]))
+	require.Equal(t, false, beaconDB.HasState(ctx, roots[1]))
+	summaries, err := beaconDB.HotStateRoots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(summaries))
+}
+
+func TestUpdateSaveHotStateToDB(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, WithSaveHotStateToDBPolicy(10, 2))
+	require.Equal(t, 2*params.BeaconConfig().SlotsPerEpoch, service.saveHotStateDB.duration)
+
+	require.NoError(t, service.UpdateSaveHotStateToDB(ctx, 9, 0))
+	require.Equal(t, false, service.saveHotStateDB.enabled)
+	require.NoError(t, service.UpdateSaveHotStateToDB(ctx, 0, 10))
+	require.Equal(t, false, service.saveHotStateDB.enabled)
+	require.NoError(t, service.UpdateSaveHotStateToDB(ctx, 10, 0))
+	require.Equal(t, true, service.saveHotStateDB.enabled)
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(b.Block.Slot))
+	require.NoError(t, service.saveStateByRoot(ctx, r, beaconState))
+	require.Equal(t, true, beaconDB.HasState(ctx, r))
+
+	// The saved hot states are deleted once the chain finalizes again.
+	require.NoError(t, service.UpdateSaveHotStateToDB(ctx, 11, 10))
+	require.Equal(t, false, service.saveHotStateDB.enabled)
+	require.Equal(t, false, beaconDB.HasState(ctx, r))
+	summaries, err := beaconDB.HotStateRoots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(summaries))
+}
@@ -0,0 +1,141 @@
+package stategen
+
+import (
+	"container/list"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+)
+
+// CanonicalChecker determines whether a block root is part of the canonical chain.
+type CanonicalChecker interface {
+	IsCanonical(root [32]byte) bool
+}
+
+// stateLRU is a least recently used cache of states, bounded by the estimated memory of the
+// states it holds rather than by their number. Fields shared between cached states are counted
+// once. When over budget, states which are not canonical are evicted before canonical ones.
+// stateLRU is not safe for concurrent use.
+type stateLRU struct {
+	name      string
+	budget    uint64
+	canonical CanonicalChecker
+	onEvict   func(root [32]byte, st state.BeaconState)
+	entries   *list.List
+	items     map[[32]byte]*list.Element
+	fieldRefs map[fieldKey]int
+	bytes     uint64
+}
+
+type stateLRUEntry struct {
+	root   [32]byte
+	state  state.BeaconState
+	fields []stateField
+}
+
+func newStateLRU(name string, budget uint64) *stateLRU {
+	return &stateLRU{
+		name:      name,
+		budget:    budget,
+		entries:   list.New(),
+		items:     make(map[[32]byte]*list.Element),
+		fieldRefs: make(map[fieldKey]int),
+	}
+}
+
+// get returns the state of a root and marks it as recently used.
+func (c *stateLRU) get(root [32]byte) (state.BeaconState, bool) {
+	e, ok := c.items[root]
+	if !ok {
+		stateCacheMisses.WithLabelValues(c.name).Inc()
+		return nil, false
+	}
+	stateCacheHits.WithLabelValues(c.name).Inc()
+	c.entries.MoveToFront(e)
+	return e.Value.(*stateLRUEntry).state, true
+}
+
+// has returns true if the cache holds the state of a root, without marking it as used.
+func (c *stateLRU) has(root [32]byte) bool {
+	_, ok := c.items[root]
+	return ok
+}
+
+// add caches the state of a root, replacing any state cached for the root, then evicts states
+// until the cache is within its budget. The added state is kept even if it alone exceeds the
+// budget.
+func (c *stateLRU) add(root [32]byte, st state.BeaconState) {
+	if e, ok := c.items[root]; ok {
+		c.removeElement(e)
+	}
+	entry := &stateLRUEntry{root: root, state: st, fields: estimateStateFields(st)}
+	for _, f := range entry.fields {
+		if c.fieldRefs[f.key] == 0 {
+			c.bytes += f.size
+		}
+		c.fieldRefs[f.key]++
+	}
+	c.bytes += smallFieldsMemSize
+	c.items[root] = c.entries.PushFront(entry)
+
+	for c.bytes > c.budget && c.entries.Len() > 1 {
+		e, canonical := c.evictionCandidate()
+		c.removeElement(e)
+		stateCacheEvictions.WithLabelValues(c.name, strconv.FormatBool(canonical)).Inc()
+		if c.onEvict != nil {
+			evicted := e.Value.(*stateLRUEntry)
+			c.onEvict(evicted.root, evicted.state)
+		}
+	}
+	c.updateMetrics()
+}
+
+// remove removes the state of a root, returning whether it was cached.
+func (c *stateLRU) remove(root [32]byte) bool {
+	e, ok := c.items[root]
+	if !ok {
+		return false
+	}
+	c.removeElement(e)
+	c.updateMetrics()
+	return true
+}
+
+// len returns the number of cached states.
+func (c *stateLRU) len() int {
+	return c.entries.Len()
+}
+
+// evictionCandidate returns the least recently used state which is not canonical, or the least
+// recently used state if every state is canonical. The most recently used state is never returned.
+func (c *stateLRU) evictionCandidate() (*list.Element, bool) {
+	oldest := c.entries.Back()
+	if c.canonical == nil {
+		return oldest, false
+	}
+	for e := oldest; e != nil && e != c.entries.Front(); e = e.Prev() {
+		if !c.canonical.IsCanonical(e.Value.(*stateLRUEntry).root) {
+			return e, false
+		}
+	}
+	return oldest, true
+}
+
+func (c *stateLRU) removeElement(e *list.Element) {
+	entry := e.Value.(*stateLRUEntry)
+	c.entries.Remove(e)
+	delete(c.items, entry.root)
+	for _, f := range entry.fields {
+		c.fieldRefs[f.key]--
+		if c.fieldRefs[f.key] == 0 {
+			delete(c.fieldRefs, f.key)
+			c.bytes -= f.size
+		}
+	}
+	c.bytes -= smallFieldsMemSize
+}
+
+func (c *stateLRU) updateMetrics() {
+	stateCacheBytes.WithLabelValues(c.name).Set(float64(c.bytes))
+	stateCacheStates.WithLabelValues(c.name).Set(float64(c.entries.Len()))
+}
@@ -0,0 +1,99 @@
+package stategen
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type mockCanonicalChecker map[[32]byte]bool
+
+func (m mockCanonicalChecker) IsCanonical(root [32]byte) bool {
+	return m[root]
+}
+
+func estimatedSize(st state.BeaconState) uint64 {
+	size := uint64(smallFieldsMemSize)
+	for _, f := range estimateStateFields(st) {
+		size += f.size
+	}
+	return size
+}
+
+func TestStateLRU_CountsSharedFieldsOnce(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	c := newStateLRU("test", DefaultHotStateCacheBudget)
+	size := estimatedSize(st)
+	assert.Equal(t, true, size > 64*validatorMemSize)
+
+	c.add([32]byte{'a'}, st)
+	assert.Equal(t, size, c.bytes)
+	// A copy shares every field with the state.
+	c.add([32]byte{'b'}, st.Copy())
+	assert.Equal(t, size+smallFieldsMemSize, c.bytes)
+	// Modifying the balances of a copy copies them.
+	cp := st.Copy()
+	require.NoError(t, cp.UpdateBalancesAtIndex(0, 1))
+	c.add([32]byte{'c'}, cp)
+	assert.Equal(t, size+2*smallFieldsMemSize+64*8, c.bytes)
+
+	// Replacing a state does not count it twice.
+	c.add([32]byte{'c'}, cp)
+	assert.Equal(t, size+2*smallFieldsMemSize+64*8, c.bytes)
+
+	require.Equal(t, true, c.remove([32]byte{'a'}))
+	require.Equal(t, false, c.remove([32]byte{'a'}))
+	assert.Equal(t, size+smallFieldsMemSize+64*8, c.bytes)
+	require.Equal(t, true, c.remove([32]byte{'b'}))
+	require.Equal(t, true, c.remove([32]byte{'c'}))
+	assert.Equal(t, uint64(0), c.bytes)
+	assert.Equal(t, 0, len(c.fieldRefs))
+}
+
+func TestStateLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	c := newStateLRU("test", 0)
+	c.budget = estimatedSize(st) + smallFieldsMemSize
+	c.add([32]byte{'a'}, st.Copy())
+	c.add([32]byte{'b'}, st.Copy())
+	_, ok := c.get([32]byte{'a'})
+	require.Equal(t, true, ok)
+	c.add([32]byte{'c'}, st.Copy())
+
+	assert.Equal(t, 2, c.len())
+	assert.Equal(t, true, c.has([32]byte{'a'}))
+	assert.Equal(t, false, c.has([32]byte{'b'}))
+	assert.Equal(t, true, c.has([32]byte{'c'}))
+}
+
+func TestStateLRU_EvictsNonCanonicalFirst(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	c := newStateLRU("test", 0)
+	c.budget = estimatedSize(st) + 2*smallFieldsMemSize
+	c.canonical = mockCanonicalChecker{{'a'}: true, {'c'}: true, {'d'}: true}
+	var evicted [][32]byte
+	c.onEvict = func(root [32]byte, _ state.BeaconState) {
+		evicted = append(evicted, root)
+	}
+	for _, r := range [][32]byte{{'a'}, {'b'}, {'c'}, {'d'}} {
+		c.add(r, st.Copy())
+	}
+	// The fork state b is evicted before the older canonical state a.
+	assert.DeepEqual(t, [][32]byte{{'b'}}, evicted)
+
+	c.add([32]byte{'e'}, st.Copy())
+	// Once every state is canonical, the least recently used one is evicted.
+	assert.DeepEqual(t, [][32]byte{{'b'}, {'a'}}, evicted)
+}
+
+func TestStateLRU_KeepsStateOverBudget(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	c := newStateLRU("test", 1)
+	c.add([32]byte{'a'}, st)
+	c.add([32]byte{'b'}, st.Copy())
+	assert.Equal(t, 1, c.len())
+	assert.Equal(t, true, c.has([32]byte{'b'}))
+}
@@ -0,0 +1,419 @@
+package stategen
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// largeFields points to the fields of a protobuf state which are stored as differences. Fields
+// which do not exist in the fork of the state are nil.
+type largeFields struct {
+	validators        *[]*ethpb.Validator
+	balances          *[]uint64
+	blockRoots        *[][]byte
+	stateRoots        *[][]byte
+	randaoMixes       *[][]byte
+	prevParticipation *[]byte
+	currParticipation *[]byte
+	inactivityScores  *[]uint64
+}
+
+func largeFieldsOf(pb proto.Message) (*largeFields, error) {
+	switch st := pb.(type) {
+	case *ethpb.BeaconState:
+		return &largeFields{
+			validators:  &st.Validators,
+			balances:    &st.Balances,
+			blockRoots:  &st.BlockRoots,
+			stateRoots:  &st.StateRoots,
+			randaoMixes: &st.RandaoMixes,
+		}, nil
+	case *ethpb.BeaconStateAltair:
+		return &largeFields{
+			validators:        &st.Validators,
+			balances:          &st.Balances,
+			blockRoots:        &st.BlockRoots,
+			stateRoots:        &st.StateRoots,
+			randaoMixes:       &st.RandaoMixes,
+			prevParticipation: &st.PreviousEpochParticipation,
+			currParticipation: &st.CurrentEpochParticipation,
+			inactivityScores:  &st.InactivityScores,
+		}, nil
+	case *ethpb.BeaconStateBellatrix:
+		return &largeFields{
+			validators:        &st.Validators,
+			balances:          &st.Balances,
+			blockRoots:        &st.BlockRoots,
+			stateRoots:        &st.StateRoots,
+			randaoMixes:       &st.RandaoMixes,
+			prevParticipation: &st.PreviousEpochParticipation,
+			currParticipation: &st.CurrentEpochParticipation,
+			inactivityScores:  &st.InactivityScores,
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported state type %T", pb)
+	}
+}
+
+// encode serializes the fields in the order of the fields of ethpb.BeaconStateDiff and clears them.
+func (f *largeFields) encode() ([8][]byte, error) {
+	var enc [8][]byte
+	for _, v := range *f.validators {
+		b, err := v.MarshalSSZ()
+		if err != nil {
+			return enc, err
+		}
+		enc[0] = append(enc[0], b...)
+	}
+	enc[1] = encodeUint64s(*f.balances)
+	enc[2] = bytes.Join(*f.blockRoots, nil)
+	enc[3] = bytes.Join(*f.stateRoots, nil)
+	enc[4] = bytes.Join(*f.randaoMixes, nil)
+	*f.validators, *f.balances, *f.blockRoots, *f.stateRoots, *f.randaoMixes = nil, nil, nil, nil, nil
+	if f.prevParticipation != nil {
+		enc[5], enc[6] = *f.prevParticipation, *f.currParticipation
+		enc[7] = encodeUint64s(*f.inactivityScores)
+		*f.prevParticipation, *f.currParticipation, *f.inactivityScores = nil, nil, nil
+	}
+	return enc, nil
+}
+
+// decode sets the fields from their serialized values.
+func (f *largeFields) decode(enc [8][]byte) error {
+	size := (&ethpb.Validator{}).SizeSSZ()
+	if len(enc[0])%size != 0 {
+		return errors.Errorf("invalid validators length %d", len(enc[0]))
+	}
+	validators := make([]*ethpb.Validator, len(enc[0])/size)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{}
+		if err := validators[i].UnmarshalSSZ(enc[0][i*size : (i+1)*size]); err != nil {
+			return err
+		}
+	}
+	*f.validators = validators
+	var err error
+	if *f.balances, err = decodeUint64s(enc[1]); err != nil {
+		return err
+	}
+	if *f.blockRoots, err = decodeRoots(enc[2]); err != nil {
+		return err
+	}
+	if *f.stateRoots, err = decodeRoots(enc[3]); err != nil {
+		return err
+	}
+	if *f.randaoMixes, err = decodeRoots(enc[4]); err != nil {
+		return err
+	}
+	if f.prevParticipation != nil {
+		*f.prevParticipation, *f.currParticipation = enc[5], enc[6]
+		if *f.inactivityScores, err = decodeUint64s(enc[7]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeStateDiff returns the difference between a state and the base state saved with the given root.
+func computeStateDiff(baseRoot [32]byte, base, st state.ReadOnlyBeaconState) (*ethpb.BeaconStateDiff, error) {
+	baseFields, err := largeFieldsOf(protoState(base))
+	if err != nil {
+		return nil, err
+	}
+	baseEnc, err := baseFields.encode()
+	if err != nil {
+		return nil, err
+	}
+	pb := protoState(st)
+	fields, err := largeFieldsOf(pb)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := fields.encode()
+	if err != nil {
+		return nil, err
+	}
+	rest, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, err
+	}
+	for i := range enc {
+		enc[i] = xorBytes(enc[i], baseEnc[i])
+	}
+	return &ethpb.BeaconStateDiff{
+		BaseRoot:                   baseRoot[:],
+		Version:                    int32(st.Version()),
+		State:                      rest,
+		Validators:                 enc[0],
+		Balances:                   enc[1],
+		BlockRoots:                 enc[2],
+		StateRoots:                 enc[3],
+		RandaoMixes:                enc[4],
+		PreviousEpochParticipation: enc[5],
+		CurrentEpochParticipation:  enc[6],
+		InactivityScores:           enc[7],
+	}, nil
+}
+
+// applyStateDiff rebuilds a state from its difference with the base state.
+func applyStateDiff(base state.ReadOnlyBeaconState, diff *ethpb.BeaconStateDiff) (state.BeaconState, error) {
+	baseFields, err := largeFieldsOf(protoState(base))
+	if err != nil {
+		return nil, err
+	}
+	baseEnc, err := baseFields.encode()
+	if err != nil {
+		return nil, err
+	}
+	var pb proto.Message
+	switch diff.Version {
+	case version.Phase0:
+		pb = &ethpb.BeaconState{}
+	case version.Altair:
+		pb = &ethpb.BeaconStateAltair{}
+	case version.Bellatrix:
+		pb = &ethpb.BeaconStateBellatrix{}
+	default:
+		return nil, errors.Errorf("unsupported state version %d", diff.Version)
+	}
+	if err := proto.Unmarshal(diff.State, pb); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	fields, err := largeFieldsOf(pb)
+	if err != nil {
+		return nil, err
+	}
+	enc := [8][]byte{
+		diff.Validators,
+		diff.Balances,
+		diff.BlockRoots,
+		diff.StateRoots,
+		diff.RandaoMixes,
+		diff.PreviousEpochParticipation,
+		diff.CurrentEpochParticipation,
+		diff.InactivityScores,
+	}
+	for i := range enc {
+		enc[i] = xorBytes(enc[i], baseEnc[i])
+	}
+	if err := fields.decode(enc); err != nil {
+		return nil, errors.Wrap(err, "could not decode state fields")
+	}
+	switch st := pb.(type) {
+	case *ethpb.BeaconState:
+		return v1.InitializeFromProtoUnsafe(st)
+	case *ethpb.BeaconStateAltair:
+		return v2.InitializeFromProtoUnsafe(st)
+	default:
+		return v3.InitializeFromProtoUnsafe(pb.(*ethpb.BeaconStateBellatrix))
+	}
+}
+
+// stateDiffRequest is an epoch start slot whose state is to be saved as a difference, along with
+// the state of that slot when it was still cached at finalization.
+type stateDiffRequest struct {
+	slot types.Slot
+	st   state.BeaconState
+}
+
+// saveStateDiffs saves the requested state differences in order. It runs in the background, one
+// batch at a time, so that computing the differences does not hold up finalization.
+func (s *State) saveStateDiffs(ctx context.Context, reqs []*stateDiffRequest) {
+	s.stateDiffLock.Lock()
+	defer s.stateDiffLock.Unlock()
+	for _, req := range reqs {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.saveStateDiff(ctx, req.slot, req.st); err != nil {
+			log.WithError(err).WithField("slot", req.slot).Error("Could not save state difference")
+			return
+		}
+	}
+}
+
+// saveStateDiff saves the state of an epoch start slot, including the block of that slot, as a
+// difference with the state of the archived point the slot belongs to. Nothing is saved if the
+// archived state is not in the DB. When the state is not given, it is rebuilt from the difference saved for the previous epoch,
+// or from the archived state, by replaying the blocks since.
+func (s *State) saveStateDiff(ctx context.Context, slot types.Slot, st state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveStateDiff")
+	defer span.End()
+
+	if s.beaconDB.HasStateDiff(ctx, slot) {
+		return nil
+	}
+	baseRoot, err := s.archivedRoot(ctx, slot-slot%s.slotsPerArchivedPoint)
+	if err != nil {
+		return err
+	}
+	if !s.beaconDB.HasState(ctx, baseRoot) {
+		log.WithField("slot", slot).Debug("No archived state to save state difference against")
+		return nil
+	}
+	base, err := s.beaconDB.State(ctx, baseRoot)
+	if err != nil {
+		return err
+	}
+	if st == nil || st.IsNil() {
+		st = base.Copy()
+		prevSlot := slot - params.BeaconConfig().SlotsPerEpoch
+		prev, err := s.beaconDB.StateDiff(ctx, prevSlot)
+		if err != nil {
+			return err
+		}
+		if prev != nil && bytesutil.ToBytes32(prev.BaseRoot) == baseRoot {
+			st, err = applyStateDiff(base, prev)
+			if err != nil {
+				return errors.Wrapf(err, "could not apply state difference at slot %d", prevSlot)
+			}
+		}
+		st, err = s.replayBlocksBelow(ctx, st, slot+1, slot)
+		if err != nil {
+			return err
+		}
+	}
+	diff, err := computeStateDiff(baseRoot, base, st)
+	if err != nil {
+		return errors.Wrapf(err, "could not compute state difference at slot %d", slot)
+	}
+	return s.beaconDB.SaveStateDiff(ctx, slot, diff)
+}
+
+// archivedRoot returns the block root the state of an archived point is saved with, which is the
+// root of the highest block below the archived point when its slot was skipped.
+func (s *State) archivedRoot(ctx context.Context, slot types.Slot) ([32]byte, error) {
+	if slot == 0 {
+		return s.genesisRoot(ctx)
+	}
+	if r := s.beaconDB.ArchivedPointRoot(ctx, slot); r != params.BeaconConfig().ZeroHash {
+		return r, nil
+	}
+	r, _, err := s.lastSavedBlock(ctx, slot)
+	return r, err
+}
+
+// stateBySlotFromDiff rebuilds a finalized state from the state difference saved at the start of
+// its epoch, replaying the blocks of the epoch up to the slot. It returns nil if there is no
+// difference to rebuild the state from.
+func (s *State) stateBySlotFromDiff(ctx context.Context, slot types.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.stateBySlotFromDiff")
+	defer span.End()
+
+	// Differences are only saved for finalized states, which have a single ancestry.
+	s.finalizedInfo.lock.RLock()
+	fSlot := s.finalizedInfo.slot
+	s.finalizedInfo.lock.RUnlock()
+	if slot >= fSlot {
+		return nil, nil
+	}
+	diffSlot, err := slots.EpochStart(slots.ToEpoch(slot))
+	if err != nil {
+		return nil, err
+	}
+	diff, err := s.beaconDB.StateDiff(ctx, diffSlot)
+	if err != nil || diff == nil {
+		return nil, err
+	}
+	baseRoot := bytesutil.ToBytes32(diff.BaseRoot)
+	// The archived state may have been pruned.
+	if !s.beaconDB.HasState(ctx, baseRoot) {
+		return nil, nil
+	}
+	base, err := s.beaconDB.State(ctx, baseRoot)
+	if err != nil {
+		return nil, err
+	}
+	st, err := applyStateDiff(base, diff)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not apply state difference at slot %d", diffSlot)
+	}
+	// The state of a slot does not include the block of that slot, which the difference does.
+	if slot == diffSlot && st.LatestBlockHeader().Slot == diffSlot {
+		return nil, nil
+	}
+	return s.replayBlocksBelow(ctx, st, slot, slot)
+}
+
+// replayBlocksBelow replays the finalized blocks after the slot of the state and below the given
+// slot, and then processes the state up to the target slot.
+func (s *State) replayBlocksBelow(ctx context.Context, st state.BeaconState, below, targetSlot types.Slot) (state.BeaconState, error) {
+	lastRoot, lastSlot, err := s.lastSavedBlock(ctx, below)
+	if err != nil {
+		return nil, err
+	}
+	var blks []block.SignedBeaconBlock
+	if lastSlot > st.Slot() {
+		blks, err = s.LoadBlocks(ctx, st.Slot()+1, lastSlot, lastRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load blocks")
+		}
+		replayBlockCount.Observe(float64(len(blks)))
+	}
+	return s.ReplayBlocks(ctx, st, blks, targetSlot)
+}
+
+// protoState returns a copy of the protobuf representation of the state.
+func protoState(st state.ReadOnlyBeaconState) proto.Message {
+	pb, ok := st.CloneInnerState().(proto.Message)
+	if !ok {
+		return nil
+	}
+	return pb
+}
+
+// xorBytes returns the XOR of a with b, over the length of a.
+func xorBytes(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	copy(out, a)
+	for i := 0; i < len(out) && i < len(b); i++ {
+		out[i] ^= b[i]
+	}
+	return out
+}
+
+func encodeUint64s(v []uint64) []byte {
+	enc := make([]byte, 8*len(v))
+	for i, x := range v {
+		binary.LittleEndian.PutUint64(enc[8*i:], x)
+	}
+	return enc
+}
+
+func decodeUint64s(enc []byte) ([]uint64, error) {
+	if len(enc)%8 != 0 {
+		return nil, errors.Errorf("invalid uint64 list length %d", len(enc))
+	}
+	v := make([]uint64, len(enc)/8)
+	for i := range v {
+		v[i] = binary.LittleEndian.Uint64(enc[8*i:])
+	}
+	return v, nil
+}
+
+func decodeRoots(enc []byte) ([][]byte, error) {
+	if len(enc)%32 != 0 {
+		return nil, errors.Errorf("invalid root list length %d", len(enc))
+	}
+	roots := make([][]byte, len(enc)/32)
+	for i := range roots {
+		roots[i] = enc[32*i : 32*(i+1)]
+	}
+	return roots, nil
+}
@@ -0,0 +1,176 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStateDiff_Roundtrip(t *testing.T) {
+	ctx := context.Background()
+	phase0, _ := util.DeterministicGenesisState(t, 32)
+	altair, _ := util.DeterministicGenesisStateAltair(t, 32)
+	for name, base := range map[string]state.BeaconState{"phase0": phase0, "altair": altair} {
+		t.Run(name, func(t *testing.T) {
+			st := base.Copy()
+			require.NoError(t, st.SetSlot(100))
+			require.NoError(t, st.UpdateBalancesAtIndex(3, 1))
+			require.NoError(t, st.UpdateRandaoMixesAtIndex(2, bytesutil.PadTo([]byte{'a'}, 32)))
+			require.NoError(t, st.AppendValidator(&ethpb.Validator{
+				PublicKey:             bytesutil.PadTo([]byte{'b'}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+			}))
+			require.NoError(t, st.AppendBalance(5))
+			wanted, err := st.HashTreeRoot(ctx)
+			require.NoError(t, err)
+
+			baseRoot := [32]byte{'c'}
+			diff, err := computeStateDiff(baseRoot, base, st)
+			require.NoError(t, err)
+			assert.DeepEqual(t, baseRoot[:], diff.BaseRoot)
+			got, err := applyStateDiff(base, diff)
+			require.NoError(t, err)
+			assert.Equal(t, st.Version(), got.Version())
+			gotRoot, err := got.HashTreeRoot(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, wanted, gotRoot)
+		})
+	}
+}
+
+func TestApplyStateDiff_Corrupted(t *testing.T) {
+	base, _ := util.DeterministicGenesisState(t, 32)
+	diff, err := computeStateDiff([32]byte{}, base, base)
+	require.NoError(t, err)
+	diff.Balances = diff.Balances[1:]
+	_, err = applyStateDiff(base, diff)
+	require.ErrorContains(t, "invalid uint64 list length", err)
+	diff.Version = 100
+	_, err = applyStateDiff(base, diff)
+	require.ErrorContains(t, "unsupported state version 100", err)
+}
+
+func TestStateBySlot_FromStateDiff(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableStateDiffs: true})
+	defer resetCfg()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+
+	beaconState, pks := util.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	st := beaconState.Copy()
+	var epochStartState state.BeaconState
+	for _, slot := range []types.Slot{1, 2, 5, 32, 33, 65} {
+		if slot == 65 {
+			// The state of the skipped epoch start slot, as cached at the epoch boundary.
+			epochStartState, err = transition.ProcessSlots(ctx, st.Copy(), 64)
+			require.NoError(t, err)
+		}
+		b, err := util.GenerateFullBlock(st, pks, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wb := wrapper.WrappedPhase0SignedBeaconBlock(b)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wb))
+		st, err = transition.ExecuteStateTransition(ctx, st, wb)
+		require.NoError(t, err)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveState(ctx, st, r))
+	}
+
+	service.finalizedInfo.slot = 96
+	// The first difference is replayed from the archived state, the next one is given.
+	require.NoError(t, service.saveStateDiff(ctx, 32, nil))
+	require.NoError(t, service.saveStateDiff(ctx, 64, epochStartState))
+	for _, slot := range []types.Slot{32, 64} {
+		require.Equal(t, true, beaconDB.HasStateDiff(ctx, slot))
+		diff, err := beaconDB.StateDiff(ctx, slot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, gRoot[:], diff.BaseRoot)
+	}
+
+	for _, slot := range []types.Slot{40, 64, 70} {
+		fromDiff, err := service.stateBySlotFromDiff(ctx, slot)
+		require.NoError(t, err)
+		require.NotNil(t, fromDiff)
+		assert.Equal(t, slot, fromDiff.Slot())
+		resetCfg := features.InitWithReset(&features.Flags{})
+		replayed, err := service.StateBySlot(ctx, slot)
+		resetCfg()
+		require.NoError(t, err)
+		wanted, err := replayed.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		got, err := fromDiff.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, wanted, got)
+	}
+
+	// States which are not finalized, precede the block of the epoch start, or have no
+	// difference saved, are replayed.
+	st, err = service.stateBySlotFromDiff(ctx, 32)
+	require.NoError(t, err)
+	assert.Equal(t, true, st == nil)
+	st, err = service.stateBySlotFromDiff(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, true, st == nil)
+	st, err = service.stateBySlotFromDiff(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, st == nil)
+}
+
+func TestSaveStateDiff_FromPreviousDiff(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableStateDiffs: true})
+	defer resetCfg()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+
+	beaconState, pks := util.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	st := beaconState.Copy()
+	for _, slot := range []types.Slot{1, 40} {
+		b, err := util.GenerateFullBlock(st, pks, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wb := wrapper.WrappedPhase0SignedBeaconBlock(b)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wb))
+		st, err = transition.ExecuteStateTransition(ctx, st, wb)
+		require.NoError(t, err)
+	}
+	service.finalizedInfo.slot = 96
+
+	// A corrupted previous difference shows that it is used to rebuild the next state.
+	prev, err := computeStateDiff(gRoot, beaconState, beaconState)
+	require.NoError(t, err)
+	prev.Balances = prev.Balances[1:]
+	require.NoError(t, beaconDB.SaveStateDiff(ctx, 32, prev))
+	require.ErrorContains(t, "could not apply state difference at slot 32", service.saveStateDiff(ctx, 64, nil))
+	require.Equal(t, false, beaconDB.HasStateDiff(ctx, 64))
+}
@@ -0,0 +1,110 @@
+package stategen
+
+import (
+	"reflect"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+const (
+	sliceHeaderSize = 24
+	// protoMessageOverhead is the memory of a protobuf message beyond its fields: its internal
+	// state, size cache and unknown fields.
+	protoMessageOverhead = 40
+	rootMemSize          = 32 + sliceHeaderSize
+	// validatorMemSize is the memory of a validator referenced from the validators slice: the
+	// pointer, the fixed size fields, the public key and withdrawal credentials slices.
+	validatorMemSize = 8 + 121 + 2*sliceHeaderSize + protoMessageOverhead
+	// eth1DataMemSize is the memory of an eth1 data vote referenced from the votes slice.
+	eth1DataMemSize = 8 + 8 + 2*rootMemSize + protoMessageOverhead
+	// smallFieldsMemSize approximates the fields of a state which are not tracked individually,
+	// such as its checkpoints, fork and latest block header.
+	smallFieldsMemSize = 2048
+)
+
+// fieldKey identifies the backing array of a state field. Copies of a state share the arrays of
+// their fields until they are modified, so fields with the same key take memory once.
+type fieldKey struct {
+	ptr    uintptr
+	length int
+}
+
+// stateField is a large field of a state and its estimated memory.
+type stateField struct {
+	key  fieldKey
+	size uint64
+}
+
+// estimateStateFields returns the large fields of a state with their estimated memory. Together
+// with smallFieldsMemSize, they approximate the memory taken by the state.
+func estimateStateFields(st state.ReadOnlyBeaconState) []stateField {
+	var fields []stateField
+	add := func(slice interface{}, length int, elemSize uint64) {
+		if length == 0 {
+			return
+		}
+		fields = append(fields, stateField{
+			key:  fieldKey{ptr: reflect.ValueOf(slice).Pointer(), length: length},
+			size: uint64(length) * elemSize,
+		})
+	}
+	switch pb := st.InnerStateUnsafe().(type) {
+	case *ethpb.BeaconState:
+		add(pb.Validators, len(pb.Validators), validatorMemSize)
+		add(pb.Balances, len(pb.Balances), 8)
+		add(pb.BlockRoots, len(pb.BlockRoots), rootMemSize)
+		add(pb.StateRoots, len(pb.StateRoots), rootMemSize)
+		add(pb.RandaoMixes, len(pb.RandaoMixes), rootMemSize)
+		add(pb.HistoricalRoots, len(pb.HistoricalRoots), rootMemSize)
+		add(pb.Slashings, len(pb.Slashings), 8)
+		add(pb.Eth1DataVotes, len(pb.Eth1DataVotes), eth1DataMemSize)
+		add(pb.PreviousEpochAttestations, len(pb.PreviousEpochAttestations), pendingAttestationsMemSize(pb.PreviousEpochAttestations))
+		add(pb.CurrentEpochAttestations, len(pb.CurrentEpochAttestations), pendingAttestationsMemSize(pb.CurrentEpochAttestations))
+	case *ethpb.BeaconStateAltair:
+		add(pb.Validators, len(pb.Validators), validatorMemSize)
+		add(pb.Balances, len(pb.Balances), 8)
+		add(pb.BlockRoots, len(pb.BlockRoots), rootMemSize)
+		add(pb.StateRoots, len(pb.StateRoots), rootMemSize)
+		add(pb.RandaoMixes, len(pb.RandaoMixes), rootMemSize)
+		add(pb.HistoricalRoots, len(pb.HistoricalRoots), rootMemSize)
+		add(pb.Slashings, len(pb.Slashings), 8)
+		add(pb.Eth1DataVotes, len(pb.Eth1DataVotes), eth1DataMemSize)
+		add(pb.PreviousEpochParticipation, len(pb.PreviousEpochParticipation), 1)
+		add(pb.CurrentEpochParticipation, len(pb.CurrentEpochParticipation), 1)
+		add(pb.InactivityScores, len(pb.InactivityScores), 8)
+	case *ethpb.BeaconStateBellatrix:
+		add(pb.Validators, len(pb.Validators), validatorMemSize)
+		add(pb.Balances, len(pb.Balances), 8)
+		add(pb.BlockRoots, len(pb.BlockRoots), rootMemSize)
+		add(pb.StateRoots, len(pb.StateRoots), rootMemSize)
+		add(pb.RandaoMixes, len(pb.RandaoMixes), rootMemSize)
+		add(pb.HistoricalRoots, len(pb.HistoricalRoots), rootMemSize)
+		add(pb.Slashings, len(pb.Slashings), 8)
+		add(pb.Eth1DataVotes, len(pb.Eth1DataVotes), eth1DataMemSize)
+		add(pb.PreviousEpochParticipation, len(pb.PreviousEpochParticipation), 1)
+		add(pb.CurrentEpochParticipation, len(pb.CurrentEpochParticipation), 1)
+		add(pb.InactivityScores, len(pb.InactivityScores), 8)
+	default:
+		// Without access to the fields of the state, count its validators and balances as
+		// belonging to the state alone.
+		fields = append(fields, stateField{
+			key:  fieldKey{ptr: reflect.ValueOf(st).Pointer()},
+			size: uint64(st.NumValidators())*validatorMemSize + uint64(st.BalancesLength())*8,
+		})
+	}
+	return fields
+}
+
+// pendingAttestationsMemSize returns the average memory of the pending attestations of a slice.
+func pendingAttestationsMemSize(atts []*ethpb.PendingAttestation) uint64 {
+	if len(atts) == 0 {
+		return 0
+	}
+	var size uint64
+	for _, a := range atts {
+		// The attestation data and its checkpoints are messages of their own.
+		size += 8 + uint64(a.SizeSSZ()) + sliceHeaderSize + 4*protoMessageOverhead
+	}
+	return size / uint64(len(atts))
+}
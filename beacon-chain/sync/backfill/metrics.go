@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillLowestSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_lowest_slot",
+		Help: "The slot of the lowest block saved by backfill. Backfill is complete when this reaches genesis.",
+	})
+	backfillBlocksImported = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_imported_total",
+		Help: "Count of blocks saved by backfill.",
+	})
+	backfillBatchesRejected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_batches_rejected_total",
+		Help: "Count of block batches received from peers that did not connect to the backfilled chain.",
+	})
+)
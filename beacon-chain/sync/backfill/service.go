@@ -0,0 +1,344 @@
+// Package backfill downloads the blocks older than the checkpoint sync origin of a beacon node.
+// A node that starts from a checkpoint has no blocks before its origin block, so it walks the
+// chain backwards from the origin, one batch at a time, until it reaches genesis.
+package backfill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/sirupsen/logrus"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+// errInvalidBatch is returned when the blocks served by a peer do not connect to the lowest backfilled block.
+var errInvalidBatch = errors.New("blocks do not connect to the backfilled chain")
+
+// Checker reports the progress of backfill to other services, such as the node api.
+type Checker interface {
+	Backfilling() bool
+	BackfillDistance() types.Slot
+}
+
+// blocksFetcher retrieves a range of blocks from a single peer.
+type blocksFetcher interface {
+	FetchBlocks(ctx context.Context, start types.Slot, count uint64) ([]block.SignedBeaconBlock, peer.ID, error)
+	Stop()
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	DB          db.NoHeadAccessDatabase
+	P2P         p2p.P2P
+	Chain       blockchain.ChainInfoFetcher
+	InitialSync prysmsync.Checker
+}
+
+// Service walks the chain backwards from the checkpoint sync origin block, saving blocks in batches until
+// it reaches genesis.
+type Service struct {
+	cfg          *Config
+	ctx          context.Context
+	cancel       context.CancelFunc
+	fetcher      blocksFetcher
+	pollInterval time.Duration
+	retryDelay   time.Duration
+
+	lock sync.RWMutex
+	// lowest is the lowest block saved so far. Every block above it, up to the origin, is in the db.
+	lowest      block.SignedBeaconBlock
+	lowestRoot  [32]byte
+	backfilling bool
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:          cfg,
+		ctx:          ctx,
+		cancel:       cancel,
+		pollInterval: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		retryDelay:   time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+	}
+}
+
+// Start backfilling once initial sync is complete, so that both services do not compete for peers.
+func (s *Service) Start() {
+	if err := s.initLowest(s.ctx); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			log.Debug("Node was not started from a checkpoint, there is nothing to backfill")
+			return
+		}
+		log.WithError(err).Error("Could not determine where to resume backfill from")
+		return
+	}
+	if s.complete(s.ctx) {
+		log.Debug("Backfill is already complete")
+		return
+	}
+	if !s.waitForInitialSync() {
+		return
+	}
+	if s.fetcher == nil {
+		s.fetcher = initialsync.NewRangeFetcher(s.ctx, &initialsync.RangeFetcherConfig{
+			Chain: s.cfg.Chain,
+			P2P:   s.cfg.P2P,
+			DB:    s.cfg.DB,
+		})
+	}
+	defer s.fetcher.Stop()
+
+	s.lock.Lock()
+	s.backfilling = true
+	s.lock.Unlock()
+	log.WithField("slot", s.lowestSlot()).Info("Starting block backfill")
+	s.run()
+	s.lock.Lock()
+	s.backfilling = false
+	s.lock.Unlock()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Backfilling returns true while the service is downloading blocks older than the checkpoint sync origin.
+func (s *Service) Backfilling() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.backfilling
+}
+
+// BackfillDistance returns the number of slots between genesis and the lowest block saved so far.
+func (s *Service) BackfillDistance() types.Slot {
+	if !s.Backfilling() {
+		return 0
+	}
+	return s.lowestSlot()
+}
+
+// run requests batches of blocks below the lowest saved block until the chain connects to genesis.
+// The request window is tracked separately from the lowest block, because a whole batch may consist
+// of skipped slots.
+func (s *Service) run() {
+	end := s.lowestSlot()
+	for {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if s.complete(s.ctx) {
+			log.Info("Block backfill is complete")
+			return
+		}
+		if end == 0 {
+			// We reached genesis without finding the parent of the lowest block, which means that one
+			// of the empty batches was not empty after all. Start over from the lowest block.
+			end = s.lowestSlot()
+		}
+		start := types.Slot(0)
+		if end > types.Slot(batchSize()) {
+			start = end - types.Slot(batchSize())
+		}
+		blocks, pid, err := s.fetcher.FetchBlocks(s.ctx, start, uint64(end-start))
+		if err != nil {
+			log.WithError(err).WithField("start", start).Debug("Could not fetch backfill batch, retrying")
+			s.wait()
+			continue
+		}
+		batch, err := s.verifyBatch(blocks)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"peer":  pid,
+				"start": start,
+			}).Debug("Rejecting backfill batch")
+			backfillBatchesRejected.Inc()
+			s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			end = s.lowestSlot()
+			s.wait()
+			continue
+		}
+		if len(batch) == 0 {
+			end = start
+			continue
+		}
+		if err := s.saveBatch(s.ctx, batch); err != nil {
+			log.WithError(err).Error("Could not save backfill batch")
+			s.wait()
+			continue
+		}
+		end = s.lowestSlot()
+	}
+}
+
+// verifyBatch returns the blocks that extend the backfilled chain downwards, sorted by ascending slot.
+// Blocks are checked from the highest slot down: the root of each block must match the parent root of
+// the block above it, starting from the lowest block already saved.
+func (s *Service) verifyBatch(blocks []block.SignedBeaconBlock) ([]block.SignedBeaconBlock, error) {
+	s.lock.RLock()
+	child := s.lowest
+	s.lock.RUnlock()
+
+	batch := make([]block.SignedBeaconBlock, 0, len(blocks))
+	for i := len(blocks) - 1; i >= 0; i-- {
+		b := blocks[i]
+		if err := helpers.BeaconBlockIsNil(b); err != nil {
+			return nil, err
+		}
+		if b.Block().Slot() >= child.Block().Slot() {
+			continue
+		}
+		r, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		expected := bytesutil.ToBytes32(child.Block().ParentRoot())
+		if r != expected {
+			return nil, errors.Wrapf(errInvalidBatch, "root %#x of block at slot %d does not match expected parent root %#x", r, b.Block().Slot(), expected)
+		}
+		batch = append(batch, b)
+		child = b
+	}
+	for i, j := 0, len(batch)-1; i < j; i, j = i+1, j-1 {
+		batch[i], batch[j] = batch[j], batch[i]
+	}
+	return batch, nil
+}
+
+// saveBatch writes the blocks to the db, adds them to the finalized index and records the new lowest block,
+// so that backfill can resume from it after a restart.
+func (s *Service) saveBatch(ctx context.Context, batch []block.SignedBeaconBlock) error {
+	s.lock.RLock()
+	childRoot := s.lowestRoot
+	s.lock.RUnlock()
+
+	if err := s.cfg.DB.SaveBlocks(ctx, batch); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	if err := s.cfg.DB.BackfillFinalizedIndex(ctx, batch, childRoot); err != nil {
+		return errors.Wrap(err, "could not update finalized index")
+	}
+	lowest := batch[0]
+	lowestRoot, err := lowest.Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := s.cfg.DB.SaveBackfillBlockRoot(ctx, lowestRoot); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+	s.setLowest(lowest, lowestRoot)
+	backfillBlocksImported.Add(float64(len(batch)))
+	log.WithFields(logrus.Fields{
+		"slot":   lowest.Block().Slot(),
+		"blocks": len(batch),
+	}).Debug("Saved backfill batch")
+	return nil
+}
+
+// initLowest loads the block that backfill resumes from: the lowest block saved by a previous run,
+// or the checkpoint sync origin block.
+func (s *Service) initLowest(ctx context.Context) error {
+	originRoot, err := s.cfg.DB.OriginBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	root, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	if err != nil {
+		if !errors.Is(err, db.ErrNotFound) {
+			return err
+		}
+		root = originRoot
+	}
+	b, err := s.cfg.DB.Block(ctx, root)
+	if err != nil {
+		return err
+	}
+	if err := helpers.BeaconBlockIsNil(b); err != nil {
+		return errors.Wrapf(err, "could not find block %#x", root)
+	}
+	s.setLowest(b, root)
+	return nil
+}
+
+// complete returns true once the lowest block is genesis, its parent is already in the db,
+// or the history below it has been pruned.
+func (s *Service) complete(ctx context.Context) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.lowest.Block().Slot() == 0 {
+		return true
+	}
+	prunedSlot, err := s.cfg.DB.HistoryPrunedSlot(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get history pruned slot")
+	} else if prunedSlot > 0 && s.lowest.Block().Slot() <= prunedSlot {
+		return true
+	}
+	return s.cfg.DB.HasBlock(ctx, bytesutil.ToBytes32(s.lowest.Block().ParentRoot()))
+}
+
+// waitForInitialSync blocks until initial sync is complete, returning false if the service is stopped first.
+func (s *Service) waitForInitialSync() bool {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for !s.cfg.InitialSync.Synced() {
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting backfill")
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) wait() {
+	select {
+	case <-time.After(s.retryDelay):
+	case <-s.ctx.Done():
+	}
+}
+
+func (s *Service) setLowest(b block.SignedBeaconBlock, root [32]byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lowest = b
+	s.lowestRoot = root
+	backfillLowestSlot.Set(float64(b.Block().Slot()))
+}
+
+func (s *Service) lowestSlot() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lowest.Block().Slot()
+}
+
+func batchSize() int {
+	if n := flags.Get().BlockBatchLimit; n > 0 {
+		return n
+	}
+	return int(params.BeaconConfig().SlotsPerEpoch)
+}
@@ -0,0 +1,165 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type testFetcher struct {
+	blocks []block.SignedBeaconBlock
+	// bad is served once, by badPeer, before the blocks of the canonical chain.
+	bad      []block.SignedBeaconBlock
+	badPeer  peer.ID
+	requests int
+}
+
+func (f *testFetcher) FetchBlocks(_ context.Context, start types.Slot, count uint64) ([]block.SignedBeaconBlock, peer.ID, error) {
+	f.requests++
+	if f.bad != nil {
+		bad := f.bad
+		f.bad = nil
+		return bad, f.badPeer, nil
+	}
+	var blocks []block.SignedBeaconBlock
+	for _, b := range f.blocks {
+		if b.Block().Slot() >= start && b.Block().Slot() < start+types.Slot(count) {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks, "good", nil
+}
+
+func (f *testFetcher) Stop() {}
+
+// makeChain builds a chain of blocks from genesis, skipping the given slots.
+func makeChain(t *testing.T, n types.Slot, skip map[types.Slot]bool) ([]block.SignedBeaconBlock, [][32]byte) {
+	var blocks []block.SignedBeaconBlock
+	var roots [][32]byte
+	parent := [32]byte{}
+	for i := types.Slot(0); i < n; i++ {
+		if skip[i] {
+			continue
+		}
+		b := util.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blocks = append(blocks, wrapper.WrappedPhase0SignedBeaconBlock(b))
+		roots = append(roots, r)
+		parent = r
+	}
+	return blocks, roots
+}
+
+// setupOrigin saves the origin block the way checkpoint sync does, so that it is the lowest block in the db.
+func setupOrigin(t *testing.T, origin block.SignedBeaconBlock, originRoot [32]byte) *kv.Store {
+	ctx := context.Background()
+	d, ok := dbtest.SetupDB(t).(*kv.Store)
+	require.Equal(t, true, ok)
+	require.NoError(t, d.SaveBlock(ctx, origin))
+	require.NoError(t, d.SaveOriginBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveState(ctx, st, originRoot))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: originRoot[:]}))
+	return d
+}
+
+func newTestService(t *testing.T, d *kv.Store, f *testFetcher) *Service {
+	s := NewService(context.Background(), &Config{
+		DB:          d,
+		P2P:         p2ptest.NewTestP2P(t),
+		Chain:       &mock.ChainService{},
+		InitialSync: &mockSync.Sync{IsSynced: true},
+	})
+	s.fetcher = f
+	s.retryDelay = 0
+	return s
+}
+
+func TestService_Backfill(t *testing.T) {
+	resetCfg := flags.Get()
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 8})
+	defer flags.Init(resetCfg)
+	ctx := context.Background()
+
+	// Skip more than a whole batch, so that some requests come back empty.
+	skip := map[types.Slot]bool{3: true, 10: true, 11: true}
+	for i := types.Slot(40); i < 60; i++ {
+		skip[i] = true
+	}
+	blocks, roots := makeChain(t, 100, skip)
+	origin := len(blocks) - 1
+	d := setupOrigin(t, blocks[origin], roots[origin])
+	f := &testFetcher{blocks: blocks}
+	s := newTestService(t, d, f)
+
+	s.Start()
+	assert.Equal(t, false, s.Backfilling())
+	assert.Equal(t, types.Slot(0), s.lowestSlot())
+	for i, r := range roots {
+		assert.Equal(t, true, d.HasBlock(ctx, r), "Block %d was not backfilled", i)
+		assert.Equal(t, true, d.IsFinalizedBlock(ctx, r), "Block %d was not added to the finalized index", i)
+	}
+	lowest, err := d.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], lowest)
+
+	// A restarted service finds nothing left to do.
+	requests := f.requests
+	newTestService(t, d, f).Start()
+	assert.Equal(t, requests, f.requests)
+}
+
+func TestService_RejectsInvalidBatch(t *testing.T) {
+	resetCfg := flags.Get()
+	flags.Init(&flags.GlobalFlags{BlockBatchLimit: 64})
+	defer flags.Init(resetCfg)
+	ctx := context.Background()
+
+	blocks, roots := makeChain(t, 32, nil)
+	forked, _ := makeChain(t, 31, map[types.Slot]bool{5: true})
+	origin := len(blocks) - 1
+	d := setupOrigin(t, blocks[origin], roots[origin])
+	f := &testFetcher{blocks: blocks, bad: forked, badPeer: "bad"}
+	s := newTestService(t, d, f)
+
+	s.Start()
+	for i, r := range roots {
+		assert.Equal(t, true, d.HasBlock(ctx, r), "Block %d was not backfilled", i)
+	}
+	for _, b := range forked[5:] {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, false, d.HasBlock(ctx, r), "Block from the invalid batch was saved")
+	}
+	count, err := s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Count("bad")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestService_NoOrigin(t *testing.T) {
+	f := &testFetcher{}
+	s := newTestService(t, dbtest.SetupDB(t).(*kv.Store), f)
+	s.Start()
+	assert.Equal(t, 0, f.requests)
+	assert.Equal(t, false, s.Backfilling())
+	assert.Equal(t, types.Slot(0), s.BackfillDistance())
+}
@@ -57,8 +57,12 @@ func rpcContext(stream network.Stream, chain blockchain.ChainInfoFetcher) ([]byt
 	}
 	switch version {
 	case p2p.SchemaVersionV1:
-		// Return empty context for a v1 method.
-		return []byte{}, nil
+		// Return empty context for a v1 method, unless the method
+		// responds with context bytes from its first version.
+		if !p2p.RPCTopic(stream.Protocol()).HasContext() {
+			return []byte{}, nil
+		}
+		fallthrough
 	case p2p.SchemaVersionV2:
 		currFork := chain.CurrentFork()
 		genRoot := chain.GenesisValidatorsRoot()
@@ -0,0 +1,80 @@
+package initialsync
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+)
+
+// RangeFetcherConfig is a config to setup a RangeFetcher.
+type RangeFetcherConfig struct {
+	Chain blockchain.ChainInfoFetcher
+	P2P   p2p.P2P
+	DB    db.ReadOnlyDatabase
+}
+
+// RangeFetcher exposes the peer selection, peer scoring and per-peer rate limiting of the
+// initial sync blocks fetcher to other sync services, such as backfill. Unlike the blocks queue,
+// it does not process any blocks, it only fetches them from finalized peers on request.
+type RangeFetcher struct {
+	fetcher *blocksFetcher
+}
+
+var errRangeFetcherNoBlockProcessing = errors.New("range fetcher does not process blocks")
+
+// rangeFetcherChain satisfies the blockchainService interface of the blocks fetcher. The
+// fetcher only needs block processing for backtracking, which the RangeFetcher never does, so
+// the block receiver methods are never expected to be called.
+type rangeFetcherChain struct {
+	blockchain.ChainInfoFetcher
+}
+
+// ReceiveBlock returns an error, the RangeFetcher does not process blocks.
+func (*rangeFetcherChain) ReceiveBlock(_ context.Context, _ block.SignedBeaconBlock, _ [32]byte) error {
+	return errRangeFetcherNoBlockProcessing
+}
+
+// ReceiveBlockBatch returns an error, the RangeFetcher does not process blocks.
+func (*rangeFetcherChain) ReceiveBlockBatch(_ context.Context, _ []block.SignedBeaconBlock, _ [][32]byte) error {
+	return errRangeFetcherNoBlockProcessing
+}
+
+// HasInitSyncBlock returns false, the RangeFetcher does not keep initial sync blocks.
+func (*rangeFetcherChain) HasInitSyncBlock(_ [32]byte) bool {
+	return false
+}
+
+// NewRangeFetcher creates a RangeFetcher that is ready to use.
+func NewRangeFetcher(ctx context.Context, cfg *RangeFetcherConfig) *RangeFetcher {
+	return &RangeFetcher{
+		fetcher: newBlocksFetcher(ctx, &blocksFetcherConfig{
+			chain: &rangeFetcherChain{ChainInfoFetcher: cfg.Chain},
+			p2p:   cfg.P2P,
+			db:    cfg.DB,
+			mode:  modeStopOnFinalizedEpoch,
+		}),
+	}
+}
+
+// FetchBlocks requests up to count blocks starting at the start slot from a single peer, picked
+// among the peers that have finalized at least as far as this node. The id of the peer that
+// served the blocks is returned too, so that callers can penalize it if the blocks are invalid.
+func (r *RangeFetcher) FetchBlocks(ctx context.Context, start types.Slot, count uint64) ([]block.SignedBeaconBlock, peer.ID, error) {
+	resp := r.fetcher.handleRequest(ctx, start, count)
+	return resp.blocks, resp.pid, resp.err
+}
+
+// Stop releases the resources held by the fetcher. The fetcher cannot be used afterwards.
+func (r *RangeFetcher) Stop() {
+	r.fetcher.cancel()
+	if r.fetcher.rateLimiter != nil {
+		r.fetcher.rateLimiter.Free()
+		r.fetcher.rateLimiter = nil
+	}
+}
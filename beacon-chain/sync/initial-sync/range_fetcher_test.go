@@ -0,0 +1,66 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestRangeFetcher_FetchBlocks(t *testing.T) {
+	mc, p2p, beaconDB := initializeTestServices(t, makeSequence(1, 320), []*peerData{
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+		},
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+		},
+	})
+	mc.Genesis = time.Now()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := NewRangeFetcher(ctx, &RangeFetcherConfig{
+		Chain: mc,
+		P2P:   p2p,
+		DB:    beaconDB,
+	})
+	defer f.Stop()
+
+	blks, pid, err := f.FetchBlocks(ctx, 100, 32)
+	require.NoError(t, err)
+	require.Equal(t, 32, len(blks))
+	assert.Equal(t, types.Slot(100), blks[0].Block().Slot())
+	assert.Equal(t, types.Slot(131), blks[31].Block().Slot())
+	assert.NotEmpty(t, pid)
+}
+
+func TestRangeFetcher_FetchBlocks_NoPeers(t *testing.T) {
+	mc, p2p, beaconDB := initializeTestServices(t, []types.Slot{}, []*peerData{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := NewRangeFetcher(ctx, &RangeFetcherConfig{
+		Chain: mc,
+		P2P:   p2p,
+		DB:    beaconDB,
+	})
+	defer f.Stop()
+
+	_, _, err := f.FetchBlocks(ctx, 100, 32)
+	require.ErrorIs(t, err, errNoPeersAvailable)
+}
+
+func TestRangeFetcherChain_DoesNotProcessBlocks(t *testing.T) {
+	chain := &rangeFetcherChain{}
+	ctx := context.Background()
+	require.ErrorIs(t, chain.ReceiveBlock(ctx, nil, [32]byte{}), errRangeFetcherNoBlockProcessing)
+	require.ErrorIs(t, chain.ReceiveBlockBatch(ctx, nil, nil), errRangeFetcherNoBlockProcessing)
+	assert.Equal(t, false, chain.HasInitSyncBlock([32]byte{}))
+}
@@ -158,6 +158,13 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 		tracing.AnnotateError(span, err)
 		return err
 	}
+	blks, fullErr := s.fullBlocks(ctx, blks)
+	if fullErr != nil {
+		log.WithError(fullErr).Debug("Could not reconstruct full blocks")
+		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, fullErr)
+		return fullErr
+	}
 	for _, b := range blks {
 		if b == nil || b.IsNil() || b.Block().IsNil() {
 			continue
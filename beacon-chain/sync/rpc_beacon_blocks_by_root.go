@@ -61,6 +61,7 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 	}
 	s.rateLimiter.add(stream, int64(len(blockRoots)))
 
+	blks := make([]block.SignedBeaconBlock, 0, len(blockRoots))
 	for _, root := range blockRoots {
 		blk, err := s.cfg.beaconDB.Block(ctx, root)
 		if err != nil {
@@ -71,6 +72,15 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 		if blk == nil || blk.IsNil() {
 			continue
 		}
+		blks = append(blks, blk)
+	}
+	blks, err := s.fullBlocks(ctx, blks)
+	if err != nil {
+		log.WithError(err).Debug("Could not reconstruct full blocks")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	for _, blk := range blks {
 		if err := s.chunkBlockWriter(stream, blk); err != nil {
 			return err
 		}
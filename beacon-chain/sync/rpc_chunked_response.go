@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"context"
+
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
@@ -23,6 +25,25 @@ func (s *Service) chunkBlockWriter(stream libp2pcore.Stream, blk block.SignedBea
 	return WriteBlockChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), blk)
 }
 
+// fullBlocks rebuilds the full blocks of the blinded blocks in the list, as blocks are stored
+// blinded when --enable-only-blinded-beacon-blocks is set while peers expect full blocks.
+func (s *Service) fullBlocks(ctx context.Context, blks []block.SignedBeaconBlock) ([]block.SignedBeaconBlock, error) {
+	hasBlinded := false
+	for _, b := range blks {
+		if b != nil && !b.IsNil() && b.Version() == version.BellatrixBlind {
+			hasBlinded = true
+			break
+		}
+	}
+	if !hasBlinded {
+		return blks, nil
+	}
+	if s.cfg.payloadReconstructor == nil {
+		return nil, errors.New("no execution client to reconstruct blinded blocks with")
+	}
+	return s.cfg.payloadReconstructor.ReconstructFullBellatrixBlockBatch(ctx, blks)
+}
+
 // WriteBlockChunk writes block chunk object to stream.
 // response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
 func WriteBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, blk block.SignedBeaconBlock) error {
@@ -1,17 +1,20 @@
 package sync
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	mockEngine "github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1/mocks"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
 )
 
 func TestExtractBlockDataType(t *testing.T) {
@@ -109,3 +112,29 @@ func TestExtractBlockDataType(t *testing.T) {
 		})
 	}
 }
+
+func TestService_FullBlocks(t *testing.T) {
+	ctx := context.Background()
+	phase0Blk := wrapper.WrappedPhase0SignedBeaconBlock(util.NewBeaconBlock())
+	blinded, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(util.NewBlindedBeaconBlockBellatrix())
+	require.NoError(t, err)
+	full, err := wrapper.WrappedBellatrixSignedBeaconBlock(util.NewBeaconBlockBellatrix())
+	require.NoError(t, err)
+	root, err := blinded.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	// Blocks which are not blinded do not need an execution client.
+	s := &Service{cfg: &config{}}
+	blks, err := s.fullBlocks(ctx, []block.SignedBeaconBlock{phase0Blk})
+	require.NoError(t, err)
+	require.DeepEqual(t, []block.SignedBeaconBlock{phase0Blk}, blks)
+	_, err = s.fullBlocks(ctx, []block.SignedBeaconBlock{phase0Blk, blinded})
+	require.ErrorContains(t, "no execution client to reconstruct blinded blocks with", err)
+
+	s.cfg.payloadReconstructor = &mockEngine.EngineClient{
+		ReconstructedBlocks: map[[32]byte]block.SignedBeaconBlock{root: full},
+	}
+	blks, err = s.fullBlocks(ctx, []block.SignedBeaconBlock{phase0Blk, blinded})
+	require.NoError(t, err)
+	require.DeepEqual(t, []block.SignedBeaconBlock{phase0Blk, full}, blks)
+}
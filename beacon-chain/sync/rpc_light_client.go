@@ -0,0 +1,154 @@
+package sync
+
+import (
+	"context"
+
+	ssz "github.com/ferranbt/fastssz"
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// lightClientBootstrapRPCHandler looks up the light client bootstrap of the requested block root
+// from the database.
+func (s *Service) lightClientBootstrapRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, ttfbTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_bootstrap")
+
+	req, ok := msg.(*p2ptypes.LightClientBootstrapReq)
+	if !ok {
+		return errors.New("message is not type LightClientBootstrapReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	bootstrap, err := s.cfg.beaconDB.LightClientBootstrap(ctx, *req)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch light client bootstrap")
+		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+		return err
+	}
+	if bootstrap == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+		return p2ptypes.ErrResourceUnavailable
+	}
+	if err := s.writeLightClientChunk(stream, bootstrap.Header.Slot, bootstrap); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientUpdatesByRangeRPCHandler looks up the best light client updates of the requested
+// sync committee periods from the database.
+func (s *Service) lightClientUpdatesByRangeRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_updates_by_range")
+
+	req, ok := msg.(*pb.LightClientUpdatesByRangeRequest)
+	if !ok {
+		return errors.New("message is not type *pb.LightClientUpdatesByRangeRequest")
+	}
+	if req.Count == 0 {
+		s.rateLimiter.add(stream, 1)
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrInvalidRequest.Error(), stream)
+		return p2ptypes.ErrInvalidRequest
+	}
+	count := req.Count
+	if count > params.BeaconNetworkConfig().MaxRequestLightClientUpdates {
+		count = params.BeaconNetworkConfig().MaxRequestLightClientUpdates
+	}
+	if err := s.rateLimiter.validateRequest(stream, count); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, int64(count))
+
+	updates, err := s.cfg.beaconDB.LightClientUpdates(ctx, req.StartPeriod, count)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch light client updates")
+		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+		return err
+	}
+	for _, update := range updates {
+		if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, update); err != nil {
+			return err
+		}
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientFinalityUpdateRPCHandler responds with the latest light client finality update.
+func (s *Service) lightClientFinalityUpdateRPCHandler(_ context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_finality_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientFinalityUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+		return p2ptypes.ErrResourceUnavailable
+	}
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, update); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientOptimisticUpdateRPCHandler responds with the latest light client optimistic update.
+func (s *Service) lightClientOptimisticUpdateRPCHandler(_ context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_optimistic_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientOptimisticUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+		return p2ptypes.ErrResourceUnavailable
+	}
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Slot, update); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// writeLightClientChunk writes the given light client object as a chunked response to the given
+// network stream, with the fork digest of the given slot as its context.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func (s *Service) writeLightClientChunk(stream libp2pcore.Stream, slot types.Slot, msg ssz.Marshaler) error {
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	valRoot := s.cfg.chain.GenesisValidatorsRoot()
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), valRoot[:])
+	if err != nil {
+		return err
+	}
+	if err := writeContextToStream(digest[:], stream, s.cfg.chain); err != nil {
+		return err
+	}
+	_, err = s.cfg.p2p.Encoding().EncodeWithMaxLength(stream, msg)
+	return err
+}
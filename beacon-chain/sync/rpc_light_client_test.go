@@ -0,0 +1,191 @@
+package sync
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// expectLightClientContext reads the context bytes of a light client response and checks
+// that they are the fork digest of the given slot.
+func expectLightClientContext(t *testing.T, stream network.Stream, slot types.Slot) {
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), make([]byte, fieldparams.RootLength))
+	require.NoError(t, err)
+	rpcCtx := make([]byte, forkDigestLength)
+	_, err = stream.Read(rpcCtx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, digest[:], rpcCtx)
+}
+
+func newLightClientRPCService(t *testing.T, p1 *p2ptest.TestP2P, chain *mock.ChainService) *Service {
+	chain.Fork = &ethpb.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+	}
+	r := &Service{cfg: &config{p2p: p1, beaconDB: db.SetupDB(t), chain: chain}, rateLimiter: newRateLimiter(p1)}
+	for topic := range p2p.RPCTopicMappings {
+		r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, false)
+	}
+	return r
+}
+
+func TestLightClientBootstrapRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := newLightClientRPCService(t, p1, &mock.ChainService{})
+
+	root := [32]byte{'a'}
+	bootstrap := util.NewLightClientBootstrap(100)
+	require.NoError(t, r.cfg.beaconDB.SaveLightClientBootstrap(context.Background(), root, bootstrap))
+
+	pcl := protocol.ID(p2p.RPCLightClientBootstrapTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		expectLightClientContext(t, stream, 100)
+		res := &ethpb.LightClientBootstrap{}
+		assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+		assert.DeepSSZEqual(t, bootstrap, res)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2ptypes.LightClientBootstrapReq(root)
+	assert.NoError(t, r.lightClientBootstrapRPCHandler(context.Background(), &req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err = p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req = p2ptypes.LightClientBootstrapReq{'b'}
+	require.ErrorIs(t, r.lightClientBootstrapRPCHandler(context.Background(), &req, stream), p2ptypes.ErrResourceUnavailable)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := newLightClientRPCService(t, p1, &mock.ChainService{})
+
+	slotsPerPeriod := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	for period := uint64(1); period <= 3; period++ {
+		update := util.NewLightClientUpdate(types.Slot(period) * slotsPerPeriod)
+		require.NoError(t, r.cfg.beaconDB.SaveLightClientUpdate(context.Background(), period, update))
+	}
+
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for period := uint64(2); period <= 3; period++ {
+			slot := types.Slot(period) * slotsPerPeriod
+			expectSuccess(t, stream)
+			expectLightClientContext(t, stream, slot)
+			res := &ethpb.LightClientUpdate{}
+			assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+			assert.Equal(t, slot, res.AttestedHeader.Slot)
+		}
+	})
+
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 2, Count: 10}
+	assert.NoError(t, r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientOptimisticUpdateRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	chain := &mock.ChainService{}
+	r := newLightClientRPCService(t, p1, chain)
+
+	pcl := protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.ErrorIs(t, r.lightClientOptimisticUpdateRPCHandler(context.Background(), nil, stream), p2ptypes.ErrResourceUnavailable)
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	update := util.NewLightClientOptimisticUpdate(100)
+	chain.OptimisticUpdate = update
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		expectLightClientContext(t, stream, 100)
+		res := &ethpb.LightClientOptimisticUpdate{}
+		assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+		assert.DeepSSZEqual(t, update, res)
+	})
+	stream, err = p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	assert.NoError(t, r.lightClientOptimisticUpdateRPCHandler(context.Background(), nil, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestRegisterRPCHandlersAltair_LightClientServer(t *testing.T) {
+	hasLightClientProtocol := func(r *Service) bool {
+		for _, p := range r.cfg.p2p.Host().Mux().Protocols() {
+			if strings.Contains(p, p2p.RPCLightClientBootstrapTopicV1) {
+				return true
+			}
+		}
+		return false
+	}
+
+	r := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t)}}
+	r.registerRPCHandlersAltair()
+	assert.Equal(t, false, hasLightClientProtocol(r))
+
+	resetCfg := features.InitWithReset(&features.Flags{EnableLightClientServer: true})
+	defer resetCfg()
+	r = &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t)}}
+	r.registerRPCHandlersAltair()
+	assert.Equal(t, true, hasLightClientProtocol(r))
+}
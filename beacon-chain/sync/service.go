@@ -29,9 +29,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	enginev1 "github.com/prysmaticlabs/prysm/beacon-chain/powchain/engine-api-client/v1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime"
@@ -83,6 +85,7 @@ type config struct {
 	stateGen                *stategen.State
 	slasherAttestationsFeed *event.Feed
 	slasherBlockHeadersFeed *event.Feed
+	payloadReconstructor    enginev1.ExecutionPayloadReconstructor
 }
 
 // This defines the interface for interacting with block chain service
@@ -95,6 +98,7 @@ type blockchainService interface {
 	blockchain.TimeFetcher
 	blockchain.GenesisFetcher
 	blockchain.CanonicalFetcher
+	blockchain.LightClientFetcher
 }
 
 // Service is responsible for handling all run time p2p related operations as the
@@ -174,6 +178,9 @@ func (s *Service) Start() {
 	s.processPendingBlocksQueue()
 	s.processPendingAttsQueue()
 	s.maintainPeerStatuses()
+	if features.Get().EnableLightClientServer {
+		go s.broadcastLightClientUpdates()
+	}
 	if !flags.Get().DisableSync {
 		s.resyncIfBehind()
 	}
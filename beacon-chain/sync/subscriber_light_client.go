@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientUpdateSubscriber is a no-op, as validated light client updates are identical to
+// the ones computed locally and only need to be relayed.
+func (_ *Service) lightClientUpdateSubscriber(_ context.Context, _ proto.Message) error {
+	return nil
+}
+
+// broadcastLightClientUpdates publishes the light client finality and optimistic updates
+// computed by the blockchain service from processed blocks.
+func (s *Service) broadcastLightClientUpdates() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			switch event.Type {
+			case statefeed.LightClientFinalityUpdate:
+				update, ok := event.Data.(*ethpb.LightClientFinalityUpdate)
+				if !ok {
+					log.Error("Event feed data is not type *ethpb.LightClientFinalityUpdate")
+					continue
+				}
+				go s.broadcastLightClientUpdate(update, s.lightClientUpdateTime(update.SignatureSlot))
+			case statefeed.LightClientOptimisticUpdate:
+				update, ok := event.Data.(*ethpb.LightClientOptimisticUpdate)
+				if !ok {
+					log.Error("Event feed data is not type *ethpb.LightClientOptimisticUpdate")
+					continue
+				}
+				go s.broadcastLightClientUpdate(update, s.lightClientUpdateTime(update.SignatureSlot))
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// broadcastLightClientUpdate publishes a light client update at the given time, unless the node
+// is syncing or a newer update was computed in the meantime.
+func (s *Service) broadcastLightClientUpdate(update proto.Message, at time.Time) {
+	select {
+	case <-time.After(prysmTime.Until(at)):
+	case <-s.ctx.Done():
+		return
+	}
+	if s.cfg.initialSync.Syncing() {
+		return
+	}
+	var latest proto.Message
+	switch update.(type) {
+	case *ethpb.LightClientFinalityUpdate:
+		latest = s.cfg.chain.LightClientFinalityUpdate()
+	case *ethpb.LightClientOptimisticUpdate:
+		latest = s.cfg.chain.LightClientOptimisticUpdate()
+	}
+	if !proto.Equal(update, latest) {
+		return
+	}
+	if err := s.cfg.p2p.Broadcast(s.ctx, update); err != nil {
+		log.WithError(err).Debug("Could not broadcast light client update")
+	}
+}
@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate accepts a light client finality update only once the block
+// at its signature slot had time to propagate, and only if it matches the latest finality update
+// computed locally from processed blocks.
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// Light client updates cannot be computed locally while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.FinalizedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if !s.lightClientUpdatePropagated(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientFinalityUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate accepts a light client optimistic update only once the block
+// at its signature slot had time to propagate, and only if it matches the latest optimistic update
+// computed locally from processed blocks.
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// Light client updates cannot be computed locally while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if !s.lightClientUpdatePropagated(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientOptimisticUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientUpdatePropagated returns true once a third of the signature slot of a light client
+// update has passed, within the allowed clock disparity.
+func (s *Service) lightClientUpdatePropagated(signatureSlot types.Slot) bool {
+	now := prysmTime.Now().Add(params.BeaconNetworkConfig().MaximumGossipClockDisparity)
+	return !now.Before(s.lightClientUpdateTime(signatureSlot))
+}
+
+// lightClientUpdateTime returns the time after which a light client update signed at the given
+// slot may be forwarded, giving the block carrying its sync aggregate a third of a slot to propagate.
+func (s *Service) lightClientUpdateTime(signatureSlot types.Slot) time.Time {
+	start := slots.StartTime(uint64(s.cfg.chain.GenesisTime().Unix()), signatureSlot)
+	return start.Add(slots.DivideSlotBy(int64(params.BeaconConfig().IntervalsPerSlot)))
+}
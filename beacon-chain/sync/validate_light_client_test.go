@@ -0,0 +1,123 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	ssz "github.com/ferranbt/fastssz"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func lightClientPubsubMessage(t *testing.T, r *Service, msg ssz.Marshaler) *pubsub.Message {
+	buf := new(bytes.Buffer)
+	_, err := r.cfg.p2p.Encoding().EncodeGossip(buf, msg)
+	require.NoError(t, err)
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	d, err := r.currentForkDigest()
+	require.NoError(t, err)
+	topic = r.addDigestToTopic(topic, d)
+	return &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+}
+
+func TestValidateLightClientFinalityUpdate(t *testing.T) {
+	ctx := context.Background()
+	currentSlot := types.Slot(200)
+	genesis := time.Now().Add(-time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	newUpdate := util.NewLightClientFinalityUpdate
+	local := newUpdate(100, 64)
+
+	tests := []struct {
+		name    string
+		update  *ethpb.LightClientFinalityUpdate
+		syncing bool
+		want    pubsub.ValidationResult
+	}{
+		{
+			name:   "matches local update",
+			update: newUpdate(100, 64),
+			want:   pubsub.ValidationAccept,
+		},
+		{
+			name:   "differs from local update",
+			update: newUpdate(100, 32),
+			want:   pubsub.ValidationIgnore,
+		},
+		{
+			name:   "signature slot too recent",
+			update: newUpdate(currentSlot-1, 64),
+			want:   pubsub.ValidationIgnore,
+		},
+		{
+			name:    "syncing",
+			update:  newUpdate(100, 64),
+			syncing: true,
+			want:    pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Service{
+				cfg: &config{
+					p2p:         p2ptest.NewTestP2P(t),
+					chain:       &mock.ChainService{Genesis: genesis, FinalityUpdate: local},
+					initialSync: &mockSync.Sync{IsSyncing: tt.syncing},
+				},
+			}
+			m := lightClientPubsubMessage(t, r, tt.update)
+			res, err := r.validateLightClientFinalityUpdate(ctx, "", m)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res)
+			if tt.want == pubsub.ValidationAccept {
+				assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+			}
+		})
+	}
+}
+
+func TestValidateLightClientOptimisticUpdate(t *testing.T) {
+	ctx := context.Background()
+	currentSlot := types.Slot(200)
+	genesis := time.Now().Add(-time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	newUpdate := util.NewLightClientOptimisticUpdate
+	r := &Service{
+		cfg: &config{
+			p2p:         p2ptest.NewTestP2P(t),
+			chain:       &mock.ChainService{Genesis: genesis, OptimisticUpdate: newUpdate(100)},
+			initialSync: &mockSync.Sync{IsSyncing: false},
+		},
+	}
+
+	m := lightClientPubsubMessage(t, r, newUpdate(100))
+	res, err := r.validateLightClientOptimisticUpdate(ctx, "", m)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+
+	res, err = r.validateLightClientOptimisticUpdate(ctx, "", lightClientPubsubMessage(t, r, newUpdate(99)))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+
+	r.cfg.chain = &mock.ChainService{Genesis: genesis}
+	res, err = r.validateLightClientOptimisticUpdate(ctx, "", lightClientPubsubMessage(t, r, newUpdate(100)))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+}
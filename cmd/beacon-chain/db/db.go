@@ -31,5 +31,86 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "compact",
+			Description: `writes a compacted copy of the database, without the space left behind by deleted data`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.CompactTargetDirFlag,
+				cmd.BoltMMapInitialSizeFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Compact(cliCtx); err != nil {
+					log.Fatalf("Could not compact database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "stats",
+			Description: `prints the number of keys and the size on disk of each bucket of the database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.BoltMMapInitialSizeFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Stats(cliCtx); err != nil {
+					log.Fatalf("Could not print database stats: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "migrate-backend",
+			Description: `copies every bucket of the database to the key-value store set with --db-backend`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.DBBackendFlag,
+				cmd.BoltMMapInitialSizeFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.MigrateBackend(cliCtx); err != nil {
+					log.Fatalf("Could not migrate database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "export-era",
+			Description: `writes the finalized blocks and states of the database to era files`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.EraDirFlag,
+				cmd.EraFromFlag,
+				cmd.EraToFlag,
+				cmd.BoltMMapInitialSizeFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.ExportEra(cliCtx); err != nil {
+					log.Fatalf("Could not export era files: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "import-era",
+			Description: `verifies era files and saves their blocks and states to the database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.EraDirFlag,
+				cmd.BoltMMapInitialSizeFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.ImportEra(cliCtx); err != nil {
+					log.Fatalf("Could not import era files: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
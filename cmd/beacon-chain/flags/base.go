@@ -35,6 +35,14 @@ var (
 			"This is not required if using an IPC connection.",
 		Value: "",
 	}
+	// MevRelayEndpoint provides an HTTP access endpoint to an external block builder or MEV relay.
+	MevRelayEndpoint = &cli.StringFlag{
+		Name: "http-mev-relay",
+		Usage: "A MEV relay or external block builder string http endpoint, used to request execution payload " +
+			"headers for block proposals. Proposals fall back to the local execution client if the relay is " +
+			"unavailable or does not respond in time.",
+		Value: "",
+	}
 	// FallbackWeb3ProviderFlag provides a fallback endpoint to an ETH 1.0 RPC.
 	FallbackWeb3ProviderFlag = &cli.StringSliceFlag{
 		Name:  "fallback-web3provider",
@@ -143,6 +151,59 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// HistoryRetentionEpochs specifies the number of epochs of blocks and states kept in the beaconDB behind the
+	// finalized checkpoint. Older history is pruned in the background after each finalization.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "The number of epochs before the finalized checkpoint for which blocks and states are kept in the beaconDB. " +
+			"Older history is pruned as the chain finalizes. Keeps the full history when set to 0.",
+		Value: 0,
+	}
+	// HotStateCacheSize specifies the estimated memory of the hot states cached by the state generator.
+	HotStateCacheSize = &cli.Uint64Flag{
+		Name:  "hot-state-cache-size-mb",
+		Usage: "The estimated memory, in MB, of the hot states kept in memory. States are evicted once it is exceeded.",
+		Value: 2048,
+	}
+	// EpochBoundaryStateCacheSize specifies the estimated memory of the epoch boundary states cached by the
+	// state generator.
+	EpochBoundaryStateCacheSize = &cli.Uint64Flag{
+		Name:  "epoch-boundary-state-cache-size-mb",
+		Usage: "The estimated memory, in MB, of the epoch boundary states kept in memory. States are evicted once it is exceeded.",
+		Value: 1024,
+	}
+	// SaveHotStatesFinalityLag specifies the number of epochs of non-finality after which hot states are saved to the DB.
+	SaveHotStatesFinalityLag = &cli.Uint64Flag{
+		Name: "save-hot-states-finality-lag",
+		Usage: "The number of epochs since the finalized checkpoint after which hot states are saved to the beaconDB, " +
+			"to bound memory use and replays during long periods of non-finality. They are deleted once the chain finalizes.",
+		Value: 100,
+	}
+	// SaveHotStatesInterval specifies the number of epochs between the hot states saved to the DB during non-finality.
+	SaveHotStatesInterval = &cli.Uint64Flag{
+		Name:  "save-hot-states-interval",
+		Usage: "The number of epochs between the hot states saved to the beaconDB during long periods of non-finality.",
+		Value: 4,
+	}
+	// HistoricalStateConcurrency specifies the number of states of past slots generated at once for the API.
+	HistoricalStateConcurrency = &cli.IntFlag{
+		Name:  "historical-state-concurrency",
+		Usage: "The number of states of past slots which are generated at once to answer API requests.",
+		Value: 2,
+	}
+	// HistoricalStateQueueSize specifies the number of states of past slots waiting to be generated for the API.
+	HistoricalStateQueueSize = &cli.IntFlag{
+		Name: "historical-state-queue-size",
+		Usage: "The number of states of past slots which can wait to be generated to answer API requests. " +
+			"Requests for other states are answered with 503 Service Unavailable.",
+		Value: 16,
+	}
+	// HistoricalStateCacheSize specifies the number of finalized states of past slots cached for the API.
+	HistoricalStateCacheSize = &cli.IntFlag{
+		Name:  "historical-state-cache-size",
+		Usage: "The number of recently generated finalized states kept in memory to answer API requests.",
+		Value: 8,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -210,6 +271,12 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// CheckpointSyncURL defines a flag to start the beacon chain from the finalized checkpoint of a trusted beacon node.
+	CheckpointSyncURL = &cli.StringFlag{
+		Name: "checkpoint-sync-url",
+		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. The finalized state and block " +
+			"are downloaded from the node's beacon API and used to initialize an empty database, instead of syncing from genesis.",
+	}
 	// MinPeersPerSubnet defines a flag to set the minimum number of peers that a node will attempt to peer with for a subnet.
 	MinPeersPerSubnet = &cli.Uint64Flag{
 		Name:  "minimum-peers-per-subnet",
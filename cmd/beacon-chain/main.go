@@ -35,6 +35,7 @@ var appFlags = []cli.Flag{
 	flags.HTTPWeb3ProviderFlag,
 	flags.ExecutionProviderFlag,
 	flags.ExecutionJWTSecretFlag,
+	flags.MevRelayEndpoint,
 	flags.FallbackWeb3ProviderFlag,
 	flags.RPCHost,
 	flags.RPCPort,
@@ -58,6 +59,14 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.HistoryRetentionEpochs,
+	flags.HotStateCacheSize,
+	flags.EpochBoundaryStateCacheSize,
+	flags.SaveHotStatesFinalityLag,
+	flags.SaveHotStatesInterval,
+	flags.HistoricalStateConcurrency,
+	flags.HistoricalStateQueueSize,
+	flags.HistoricalStateCacheSize,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
@@ -66,6 +75,7 @@ var appFlags = []cli.Flag{
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.CheckpointSyncURL,
 	flags.MinPeersPerSubnet,
 	flags.FeeRecipient,
 	cmd.EnableBackupWebhookFlag,
@@ -79,6 +89,7 @@ var appFlags = []cli.Flag{
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
+	cmd.P2PQUICPort,
 	cmd.P2PIP,
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
@@ -117,6 +128,7 @@ var appFlags = []cli.Flag{
 	cmd.RestoreSourceFileFlag,
 	cmd.RestoreTargetDirFlag,
 	cmd.BoltMMapInitialSizeFlag,
+	cmd.DBBackendFlag,
 	cmd.ValidatorMonitorIndicesFlag,
 	cmd.ApiTimeoutFlag,
 }
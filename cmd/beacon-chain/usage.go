@@ -52,6 +52,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PUDPPort,
 			cmd.P2PTCPPort,
+			cmd.P2PQUICPort,
 			cmd.DataDirFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
@@ -73,6 +74,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RestoreSourceFileFlag,
 			cmd.RestoreTargetDirFlag,
 			cmd.BoltMMapInitialSizeFlag,
+			cmd.DBBackendFlag,
 			cmd.ValidatorMonitorIndicesFlag,
 			cmd.ApiTimeoutFlag,
 		},
@@ -109,11 +111,20 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HTTPWeb3ProviderFlag,
 			flags.ExecutionProviderFlag,
 			flags.ExecutionJWTSecretFlag,
+			flags.MevRelayEndpoint,
 			flags.FallbackWeb3ProviderFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.HistoryRetentionEpochs,
+			flags.HotStateCacheSize,
+			flags.EpochBoundaryStateCacheSize,
+			flags.SaveHotStatesFinalityLag,
+			flags.SaveHotStatesInterval,
+			flags.HistoricalStateConcurrency,
+			flags.HistoricalStateQueueSize,
+			flags.HistoricalStateCacheSize,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
@@ -125,6 +136,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.CheckpointSyncURL,
 			flags.MinPeersPerSubnet,
 		},
 	},
@@ -119,6 +119,12 @@ var (
 		Usage: "The port used by libp2p.",
 		Value: 13000,
 	}
+	// P2PQUICPort defines the port to be used by the libp2p QUIC transport.
+	P2PQUICPort = &cli.IntFlag{
+		Name:  "p2p-quic-port",
+		Usage: "The UDP port used by libp2p over QUIC, alongside TCP. QUIC is disabled when not set.",
+		Value: 0,
+	}
 	// P2PIP defines the local IP to be used by libp2p.
 	P2PIP = &cli.StringFlag{
 		Name:  "p2p-local-ip",
@@ -243,12 +249,39 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// CompactTargetDirFlag specifies the target directory of the compacted database.
+	CompactTargetDirFlag = &cli.StringFlag{
+		Name:  "compact-target-dir",
+		Usage: "Target directory of the compacted database",
+	}
+	// EraDirFlag specifies the directory era files are written to or read from.
+	EraDirFlag = &cli.StringFlag{
+		Name:  "era-dir",
+		Usage: "Directory era files are written to or read from",
+	}
+	// EraFromFlag specifies the first era to export.
+	EraFromFlag = &cli.Uint64Flag{
+		Name:  "from",
+		Usage: "First era to export",
+		Value: 1,
+	}
+	// EraToFlag specifies the last era to export.
+	EraToFlag = &cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Last era to export, inclusive",
+	}
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
 		Usage: "Specifies the size in bytes of bolt db's mmap syscall allocation",
 		Value: 536870912, // 512 Mb as a default value.
 	}
+	// DBBackendFlag specifies the key-value store of the beacon node database.
+	DBBackendFlag = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "Key-value store of the beacon node database, bolt or leveldb. Defaults to the store of the " +
+			"existing database, and to bolt for a new database. Use the db migrate-backend command to switch stores",
+	}
 	// ApiTimeoutFlag specifies the timeout value for API requests in seconds. A timeout of zero means no timeout.
 	ApiTimeoutFlag = &cli.IntFlag{
 		Name:  "api-timeout",
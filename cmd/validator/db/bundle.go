@@ -0,0 +1,123 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/io/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/bundle"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+const bundlePasswordPromptText = "Bundle password"
+
+// Writes the slashing protection history of the validator DB, the wallet keystores and
+// the keymanager configuration to a single encrypted bundle file.
+func exportBundle(cliCtx *cli.Context) error {
+	dataDir, walletDir, bundleFile, err := bundlePaths(cliCtx)
+	if err != nil {
+		return err
+	}
+	found, _, err := file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	if err != nil {
+		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found {
+		return fmt.Errorf("validator.db file (validator database) was not found at path %s, so nothing to export", dataDir)
+	}
+	if file.FileExists(bundleFile) {
+		return fmt.Errorf("bundle file %s already exists", bundleFile)
+	}
+	password, err := wallet.InputPassword(
+		cliCtx, flags.BundlePasswordFileFlag, bundlePasswordPromptText, true /* confirm password */, prompt.ValidatePasswordInput,
+	)
+	if err != nil {
+		return err
+	}
+
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+	buf := new(bytes.Buffer)
+	if err := bundle.Export(cliCtx.Context, validatorDB, walletDir, password, buf); err != nil {
+		return err
+	}
+	if err := file.WriteFile(bundleFile, buf.Bytes()); err != nil {
+		return errors.Wrapf(err, "could not write bundle to path %s", bundleFile)
+	}
+	log.Infof(
+		"Successfully wrote %s. You can import it using the validator db import-bundle command on another machine. "+
+			"Do not run this validator anywhere else until then",
+		bundleFile,
+	)
+	return nil
+}
+
+// Imports the slashing protection history of a bundle into the validator DB and writes its wallet
+// to the wallet directory.
+func importBundle(cliCtx *cli.Context) error {
+	dataDir, walletDir, bundleFile, err := bundlePaths(cliCtx)
+	if err != nil {
+		return err
+	}
+	enc, err := file.ReadFileAsBytes(bundleFile)
+	if err != nil {
+		return errors.Wrapf(err, "could not read bundle at path %s", bundleFile)
+	}
+	password, err := wallet.InputPassword(
+		cliCtx, flags.BundlePasswordFileFlag, bundlePasswordPromptText, false /* confirm password */, prompt.NotEmpty,
+	)
+	if err != nil {
+		return err
+	}
+
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+	if err := bundle.Import(cliCtx.Context, validatorDB, walletDir, password, bytes.NewReader(enc)); err != nil {
+		return err
+	}
+	log.Infof("Successfully imported %s into the validator database at %s and the wallet at %s", bundleFile, dataDir, walletDir)
+	return nil
+}
+
+func bundlePaths(cliCtx *cli.Context) (dataDir, walletDir, bundleFile string, err error) {
+	dataDir = cliCtx.String(cmd.DataDirFlag.Name)
+	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dataDir, err = userprompt.InputDirectory(cliCtx, userprompt.DataDirDirPromptText, cmd.DataDirFlag)
+		if err != nil {
+			return "", "", "", errors.Wrap(err, "could not read directory value from input")
+		}
+	}
+	walletDir, err = userprompt.InputDirectory(cliCtx, userprompt.WalletDirPromptText, flags.WalletDirFlag)
+	if err != nil {
+		return "", "", "", errors.Wrap(err, "could not read wallet directory from input")
+	}
+	if cliCtx.String(flags.BundleFileFlag.Name) == "" {
+		return "", "", "", fmt.Errorf("no bundle file specified, please set the %s flag", flags.BundleFileFlag.Name)
+	}
+	bundleFile, err = file.ExpandPath(cliCtx.String(flags.BundleFileFlag.Name))
+	if err != nil {
+		return "", "", "", err
+	}
+	return dataDir, walletDir, bundleFile, nil
+}
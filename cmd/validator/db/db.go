@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/runtime/tos"
 	validatordb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
@@ -31,6 +32,42 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "export-bundle",
+			Description: `exports the slashing protection history, wallet keystores and keymanager configuration of a validator into one encrypted bundle file`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.WalletDirFlag,
+				flags.BundleFileFlag,
+				flags.BundlePasswordFileFlag,
+				cmd.AcceptTosFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportBundle(cliCtx); err != nil {
+					log.Fatalf("Could not export bundle: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "import-bundle",
+			Description: `imports a bundle written by export-bundle into the validator database and wallet directory`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.WalletDirFlag,
+				flags.BundleFileFlag,
+				flags.BundlePasswordFileFlag,
+				cmd.AcceptTosFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := importBundle(cliCtx); err != nil {
+					log.Fatalf("Could not import bundle: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:     "migrate",
 			Category: "db",
@@ -38,6 +38,14 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint used instead of the gRPC API.
+	BeaconRESTApiProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint, e.g. http://127.0.0.1:3500. If set, the validator talks to " +
+			"the beacon node only through the standard beacon node REST API instead of gRPC, which allows using " +
+			"any beacon node implementation",
+		Value: "",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -286,6 +294,14 @@ var (
 		Value: "",
 	}
 
+	// Web3SignerKeyFileFlag defines the file in which public keys added through the remote keys API are persisted.
+	// example: --validators-external-signer-key-file=/path/to/remote-keys.json
+	Web3SignerKeyFileFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-key-file",
+		Usage: "path to the file used to persist web3signer public keys added or removed through the remote keys API, defaults to remote-keys.json in the wallet directory. The provided public keys are merged with the keys in the file, except for keys deleted through the remote keys API",
+		Value: "",
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -311,11 +327,35 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// BundleFileFlag is the path of the encrypted bundle exported or imported by the validator db
+	// export-bundle and import-bundle commands.
+	BundleFileFlag = &cli.StringFlag{
+		Name:  "bundle-file",
+		Usage: "Path to the encrypted bundle of a validator's slashing protection history, keystores and keymanager configuration",
+	}
+	// BundlePasswordFileFlag is the path to a file containing the password of a bundle.
+	BundlePasswordFileFlag = &cli.StringFlag{
+		Name:  "bundle-password-file",
+		Usage: "Path to a plain-text, .txt file containing the password used to encrypt or decrypt a bundle",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
 		Usage: "The path to a YAML file with graffiti values",
 	}
+	// ProposerSettingsFileFlag specifies the file path to load the proposer settings, such as the fee recipient of each validator.
+	ProposerSettingsFileFlag = &cli.StringFlag{
+		Name: "proposer-settings-file",
+		Usage: "The path to a JSON or YAML file with the fee recipient of each validator public key, and a default fee recipient " +
+			"for all other keys. The file is reloaded and sent to the beacon node every epoch",
+	}
+	// EnableBuilderFlag enables the signing and submission of builder registrations.
+	EnableBuilderFlag = &cli.BoolFlag{
+		Name: "enable-builder",
+		Usage: "Signs a builder registration with the fee recipient and gas limit of each validator, and submits them " +
+			"to the beacon node every epoch, so that block proposals can use the external block builder of the beacon node",
+		Value: false,
+	}
 	// EnableDutyCountDown enables more verbose logging for counting down to duty.
 	EnableDutyCountDown = &cli.BoolFlag{
 		Name:  "enable-duty-count-down",
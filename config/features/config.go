@@ -77,6 +77,9 @@ type Flags struct {
 	EnableNativeState                bool // EnableNativeState defines whether the beacon state will be represented as a pure Go struct or a Go struct that wraps a proto struct.
 	EnableVectorizedHTR              bool // EnableVectorizedHTR specifies whether the beacon state will use the optimized sha256 routines.
 	EnableForkChoiceDoublyLinkedTree bool // EnableForkChoiceDoublyLinkedTree specifies whether fork choice store will use a doubly linked tree.
+	EnableOnlyBlindedBeaconBlocks    bool // EnableOnlyBlindedBeaconBlocks stores beacon blocks with only the execution payload header in the database.
+	EnableStateDiffs                 bool // EnableStateDiffs stores per epoch differences between archived states, to rebuild historical states without replaying blocks.
+	EnableLightClientServer          bool // EnableLightClientServer computes light client data from processed blocks and serves it over p2p and the beacon API.
 
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
@@ -232,6 +235,18 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		logEnabled(enableForkChoiceDoublyLinkedTree)
 		cfg.EnableForkChoiceDoublyLinkedTree = true
 	}
+	if ctx.Bool(enableOnlyBlindedBeaconBlocks.Name) {
+		logEnabled(enableOnlyBlindedBeaconBlocks)
+		cfg.EnableOnlyBlindedBeaconBlocks = true
+	}
+	if ctx.Bool(enableStateDiffs.Name) {
+		logEnabled(enableStateDiffs)
+		cfg.EnableStateDiffs = true
+	}
+	if ctx.Bool(enableLightClientServer.Name) {
+		logEnabled(enableLightClientServer)
+		cfg.EnableLightClientServer = true
+	}
 	Init(cfg)
 }
 
@@ -142,6 +142,22 @@ var (
 		Name:  "enable-forkchoice-doubly-linked-tree",
 		Usage: "Enables new forkchoice store structure that uses doubly linked trees",
 	}
+	enableOnlyBlindedBeaconBlocks = &cli.BoolFlag{
+		Name: "enable-only-blinded-beacon-blocks",
+		Usage: "Stores beacon blocks with only the execution payload header in the database, and rebuilds " +
+			"full blocks from the execution client when serving them to peers and API clients. Existing blocks " +
+			"are migrated on startup.",
+	}
+	enableStateDiffs = &cli.BoolFlag{
+		Name: "enable-state-diffs",
+		Usage: "Stores the finalized state of every epoch as a difference with the last archived state, " +
+			"so that historical states are rebuilt without replaying blocks. Meant for archival nodes.",
+	}
+	enableLightClientServer = &cli.BoolFlag{
+		Name: "enable-light-client-server",
+		Usage: "Computes light client bootstraps and updates from processed blocks, and serves them over " +
+			"req/resp, gossip and the beacon API.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -195,6 +211,9 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	enableNativeState,
 	enableVecHTR,
 	enableForkChoiceDoublyLinkedTree,
+	enableOnlyBlindedBeaconBlocks,
+	enableStateDiffs,
+	enableLightClientServer,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
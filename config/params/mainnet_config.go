@@ -35,6 +35,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	AttestationSubnetCount:          64,
 	AttestationPropagationSlotRange: 32,
 	MaxRequestBlocks:                1 << 10, // 1024
+	MaxRequestLightClientUpdates:    128,
 	TtfbTimeout:                     5 * time.Second,
 	RespTimeout:                     10 * time.Second,
 	MaximumGossipClockDisparity:     500 * time.Millisecond,
@@ -167,6 +168,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DomainSyncCommittee:               bytesutil.ToBytes4(bytesutil.Bytes4(7)),
 	DomainSyncCommitteeSelectionProof: bytesutil.ToBytes4(bytesutil.Bytes4(8)),
 	DomainContributionAndProof:        bytesutil.ToBytes4(bytesutil.Bytes4(9)),
+	DomainApplicationBuilder:          bytesutil.ToBytes4(bytesutil.Bytes4(16777216)),
 
 	// Prysm constants.
 	GweiPerEth:                     1000000000,
@@ -67,6 +67,19 @@ func V1Alpha1ToV1Block(alphaBlk *ethpbalpha.BeaconBlock) (*ethpbv1.BeaconBlock,
 	return v1Block, nil
 }
 
+// V1ToV1Alpha1Block converts a v1 BeaconBlock proto to a v1alpha1 proto.
+func V1ToV1Alpha1Block(v1Blk *ethpbv1.BeaconBlock) (*ethpbalpha.BeaconBlock, error) {
+	marshaledBlk, err := proto.Marshal(v1Blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v1alpha1Block := &ethpbalpha.BeaconBlock{}
+	if err := proto.Unmarshal(marshaledBlk, v1alpha1Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v1alpha1Block, nil
+}
+
 // V1Alpha1AggregateAttAndProofToV1 converts a v1alpha1 aggregate attestation and proof to v1.
 func V1Alpha1AggregateAttAndProofToV1(v1alpha1Att *ethpbalpha.AggregateAttestationAndProof) *ethpbv1.AggregateAttestationAndProof {
 	if v1alpha1Att == nil {
@@ -120,7 +120,7 @@ func Test_V1ToV1Alpha1SignedBlock(t *testing.T) {
 	assert.DeepEqual(t, v1Root, alphaRoot)
 }
 
-func Test_V1ToV1Alpha1Block(t *testing.T) {
+func Test_V1Alpha1ToV1Block(t *testing.T) {
 	alphaBlock := util.HydrateBeaconBlock(&ethpbalpha.BeaconBlock{})
 	alphaBlock.Slot = slot
 	alphaBlock.ProposerIndex = validatorIndex
@@ -142,6 +142,23 @@ func Test_V1ToV1Alpha1Block(t *testing.T) {
 	assert.DeepEqual(t, alphaRoot, v1Root)
 }
 
+func Test_V1ToV1Alpha1Block(t *testing.T) {
+	v1Block := util.HydrateV1BeaconBlock(&ethpbv1.BeaconBlock{})
+	v1Block.Slot = slot
+	v1Block.ProposerIndex = validatorIndex
+	v1Block.ParentRoot = parentRoot
+	v1Block.StateRoot = stateRoot
+	v1Block.Body.RandaoReveal = randaoReveal
+
+	alphaBlock, err := V1ToV1Alpha1Block(v1Block)
+	require.NoError(t, err)
+	alphaRoot, err := alphaBlock.HashTreeRoot()
+	require.NoError(t, err)
+	v1Root, err := v1Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, v1Root, alphaRoot)
+}
+
 func Test_V1Alpha1AttSlashingToV1(t *testing.T) {
 	alphaAttestation := &ethpbalpha.IndexedAttestation{
 		AttestingIndices: attestingIndices,
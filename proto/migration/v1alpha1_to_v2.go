@@ -36,6 +36,19 @@ func AltairToV1Alpha1SignedBlock(altairBlk *ethpbv2.SignedBeaconBlockAltair) (*e
 	return v1alpha1Block, nil
 }
 
+// AltairToV1Alpha1Block converts a v2 BeaconBlockAltair proto to a v1alpha1 proto.
+func AltairToV1Alpha1Block(altairBlk *ethpbv2.BeaconBlockAltair) (*ethpbalpha.BeaconBlockAltair, error) {
+	marshaledBlk, err := proto.Marshal(altairBlk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v1alpha1Block := &ethpbalpha.BeaconBlockAltair{}
+	if err := proto.Unmarshal(marshaledBlk, v1alpha1Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v1alpha1Block, nil
+}
+
 // V1Alpha1BeaconBlockBellatrixToV2 converts a v1alpha1 Bellatrix beacon block to a v2
 // Bellatrix block.
 func V1Alpha1BeaconBlockBellatrixToV2(v1alpha1Block *ethpbalpha.BeaconBlockBellatrix) (*ethpbv2.BeaconBlockBellatrix, error) {
@@ -50,6 +63,19 @@ func V1Alpha1BeaconBlockBellatrixToV2(v1alpha1Block *ethpbalpha.BeaconBlockBella
 	return v2Block, nil
 }
 
+// BellatrixToV1Alpha1Block converts a v2 BeaconBlockBellatrix proto to a v1alpha1 proto.
+func BellatrixToV1Alpha1Block(bellatrixBlk *ethpbv2.BeaconBlockBellatrix) (*ethpbalpha.BeaconBlockBellatrix, error) {
+	marshaledBlk, err := proto.Marshal(bellatrixBlk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	v1alpha1Block := &ethpbalpha.BeaconBlockBellatrix{}
+	if err := proto.Unmarshal(marshaledBlk, v1alpha1Block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return v1alpha1Block, nil
+}
+
 // BeaconStateAltairToProto converts a state.BeaconStateAltair object to its protobuf equivalent.
 func BeaconStateAltairToProto(altairState state.BeaconStateAltair) (*ethpbv2.BeaconStateV2, error) {
 	sourceFork := altairState.Fork()
@@ -313,3 +339,78 @@ func V1Alpha1SignedContributionAndProofToV2(alphaContribution *ethpbalpha.Signed
 	}
 	return result
 }
+
+// V1Alpha1SyncCommitteeMessageToV2 converts a v1alpha1 sync committee message to v2.
+func V1Alpha1SyncCommitteeMessageToV2(alphaMsg *ethpbalpha.SyncCommitteeMessage) *ethpbv2.SyncCommitteeMessage {
+	return &ethpbv2.SyncCommitteeMessage{
+		Slot:            alphaMsg.Slot,
+		BeaconBlockRoot: alphaMsg.BlockRoot,
+		ValidatorIndex:  alphaMsg.ValidatorIndex,
+		Signature:       alphaMsg.Signature,
+	}
+}
+
+// V2SyncCommitteeContributionToV1Alpha1 converts a v2 sync committee contribution to v1alpha1.
+func V2SyncCommitteeContributionToV1Alpha1(v2Contribution *ethpbv2.SyncCommitteeContribution) *ethpbalpha.SyncCommitteeContribution {
+	return &ethpbalpha.SyncCommitteeContribution{
+		Slot:              v2Contribution.Slot,
+		BlockRoot:         v2Contribution.BeaconBlockRoot,
+		SubcommitteeIndex: v2Contribution.SubcommitteeIndex,
+		AggregationBits:   v2Contribution.AggregationBits,
+		Signature:         v2Contribution.Signature,
+	}
+}
+
+// V1Alpha1LightClientBootstrapToV2 converts a v1alpha1 light client bootstrap to a v2 bootstrap.
+func V1Alpha1LightClientBootstrapToV2(v1alpha1Bootstrap *ethpbalpha.LightClientBootstrap) (*ethpbv2.LightClientBootstrap, error) {
+	marshaledBootstrap, err := proto.Marshal(v1alpha1Bootstrap)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal bootstrap")
+	}
+	v2Bootstrap := &ethpbv2.LightClientBootstrap{}
+	if err := proto.Unmarshal(marshaledBootstrap, v2Bootstrap); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal bootstrap")
+	}
+	return v2Bootstrap, nil
+}
+
+// V1Alpha1LightClientUpdateToV2 converts a v1alpha1 light client update to a v2 update.
+func V1Alpha1LightClientUpdateToV2(v1alpha1Update *ethpbalpha.LightClientUpdate) (*ethpbv2.LightClientUpdate, error) {
+	marshaledUpdate, err := proto.Marshal(v1alpha1Update)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal update")
+	}
+	v2Update := &ethpbv2.LightClientUpdate{}
+	if err := proto.Unmarshal(marshaledUpdate, v2Update); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal update")
+	}
+	return v2Update, nil
+}
+
+// V1Alpha1LightClientFinalityUpdateToV2 converts a v1alpha1 light client finality update to a v2
+// finality update.
+func V1Alpha1LightClientFinalityUpdateToV2(v1alpha1Update *ethpbalpha.LightClientFinalityUpdate) (*ethpbv2.LightClientFinalityUpdate, error) {
+	marshaledUpdate, err := proto.Marshal(v1alpha1Update)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal finality update")
+	}
+	v2Update := &ethpbv2.LightClientFinalityUpdate{}
+	if err := proto.Unmarshal(marshaledUpdate, v2Update); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal finality update")
+	}
+	return v2Update, nil
+}
+
+// V1Alpha1LightClientOptimisticUpdateToV2 converts a v1alpha1 light client optimistic update to a v2
+// optimistic update.
+func V1Alpha1LightClientOptimisticUpdateToV2(v1alpha1Update *ethpbalpha.LightClientOptimisticUpdate) (*ethpbv2.LightClientOptimisticUpdate, error) {
+	marshaledUpdate, err := proto.Marshal(v1alpha1Update)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal optimistic update")
+	}
+	v2Update := &ethpbv2.LightClientOptimisticUpdate{}
+	if err := proto.Unmarshal(marshaledUpdate, v2Update); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal optimistic update")
+	}
+	return v2Update, nil
+}
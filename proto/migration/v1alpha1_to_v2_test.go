@@ -44,6 +44,36 @@ func TestV1Alpha1SignedContributionAndProofToV2(t *testing.T) {
 	assert.DeepEqual(t, bitfield.NewBitvector128(), contrib.AggregationBits)
 	assert.DeepEqual(t, signature, contrib.Signature)
 }
+func TestV1Alpha1SyncCommitteeMessageToV2(t *testing.T) {
+	alphaMsg := &ethpbalpha.SyncCommitteeMessage{
+		Slot:           slot,
+		BlockRoot:      blockHash,
+		ValidatorIndex: validatorIndex,
+		Signature:      signature,
+	}
+	v2Msg := V1Alpha1SyncCommitteeMessageToV2(alphaMsg)
+	assert.Equal(t, slot, v2Msg.Slot)
+	assert.DeepEqual(t, blockHash, v2Msg.BeaconBlockRoot)
+	assert.Equal(t, validatorIndex, v2Msg.ValidatorIndex)
+	assert.DeepEqual(t, signature, v2Msg.Signature)
+}
+
+func TestV2SyncCommitteeContributionToV1Alpha1(t *testing.T) {
+	v2Contribution := &ethpbv2.SyncCommitteeContribution{
+		Slot:              slot,
+		BeaconBlockRoot:   blockHash,
+		SubcommitteeIndex: 1,
+		AggregationBits:   bitfield.NewBitvector128(),
+		Signature:         signature,
+	}
+	alphaContribution := V2SyncCommitteeContributionToV1Alpha1(v2Contribution)
+	assert.Equal(t, slot, alphaContribution.Slot)
+	assert.DeepEqual(t, blockHash, alphaContribution.BlockRoot)
+	assert.Equal(t, uint64(1), alphaContribution.SubcommitteeIndex)
+	assert.DeepEqual(t, bitfield.NewBitvector128(), alphaContribution.AggregationBits)
+	assert.DeepEqual(t, signature, alphaContribution.Signature)
+}
+
 func Test_V1Alpha1BeaconBlockAltairToV2(t *testing.T) {
 	alphaBlock := util.HydrateBeaconBlockAltair(&ethpbalpha.BeaconBlockAltair{})
 	alphaBlock.Slot = slot
@@ -101,6 +131,23 @@ func Test_AltairToV1Alpha1SignedBlock(t *testing.T) {
 	assert.DeepEqual(t, v2Root, alphaRoot)
 }
 
+func Test_AltairToV1Alpha1Block(t *testing.T) {
+	v2Block := util.HydrateV2BeaconBlock(&ethpbv2.BeaconBlockAltair{})
+	v2Block.Slot = slot
+	v2Block.ProposerIndex = validatorIndex
+	v2Block.ParentRoot = parentRoot
+	v2Block.StateRoot = stateRoot
+	v2Block.Body.RandaoReveal = randaoReveal
+
+	alphaBlock, err := AltairToV1Alpha1Block(v2Block)
+	require.NoError(t, err)
+	alphaRoot, err := alphaBlock.HashTreeRoot()
+	require.NoError(t, err)
+	v2Root, err := v2Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, v2Root, alphaRoot)
+}
+
 func Test_V1Alpha1BeaconBlockBellatrixToV2(t *testing.T) {
 	alphaBlock := util.HydrateBeaconBlockBellatrix(&ethpbalpha.BeaconBlockBellatrix{})
 	alphaBlock.Slot = slot
@@ -129,6 +176,82 @@ func Test_V1Alpha1BeaconBlockBellatrixToV2(t *testing.T) {
 	assert.DeepEqual(t, alphaRoot, v2Root)
 }
 
+func Test_BellatrixToV1Alpha1Block(t *testing.T) {
+	alphaBlock := util.HydrateBeaconBlockBellatrix(&ethpbalpha.BeaconBlockBellatrix{})
+	alphaBlock.Slot = slot
+	alphaBlock.ProposerIndex = validatorIndex
+	alphaBlock.Body.ExecutionPayload.BlockNumber = 10
+	alphaBlock.Body.ExecutionPayload.Transactions = [][]byte{{'a'}}
+	v2Block, err := V1Alpha1BeaconBlockBellatrixToV2(alphaBlock)
+	require.NoError(t, err)
+
+	converted, err := BellatrixToV1Alpha1Block(v2Block)
+	require.NoError(t, err)
+	assert.DeepEqual(t, alphaBlock, converted)
+}
+
+func Test_V1Alpha1LightClientUpdateToV2(t *testing.T) {
+	syncCommitteeBits := bitfield.NewBitvector512()
+	syncCommitteeBits.SetBitAt(100, true)
+	branch := func(depth int) [][]byte {
+		b := make([][]byte, depth)
+		for i := range b {
+			b[i] = bytesutil.PadTo([]byte{byte(i)}, 32)
+		}
+		return b
+	}
+	alphaUpdate := &ethpbalpha.LightClientUpdate{
+		AttestedHeader: &ethpbalpha.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: validatorIndex,
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot,
+		},
+		NextSyncCommittee: &ethpbalpha.SyncCommittee{
+			Pubkeys:         [][]byte{bytesutil.PadTo([]byte("pubkey"), 48)},
+			AggregatePubkey: bytesutil.PadTo([]byte("aggregate"), 48),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader: &ethpbalpha.BeaconBlockHeader{
+			Slot:          slot - 1,
+			ProposerIndex: validatorIndex,
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot,
+		},
+		FinalityBranch: branch(6),
+		SyncAggregate: &ethpbalpha.SyncAggregate{
+			SyncCommitteeBits:      syncCommitteeBits,
+			SyncCommitteeSignature: signature,
+		},
+		SignatureSlot: slot + 1,
+	}
+
+	v2Update, err := V1Alpha1LightClientUpdateToV2(alphaUpdate)
+	require.NoError(t, err)
+	assert.Equal(t, slot, v2Update.AttestedHeader.Slot)
+	assert.DeepEqual(t, bodyRoot, v2Update.AttestedHeader.BodyRoot)
+	assert.DeepEqual(t, alphaUpdate.NextSyncCommittee.Pubkeys, v2Update.NextSyncCommittee.Pubkeys)
+	assert.DeepEqual(t, alphaUpdate.NextSyncCommittee.AggregatePubkey, v2Update.NextSyncCommittee.AggregatePubkey)
+	assert.DeepEqual(t, alphaUpdate.NextSyncCommitteeBranch, v2Update.NextSyncCommitteeBranch)
+	assert.Equal(t, slot-1, v2Update.FinalizedHeader.Slot)
+	assert.DeepEqual(t, alphaUpdate.FinalityBranch, v2Update.FinalityBranch)
+	assert.DeepEqual(t, syncCommitteeBits, v2Update.SyncAggregate.SyncCommitteeBits)
+	assert.DeepEqual(t, signature, v2Update.SyncAggregate.SyncCommitteeSignature)
+	assert.Equal(t, slot+1, v2Update.SignatureSlot)
+
+	v2Optimistic, err := V1Alpha1LightClientOptimisticUpdateToV2(&ethpbalpha.LightClientOptimisticUpdate{
+		AttestedHeader: alphaUpdate.AttestedHeader,
+		SyncAggregate:  alphaUpdate.SyncAggregate,
+		SignatureSlot:  alphaUpdate.SignatureSlot,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, v2Update.AttestedHeader, v2Optimistic.AttestedHeader)
+	assert.DeepEqual(t, v2Update.SyncAggregate, v2Optimistic.SyncAggregate)
+	assert.Equal(t, v2Update.SignatureSlot, v2Optimistic.SignatureSlot)
+}
+
 func TestBeaconStateAltairToProto(t *testing.T) {
 	source, err := util.NewBeaconStateAltair(util.FillRootsNaturalOptAltair, func(state *ethpbalpha.BeaconStateAltair) error {
 		state.GenesisTime = 1
@@ -31,6 +31,8 @@ func WrappedSignedBeaconBlock(i interface{}) (block.SignedBeaconBlock, error) {
 		return WrappedAltairSignedBeaconBlock(b)
 	case *eth.SignedBeaconBlockBellatrix:
 		return WrappedBellatrixSignedBeaconBlock(b)
+	case *eth.SignedBlindedBeaconBlockBellatrix:
+		return WrappedSignedBlindedBeaconBlockBellatrix(b)
 	default:
 		return nil, errors.Wrapf(ErrUnsupportedSignedBeaconBlock, "unable to wrap block of type %T", i)
 	}
@@ -52,6 +54,10 @@ func WrappedBeaconBlock(i interface{}) (block.BeaconBlock, error) {
 		return WrappedBellatrixBeaconBlock(b.Bellatrix)
 	case *eth.BeaconBlockBellatrix:
 		return WrappedBellatrixBeaconBlock(b)
+	case *eth.GenericBeaconBlock_BlindedBellatrix:
+		return WrappedBlindedBeaconBlockBellatrix(b.BlindedBellatrix)
+	case *eth.BlindedBeaconBlockBellatrix:
+		return WrappedBlindedBeaconBlockBellatrix(b)
 	default:
 		return nil, errors.Wrapf(ErrUnsupportedBeaconBlock, "unable to wrap block of type %T", i)
 	}
@@ -80,6 +86,12 @@ func BuildSignedBeaconBlock(blk block.BeaconBlock, signature []byte) (block.Sign
 			return nil, errors.New("unable to access inner bellatrix proto")
 		}
 		return WrappedSignedBeaconBlock(&eth.SignedBeaconBlockBellatrix{Block: pb, Signature: signature})
+	case blindedBeaconBlockBellatrix:
+		pb, ok := b.Proto().(*eth.BlindedBeaconBlockBellatrix)
+		if !ok {
+			return nil, errors.New("unable to access inner blinded bellatrix proto")
+		}
+		return WrappedSignedBeaconBlock(&eth.SignedBlindedBeaconBlockBellatrix{Block: pb, Signature: signature})
 	default:
 		return nil, errors.Wrapf(ErrUnsupportedBeaconBlock, "unable to wrap block of type %T", b)
 	}
@@ -96,6 +108,8 @@ func UnwrapGenericSignedBeaconBlock(gb *eth.GenericSignedBeaconBlock) (block.Sig
 		return WrappedSignedBeaconBlock(bb.Altair)
 	case *eth.GenericSignedBeaconBlock_Bellatrix:
 		return WrappedSignedBeaconBlock(bb.Bellatrix)
+	case *eth.GenericSignedBeaconBlock_BlindedBellatrix:
+		return WrappedSignedBeaconBlock(bb.BlindedBellatrix)
 	default:
 		return nil, errors.Wrapf(ErrUnsupportedSignedBeaconBlock, "unable to wrap block of type %T", gb)
 	}
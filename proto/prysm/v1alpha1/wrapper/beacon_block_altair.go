@@ -109,6 +109,11 @@ func (altairSignedBeaconBlock) PbBellatrixBlock() (*eth.SignedBeaconBlockBellatr
 	return nil, errors.New("unsupported bellatrix block")
 }
 
+// PbBlindedBellatrixBlock is a stub.
+func (altairSignedBeaconBlock) PbBlindedBellatrixBlock() (*eth.SignedBlindedBeaconBlockBellatrix, error) {
+	return nil, errors.New("unsupported blinded bellatrix block")
+}
+
 // Version of the underlying protobuf object.
 func (altairSignedBeaconBlock) Version() int {
 	return version.Altair
@@ -309,3 +314,8 @@ func (w altairBeaconBlockBody) Proto() proto.Message {
 func (altairBeaconBlockBody) ExecutionPayload() (*enginev1.ExecutionPayload, error) {
 	return nil, errors.New("ExecutionPayload is not supported in altair block body")
 }
+
+// ExecutionPayloadHeader is a stub.
+func (altairBeaconBlockBody) ExecutionPayloadHeader() (*eth.ExecutionPayloadHeader, error) {
+	return nil, errors.New("ExecutionPayloadHeader is not supported in altair block body")
+}
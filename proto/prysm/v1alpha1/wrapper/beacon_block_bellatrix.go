@@ -94,6 +94,11 @@ func (w bellatrixSignedBeaconBlock) PbBellatrixBlock() (*eth.SignedBeaconBlockBe
 	return w.b, nil
 }
 
+// PbBlindedBellatrixBlock is a stub.
+func (bellatrixSignedBeaconBlock) PbBlindedBellatrixBlock() (*eth.SignedBlindedBeaconBlockBellatrix, error) {
+	return nil, errors.New("unsupported blinded bellatrix block")
+}
+
 // PbPhase0Block is a stub.
 func (bellatrixSignedBeaconBlock) PbPhase0Block() (*eth.SignedBeaconBlock, error) {
 	return nil, ErrUnsupportedPhase0Block
@@ -304,3 +309,8 @@ func (w bellatrixBeaconBlockBody) Proto() proto.Message {
 func (w bellatrixBeaconBlockBody) ExecutionPayload() (*enginev1.ExecutionPayload, error) {
 	return w.b.ExecutionPayload, nil
 }
+
+// ExecutionPayloadHeader is a stub.
+func (bellatrixBeaconBlockBody) ExecutionPayloadHeader() (*eth.ExecutionPayloadHeader, error) {
+	return nil, errors.New("ExecutionPayloadHeader is not supported in bellatrix block body")
+}
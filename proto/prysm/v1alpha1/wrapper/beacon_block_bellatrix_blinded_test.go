@@ -0,0 +1,150 @@
+package wrapper_test
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestBlindedBellatrixSignedBeaconBlock_Header(t *testing.T) {
+	root := bytesutil.PadTo([]byte("root"), 32)
+	signature := bytesutil.PadTo([]byte("sig"), 96)
+	body := util.HydrateBlindedBeaconBlockBodyBellatrix(&ethpb.BlindedBeaconBlockBodyBellatrix{})
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	block := &ethpb.SignedBlindedBeaconBlockBellatrix{
+		Block: &ethpb.BlindedBeaconBlockBellatrix{
+			Slot:          1,
+			ProposerIndex: 1,
+			ParentRoot:    root,
+			StateRoot:     root,
+			Body:          body,
+		},
+		Signature: signature,
+	}
+	wrapped, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(block)
+	require.NoError(t, err)
+
+	header, err := wrapped.Header()
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(1), header.Header.ProposerIndex)
+	assert.Equal(t, types.Slot(1), header.Header.Slot)
+	assert.DeepEqual(t, bodyRoot[:], header.Header.BodyRoot)
+	assert.DeepEqual(t, root, header.Header.StateRoot)
+	assert.DeepEqual(t, root, header.Header.ParentRoot)
+	assert.DeepEqual(t, signature, header.Signature)
+}
+
+func TestBlindedBellatrixSignedBeaconBlock_IsNil(t *testing.T) {
+	_, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(nil)
+	require.Equal(t, wrapper.ErrNilObjectWrapped, err)
+
+	wsb, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(&ethpb.SignedBlindedBeaconBlockBellatrix{Block: &ethpb.BlindedBeaconBlockBellatrix{}})
+	require.NoError(t, err)
+	assert.Equal(t, false, wsb.IsNil())
+}
+
+func TestBlindedBellatrixSignedBeaconBlock_PbBlindedBellatrixBlock(t *testing.T) {
+	sb := &ethpb.SignedBlindedBeaconBlockBellatrix{
+		Block:     &ethpb.BlindedBeaconBlockBellatrix{Slot: 66},
+		Signature: []byte{0x11, 0x22},
+	}
+	wsb, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(sb)
+	require.NoError(t, err)
+
+	got, err := wsb.PbBlindedBellatrixBlock()
+	assert.NoError(t, err)
+	assert.Equal(t, sb, got)
+
+	_, err = wsb.PbBellatrixBlock()
+	assert.ErrorContains(t, "unsupported bellatrix block", err)
+}
+
+func TestBlindedBellatrixSignedBeaconBlock_SSZ(t *testing.T) {
+	wsb, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(util.HydrateSignedBlindedBeaconBlockBellatrix(&ethpb.SignedBlindedBeaconBlockBellatrix{}))
+	assert.NoError(t, err)
+
+	b, err := wsb.MarshalSSZ()
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, len(b))
+
+	assert.NotEqual(t, 0, wsb.SizeSSZ())
+
+	assert.NoError(t, wsb.UnmarshalSSZ(b))
+}
+
+func TestBlindedBellatrixSignedBeaconBlock_Version(t *testing.T) {
+	wsb, err := wrapper.WrappedSignedBlindedBeaconBlockBellatrix(&ethpb.SignedBlindedBeaconBlockBellatrix{Block: &ethpb.BlindedBeaconBlockBellatrix{}})
+	require.NoError(t, err)
+
+	assert.Equal(t, version.BellatrixBlind, wsb.Version())
+	assert.Equal(t, version.BellatrixBlind, wsb.Block().Version())
+}
+
+func TestBlindedBellatrixBeaconBlock_HashTreeRoot(t *testing.T) {
+	wb, err := wrapper.WrappedBlindedBeaconBlockBellatrix(util.HydrateBlindedBeaconBlockBellatrix(&ethpb.BlindedBeaconBlockBellatrix{}))
+	require.NoError(t, err)
+
+	rt, err := wb.HashTreeRoot()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rt)
+}
+
+func TestBlindedBellatrixBeaconBlockBody_ExecutionPayloadHeader(t *testing.T) {
+	header := &ethpb.ExecutionPayloadHeader{
+		BlockNumber: 100,
+	}
+	body := &ethpb.BlindedBeaconBlockBodyBellatrix{ExecutionPayloadHeader: header}
+	wbb, err := wrapper.WrappedBlindedBeaconBlockBodyBellatrix(body)
+	require.NoError(t, err)
+
+	got, err := wbb.ExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.DeepEqual(t, header, got)
+
+	_, err = wbb.ExecutionPayload()
+	assert.ErrorContains(t, "ExecutionPayload is not supported in", err)
+}
+
+func TestBlindedBellatrixBeaconBlock_PbGenericBlock(t *testing.T) {
+	abb := &ethpb.SignedBlindedBeaconBlockBellatrix{
+		Block: util.HydrateBlindedBeaconBlockBellatrix(&ethpb.BlindedBeaconBlockBellatrix{}),
+	}
+	wsb, err := wrapper.WrappedSignedBeaconBlock(abb)
+	require.NoError(t, err)
+
+	got, err := wsb.PbGenericBlock()
+	require.NoError(t, err)
+	assert.Equal(t, abb, got.GetBlindedBellatrix())
+}
+
+func TestBlindedBellatrixBeaconBlock_AsSignRequestObject(t *testing.T) {
+	abb := util.HydrateBlindedBeaconBlockBellatrix(&ethpb.BlindedBeaconBlockBellatrix{})
+	wsb, err := wrapper.WrappedBeaconBlock(abb)
+	require.NoError(t, err)
+
+	sro := wsb.AsSignRequestObject()
+	got, ok := sro.(*validatorpb.SignRequest_BlindedBlockV3)
+	require.Equal(t, true, ok, "Not a SignRequest_BlindedBlockV3")
+	assert.Equal(t, abb, got.BlindedBlockV3)
+}
+
+func TestBuildSignedBeaconBlock_BlindedBellatrix(t *testing.T) {
+	sig := bytesutil.PadTo([]byte("sig"), 96)
+	blk, err := wrapper.WrappedBeaconBlock(util.HydrateBlindedBeaconBlockBellatrix(&ethpb.BlindedBeaconBlockBellatrix{Slot: 5}))
+	require.NoError(t, err)
+
+	sb, err := wrapper.BuildSignedBeaconBlock(blk, sig)
+	require.NoError(t, err)
+	assert.Equal(t, version.BellatrixBlind, sb.Version())
+	assert.DeepEqual(t, sig, sb.Signature())
+	assert.Equal(t, types.Slot(5), sb.Block().Slot())
+}
@@ -105,6 +105,11 @@ func (Phase0SignedBeaconBlock) PbBellatrixBlock() (*eth.SignedBeaconBlockBellatr
 	return nil, errors.New("unsupported bellatrix block")
 }
 
+// PbBlindedBellatrixBlock is a stub.
+func (Phase0SignedBeaconBlock) PbBlindedBellatrixBlock() (*eth.SignedBlindedBeaconBlockBellatrix, error) {
+	return nil, errors.New("unsupported blinded bellatrix block")
+}
+
 // Version of the underlying protobuf object.
 func (Phase0SignedBeaconBlock) Version() int {
 	return version.Phase0
@@ -297,3 +302,8 @@ func (w Phase0BeaconBlockBody) Proto() proto.Message {
 func (Phase0BeaconBlockBody) ExecutionPayload() (*enginev1.ExecutionPayload, error) {
 	return nil, errors.New("ExecutionPayload is not supported in phase 0 block body")
 }
+
+// ExecutionPayloadHeader is a stub.
+func (Phase0BeaconBlockBody) ExecutionPayloadHeader() (*eth.ExecutionPayloadHeader, error) {
+	return nil, errors.New("ExecutionPayloadHeader is not supported in phase 0 block body")
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// NewLightClientBootstrap creates a light client bootstrap with a header at the given slot and
+// zeroed sync committee and branch.
+func NewLightClientBootstrap(slot types.Slot) *ethpb.LightClientBootstrap {
+	return &ethpb.LightClientBootstrap{
+		Header:                     lightClientHeader(slot),
+		CurrentSyncCommittee:       lightClientSyncCommittee(),
+		CurrentSyncCommitteeBranch: lightClientBranch(5),
+	}
+}
+
+// NewLightClientUpdate creates a light client update attesting a header at the given slot, signed
+// in the next slot and finalizing a header at genesis.
+func NewLightClientUpdate(attestedSlot types.Slot) *ethpb.LightClientUpdate {
+	return &ethpb.LightClientUpdate{
+		AttestedHeader:          lightClientHeader(attestedSlot),
+		NextSyncCommittee:       lightClientSyncCommittee(),
+		NextSyncCommitteeBranch: lightClientBranch(5),
+		FinalizedHeader:         lightClientHeader(0),
+		FinalityBranch:          lightClientBranch(6),
+		SyncAggregate:           lightClientSyncAggregate(),
+		SignatureSlot:           attestedSlot + 1,
+	}
+}
+
+// NewLightClientFinalityUpdate creates a light client finality update attesting a header at the
+// given slot, signed in the next slot and finalizing a header at the given finalized slot.
+func NewLightClientFinalityUpdate(attestedSlot, finalizedSlot types.Slot) *ethpb.LightClientFinalityUpdate {
+	return &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  lightClientHeader(attestedSlot),
+		FinalizedHeader: lightClientHeader(finalizedSlot),
+		FinalityBranch:  lightClientBranch(6),
+		SyncAggregate:   lightClientSyncAggregate(),
+		SignatureSlot:   attestedSlot + 1,
+	}
+}
+
+// NewLightClientOptimisticUpdate creates a light client optimistic update attesting a header at the
+// given slot, signed in the next slot.
+func NewLightClientOptimisticUpdate(attestedSlot types.Slot) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: lightClientHeader(attestedSlot),
+		SyncAggregate:  lightClientSyncAggregate(),
+		SignatureSlot:  attestedSlot + 1,
+	}
+}
+
+// lightClientHeader returns a header at the given slot, with a state root derived from the slot so
+// that headers at different slots differ.
+func lightClientHeader(slot types.Slot) *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  bytesutil.PadTo([]byte{byte(slot)}, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+}
+
+func lightClientSyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)}
+}
+
+func lightClientBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+func lightClientSyncAggregate() *ethpb.SyncAggregate {
+	return &ethpb.SyncAggregate{
+		SyncCommitteeBits:      bitfield.NewBitvector512(),
+		SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
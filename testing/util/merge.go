@@ -6,3 +6,8 @@ import ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 func NewBeaconBlockBellatrix() *ethpb.SignedBeaconBlockBellatrix {
 	return HydrateSignedBeaconBlockBellatrix(&ethpb.SignedBeaconBlockBellatrix{})
 }
+
+// NewBlindedBeaconBlockBellatrix creates a blinded beacon block with minimum marshalable fields.
+func NewBlindedBeaconBlockBellatrix() *ethpb.SignedBlindedBeaconBlockBellatrix {
+	return HydrateSignedBlindedBeaconBlockBellatrix(&ethpb.SignedBlindedBeaconBlockBellatrix{})
+}
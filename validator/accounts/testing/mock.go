@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	iface2 "github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 )
 
 // Wallet contains an in-memory, simulated wallet implementation.
@@ -80,7 +81,8 @@ func (_ *Wallet) InitializeKeymanager(_ context.Context, _ iface.InitKeymanagerC
 }
 
 type MockValidator struct {
-	Km keymanager.IKeymanager
+	Km                  keymanager.IKeymanager
+	ProposerSettingsRet *proposer.Settings
 }
 
 func (_ MockValidator) Done() {
@@ -178,3 +180,16 @@ func (_ MockValidator) HandleKeyReload(_ context.Context, _ [][48]byte) (bool, e
 func (_ MockValidator) CheckDoppelGanger(_ context.Context) error {
 	panic("implement me")
 }
+
+func (_ MockValidator) PushProposerSettings(_ context.Context, _ keymanager.IKeymanager) error {
+	panic("implement me")
+}
+
+func (_ MockValidator) SubmitValidatorRegistrations(_ context.Context, _ keymanager.IKeymanager) error {
+	panic("implement me")
+}
+
+// ProposerSettings for mocking
+func (m MockValidator) ProposerSettings() *proposer.Settings {
+	return m.ProposerSettingsRet
+}
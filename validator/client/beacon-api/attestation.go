@@ -0,0 +1,146 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	attestationDataPath              = "/eth/v1/validator/attestation_data?slot=%d&committee_index=%d"
+	postAttestationsPath             = "/eth/v1/beacon/pool/attestations"
+	aggregateAttestationPath         = "/eth/v1/validator/aggregate_attestation?attestation_data_root=%#x&slot=%d"
+	postAggregateAndProofsPath       = "/eth/v1/validator/aggregate_and_proofs"
+	beaconCommitteeSubscriptionsPath = "/eth/v1/validator/beacon_committee_subscriptions"
+)
+
+// GetAttestationData requests the data to attest to for the given slot and committee.
+func (c *beaconApiValidatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	resp := &apimiddleware.ProduceAttestationDataResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, fmt.Sprintf(attestationDataPath, in.Slot, in.CommitteeIndex), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get attestation data")
+	}
+	data := &ethpbv1.AttestationData{}
+	if err := jsonToProto(resp.Data, data); err != nil {
+		return nil, err
+	}
+	return migration.V1AttDataToV1Alpha1(data), nil
+}
+
+// ProposeAttestation submits a signed attestation to the operation pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	if in == nil || in.Data == nil {
+		return nil, errors.New("attestation is nil")
+	}
+	root, err := in.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	req := &apimiddleware.SubmitAttestationRequestJson{}
+	if err := protoToJson(&ethpbv1.SubmitAttestationsRequest{Data: []*ethpbv1.Attestation{migration.V1Alpha1AttestationToV1(in)}}, req); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(req.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal attestations")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, postAttestationsPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit attestation")
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof returns the best aggregate attestation known by the beacon node for the
+// given slot and committee, wrapped in an aggregate and proof of the requesting validator.
+func (c *beaconApiValidatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	idx, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: in.Slot, CommitteeIndex: in.CommitteeIndex})
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	resp := &apimiddleware.AggregateAttestationResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, fmt.Sprintf(aggregateAttestationPath, root, in.Slot), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get aggregate attestation")
+	}
+	aggregate := &ethpbv1.Attestation{}
+	if err := jsonToProto(resp.Data, aggregate); err != nil {
+		return nil, err
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: idx.Index,
+			Aggregate:       migration.V1AttToV1Alpha1(aggregate),
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof publishes a signed aggregate and proof through the beacon node.
+func (c *beaconApiValidatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	agg := in.SignedAggregateAndProof
+	if agg == nil || agg.Message == nil || agg.Message.Aggregate == nil || agg.Message.Aggregate.Data == nil {
+		return nil, errors.New("signed aggregate and proof is nil")
+	}
+	root, err := agg.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	v1Agg := &ethpbv1.SignedAggregateAttestationAndProof{
+		Message:   migration.V1Alpha1AggregateAttAndProofToV1(agg.Message),
+		Signature: agg.Signature,
+	}
+	req := &apimiddleware.SubmitAggregateAndProofsRequestJson{}
+	if err := protoToJson(&ethpbv1.SubmitAggregateAndProofsRequest{Data: []*ethpbv1.SignedAggregateAttestationAndProof{v1Agg}}, req); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(req.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal aggregate and proofs")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, postAggregateAndProofsPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit aggregate and proof")
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubscribeCommitteeSubnets subscribes the beacon node to the attestation subnets of the given committees.
+func (c *beaconApiValidatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if len(in.Slots) != len(in.CommitteeIds) || len(in.Slots) != len(in.IsAggregator) ||
+		len(in.Slots) != len(in.ValidatorIndices) || len(in.Slots) != len(in.CommitteesAtSlot) {
+		return nil, errors.New("subscription request fields have different lengths")
+	}
+	subs := make([]*apimiddleware.BeaconCommitteeSubscribeJson, len(in.Slots))
+	for i := range in.Slots {
+		subs[i] = &apimiddleware.BeaconCommitteeSubscribeJson{
+			ValidatorIndex:   strconv.FormatUint(uint64(in.ValidatorIndices[i]), 10),
+			CommitteeIndex:   strconv.FormatUint(uint64(in.CommitteeIds[i]), 10),
+			CommitteesAtSlot: strconv.FormatUint(in.CommitteesAtSlot[i], 10),
+			Slot:             strconv.FormatUint(uint64(in.Slots[i]), 10),
+			IsAggregator:     in.IsAggregator[i],
+		}
+	}
+	body, err := json.Marshal(subs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal committee subscriptions")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, beaconCommitteeSubscriptionsPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to committee subnets")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,95 @@
+package beacon_api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// beaconApiValidatorClient implements ethpb.BeaconNodeValidatorClient on top of the beacon node REST API.
+type beaconApiValidatorClient struct {
+	// Methods without an equivalent in the beacon API, such as StreamDuties, fall through to
+	// a gRPC client which always returns an Unimplemented error.
+	ethpb.BeaconNodeValidatorClient
+	jsonRestHandler jsonRestHandler
+
+	genesisLock sync.Mutex
+	genesis     *ethpb.ChainStartResponse
+}
+
+// NewValidatorClient returns a validator client which talks to the beacon node REST API through the given client.
+func NewValidatorClient(client *beacon.Client) ethpb.BeaconNodeValidatorClient {
+	return &beaconApiValidatorClient{
+		BeaconNodeValidatorClient: ethpb.NewBeaconNodeValidatorClient(unsupportedConn{}),
+		jsonRestHandler:           jsonRestHandler{client: client},
+	}
+}
+
+// unsupportedConn is a gRPC connection on which every call fails with an Unimplemented error.
+// It backs the client methods which cannot be implemented with the beacon API.
+type unsupportedConn struct{}
+
+// Invoke returns an Unimplemented error.
+func (unsupportedConn) Invoke(_ context.Context, method string, _, _ interface{}, _ ...grpc.CallOption) error {
+	return status.Errorf(codes.Unimplemented, "%s is not supported by the beacon API", method)
+}
+
+// NewStream returns an Unimplemented error.
+func (unsupportedConn) NewStream(_ context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "%s is not supported by the beacon API", method)
+}
+
+// restStream implements the grpc.ClientStream methods for the streams which the beacon API client
+// emulates by polling the beacon node.
+type restStream struct {
+	ctx context.Context
+}
+
+// Header is not supported for REST streams.
+func (*restStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+// Trailer is not supported for REST streams.
+func (*restStream) Trailer() metadata.MD {
+	return nil
+}
+
+// CloseSend is a no-op for REST streams.
+func (*restStream) CloseSend() error {
+	return nil
+}
+
+// Context returns the context of the stream.
+func (s *restStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg is not supported for REST streams.
+func (*restStream) SendMsg(_ interface{}) error {
+	return status.Error(codes.Unimplemented, "SendMsg is not supported by the beacon API")
+}
+
+// RecvMsg is not supported for REST streams, use the typed Recv method instead.
+func (*restStream) RecvMsg(_ interface{}) error {
+	return status.Error(codes.Unimplemented, "RecvMsg is not supported by the beacon API")
+}
+
+// wait blocks for the given duration or until the stream context is done.
+func (s *restStream) wait(d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package beacon_api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const getFinalityCheckpointsPath = "/eth/v1/beacon/states/head/finality_checkpoints"
+
+// beaconApiBeaconChainClient implements the methods of ethpb.BeaconChainClient used by the validator
+// on top of the beacon node REST API.
+type beaconApiBeaconChainClient struct {
+	// Methods without an equivalent in the beacon API fall through to a gRPC client which always
+	// returns an Unimplemented error.
+	ethpb.BeaconChainClient
+	jsonRestHandler jsonRestHandler
+}
+
+// NewBeaconChainClient returns a beacon chain client which talks to the beacon node REST API through the given client.
+func NewBeaconChainClient(client *beacon.Client) ethpb.BeaconChainClient {
+	return &beaconApiBeaconChainClient{
+		BeaconChainClient: ethpb.NewBeaconChainClient(unsupportedConn{}),
+		jsonRestHandler:   jsonRestHandler{client: client},
+	}
+}
+
+// GetChainHead returns the head of the beacon node along with its finalized and justified checkpoints.
+func (c *beaconApiBeaconChainClient) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	headerJson := &apimiddleware.BlockHeaderResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, getHeadHeaderPath, headerJson); err != nil {
+		return nil, errors.Wrap(err, "could not get head block header")
+	}
+	headerResp := &ethpbv1.BlockHeaderResponse{}
+	if err := jsonToProto(headerJson, headerResp); err != nil {
+		return nil, err
+	}
+	if headerResp.Data == nil || headerResp.Data.Header == nil || headerResp.Data.Header.Message == nil {
+		return nil, errors.New("head block header is nil")
+	}
+	headSlot := headerResp.Data.Header.Message.Slot
+
+	checkpointsJson := &apimiddleware.StateFinalityCheckpointResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, getFinalityCheckpointsPath, checkpointsJson); err != nil {
+		return nil, errors.Wrap(err, "could not get finality checkpoints")
+	}
+	checkpointsResp := &ethpbv1.StateFinalityCheckpointResponse{}
+	if err := jsonToProto(checkpointsJson, checkpointsResp); err != nil {
+		return nil, err
+	}
+	checkpoints := checkpointsResp.Data
+	if checkpoints == nil || checkpoints.Finalized == nil || checkpoints.CurrentJustified == nil || checkpoints.PreviousJustified == nil {
+		return nil, errors.New("finality checkpoints are nil")
+	}
+
+	head := &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  slots.ToEpoch(headSlot),
+		HeadBlockRoot:              headerResp.Data.Root,
+		FinalizedEpoch:             checkpoints.Finalized.Epoch,
+		FinalizedBlockRoot:         checkpoints.Finalized.Root,
+		JustifiedEpoch:             checkpoints.CurrentJustified.Epoch,
+		JustifiedBlockRoot:         checkpoints.CurrentJustified.Root,
+		PreviousJustifiedEpoch:     checkpoints.PreviousJustified.Epoch,
+		PreviousJustifiedBlockRoot: checkpoints.PreviousJustified.Root,
+	}
+	var err error
+	if head.FinalizedSlot, err = slots.EpochStart(head.FinalizedEpoch); err != nil {
+		return nil, err
+	}
+	if head.JustifiedSlot, err = slots.EpochStart(head.JustifiedEpoch); err != nil {
+		return nil, err
+	}
+	if head.PreviousJustifiedSlot, err = slots.EpochStart(head.PreviousJustifiedEpoch); err != nil {
+		return nil, err
+	}
+	return head, nil
+}
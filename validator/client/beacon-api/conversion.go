@@ -0,0 +1,51 @@
+package beacon_api
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	gatewayMiddleware "github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// protoToJson fills an apimiddleware JSON container from a message of the Ethereum API protos, the same
+// way the API middleware of the beacon node turns gRPC gateway responses into beacon API responses.
+func protoToJson(m proto.Message, container interface{}) error {
+	b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal protobuf message")
+	}
+	if err := json.Unmarshal(b, container); err != nil {
+		return errors.Wrap(err, "could not unmarshal into JSON container")
+	}
+	if errJson := gatewayMiddleware.ProcessMiddlewareResponseFields(container); errJson != nil {
+		return errors.New(errJson.Msg())
+	}
+	return nil
+}
+
+// jsonToProto decodes an apimiddleware JSON container received from the beacon API into a message of the
+// Ethereum API protos, the same way the API middleware of the beacon node prepares requests for the gRPC gateway.
+func jsonToProto(container interface{}, m proto.Message) error {
+	if errJson := gatewayMiddleware.ProcessRequestContainerFields(container); errJson != nil {
+		return errors.New(errJson.Msg())
+	}
+	b, err := json.Marshal(container)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal JSON container")
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, m); err != nil {
+		return errors.Wrapf(err, "could not unmarshal %s", m.ProtoReflect().Descriptor().FullName())
+	}
+	return nil
+}
+
+// decodeJson decodes the JSON of a consensus object into its apimiddleware container, and then into the
+// corresponding message of the Ethereum API protos.
+func decodeJson(data json.RawMessage, container interface{}, m proto.Message) error {
+	if err := json.Unmarshal(data, container); err != nil {
+		return errors.Wrap(err, "could not decode JSON")
+	}
+	return jsonToProto(container, m)
+}
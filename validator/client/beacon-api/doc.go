@@ -0,0 +1,10 @@
+/*
+Package beacon_api implements the clients the validator uses to talk to a beacon node on top of
+the standard beacon node REST API (https://ethereum.github.io/beacon-APIs/), instead of Prysm's
+gRPC API. This allows Prysm validators to run against any beacon node implementation.
+
+The clients satisfy the same v1alpha1 gRPC client interfaces used by the validator, translating
+each call into one or more requests to the /eth/v1 and /eth/v2 endpoints. Methods that have no
+equivalent in the standard API return an Unimplemented error.
+*/
+package beacon_api
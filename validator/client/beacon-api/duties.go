@@ -0,0 +1,274 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/grpc"
+)
+
+const (
+	attesterDutiesPath             = "/eth/v1/validator/duties/attester/%d"
+	proposerDutiesPath             = "/eth/v1/validator/duties/proposer/%d"
+	syncDutiesPath                 = "/eth/v1/validator/duties/sync/%d"
+	stateCommitteesPath            = "/eth/v1/beacon/states/head/committees?epoch=%d"
+	syncCommitteeSubscriptionsPath = "/eth/v1/validator/sync_committee_subscriptions"
+)
+
+// GetDuties returns the duties of the given validators for the requested epoch and the epoch after it.
+// As the gRPC server does, it also subscribes the beacon node to the sync committee subnets of the
+// validators which are part of the current sync committee.
+func (c *beaconApiValidatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	vals, err := c.getValidatorsByPubkey(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]types.ValidatorIndex, 0, len(vals))
+	for _, pk := range in.PublicKeys {
+		if v, ok := vals[bytesutil.ToBytes48(pk)]; ok {
+			indices = append(indices, v.index)
+		}
+	}
+
+	currentDuties, err := c.epochDuties(ctx, in.Epoch, indices, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties for epoch %d", in.Epoch)
+	}
+	nextDuties, err := c.epochDuties(ctx, in.Epoch+1, indices, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties for epoch %d", in.Epoch+1)
+	}
+	if err := c.subscribeToSyncCommittees(ctx, in.Epoch, currentDuties.syncCommitteeIndices); err != nil {
+		return nil, err
+	}
+
+	current := make([]*ethpb.DutiesResponse_Duty, len(in.PublicKeys))
+	next := make([]*ethpb.DutiesResponse_Duty, len(in.PublicKeys))
+	for i, pk := range in.PublicKeys {
+		current[i] = &ethpb.DutiesResponse_Duty{PublicKey: pk}
+		next[i] = &ethpb.DutiesResponse_Duty{PublicKey: pk}
+		v, ok := vals[bytesutil.ToBytes48(pk)]
+		if !ok {
+			current[i].Status = ethpb.ValidatorStatus_UNKNOWN_STATUS
+			next[i].Status = ethpb.ValidatorStatus_UNKNOWN_STATUS
+			continue
+		}
+		currentDuties.fill(current[i], v)
+		nextDuties.fill(next[i], v)
+	}
+	return &ethpb.DutiesResponse{
+		Duties:             current,
+		CurrentEpochDuties: current,
+		NextEpochDuties:    next,
+	}, nil
+}
+
+// epochDuties holds the duties of a set of validators for a single epoch.
+type epochDuties struct {
+	attester             map[types.ValidatorIndex]*ethpb.DutiesResponse_Duty
+	proposerSlots        map[types.ValidatorIndex][]types.Slot
+	syncCommitteeIndices map[types.ValidatorIndex][]string
+}
+
+// fill copies the duties of validator v into duty.
+func (d *epochDuties) fill(duty *ethpb.DutiesResponse_Duty, v *stateValidator) {
+	duty.ValidatorIndex = v.index
+	duty.Status = v.status
+	duty.ProposerSlots = d.proposerSlots[v.index]
+	_, duty.IsSyncCommittee = d.syncCommitteeIndices[v.index]
+	if a, ok := d.attester[v.index]; ok {
+		duty.Committee = a.Committee
+		duty.CommitteeIndex = a.CommitteeIndex
+		duty.AttesterSlot = a.AttesterSlot
+		duty.CommitteesAtSlot = a.CommitteesAtSlot
+	}
+}
+
+func (c *beaconApiValidatorClient) epochDuties(
+	ctx context.Context,
+	epoch types.Epoch,
+	indices []types.ValidatorIndex,
+	withProposer bool,
+) (*epochDuties, error) {
+	d := &epochDuties{
+		attester:             make(map[types.ValidatorIndex]*ethpb.DutiesResponse_Duty),
+		proposerSlots:        make(map[types.ValidatorIndex][]types.Slot),
+		syncCommitteeIndices: make(map[types.ValidatorIndex][]string),
+	}
+	if len(indices) == 0 {
+		return d, nil
+	}
+	body, err := indicesJson(indices)
+	if err != nil {
+		return nil, err
+	}
+
+	attesterResp := &apimiddleware.AttesterDutiesResponseJson{}
+	if err := c.jsonRestHandler.postRestJson(ctx, fmt.Sprintf(attesterDutiesPath, epoch), nil, body, attesterResp); err != nil {
+		return nil, errors.Wrap(err, "could not get attester duties")
+	}
+	if len(attesterResp.Data) > 0 {
+		committees, err := c.committees(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range attesterResp.Data {
+			duty, err := attesterDutyFromJson(a, committees)
+			if err != nil {
+				return nil, err
+			}
+			d.attester[duty.ValidatorIndex] = duty
+		}
+	}
+
+	if withProposer {
+		proposerResp := &apimiddleware.ProposerDutiesResponseJson{}
+		if err := c.jsonRestHandler.getRestJsonResponse(ctx, fmt.Sprintf(proposerDutiesPath, epoch), proposerResp); err != nil {
+			return nil, errors.Wrap(err, "could not get proposer duties")
+		}
+		requested := make(map[types.ValidatorIndex]bool, len(indices))
+		for _, idx := range indices {
+			requested[idx] = true
+		}
+		for _, p := range proposerResp.Data {
+			idx, err := strconv.ParseUint(p.ValidatorIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid proposer index %s", p.ValidatorIndex)
+			}
+			slot, err := strconv.ParseUint(p.Slot, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid proposer slot %s", p.Slot)
+			}
+			if requested[types.ValidatorIndex(idx)] {
+				d.proposerSlots[types.ValidatorIndex(idx)] = append(d.proposerSlots[types.ValidatorIndex(idx)], types.Slot(slot))
+			}
+		}
+	}
+
+	if epoch >= params.BeaconConfig().AltairForkEpoch {
+		syncResp := &apimiddleware.SyncCommitteeDutiesResponseJson{}
+		if err := c.jsonRestHandler.postRestJson(ctx, fmt.Sprintf(syncDutiesPath, epoch), nil, body, syncResp); err != nil {
+			return nil, errors.Wrap(err, "could not get sync committee duties")
+		}
+		for _, s := range syncResp.Data {
+			idx, err := strconv.ParseUint(s.ValidatorIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid sync committee validator index %s", s.ValidatorIndex)
+			}
+			d.syncCommitteeIndices[types.ValidatorIndex(idx)] = s.ValidatorSyncCommitteeIndices
+		}
+	}
+	return d, nil
+}
+
+// committeeKey identifies a beacon committee by slot and committee index.
+type committeeKey struct {
+	slot  types.Slot
+	index types.CommitteeIndex
+}
+
+// committees returns all beacon committees of the given epoch.
+func (c *beaconApiValidatorClient) committees(ctx context.Context, epoch types.Epoch) (map[committeeKey][]types.ValidatorIndex, error) {
+	resp := &apimiddleware.StateCommitteesResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, fmt.Sprintf(stateCommitteesPath, epoch), resp); err != nil {
+		return nil, errors.Wrap(err, "could not get committees")
+	}
+	res := make(map[committeeKey][]types.ValidatorIndex, len(resp.Data))
+	for _, cm := range resp.Data {
+		slot, err := strconv.ParseUint(cm.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid committee slot %s", cm.Slot)
+		}
+		index, err := strconv.ParseUint(cm.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid committee index %s", cm.Index)
+		}
+		validators := make([]types.ValidatorIndex, len(cm.Validators))
+		for i, v := range cm.Validators {
+			idx, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid committee member %s", v)
+			}
+			validators[i] = types.ValidatorIndex(idx)
+		}
+		res[committeeKey{slot: types.Slot(slot), index: types.CommitteeIndex(index)}] = validators
+	}
+	return res, nil
+}
+
+func attesterDutyFromJson(a *apimiddleware.AttesterDutyJson, committees map[committeeKey][]types.ValidatorIndex) (*ethpb.DutiesResponse_Duty, error) {
+	idx, err := strconv.ParseUint(a.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid attester index %s", a.ValidatorIndex)
+	}
+	slot, err := strconv.ParseUint(a.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid attester slot %s", a.Slot)
+	}
+	committeeIndex, err := strconv.ParseUint(a.CommitteeIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid committee index %s", a.CommitteeIndex)
+	}
+	committeesAtSlot, err := strconv.ParseUint(a.CommitteesAtSlot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid committees at slot %s", a.CommitteesAtSlot)
+	}
+	committee, ok := committees[committeeKey{slot: types.Slot(slot), index: types.CommitteeIndex(committeeIndex)}]
+	if !ok {
+		return nil, errors.Errorf("committee %d of slot %d not found", committeeIndex, slot)
+	}
+	return &ethpb.DutiesResponse_Duty{
+		Committee:        committee,
+		CommitteeIndex:   types.CommitteeIndex(committeeIndex),
+		AttesterSlot:     types.Slot(slot),
+		ValidatorIndex:   types.ValidatorIndex(idx),
+		CommitteesAtSlot: committeesAtSlot,
+	}, nil
+}
+
+// subscribeToSyncCommittees subscribes the beacon node to the sync committee subnets of the given
+// validators until the end of the current sync committee period.
+func (c *beaconApiValidatorClient) subscribeToSyncCommittees(
+	ctx context.Context,
+	epoch types.Epoch,
+	syncCommitteeIndices map[types.ValidatorIndex][]string,
+) error {
+	if len(syncCommitteeIndices) == 0 {
+		return nil
+	}
+	untilEpoch := (types.Epoch(slots.SyncCommitteePeriod(epoch)) + 1) * params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	subs := make([]*apimiddleware.SyncCommitteeSubscriptionJson, 0, len(syncCommitteeIndices))
+	for idx, committeeIndices := range syncCommitteeIndices {
+		subs = append(subs, &apimiddleware.SyncCommitteeSubscriptionJson{
+			ValidatorIndex:       strconv.FormatUint(uint64(idx), 10),
+			SyncCommitteeIndices: committeeIndices,
+			UntilEpoch:           strconv.FormatUint(uint64(untilEpoch), 10),
+		})
+	}
+	body, err := json.Marshal(subs)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal sync committee subscriptions")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, syncCommitteeSubscriptionsPath, nil, body, nil); err != nil {
+		return errors.Wrap(err, "could not subscribe to sync committee subnets")
+	}
+	return nil
+}
+
+// indicesJson encodes validator indices as a JSON array of strings.
+func indicesJson(indices []types.ValidatorIndex) ([]byte, error) {
+	ids := make([]string, len(indices))
+	for i, idx := range indices {
+		ids[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	return json.Marshal(ids)
+}
@@ -0,0 +1,126 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestGetDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[` +
+			stateValidatorJson(1, testPubkey1, "active_ongoing", 0) + `,` +
+			stateValidatorJson(2, testPubkey2, "active_ongoing", 0) + `]}`,
+		"POST " + fmt.Sprintf(attesterDutiesPath, 5): `{"dependent_root":"0x01","data":[
+  {"pubkey":"0x01","validator_index":"1","committee_index":"0","committee_length":"2","committees_at_slot":"3","validator_committee_index":"0","slot":"161"},
+  {"pubkey":"0x02","validator_index":"2","committee_index":"1","committee_length":"2","committees_at_slot":"3","validator_committee_index":"1","slot":"163"}]}`,
+		"POST " + fmt.Sprintf(attesterDutiesPath, 6): `{"dependent_root":"0x01","data":[
+  {"pubkey":"0x01","validator_index":"1","committee_index":"2","committee_length":"2","committees_at_slot":"4","validator_committee_index":"1","slot":"200"}]}`,
+		"GET /eth/v1/beacon/states/head/committees": `{"data":[
+  {"index":"0","slot":"161","validators":["1","9"]},
+  {"index":"1","slot":"163","validators":["8","2"]},
+  {"index":"2","slot":"200","validators":["7","1"]}]}`,
+		"GET " + fmt.Sprintf(proposerDutiesPath, 5): `{"dependent_root":"0x01","data":[
+  {"pubkey":"0x01","validator_index":"1","slot":"162"},
+  {"pubkey":"0x05","validator_index":"5","slot":"164"}]}`,
+		"POST " + fmt.Sprintf(syncDutiesPath, 5): `{"data":[{"pubkey":"0x02","validator_index":"2","validator_sync_committee_indices":["3","140"]}]}`,
+		"POST " + fmt.Sprintf(syncDutiesPath, 6): `{"data":[]}`,
+		"POST " + syncCommitteeSubscriptionsPath: ``,
+	})
+
+	resp, err := m.validatorClient().GetDuties(context.Background(), &ethpb.DutiesRequest{
+		Epoch:      5,
+		PublicKeys: [][]byte{testPubkey1, testPubkey2, testPubkey3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.CurrentEpochDuties))
+	require.Equal(t, 3, len(resp.NextEpochDuties))
+
+	want := []*ethpb.DutiesResponse_Duty{
+		{
+			Committee:        []types.ValidatorIndex{1, 9},
+			CommitteeIndex:   0,
+			AttesterSlot:     161,
+			ProposerSlots:    []types.Slot{162},
+			PublicKey:        testPubkey1,
+			Status:           ethpb.ValidatorStatus_ACTIVE,
+			ValidatorIndex:   1,
+			CommitteesAtSlot: 3,
+		},
+		{
+			Committee:        []types.ValidatorIndex{8, 2},
+			CommitteeIndex:   1,
+			AttesterSlot:     163,
+			PublicKey:        testPubkey2,
+			Status:           ethpb.ValidatorStatus_ACTIVE,
+			ValidatorIndex:   2,
+			IsSyncCommittee:  true,
+			CommitteesAtSlot: 3,
+		},
+		{
+			PublicKey: testPubkey3,
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		},
+	}
+	assert.DeepEqual(t, want, resp.CurrentEpochDuties)
+
+	next := resp.NextEpochDuties[0]
+	assert.DeepEqual(t, []types.ValidatorIndex{7, 1}, next.Committee)
+	assert.Equal(t, types.Slot(200), next.AttesterSlot)
+	assert.Equal(t, uint64(4), next.CommitteesAtSlot)
+	assert.Equal(t, 0, len(next.ProposerSlots))
+	assert.Equal(t, false, resp.NextEpochDuties[1].IsSyncCommittee)
+
+	// Only the indices of known validators are requested.
+	var requested []string
+	require.NoError(t, json.Unmarshal(m.body("POST "+fmt.Sprintf(attesterDutiesPath, 5)), &requested))
+	assert.DeepEqual(t, []string{"1", "2"}, requested)
+
+	// The beacon node is subscribed to the sync committee subnets until the end of the period.
+	var subs []*apimiddleware.SyncCommitteeSubscriptionJson
+	require.NoError(t, json.Unmarshal(m.body("POST "+syncCommitteeSubscriptionsPath), &subs))
+	require.Equal(t, 1, len(subs))
+	assert.DeepEqual(t, &apimiddleware.SyncCommitteeSubscriptionJson{
+		ValidatorIndex:       "2",
+		SyncCommitteeIndices: []string{"3", "140"},
+		UntilEpoch:           fmt.Sprintf("%d", params.BeaconConfig().EpochsPerSyncCommitteePeriod),
+	}, subs[0])
+}
+
+func TestGetDuties_NoKnownValidators(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[]}`,
+	})
+	resp, err := m.validatorClient().GetDuties(context.Background(), &ethpb.DutiesRequest{
+		Epoch:      1,
+		PublicKeys: [][]byte{testPubkey1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.CurrentEpochDuties))
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.CurrentEpochDuties[0].Status)
+	assert.Equal(t, 0, m.hitCount("POST "+fmt.Sprintf(attesterDutiesPath, 1)))
+}
+
+func TestGetDuties_MissingCommittee(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[` + stateValidatorJson(1, testPubkey1, "active_ongoing", 0) + `]}`,
+		"POST " + fmt.Sprintf(attesterDutiesPath, 0): `{"data":[
+  {"pubkey":"0x01","validator_index":"1","committee_index":"0","committee_length":"2","committees_at_slot":"1","validator_committee_index":"0","slot":"3"}]}`,
+		"GET /eth/v1/beacon/states/head/committees": `{"data":[]}`,
+	})
+	_, err := m.validatorClient().GetDuties(context.Background(), &ethpb.DutiesRequest{PublicKeys: [][]byte{testPubkey1}})
+	require.ErrorContains(t, "committee 0 of slot 3 not found", err)
+}
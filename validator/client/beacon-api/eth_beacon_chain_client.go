@@ -0,0 +1,64 @@
+package beacon_api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"google.golang.org/grpc"
+)
+
+// beaconApiEthBeaconChainClient implements the methods of ethpbservice.BeaconChainClient used by the
+// validator on top of the beacon node REST API.
+type beaconApiEthBeaconChainClient struct {
+	// The validator only relies on the state validators endpoint of this service, other methods fall
+	// through to a gRPC client which always returns an Unimplemented error.
+	ethpbservice.BeaconChainClient
+	jsonRestHandler jsonRestHandler
+}
+
+// NewEthBeaconChainClient returns an Ethereum beacon chain API client which talks to the beacon node REST API through the given client.
+func NewEthBeaconChainClient(client *beacon.Client) ethpbservice.BeaconChainClient {
+	return &beaconApiEthBeaconChainClient{
+		BeaconChainClient: ethpbservice.NewBeaconChainClient(unsupportedConn{}),
+		jsonRestHandler:   jsonRestHandler{client: client},
+	}
+}
+
+// ListValidators returns the validators of the head state matching the given ids, which are either public keys
+// or validator indices. Validators which do not exist in the state are omitted.
+func (c *beaconApiEthBeaconChainClient) ListValidators(ctx context.Context, in *ethpbv1.StateValidatorsRequest, _ ...grpc.CallOption) (*ethpbv1.StateValidatorsResponse, error) {
+	if string(in.StateId) != "head" {
+		return nil, errors.Errorf("unsupported state id %s, only the head state is supported", in.StateId)
+	}
+	if len(in.Status) > 0 {
+		return nil, errors.New("filtering validators by status is not supported")
+	}
+	ids := make([]string, len(in.Id))
+	for i, id := range in.Id {
+		if len(id) == fieldparams.BLSPubkeyLength {
+			ids[i] = hexutil.Encode(id)
+		} else {
+			ids[i] = string(id)
+		}
+	}
+	containers, err := getStateValidatorsJson(ctx, c.jsonRestHandler, ids)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpbv1.StateValidatorsResponse{Data: make([]*ethpbv1.ValidatorContainer, len(containers))}
+	for i, v := range containers {
+		// Statuses are lowercase in the beacon API and uppercase in the protobuf enum.
+		v.Status = strings.ToUpper(v.Status)
+		resp.Data[i] = &ethpbv1.ValidatorContainer{}
+		if err := jsonToProto(v, resp.Data[i]); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
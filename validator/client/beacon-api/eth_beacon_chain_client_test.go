@@ -0,0 +1,57 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListValidators(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[` + stateValidatorJson(7, testPubkey1, "active_ongoing", 0) + `]}`,
+	})
+	c := NewEthBeaconChainClient(m.client())
+
+	resp, err := c.ListValidators(context.Background(), &ethpbv1.StateValidatorsRequest{
+		StateId: []byte("head"),
+		Id:      [][]byte{testPubkey1, testPubkey2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, types.ValidatorIndex(7), resp.Data[0].Index)
+	assert.Equal(t, ethpbv1.ValidatorStatus_ACTIVE_ONGOING, resp.Data[0].Status)
+	assert.DeepEqual(t, testPubkey1, resp.Data[0].Validator.Pubkey)
+
+	_, err = c.ListValidators(context.Background(), &ethpbv1.StateValidatorsRequest{StateId: []byte("finalized")})
+	require.ErrorContains(t, "unsupported state id", err)
+}
+
+func TestGetLiveness(t *testing.T) {
+	route := "POST " + fmt.Sprintf(livenessPath, 3)
+	m := newMockBeaconNode(t, map[string]string{
+		route: `{"data":[{"index":"1","epoch":"3","is_live":true},{"index":"2","epoch":"3","is_live":false}]}`,
+	})
+	c := NewEthValidatorClient(m.client())
+	req := &ethpbv1.GetLivenessRequest{Epoch: 3, Index: []types.ValidatorIndex{1, 2}}
+
+	resp, err := c.GetLiveness(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpbv1.GetLivenessResponse_Liveness{
+		{Index: 1, Epoch: 3, IsLive: true},
+		{Index: 2, Epoch: 3, IsLive: false},
+	}, resp.Data)
+	assert.Equal(t, `["1","2"]`, string(m.body(route)))
+
+	// The beacon node does not know the liveness of the epoch yet.
+	m.setResponse(route, http.StatusServiceUnavailable, `{"code":503,"message":"Could not determine liveness"}`)
+	_, err = c.GetLiveness(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
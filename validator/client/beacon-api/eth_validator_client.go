@@ -0,0 +1,69 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const livenessPath = "/eth/v1/validator/liveness/%d"
+
+// beaconApiEthValidatorClient implements the methods of ethpbservice.BeaconValidatorClient used by the
+// validator on top of the beacon node REST API.
+type beaconApiEthValidatorClient struct {
+	// The validator only relies on the liveness endpoint of this service, other methods fall through
+	// to a gRPC client which always returns an Unimplemented error.
+	ethpbservice.BeaconValidatorClient
+	jsonRestHandler jsonRestHandler
+}
+
+// NewEthValidatorClient returns an Ethereum validator API client which talks to the beacon node REST API through the given client.
+func NewEthValidatorClient(client *beacon.Client) ethpbservice.BeaconValidatorClient {
+	return &beaconApiEthValidatorClient{
+		BeaconValidatorClient: ethpbservice.NewBeaconValidatorClient(unsupportedConn{}),
+		jsonRestHandler:       jsonRestHandler{client: client},
+	}
+}
+
+// GetLiveness returns whether the given validators were seen participating in the network in the requested epoch.
+func (c *beaconApiEthValidatorClient) GetLiveness(ctx context.Context, in *ethpbv1.GetLivenessRequest, _ ...grpc.CallOption) (*ethpbv1.GetLivenessResponse, error) {
+	body, err := indicesJson(in.Index)
+	if err != nil {
+		return nil, err
+	}
+	resp := &apimiddleware.LivenessResponseJson{}
+	if err := c.jsonRestHandler.postRestJson(ctx, fmt.Sprintf(livenessPath, in.Epoch), nil, body, resp); err != nil {
+		if errors.Is(err, beacon.ErrUnavailable) {
+			// The beacon node has not followed the chain for long enough to know the liveness of the epoch.
+			return nil, status.Errorf(codes.Unavailable, "could not get liveness: %v", err)
+		}
+		return nil, errors.Wrap(err, "could not get liveness")
+	}
+	res := &ethpbv1.GetLivenessResponse{Data: make([]*ethpbv1.GetLivenessResponse_Liveness, len(resp.Data))}
+	for i, l := range resp.Data {
+		idx, err := strconv.ParseUint(l.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator index %s", l.Index)
+		}
+		epoch, err := strconv.ParseUint(l.Epoch, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid epoch %s", l.Epoch)
+		}
+		res.Data[i] = &ethpbv1.GetLivenessResponse_Liveness{
+			Index:  types.ValidatorIndex(idx),
+			Epoch:  types.Epoch(epoch),
+			IsLive: l.IsLive,
+		}
+	}
+	return res, nil
+}
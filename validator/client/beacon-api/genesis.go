@@ -0,0 +1,108 @@
+package beacon_api
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const getGenesisPath = "/eth/v1/beacon/genesis"
+
+// How often the beacon node is polled while waiting for the chain to start.
+var chainStartPollInterval = 5 * time.Second
+
+// getGenesis returns the genesis of the chain, which is only requested once from the beacon node.
+// If the chain has not started yet, the returned error wraps beacon.ErrNotFound.
+func (c *beaconApiValidatorClient) getGenesis(ctx context.Context) (*ethpb.ChainStartResponse, error) {
+	c.genesisLock.Lock()
+	defer c.genesisLock.Unlock()
+	if c.genesis != nil {
+		return c.genesis, nil
+	}
+	genesis, err := requestGenesis(ctx, c.jsonRestHandler)
+	if err != nil {
+		return nil, err
+	}
+	c.genesis = genesis
+	return genesis, nil
+}
+
+// requestGenesis requests the genesis of the chain from the beacon node.
+func requestGenesis(ctx context.Context, handler jsonRestHandler) (*ethpb.ChainStartResponse, error) {
+	resp := &apimiddleware.GenesisResponseJson{}
+	if err := handler.getRestJsonResponse(ctx, getGenesisPath, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("genesis data is nil")
+	}
+	genesisTime, err := strconv.ParseUint(resp.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis time %s", resp.Data.GenesisTime)
+	}
+	root, err := hexutil.Decode(resp.Data.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis validators root %s", resp.Data.GenesisValidatorsRoot)
+	}
+	return &ethpb.ChainStartResponse{
+		Started:               true,
+		GenesisTime:           genesisTime,
+		GenesisValidatorsRoot: root,
+	}, nil
+}
+
+// WaitForChainStart returns a stream which receives the genesis of the chain once it has started.
+func (c *beaconApiValidatorClient) WaitForChainStart(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &waitForChainStartStream{restStream: restStream{ctx: ctx}, client: c}, nil
+}
+
+type waitForChainStartStream struct {
+	restStream
+	client *beaconApiValidatorClient
+}
+
+// Recv polls the genesis endpoint of the beacon node until the chain has started.
+func (s *waitForChainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	for {
+		genesis, err := s.client.getGenesis(s.ctx)
+		if err == nil {
+			return genesis, nil
+		}
+		if !errors.Is(err, beacon.ErrNotFound) {
+			return nil, errors.Wrap(err, "could not get genesis")
+		}
+		log.Info("Waiting for the beacon chain to start")
+		if err := s.wait(chainStartPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// DomainData computes the signing domain for the given epoch and domain type from the fork schedule
+// of the local config and the genesis validators root of the beacon node.
+func (c *beaconApiValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	genesis, err := c.getGenesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	fork, err := forks.Fork(in.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	dv, err := signing.Domain(fork, in.Epoch, bytesutil.ToBytes4(in.Domain), genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.DomainResponse{SignatureDomain: dv}, nil
+}
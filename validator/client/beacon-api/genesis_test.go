@@ -0,0 +1,74 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const genesisResponse = `{"data":{
+  "genesis_time":"1606824023",
+  "genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+  "genesis_fork_version":"0x00000000"}}`
+
+func TestWaitForChainStart(t *testing.T) {
+	defer func(d time.Duration) { chainStartPollInterval = d }(chainStartPollInterval)
+	chainStartPollInterval = 10 * time.Millisecond
+
+	m := newMockBeaconNode(t, map[string]string{})
+	m.setResponse("GET "+getGenesisPath, http.StatusNotFound, `{"code":404,"message":"Chain genesis info is not yet known"}`)
+	c := m.validatorClient()
+	stream, err := c.WaitForChainStart(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		m.setResponse("GET "+getGenesisPath, http.StatusOK, genesisResponse)
+	}()
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Started)
+	assert.Equal(t, uint64(1606824023), resp.GenesisTime)
+	assert.Equal(t, "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95", hexutil.Encode(resp.GenesisValidatorsRoot))
+
+	// The genesis is cached once the chain has started.
+	hits := m.hitCount("GET " + getGenesisPath)
+	_, err = c.getGenesis(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, hits, m.hitCount("GET "+getGenesisPath))
+}
+
+func TestWaitForChainStart_Error(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{})
+	m.setResponse("GET "+getGenesisPath, http.StatusInternalServerError, `{"code":500,"message":"internal error"}`)
+	stream, err := m.validatorClient().WaitForChainStart(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.ErrorContains(t, "internal error", err)
+}
+
+func TestDomainData(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getGenesisPath: genesisResponse,
+	})
+	domainType := params.BeaconConfig().DomainBeaconAttester
+	resp, err := m.validatorClient().DomainData(context.Background(), &ethpb.DomainRequest{
+		Epoch:  0,
+		Domain: domainType[:],
+	})
+	require.NoError(t, err)
+	root, err := hexutil.Decode("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")
+	require.NoError(t, err)
+	want, err := signing.ComputeDomain(domainType, params.BeaconConfig().GenesisForkVersion, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, resp.SignatureDomain)
+}
@@ -0,0 +1,31 @@
+package beacon_api
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+)
+
+// jsonRestHandler sends JSON requests to the REST API of a beacon node. Responses with a non-2xx status
+// code are returned as errors wrapping beacon.ErrNotOK, or beacon.ErrNotFound for a 404 status code.
+type jsonRestHandler struct {
+	client *beacon.Client
+}
+
+// getRestJsonResponse sends a GET request to the given endpoint and decodes the JSON response body
+// into responseJson.
+func (c jsonRestHandler) getRestJsonResponse(ctx context.Context, endpoint string, responseJson interface{}) error {
+	return c.client.GetJSON(ctx, endpoint, responseJson)
+}
+
+// postRestJson sends a POST request with the given JSON body to the given endpoint. If responseJson is not
+// nil, the JSON response body is decoded into it.
+func (c jsonRestHandler) postRestJson(
+	ctx context.Context,
+	endpoint string,
+	headers map[string]string,
+	body []byte,
+	responseJson interface{},
+) error {
+	return c.client.PostJSON(ctx, endpoint, headers, body, responseJson)
+}
@@ -0,0 +1,126 @@
+package beacon_api
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// mockBeaconNode serves recorded beacon API responses and records the requests it receives.
+type mockBeaconNode struct {
+	t      *testing.T
+	srv    *httptest.Server
+	lock   sync.Mutex
+	routes map[string]mockResponse
+	bodies map[string][]byte
+	hits   map[string]int
+}
+
+type mockResponse struct {
+	status int
+	body   string
+}
+
+// newMockBeaconNode starts a beacon node which answers "METHOD /path" requests with the given JSON responses.
+func newMockBeaconNode(t *testing.T, routes map[string]string) *mockBeaconNode {
+	m := &mockBeaconNode{
+		t:      t,
+		routes: make(map[string]mockResponse, len(routes)),
+		bodies: make(map[string][]byte),
+		hits:   make(map[string]int),
+	}
+	for k, v := range routes {
+		m.routes[k] = mockResponse{status: http.StatusOK, body: v}
+	}
+	m.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		m.lock.Lock()
+		resp, ok := m.routes[key]
+		m.bodies[key] = body
+		m.hits[key]++
+		m.lock.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"code":404,"message":"route not found"}`))
+			require.NoError(t, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(resp.status)
+		_, err = w.Write([]byte(resp.body))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+func (m *mockBeaconNode) setResponse(route string, status int, body string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.routes[route] = mockResponse{status: status, body: body}
+}
+
+func (m *mockBeaconNode) body(route string) []byte {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.bodies[route]
+}
+
+func (m *mockBeaconNode) hitCount(route string) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.hits[route]
+}
+
+func (m *mockBeaconNode) client() *beacon.Client {
+	c, err := beacon.NewClient(m.srv.URL, beacon.WithTimeout(time.Second))
+	require.NoError(m.t, err)
+	return c
+}
+
+func (m *mockBeaconNode) validatorClient() *beaconApiValidatorClient {
+	return NewValidatorClient(m.client()).(*beaconApiValidatorClient)
+}
+
+func TestJsonRestHandler_ErrorResponses(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{})
+	m.setResponse("GET /bad", http.StatusBadRequest, `{"code":400,"message":"invalid slot"}`)
+	h := jsonRestHandler{client: m.client()}
+
+	resp := &versionedDataResponseJson{}
+	err := h.getRestJsonResponse(context.Background(), "/bad", resp)
+	require.ErrorContains(t, "invalid slot", err)
+	assert.Equal(t, true, errors.Is(err, beacon.ErrNotOK))
+	assert.Equal(t, false, errors.Is(err, beacon.ErrNotFound))
+
+	err = h.getRestJsonResponse(context.Background(), "/missing", resp)
+	require.ErrorContains(t, "route not found", err)
+	assert.Equal(t, true, errors.Is(err, beacon.ErrNotFound))
+}
+
+func TestJsonRestHandler_PostHeaders(t *testing.T) {
+	var gotVersion, gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotVersion = r.Header.Get(consensusVersionHeader)
+		gotContentType = r.Header.Get("Content-Type")
+	}))
+	defer srv.Close()
+	c, err := beacon.NewClient(srv.URL, beacon.WithTimeout(time.Second))
+	require.NoError(t, err)
+	h := jsonRestHandler{client: c}
+	headers := map[string]string{consensusVersionHeader: altairVersion}
+	require.NoError(t, h.postRestJson(context.Background(), postBlockPath, headers, []byte(`{}`), nil))
+	assert.Equal(t, altairVersion, gotVersion)
+	assert.Equal(t, "application/json", gotContentType)
+}
@@ -0,0 +1,86 @@
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	getSyncingPath         = "/eth/v1/node/syncing"
+	getVersionPath         = "/eth/v1/node/version"
+	getDepositContractPath = "/eth/v1/config/deposit_contract"
+)
+
+// beaconApiNodeClient implements the methods of ethpb.NodeClient used by the validator on top of
+// the beacon node REST API.
+type beaconApiNodeClient struct {
+	// Methods without an equivalent in the beacon API fall through to a gRPC client which always
+	// returns an Unimplemented error.
+	ethpb.NodeClient
+	jsonRestHandler jsonRestHandler
+}
+
+// NewNodeClient returns a node client which talks to the beacon node REST API through the given client.
+func NewNodeClient(client *beacon.Client) ethpb.NodeClient {
+	return &beaconApiNodeClient{
+		NodeClient:      ethpb.NewNodeClient(unsupportedConn{}),
+		jsonRestHandler: jsonRestHandler{client: client},
+	}
+}
+
+// GetSyncStatus returns whether the beacon node is syncing.
+func (c *beaconApiNodeClient) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	resp := &apimiddleware.SyncingResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, getSyncingPath, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("sync status is nil")
+	}
+	return &ethpb.SyncStatus{Syncing: resp.Data.IsSyncing}, nil
+}
+
+// GetGenesis returns the genesis of the chain and the address of the deposit contract.
+func (c *beaconApiNodeClient) GetGenesis(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	genesis, err := requestGenesis(ctx, c.jsonRestHandler)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	resp := &apimiddleware.DepositContractResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, getDepositContractPath, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get deposit contract")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("deposit contract is nil")
+	}
+	address, err := hexutil.Decode(resp.Data.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid deposit contract address %s", resp.Data.Address)
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            timestamppb.New(time.Unix(int64(genesis.GenesisTime), 0)),
+		DepositContractAddress: address,
+		GenesisValidatorsRoot:  genesis.GenesisValidatorsRoot,
+	}, nil
+}
+
+// GetVersion returns the version of the beacon node.
+func (c *beaconApiNodeClient) GetVersion(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Version, error) {
+	resp := &apimiddleware.VersionResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, getVersionPath, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get version")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("version is nil")
+	}
+	return &ethpb.Version{Version: resp.Data.Version}, nil
+}
@@ -0,0 +1,300 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	produceBlockPath          = "/eth/v2/validator/blocks/%d"
+	postBlockPath             = "/eth/v1/beacon/blocks"
+	getBlockPath              = "/eth/v2/beacon/blocks/%s"
+	getHeadHeaderPath         = "/eth/v1/beacon/headers/head"
+	prepareBeaconProposerPath = "/eth/v1/validator/prepare_beacon_proposer"
+	registerValidatorPath     = "/eth/v1/validator/register_validator"
+	postVoluntaryExitPath     = "/eth/v1/beacon/pool/voluntary_exits"
+
+	// Header used by the beacon API to tell which fork a block belongs to.
+	consensusVersionHeader = "Eth-Consensus-Version"
+)
+
+// Fork names used as consensus versions by the beacon API.
+const (
+	phase0Version    = "phase0"
+	altairVersion    = "altair"
+	bellatrixVersion = "bellatrix"
+)
+
+// How often the head of the beacon node is polled to emulate the block stream.
+var blockPollInterval = time.Second
+
+// GetBeaconBlock requests a new unsigned beacon block for the given slot from the beacon node.
+func (c *beaconApiValidatorClient) GetBeaconBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.GenericBeaconBlock, error) {
+	query := url.Values{}
+	query.Set("randao_reveal", hexutil.Encode(in.RandaoReveal))
+	if len(in.Graffiti) > 0 {
+		query.Set("graffiti", hexutil.Encode(in.Graffiti))
+	}
+	resp := &versionedDataResponseJson{}
+	endpoint := fmt.Sprintf(produceBlockPath, in.Slot) + "?" + query.Encode()
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce block")
+	}
+	switch resp.Version {
+	case phase0Version:
+		v1Blk := &ethpbv1.BeaconBlock{}
+		if err := decodeJson(resp.Data, &apimiddleware.BeaconBlockJson{}, v1Blk); err != nil {
+			return nil, err
+		}
+		blk, err := migration.V1ToV1Alpha1Block(v1Blk)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: blk}}, nil
+	case altairVersion:
+		v2Blk := &ethpbv2.BeaconBlockAltair{}
+		if err := decodeJson(resp.Data, &apimiddleware.BeaconBlockAltairJson{}, v2Blk); err != nil {
+			return nil, err
+		}
+		blk, err := migration.AltairToV1Alpha1Block(v2Blk)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Altair{Altair: blk}}, nil
+	case bellatrixVersion:
+		v2Blk := &ethpbv2.BeaconBlockBellatrix{}
+		if err := decodeJson(resp.Data, &apimiddleware.BeaconBlockBellatrixJson{}, v2Blk); err != nil {
+			return nil, err
+		}
+		blk, err := migration.BellatrixToV1Alpha1Block(v2Blk)
+		if err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
+	default:
+		return nil, errors.Errorf("unsupported block version %s", resp.Version)
+	}
+}
+
+// ProposeBeaconBlock publishes a signed beacon block through the beacon node. Blinded blocks are not
+// supported, as GetBeaconBlock never returns them.
+func (c *beaconApiValidatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	var (
+		signedBlock interface{}
+		root        [32]byte
+		version     string
+		err         error
+	)
+	switch b := in.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_Phase0:
+		version = phase0Version
+		if root, err = b.Phase0.Block.HashTreeRoot(); err != nil {
+			return nil, errors.Wrap(err, "could not compute block root")
+		}
+		v1Blk, err := migration.V1Alpha1ToV1Block(b.Phase0.Block)
+		if err != nil {
+			return nil, err
+		}
+		container := &apimiddleware.SignedBeaconBlockContainerJson{Message: &apimiddleware.BeaconBlockJson{}}
+		if err := protoToJson(v1Blk, container.Message); err != nil {
+			return nil, err
+		}
+		container.Signature = hexutil.Encode(b.Phase0.Signature)
+		signedBlock = container
+	case *ethpb.GenericSignedBeaconBlock_Altair:
+		version = altairVersion
+		if root, err = b.Altair.Block.HashTreeRoot(); err != nil {
+			return nil, errors.Wrap(err, "could not compute block root")
+		}
+		v2Blk, err := migration.V1Alpha1BeaconBlockAltairToV2(b.Altair.Block)
+		if err != nil {
+			return nil, err
+		}
+		container := &apimiddleware.SignedBeaconBlockAltairContainerJson{}
+		if err := protoToJson(&ethpbv2.SignedBeaconBlockAltair{Message: v2Blk, Signature: b.Altair.Signature}, container); err != nil {
+			return nil, err
+		}
+		signedBlock = container
+	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
+		version = bellatrixVersion
+		if root, err = b.Bellatrix.Block.HashTreeRoot(); err != nil {
+			return nil, errors.Wrap(err, "could not compute block root")
+		}
+		v2Blk, err := migration.V1Alpha1BeaconBlockBellatrixToV2(b.Bellatrix.Block)
+		if err != nil {
+			return nil, err
+		}
+		container := &apimiddleware.SignedBeaconBlockBellatrixContainerJson{}
+		if err := protoToJson(&ethpbv2.SignedBeaconBlockBellatrix{Message: v2Blk, Signature: b.Bellatrix.Signature}, container); err != nil {
+			return nil, err
+		}
+		signedBlock = container
+	default:
+		return nil, errors.Errorf("unsupported block type %T", in.Block)
+	}
+	body, err := json.Marshal(signedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	headers := map[string]string{consensusVersionHeader: version}
+	if err := c.jsonRestHandler.postRestJson(ctx, postBlockPath, headers, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish block")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// PrepareBeaconProposer sends the fee recipients of the validators to the beacon node.
+func (c *beaconApiValidatorClient) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	recipients := make([]*feeRecipientJson, len(in.Recipients))
+	for i, r := range in.Recipients {
+		recipients[i] = &feeRecipientJson{
+			ValidatorIndex: strconv.FormatUint(uint64(r.ValidatorIndex), 10),
+			FeeRecipient:   hexutil.Encode(r.FeeRecipient),
+		}
+	}
+	body, err := json.Marshal(recipients)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal fee recipients")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, prepareBeaconProposerPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not prepare beacon proposer")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SubmitValidatorRegistration sends signed validator registrations to the beacon node, which forwards them to the builder.
+func (c *beaconApiValidatorClient) SubmitValidatorRegistration(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	registrations := make([]*signedValidatorRegistrationJson, len(in.Messages))
+	for i, m := range in.Messages {
+		if m == nil || m.Message == nil {
+			return nil, errors.New("validator registration is nil")
+		}
+		registrations[i] = &signedValidatorRegistrationJson{
+			Message: &validatorRegistrationJson{
+				FeeRecipient: hexutil.Encode(m.Message.FeeRecipient),
+				GasLimit:     strconv.FormatUint(m.Message.GasLimit, 10),
+				Timestamp:    strconv.FormatUint(m.Message.Timestamp, 10),
+				Pubkey:       hexutil.Encode(m.Message.Pubkey),
+			},
+			Signature: hexutil.Encode(m.Signature),
+		}
+	}
+	body, err := json.Marshal(registrations)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal validator registrations")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, registerValidatorPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not register validators")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ProposeExit submits a signed voluntary exit to the operation pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	if in == nil || in.Exit == nil {
+		return nil, errors.New("signed voluntary exit is nil")
+	}
+	root, err := in.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute exit root")
+	}
+	exit := &apimiddleware.SignedVoluntaryExitJson{}
+	if err := protoToJson(migration.V1Alpha1ExitToV1(in), exit); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(exit)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal voluntary exit")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, postVoluntaryExitPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit voluntary exit")
+	}
+	return &ethpb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
+
+// StreamBlocksAltair returns a stream which receives the new head blocks of the beacon node.
+// Only phase 0 and Altair blocks are sent, as with the gRPC server.
+func (c *beaconApiValidatorClient) StreamBlocksAltair(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+	return &streamBlocksStream{restStream: restStream{ctx: ctx}, client: c}, nil
+}
+
+type streamBlocksStream struct {
+	restStream
+	client   *beaconApiValidatorClient
+	lastRoot string
+}
+
+// Recv polls the head of the beacon node until it changes and returns the new head block.
+func (s *streamBlocksStream) Recv() (*ethpb.StreamBlocksResponse, error) {
+	for {
+		header := &apimiddleware.BlockHeaderResponseJson{}
+		if err := s.client.jsonRestHandler.getRestJsonResponse(s.ctx, getHeadHeaderPath, header); err != nil {
+			return nil, errors.Wrap(err, "could not get head block header")
+		}
+		if header.Data == nil {
+			return nil, errors.New("head block header is nil")
+		}
+		if header.Data.Root == s.lastRoot {
+			if err := s.wait(blockPollInterval); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		s.lastRoot = header.Data.Root
+
+		resp := &versionedDataResponseJson{}
+		if err := s.client.jsonRestHandler.getRestJsonResponse(s.ctx, fmt.Sprintf(getBlockPath, header.Data.Root), resp); err != nil {
+			return nil, errors.Wrap(err, "could not get head block")
+		}
+		switch resp.Version {
+		case phase0Version:
+			container := &apimiddleware.SignedBeaconBlockContainerJson{}
+			if err := json.Unmarshal(resp.Data, container); err != nil {
+				return nil, errors.Wrap(err, "could not decode block")
+			}
+			if container.Message == nil {
+				return nil, errors.New("head block is nil")
+			}
+			v1Blk := &ethpbv1.BeaconBlock{}
+			if err := jsonToProto(container.Message, v1Blk); err != nil {
+				return nil, err
+			}
+			blk, err := migration.V1ToV1Alpha1Block(v1Blk)
+			if err != nil {
+				return nil, err
+			}
+			sig, err := hexutil.Decode(container.Signature)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid block signature %s", container.Signature)
+			}
+			signed := &ethpb.SignedBeaconBlock{Block: blk, Signature: sig}
+			return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_Phase0Block{Phase0Block: signed}}, nil
+		case altairVersion:
+			v2Blk := &ethpbv2.SignedBeaconBlockAltair{}
+			if err := decodeJson(resp.Data, &apimiddleware.SignedBeaconBlockAltairContainerJson{}, v2Blk); err != nil {
+				return nil, err
+			}
+			blk, err := migration.AltairToV1Alpha1SignedBlock(v2Blk)
+			if err != nil {
+				return nil, err
+			}
+			return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_AltairBlock{AltairBlock: blk}}, nil
+		default:
+			return &ethpb.StreamBlocksResponse{}, nil
+		}
+	}
+}
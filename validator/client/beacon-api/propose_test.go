@@ -0,0 +1,100 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestGetBeaconBlock(t *testing.T) {
+	blk := util.HydrateBeaconBlockBellatrix(&ethpb.BeaconBlockBellatrix{})
+	blk.Slot = 12
+	blk.ProposerIndex = 3
+	blk.Body.SyncAggregate.SyncCommitteeBits = make([]byte, 64)
+	blk.Body.ExecutionPayload.FeeRecipient = bytes.Repeat([]byte{0xaa}, 20)
+	blk.Body.ExecutionPayload.PrevRandao = bytes.Repeat([]byte{0xbb}, 32)
+	v2Blk, err := migration.V1Alpha1BeaconBlockBellatrixToV2(blk)
+	require.NoError(t, err)
+	container := &apimiddleware.BeaconBlockBellatrixJson{}
+	require.NoError(t, protoToJson(v2Blk, container))
+	data, err := json.Marshal(container)
+	require.NoError(t, err)
+
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + fmt.Sprintf(produceBlockPath, 12): `{"version":"bellatrix","data":` + string(data) + `}`,
+	})
+	resp, err := m.validatorClient().GetBeaconBlock(context.Background(), &ethpb.BlockRequest{
+		Slot:         12,
+		RandaoReveal: make([]byte, 96),
+		Graffiti:     make([]byte, 32),
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, resp.GetBellatrix())
+
+	m.setResponse("GET "+fmt.Sprintf(produceBlockPath, 12), 200, `{"version":"capella","data":{}}`)
+	_, err = m.validatorClient().GetBeaconBlock(context.Background(), &ethpb.BlockRequest{Slot: 12})
+	require.ErrorContains(t, "unsupported block version capella", err)
+}
+
+func TestProposeBeaconBlock(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"POST " + postBlockPath: ``,
+	})
+	c := m.validatorClient()
+
+	blk := util.HydrateSignedBeaconBlock(&ethpb.SignedBeaconBlock{})
+	blk.Block.Slot = 5
+	blk.Signature = bytes.Repeat([]byte{0xcc}, 96)
+	resp, err := c.ProposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{
+		Block: &ethpb.GenericSignedBeaconBlock_Phase0{Phase0: blk},
+	})
+	require.NoError(t, err)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.BlockRoot)
+
+	posted := &apimiddleware.SignedBeaconBlockContainerJson{}
+	require.NoError(t, json.Unmarshal(m.body("POST "+postBlockPath), posted))
+	assert.Equal(t, hexutil.Encode(blk.Signature), posted.Signature)
+	v1Blk := &ethpbv1.BeaconBlock{}
+	require.NoError(t, jsonToProto(posted.Message, v1Blk))
+	postedBlk, err := migration.V1ToV1Alpha1Block(v1Blk)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk.Block, postedBlk)
+
+	bellatrixBlk := util.HydrateSignedBeaconBlockBellatrix(&ethpb.SignedBeaconBlockBellatrix{})
+	bellatrixBlk.Block.Body.SyncAggregate.SyncCommitteeBits = make([]byte, 64)
+	bellatrixBlk.Block.Body.ExecutionPayload.FeeRecipient = bytes.Repeat([]byte{0xaa}, 20)
+	_, err = c.ProposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{
+		Block: &ethpb.GenericSignedBeaconBlock_Bellatrix{Bellatrix: bellatrixBlk},
+	})
+	require.NoError(t, err)
+	postedBellatrix := &apimiddleware.SignedBeaconBlockBellatrixContainerJson{}
+	require.NoError(t, json.Unmarshal(m.body("POST "+postBlockPath), postedBellatrix))
+	assert.Equal(t, hexutil.Encode(bellatrixBlk.Block.Body.ExecutionPayload.FeeRecipient), postedBellatrix.Message.Body.ExecutionPayload.FeeRecipient)
+	v2Blk := &ethpbv2.SignedBeaconBlockBellatrix{}
+	require.NoError(t, jsonToProto(postedBellatrix, v2Blk))
+	postedBellatrixBlk, err := migration.BellatrixToV1Alpha1Block(v2Blk.Message)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bellatrixBlk.Block, postedBellatrixBlk)
+	assert.DeepEqual(t, bellatrixBlk.Signature, v2Blk.Signature)
+	assert.Equal(t, 2, m.hitCount("POST "+postBlockPath))
+
+	blinded := util.HydrateSignedBlindedBeaconBlockBellatrix(&ethpb.SignedBlindedBeaconBlockBellatrix{})
+	_, err = c.ProposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{
+		Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{BlindedBellatrix: blinded},
+	})
+	require.ErrorContains(t, "unsupported block type", err)
+}
@@ -0,0 +1,259 @@
+package beacon_api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const getStateValidatorsPath = "/eth/v1/beacon/states/head/validators"
+
+// Maximum number of validator ids sent in a single state validators request, to keep URLs short.
+const stateValidatorsBatchSize = 64
+
+// Using ^0 as the index of validators which do not exist in the beacon state, as the gRPC server does.
+const nonExistentIndex = types.ValidatorIndex(^uint64(0))
+
+// Maps the validator statuses of the beacon API to the statuses of the gRPC API.
+var validatorStatuses = map[string]ethpb.ValidatorStatus{
+	"pending_initialized": ethpb.ValidatorStatus_DEPOSITED,
+	"pending_queued":      ethpb.ValidatorStatus_PENDING,
+	"active_ongoing":      ethpb.ValidatorStatus_ACTIVE,
+	"active_exiting":      ethpb.ValidatorStatus_EXITING,
+	"active_slashed":      ethpb.ValidatorStatus_SLASHING,
+	"exited_unslashed":    ethpb.ValidatorStatus_EXITED,
+	"exited_slashed":      ethpb.ValidatorStatus_EXITED,
+	"withdrawal_possible": ethpb.ValidatorStatus_EXITED,
+	"withdrawal_done":     ethpb.ValidatorStatus_EXITED,
+}
+
+// stateValidator is a validator of the head state as returned by the beacon API.
+type stateValidator struct {
+	index           types.ValidatorIndex
+	pubkey          []byte
+	status          ethpb.ValidatorStatus
+	activationEpoch types.Epoch
+}
+
+// getStateValidators returns the validators of the head state matching the given ids, which are either
+// 0x-prefixed public keys or validator indices. Validators which do not exist in the state are omitted.
+func (c *beaconApiValidatorClient) getStateValidators(ctx context.Context, ids []string) ([]*stateValidator, error) {
+	containers, err := getStateValidatorsJson(ctx, c.jsonRestHandler, ids)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]*stateValidator, 0, len(containers))
+	for _, v := range containers {
+		val, err := stateValidatorFromJson(v)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+// getStateValidatorsJson requests the validators of the head state matching the given ids in batches.
+func getStateValidatorsJson(ctx context.Context, h jsonRestHandler, ids []string) ([]*apimiddleware.ValidatorContainerJson, error) {
+	vals := make([]*apimiddleware.ValidatorContainerJson, 0, len(ids))
+	for start := 0; start < len(ids); start += stateValidatorsBatchSize {
+		end := start + stateValidatorsBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		query := url.Values{}
+		for _, id := range ids[start:end] {
+			query.Add("id", id)
+		}
+		resp := &apimiddleware.StateValidatorsResponseJson{}
+		if err := h.getRestJsonResponse(ctx, getStateValidatorsPath+"?"+query.Encode(), resp); err != nil {
+			return nil, errors.Wrap(err, "could not get state validators")
+		}
+		vals = append(vals, resp.Data...)
+	}
+	return vals, nil
+}
+
+func stateValidatorFromJson(v *apimiddleware.ValidatorContainerJson) (*stateValidator, error) {
+	if v == nil || v.Validator == nil {
+		return nil, errors.New("validator is nil")
+	}
+	index, err := strconv.ParseUint(v.Index, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid validator index %s", v.Index)
+	}
+	pubkey, err := hexutil.Decode(v.Validator.PublicKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid validator public key %s", v.Validator.PublicKey)
+	}
+	activationEpoch, err := strconv.ParseUint(v.Validator.ActivationEpoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid activation epoch %s", v.Validator.ActivationEpoch)
+	}
+	s, ok := validatorStatuses[v.Status]
+	if !ok {
+		return nil, errors.Errorf("unknown validator status %s", v.Status)
+	}
+	return &stateValidator{
+		index:           types.ValidatorIndex(index),
+		pubkey:          pubkey,
+		status:          s,
+		activationEpoch: types.Epoch(activationEpoch),
+	}, nil
+}
+
+// getValidatorsByPubkey returns the validators of the head state with the given public keys, keyed by public key.
+func (c *beaconApiValidatorClient) getValidatorsByPubkey(ctx context.Context, pubkeys [][]byte) (map[[fieldparams.BLSPubkeyLength]byte]*stateValidator, error) {
+	ids := make([]string, len(pubkeys))
+	for i, pk := range pubkeys {
+		ids[i] = hexutil.Encode(pk)
+	}
+	vals, err := c.getStateValidators(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[[fieldparams.BLSPubkeyLength]byte]*stateValidator, len(vals))
+	for _, v := range vals {
+		res[bytesutil.ToBytes48(v.pubkey)] = v
+	}
+	return res, nil
+}
+
+// statusResponse returns the status of the given validator in the format of the gRPC API.
+// A nil validator has an unknown status.
+func statusResponse(v *stateValidator) (*ethpb.ValidatorStatusResponse, types.ValidatorIndex) {
+	if v == nil {
+		return &ethpb.ValidatorStatusResponse{
+			Status:          ethpb.ValidatorStatus_UNKNOWN_STATUS,
+			ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+		}, nonExistentIndex
+	}
+	return &ethpb.ValidatorStatusResponse{
+		Status:          v.status,
+		ActivationEpoch: v.activationEpoch,
+	}, v.index
+}
+
+// ValidatorIndex returns the index of the validator with the given public key.
+func (c *beaconApiValidatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	vals, err := c.getValidatorsByPubkey(ctx, [][]byte{in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	v, ok := vals[bytesutil.ToBytes48(in.PublicKey)]
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "Could not find validator index for public key %#x not found", in.PublicKey)
+	}
+	return &ethpb.ValidatorIndexResponse{Index: v.index}, nil
+}
+
+// ValidatorStatus returns the status of the validator with the given public key.
+func (c *beaconApiValidatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	vals, err := c.getValidatorsByPubkey(ctx, [][]byte{in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	resp, _ := statusResponse(vals[bytesutil.ToBytes48(in.PublicKey)])
+	return resp, nil
+}
+
+// MultipleValidatorStatus returns the statuses of the validators with the given public keys and indices.
+func (c *beaconApiValidatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	filtered := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	filtered[[fieldparams.BLSPubkeyLength]byte{}] = true // Filter out keys with all zeros.
+	pubkeys := make([][]byte, 0, len(in.PublicKeys)+len(in.Indices))
+	for _, pk := range in.PublicKeys {
+		if key := bytesutil.ToBytes48(pk); !filtered[key] {
+			pubkeys = append(pubkeys, pk)
+			filtered[key] = true
+		}
+	}
+	vals, err := c.getValidatorsByPubkey(ctx, pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	// Convert indices to public keys.
+	if len(in.Indices) > 0 {
+		ids := make([]string, len(in.Indices))
+		for i, idx := range in.Indices {
+			ids[i] = strconv.FormatInt(idx, 10)
+		}
+		byIndex, err := c.getStateValidators(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range byIndex {
+			if key := bytesutil.ToBytes48(v.pubkey); !filtered[key] {
+				pubkeys = append(pubkeys, v.pubkey)
+				filtered[key] = true
+				vals[key] = v
+			}
+		}
+	}
+
+	resp := &ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: pubkeys,
+		Statuses:   make([]*ethpb.ValidatorStatusResponse, len(pubkeys)),
+		Indices:    make([]types.ValidatorIndex, len(pubkeys)),
+	}
+	for i, pk := range pubkeys {
+		resp.Statuses[i], resp.Indices[i] = statusResponse(vals[bytesutil.ToBytes48(pk)])
+	}
+	return resp, nil
+}
+
+// WaitForActivation returns a stream which receives the statuses of the given validators once immediately,
+// and then every slot until one of them is active.
+func (c *beaconApiValidatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &waitForActivationStream{
+		restStream: restStream{ctx: ctx},
+		client:     c,
+		pubkeys:    in.PublicKeys,
+	}, nil
+}
+
+type waitForActivationStream struct {
+	restStream
+	client   *beaconApiValidatorClient
+	pubkeys  [][]byte
+	received bool
+}
+
+// Recv returns the current statuses of the validators. Except for the first call, it waits for one slot first.
+func (s *waitForActivationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.received {
+		if err := s.wait(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second); err != nil {
+			return nil, err
+		}
+	}
+	s.received = true
+	vals, err := s.client.getValidatorsByPubkey(s.ctx, s.pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	res := &ethpb.ValidatorActivationResponse{
+		Statuses: make([]*ethpb.ValidatorActivationResponse_Status, len(s.pubkeys)),
+	}
+	for i, pk := range s.pubkeys {
+		st, idx := statusResponse(vals[bytesutil.ToBytes48(pk)])
+		res.Statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pk,
+			Status:    st,
+			Index:     idx,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,110 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+var (
+	testPubkey1 = bytesutil.PadTo([]byte{0x01}, 48)
+	testPubkey2 = bytesutil.PadTo([]byte{0x02}, 48)
+	testPubkey3 = bytesutil.PadTo([]byte{0x03}, 48)
+)
+
+func stateValidatorJson(index uint64, pubkey []byte, status string, activationEpoch uint64) string {
+	return fmt.Sprintf(`{
+  "index": "%d",
+  "balance": "32000000000",
+  "status": "%s",
+  "validator": {
+    "pubkey": "%#x",
+    "withdrawal_credentials": "0x0000000000000000000000000000000000000000000000000000000000000000",
+    "effective_balance": "32000000000",
+    "slashed": false,
+    "activation_eligibility_epoch": "0",
+    "activation_epoch": "%d",
+    "exit_epoch": "18446744073709551615",
+    "withdrawable_epoch": "18446744073709551615"
+  }
+}`, index, status, pubkey, activationEpoch)
+}
+
+func TestValidatorIndex(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[` + stateValidatorJson(7, testPubkey1, "active_ongoing", 0) + `]}`,
+	})
+	c := m.validatorClient()
+	resp, err := c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: testPubkey1})
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(7), resp.Index)
+
+	m.setResponse("GET "+getStateValidatorsPath, 200, `{"data":[]}`)
+	_, err = c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: testPubkey1})
+	require.ErrorContains(t, "Could not find validator index", err)
+}
+
+func TestMultipleValidatorStatus(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[` +
+			stateValidatorJson(1, testPubkey1, "active_ongoing", 3) + `,` +
+			stateValidatorJson(2, testPubkey2, "pending_queued", 10) + `]}`,
+	})
+	c := m.validatorClient()
+	resp, err := c.MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{testPubkey1, testPubkey2, testPubkey3, testPubkey1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.PublicKeys))
+	assert.DeepEqual(t, [][]byte{testPubkey1, testPubkey2, testPubkey3}, resp.PublicKeys)
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 2, nonExistentIndex}, resp.Indices)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status)
+	assert.Equal(t, types.Epoch(3), resp.Statuses[0].ActivationEpoch)
+	assert.Equal(t, ethpb.ValidatorStatus_PENDING, resp.Statuses[1].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[2].Status)
+	assert.Equal(t, params.BeaconConfig().FarFutureEpoch, resp.Statuses[2].ActivationEpoch)
+}
+
+func TestMultipleValidatorStatus_UnknownStatus(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[` + stateValidatorJson(1, testPubkey1, "unknown", 3) + `]}`,
+	})
+	_, err := m.validatorClient().MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{testPubkey1},
+	})
+	require.ErrorContains(t, "unknown validator status", err)
+}
+
+func TestWaitForActivation(t *testing.T) {
+	m := newMockBeaconNode(t, map[string]string{
+		"GET " + getStateValidatorsPath: `{"data":[` + stateValidatorJson(4, testPubkey1, "active_exiting", 0) + `]}`,
+	})
+	stream, err := m.validatorClient().WaitForActivation(context.Background(), &ethpb.ValidatorActivationRequest{
+		PublicKeys: [][]byte{testPubkey1, testPubkey2},
+	})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Statuses))
+	assert.Equal(t, types.ValidatorIndex(4), resp.Statuses[0].Index)
+	assert.Equal(t, ethpb.ValidatorStatus_EXITING, resp.Statuses[0].Status.Status)
+	assert.Equal(t, nonExistentIndex, resp.Statuses[1].Index)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status.Status)
+
+	// Later calls wait for the next slot, and stop when the context is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err = m.validatorClient().WaitForActivation(ctx, &ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{testPubkey1}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	cancel()
+	_, err = stream.Recv()
+	require.ErrorContains(t, "context canceled", err)
+}
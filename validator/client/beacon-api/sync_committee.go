@@ -0,0 +1,133 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	getHeadBlockRootPath          = "/eth/v1/beacon/blocks/head/root"
+	postSyncCommitteeMessagesPath = "/eth/v1/beacon/pool/sync_committees"
+	syncCommitteeContributionPath = "/eth/v1/validator/sync_committee_contribution?slot=%d&subcommittee_index=%d&beacon_block_root=%s"
+	postContributionAndProofsPath = "/eth/v1/validator/contribution_and_proofs"
+)
+
+// GetSyncMessageBlockRoot returns the root of the head block of the beacon node, which sync committee members sign.
+func (c *beaconApiValidatorClient) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncMessageBlockRootResponse, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SyncMessageBlockRootResponse{Root: root}, nil
+}
+
+func (c *beaconApiValidatorClient) headBlockRoot(ctx context.Context) ([]byte, error) {
+	resp := &apimiddleware.BlockRootResponseJson{}
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, getHeadBlockRootPath, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get head block root")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("head block root is nil")
+	}
+	root, err := hexutil.Decode(resp.Data.Root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid head block root %s", resp.Data.Root)
+	}
+	return root, nil
+}
+
+// SubmitSyncMessage submits a sync committee message to the beacon node.
+func (c *beaconApiValidatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	req := &apimiddleware.SubmitSyncCommitteeSignaturesRequestJson{}
+	msgs := []*ethpbv2.SyncCommitteeMessage{migration.V1Alpha1SyncCommitteeMessageToV2(in)}
+	if err := protoToJson(&ethpbv2.SubmitSyncCommitteeSignaturesRequest{Data: msgs}, req); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(req.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal sync committee messages")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, postSyncCommitteeMessagesPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit sync committee message")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex returns the positions of the given validator in the sync committee of the given slot.
+func (c *beaconApiValidatorClient) GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest, _ ...grpc.CallOption) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	idx, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	body, err := indicesJson([]types.ValidatorIndex{idx.Index})
+	if err != nil {
+		return nil, err
+	}
+	resp := &apimiddleware.SyncCommitteeDutiesResponseJson{}
+	if err := c.jsonRestHandler.postRestJson(ctx, fmt.Sprintf(syncDutiesPath, slots.ToEpoch(in.Slot)), nil, body, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync committee duties")
+	}
+	res := &ethpb.SyncSubcommitteeIndexResponse{}
+	for _, duty := range resp.Data {
+		for _, s := range duty.ValidatorSyncCommitteeIndices {
+			i, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid sync committee index %s", s)
+			}
+			res.Indices = append(res.Indices, types.CommitteeIndex(i))
+		}
+	}
+	return res, nil
+}
+
+// GetSyncCommitteeContribution returns the aggregate of the sync committee messages of the given subcommittee
+// over the current head block.
+func (c *beaconApiValidatorClient) GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest, _ ...grpc.CallOption) (*ethpb.SyncCommitteeContribution, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &apimiddleware.ProduceSyncCommitteeContributionResponseJson{}
+	endpoint := fmt.Sprintf(syncCommitteeContributionPath, in.Slot, in.SubnetId, hexutil.Encode(root))
+	if err := c.jsonRestHandler.getRestJsonResponse(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync committee contribution")
+	}
+	contribution := &ethpbv2.SyncCommitteeContribution{}
+	if err := jsonToProto(resp.Data, contribution); err != nil {
+		return nil, err
+	}
+	return migration.V2SyncCommitteeContributionToV1Alpha1(contribution), nil
+}
+
+// SubmitSignedContributionAndProof publishes a signed sync committee contribution through the beacon node.
+func (c *beaconApiValidatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if in == nil || in.Message == nil || in.Message.Contribution == nil {
+		return nil, errors.New("signed contribution and proof is nil")
+	}
+	req := &apimiddleware.SubmitContributionAndProofsRequestJson{}
+	contributions := []*ethpbv2.SignedContributionAndProof{migration.V1Alpha1SignedContributionAndProofToV2(in)}
+	if err := protoToJson(&ethpbv2.SubmitContributionAndProofsRequest{Data: contributions}, req); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(req.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal contribution and proofs")
+	}
+	if err := c.jsonRestHandler.postRestJson(ctx, postContributionAndProofsPath, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit contribution and proof")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,28 @@
+package beacon_api
+
+import "encoding/json"
+
+// The structs below mirror the JSON of the beacon API endpoints which have no counterpart in the
+// apimiddleware package of the beacon node. Everything else is decoded with the apimiddleware structs.
+
+type versionedDataResponseJson struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type feeRecipientJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	FeeRecipient   string `json:"fee_recipient"`
+}
+
+type signedValidatorRegistrationJson struct {
+	Message   *validatorRegistrationJson `json:"message"`
+	Signature string                     `json:"signature"`
+}
+
+type validatorRegistrationJson struct {
+	FeeRecipient string `json:"fee_recipient"`
+	GasLimit     string `json:"gas_limit"`
+	Timestamp    string `json:"timestamp"`
+	Pubkey       string `json:"pubkey"`
+}
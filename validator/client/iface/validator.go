@@ -8,6 +8,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 )
 
 // ErrConnectionIssue represents a connection problem.
@@ -57,4 +58,7 @@ type Validator interface {
 	ReceiveBlocks(ctx context.Context, connectionErrorChannel chan<- error)
 	HandleKeyReload(ctx context.Context, newKeys [][fieldparams.BLSPubkeyLength]byte) (bool, error)
 	CheckDoppelGanger(ctx context.Context) error
+	PushProposerSettings(ctx context.Context, km keymanager.IKeymanager) error
+	SubmitValidatorRegistrations(ctx context.Context, km keymanager.IKeymanager) error
+	ProposerSettings() *proposer.Settings
 }
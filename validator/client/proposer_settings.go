@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
+	"go.opencensus.io/trace"
+)
+
+// The beacon node returns ^0 as the index of validators which are not in the beacon state yet.
+var nonExistentIndex = types.ValidatorIndex(^uint64(0))
+
+// PushProposerSettings sends the fee recipient of every validator key to the beacon node, so that it can
+// build execution payloads which pay to the right address. Fee recipients set through the key management
+// API take precedence over the proposer settings file, which is read again on every call, so that changes
+// to it take effect without restarting the validator client.
+func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKeymanager) error {
+	ctx, span := trace.StartSpan(ctx, "validator.PushProposerSettings")
+	defer span.End()
+
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
+	v.reloadProposerSettings()
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, msgCouldNotFetchKeys)
+	}
+	feeRecipients := make(map[[fieldparams.BLSPubkeyLength]byte]common.Address, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		feeRecipient, ok, err := v.feeRecipient(ctx, pubKey)
+		if err != nil {
+			return err
+		}
+		if ok {
+			feeRecipients[pubKey] = feeRecipient
+		}
+	}
+	if len(feeRecipients) == 0 {
+		return nil
+	}
+	req := &ethpb.MultipleValidatorStatusRequest{PublicKeys: make([][]byte, 0, len(feeRecipients))}
+	for _, pubKey := range pubKeys {
+		if _, ok := feeRecipients[pubKey]; ok {
+			req.PublicKeys = append(req.PublicKeys, bytesutil.SafeCopyBytes(pubKey[:]))
+		}
+	}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator indices")
+	}
+
+	var recipients []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer
+	for i, pubKey := range resp.PublicKeys {
+		if resp.Indices[i] == nonExistentIndex {
+			continue
+		}
+		feeRecipient, ok := feeRecipients[bytesutil.ToBytes48(pubKey)]
+		if !ok {
+			continue
+		}
+		recipients = append(recipients, &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			FeeRecipient:   feeRecipient.Bytes(),
+			ValidatorIndex: resp.Indices[i],
+		})
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+	if _, err := v.validatorClient.PrepareBeaconProposer(ctx, &ethpb.PrepareBeaconProposerRequest{
+		Recipients: recipients,
+	}); err != nil {
+		return errors.Wrap(err, "could not send proposer settings to the beacon node")
+	}
+	log.WithField("validators", len(recipients)).Debug("Sent fee recipients to the beacon node")
+	return nil
+}
+
+// ProposerSettings returns the proposer settings last read from the proposer settings file, or nil if
+// the validator client runs without one.
+func (v *validator) ProposerSettings() *proposer.Settings {
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
+	return v.proposerSettings
+}
+
+// feeRecipient returns the fee recipient of a validator key, preferring the one stored in the
+// validator database over the proposer settings file.
+func (v *validator) feeRecipient(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool, error) {
+	if v.db != nil {
+		feeRecipient, ok, err := v.db.FeeRecipientByPubKey(ctx, pubKey)
+		if err != nil {
+			return common.Address{}, false, errors.Wrap(err, "could not get fee recipient from the validator database")
+		}
+		if ok {
+			return feeRecipient, true, nil
+		}
+	}
+	if v.proposerSettings == nil {
+		return common.Address{}, false, nil
+	}
+	feeRecipient, ok := v.proposerSettings.FeeRecipient(pubKey)
+	return feeRecipient, ok, nil
+}
+
+// gasLimit returns the execution gas limit of a validator key, preferring the one stored in the validator
+// database over the proposer settings file, and falling back to proposer.DefaultGasLimit.
+func (v *validator) gasLimit(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (uint64, error) {
+	if v.db != nil {
+		gasLimit, ok, err := v.db.GasLimitByPubKey(ctx, pubKey)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get gas limit from the validator database")
+		}
+		if ok {
+			return gasLimit, nil
+		}
+	}
+	if v.proposerSettings == nil {
+		return proposer.DefaultGasLimit, nil
+	}
+	return v.proposerSettings.GasLimit(pubKey), nil
+}
+
+// reloadProposerSettings parses the proposer settings file again and replaces the current settings if the
+// file changed. An invalid file is logged and ignored, so that the last valid settings stay in use.
+func (v *validator) reloadProposerSettings() {
+	if v.proposerSettingsFile == "" {
+		return
+	}
+	s, err := proposer.ParseSettingsFile(v.proposerSettingsFile)
+	if err != nil {
+		log.WithError(err).Error("Could not reload proposer settings file, keeping the previous settings")
+		return
+	}
+	if v.proposerSettings != nil && v.proposerSettings.Hash == s.Hash {
+		return
+	}
+	if v.proposerSettings != nil {
+		log.WithField("file", v.proposerSettingsFile).Info("Reloaded proposer settings file")
+	}
+	v.proposerSettings = s
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/mock"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/client/testutil"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestValidator_PushProposerSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hook := logTest.NewGlobal()
+
+	// The first key has its own fee recipient, the second one uses the default and the third is not in the state yet.
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 3)
+	keysMap := make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
+	rawPubKeys := make([][]byte, len(pubKeys))
+	for i := range pubKeys {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		copy(pubKeys[i][:], priv.PublicKey().Marshal())
+		keysMap[pubKeys[i]] = priv
+		rawPubKeys[i] = pubKeys[i][:]
+	}
+	km := &mockKeymanager{keysMap: keysMap}
+	resp := testutil.GenerateMultipleValidatorStatusResponse(rawPubKeys)
+	resp.Indices[2] = nonExistentIndex
+
+	feeRecipient := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	defaultRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	settingsFile := filepath.Join(t.TempDir(), "proposer-settings.json")
+	writeSettings := func(defaultRecipient common.Address) {
+		enc := fmt.Sprintf(`{"proposer_config": {"%#x": {"fee_recipient": "%s"}}, "default_config": {"fee_recipient": "%s"}}`,
+			pubKeys[0], feeRecipient.Hex(), defaultRecipient.Hex())
+		require.NoError(t, ioutil.WriteFile(settingsFile, []byte(enc), os.ModePerm))
+	}
+	writeSettings(defaultRecipient)
+
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{
+		validatorClient:      client,
+		keyManager:           km,
+		db:                   dbTest.SetupDB(t, pubKeys),
+		proposerSettingsFile: settingsFile,
+	}
+	expectPrepare := func(defaultRecipient common.Address) {
+		client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(resp, nil)
+		client.EXPECT().PrepareBeaconProposer(gomock.Any(), &ethpb.PrepareBeaconProposerRequest{
+			Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+				{FeeRecipient: feeRecipient.Bytes(), ValidatorIndex: 0},
+				{FeeRecipient: defaultRecipient.Bytes(), ValidatorIndex: 1},
+			},
+		}).Return(nil, nil)
+	}
+
+	ctx := context.Background()
+	expectPrepare(defaultRecipient)
+	require.NoError(t, v.PushProposerSettings(ctx, km))
+	assert.LogsDoNotContain(t, hook, "Reloaded proposer settings file")
+
+	// A change to the file is picked up on the next push.
+	newDefault := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	writeSettings(newDefault)
+	expectPrepare(newDefault)
+	require.NoError(t, v.PushProposerSettings(ctx, km))
+	assert.LogsContain(t, hook, "Reloaded proposer settings file")
+
+	// An invalid file does not replace the previous settings.
+	require.NoError(t, ioutil.WriteFile(settingsFile, []byte(`{"default_config": {"fee_recipient": "foo"}}`), os.ModePerm))
+	expectPrepare(newDefault)
+	require.NoError(t, v.PushProposerSettings(ctx, km))
+	assert.LogsContain(t, hook, "Could not reload proposer settings file")
+
+	// A fee recipient stored in the validator database overrides the proposer settings file.
+	dbRecipient := common.HexToAddress("0x9F1DD4c1d6fC2AAA4E2A84B1B8F6B0EA81EE20F8")
+	require.NoError(t, v.db.SaveFeeRecipientByPubKey(ctx, pubKeys[1], dbRecipient))
+	expectPrepare(dbRecipient)
+	require.NoError(t, v.PushProposerSettings(ctx, km))
+}
+
+func TestValidator_PushProposerSettings_NoSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// No calls to the beacon node are expected.
+	v := validator{validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl)}
+	require.NoError(t, v.PushProposerSettings(context.Background(), &mockKeymanager{}))
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"go.opencensus.io/trace"
+)
+
+// SubmitValidatorRegistrations signs a builder registration for every validator key with a fee recipient,
+// and submits them to the beacon node, which forwards them to its external block builder. Registrations
+// are persisted in the validator database and only signed again when the fee recipient or gas limit of
+// the validator changes, so that restarts do not produce registrations with a new timestamp.
+func (v *validator) SubmitValidatorRegistrations(ctx context.Context, km keymanager.IKeymanager) error {
+	if !v.enableBuilder {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitValidatorRegistrations")
+	defer span.End()
+
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, msgCouldNotFetchKeys)
+	}
+	var regs []*ethpb.SignedValidatorRegistrationV1
+	for _, pubKey := range pubKeys {
+		feeRecipient, ok, err := v.feeRecipient(ctx, pubKey)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		gasLimit, err := v.gasLimit(ctx, pubKey)
+		if err != nil {
+			return err
+		}
+		reg, err := v.signedValidatorRegistration(ctx, km, pubKey, feeRecipient, gasLimit)
+		if err != nil {
+			return err
+		}
+		regs = append(regs, reg)
+	}
+	if len(regs) == 0 {
+		return nil
+	}
+	if _, err := v.validatorClient.SubmitValidatorRegistration(ctx, &ethpb.SignedValidatorRegistrationsV1{
+		Messages: regs,
+	}); err != nil {
+		return errors.Wrap(err, "could not submit validator registrations to the beacon node")
+	}
+	log.WithField("validators", len(regs)).Debug("Submitted builder registrations to the beacon node")
+	return nil
+}
+
+// signedValidatorRegistration returns the registration last signed for a validator key if it still matches
+// the given fee recipient and gas limit, or signs and persists a new one otherwise.
+func (v *validator) signedValidatorRegistration(
+	ctx context.Context,
+	km keymanager.IKeymanager,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	feeRecipient common.Address,
+	gasLimit uint64,
+) (*ethpb.SignedValidatorRegistrationV1, error) {
+	if v.db != nil {
+		reg, ok, err := v.db.SignedValidatorRegistrationByPubKey(ctx, pubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get validator registration from the validator database")
+		}
+		if ok && reg.Message.GasLimit == gasLimit && bytes.Equal(reg.Message.FeeRecipient, feeRecipient.Bytes()) {
+			return reg, nil
+		}
+	}
+
+	msg := &ethpb.ValidatorRegistrationV1{
+		FeeRecipient: feeRecipient.Bytes(),
+		GasLimit:     gasLimit,
+		Timestamp:    uint64(prysmTime.Now().Unix()),
+		Pubkey:       pubKey[:],
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil /* fork version */, nil /* genesis val root */)
+	if err != nil {
+		return nil, err
+	}
+	root, err := signing.ComputeSigningRoot(msg, d)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: d,
+		Object:          &validatorpb.SignRequest_Registration{Registration: msg},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign validator registration")
+	}
+	reg := &ethpb.SignedValidatorRegistrationV1{Message: msg, Signature: sig.Marshal()}
+	if v.db != nil {
+		if err := v.db.SaveSignedValidatorRegistration(ctx, pubKey, reg); err != nil {
+			return nil, errors.Wrap(err, "could not save validator registration in the validator database")
+		}
+	}
+	return reg, nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/mock"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
+	"google.golang.org/grpc"
+)
+
+func TestValidator_SubmitValidatorRegistrations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The first key has a fee recipient and a custom gas limit, the second one has no fee recipient.
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 2)
+	keysMap := make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
+	for i := range pubKeys {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		copy(pubKeys[i][:], priv.PublicKey().Marshal())
+		keysMap[pubKeys[i]] = priv
+	}
+	km := &mockKeymanager{keysMap: keysMap}
+	feeRecipient := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{
+		validatorClient: client,
+		keyManager:      km,
+		db:              dbTest.SetupDB(t, pubKeys),
+		enableBuilder:   true,
+		proposerSettings: &proposer.Settings{
+			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+				pubKeys[0]: {FeeRecipient: feeRecipient, GasLimit: 35000000},
+			},
+		},
+	}
+	var submitted []*ethpb.SignedValidatorRegistrationV1
+	expectSubmit := func() {
+		client.EXPECT().SubmitValidatorRegistration(gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, req *ethpb.SignedValidatorRegistrationsV1, _ ...grpc.CallOption) {
+				submitted = req.Messages
+			}).Return(nil, nil)
+	}
+
+	ctx := context.Background()
+	expectSubmit()
+	require.NoError(t, v.SubmitValidatorRegistrations(ctx, km))
+	require.Equal(t, 1, len(submitted))
+	first := submitted[0]
+	require.DeepEqual(t, pubKeys[0][:], first.Message.Pubkey)
+	require.DeepEqual(t, feeRecipient.Bytes(), first.Message.FeeRecipient)
+	require.Equal(t, uint64(35000000), first.Message.GasLimit)
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, signing.VerifySigningRoot(first.Message, first.Message.Pubkey, first.Signature, d))
+
+	// Unchanged preferences reuse the persisted registration instead of signing a new one.
+	expectSubmit()
+	require.NoError(t, v.SubmitValidatorRegistrations(ctx, km))
+	require.DeepEqual(t, first, submitted[0])
+
+	// A new gas limit in the validator database is signed again.
+	require.NoError(t, v.db.SaveGasLimitByPubKey(ctx, pubKeys[0], 40000000))
+	expectSubmit()
+	require.NoError(t, v.SubmitValidatorRegistrations(ctx, km))
+	require.Equal(t, uint64(40000000), submitted[0].Message.GasLimit)
+	require.NoError(t, signing.VerifySigningRoot(submitted[0].Message, submitted[0].Message.Pubkey, submitted[0].Signature, d))
+	stored, ok, err := v.db.SignedValidatorRegistrationByPubKey(ctx, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, submitted[0], stored)
+}
+
+func TestValidator_SubmitValidatorRegistrations_BuilderDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// No call is expected on the beacon node client.
+	v := validator{validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl)}
+	require.NoError(t, v.SubmitValidatorRegistrations(context.Background(), &mockKeymanager{}))
+}
@@ -114,6 +114,12 @@ func run(ctx context.Context, v iface.Validator) {
 		log.Fatalf("Could not get keymanager: %v", err)
 	}
 	sub := km.SubscribeAccountChanges(accountsChangedChan)
+	if err := v.PushProposerSettings(ctx, km); err != nil {
+		log.WithError(err).Warn("Could not send proposer settings to the beacon node")
+	}
+	if err := v.SubmitValidatorRegistrations(ctx, km); err != nil {
+		log.WithError(err).Warn("Could not submit validator registrations to the beacon node")
+	}
 	for {
 		slotCtx, cancel := context.WithCancel(ctx)
 		ctx, span := trace.StartSpan(ctx, "validator.processSlot")
@@ -183,6 +189,19 @@ func run(ctx context.Context, v iface.Validator) {
 				go v.UpdateDomainDataCaches(ctx, slot+1)
 			}
 
+			// Send the fee recipients and builder registrations to the beacon node every epoch, picking up changes
+			// to the proposer settings file and validators which were activated since the last update.
+			if slots.IsEpochStart(slot) {
+				go func() {
+					if err := v.PushProposerSettings(ctx, km); err != nil {
+						log.WithError(err).Warn("Could not send proposer settings to the beacon node")
+					}
+					if err := v.SubmitValidatorRegistrations(ctx, km); err != nil {
+						log.WithError(err).Warn("Could not submit validator registrations to the beacon node")
+					}
+				}()
+			}
+
 			var wg sync.WaitGroup
 
 			allRoles, err := v.RolesAt(ctx, slot)
@@ -12,20 +12,25 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
 	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
 	"github.com/prysmaticlabs/prysm/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	beaconApi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	remote_web3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -51,8 +56,10 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
+	enableBuilder         bool
 	interopKeysConfig     *local.InteropKeymanagerConfig
 	conn                  *grpc.ClientConn
+	nodeClient            ethpb.NodeClient
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -60,9 +67,12 @@ type ValidatorService struct {
 	walletInitializedFeed *event.Feed
 	wallet                *wallet.Wallet
 	graffitiStruct        *graffiti.Graffiti
+	proposerSettings      *proposer.Settings
+	proposerSettingsFile  string
 	dataDir               string
 	withCert              string
 	endpoint              string
+	beaconApiEndpoint     string
 	ctx                   context.Context
 	validator             iface.Validator
 	db                    db.Database
@@ -77,6 +87,7 @@ type Config struct {
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
 	LogDutyCountDown           bool
+	EnableBuilder              bool
 	InteropKeysConfig          *local.InteropKeymanagerConfig
 	Wallet                     *wallet.Wallet
 	WalletInitializedFeed      *event.Feed
@@ -84,13 +95,16 @@ type Config struct {
 	GrpcMaxCallRecvMsgSizeFlag int
 	GrpcRetryDelay             time.Duration
 	GraffitiStruct             *graffiti.Graffiti
+	ProposerSettings           *proposer.Settings
 	Validator                  iface.Validator
 	ValDB                      db.Database
 	CertFlag                   string
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiFlag               string
+	ProposerSettingsFile       string
 	Endpoint                   string
+	BeaconApiEndpoint          string
 	Web3SignerConfig           *remote_web3signer.SetupConfig
 }
 
@@ -102,6 +116,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		beaconApiEndpoint:     cfg.BeaconApiEndpoint,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -118,7 +133,10 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		interopKeysConfig:     cfg.InteropKeysConfig,
 		graffitiStruct:        cfg.GraffitiStruct,
+		proposerSettings:      cfg.ProposerSettings,
+		proposerSettingsFile:  cfg.ProposerSettingsFile,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		enableBuilder:         cfg.EnableBuilder,
 		web3SignerConfig:      cfg.Web3SignerConfig,
 	}, nil
 }
@@ -126,28 +144,67 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	dialOpts := ConstructDialOptions(
-		v.maxCallRecvMsgSize,
-		v.withCert,
-		v.grpcRetries,
-		v.grpcRetryDelay,
+	var (
+		validatorClient          ethpb.BeaconNodeValidatorClient
+		ethValidatorClient       ethpbservice.BeaconValidatorClient
+		beaconClient             ethpb.BeaconChainClient
+		ethBeaconClient          ethpbservice.BeaconChainClient
+		slashingProtectionClient ethpb.SlasherClient
+		nodeClient               ethpb.NodeClient
 	)
-	if dialOpts == nil {
-		return
-	}
+	if v.beaconApiEndpoint != "" {
+		if features.Get().RemoteSlasherProtection {
+			log.Error("Remote slashing protection is not supported with the beacon node REST API")
+			return
+		}
+		timeout := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+		client, err := beacon.NewClient(v.beaconApiEndpoint, beacon.WithTimeout(timeout))
+		if err != nil {
+			log.WithError(err).Error("Could not create beacon node REST API client")
+			return
+		}
+		validatorClient = beaconApi.NewValidatorClient(client)
+		ethValidatorClient = beaconApi.NewEthValidatorClient(client)
+		beaconClient = beaconApi.NewBeaconChainClient(client)
+		ethBeaconClient = beaconApi.NewEthBeaconChainClient(client)
+		nodeClient = beaconApi.NewNodeClient(client)
+		if v.logValidatorBalances {
+			log.Warn("Validator balance and performance logging is not supported with the beacon node REST API")
+			v.logValidatorBalances = false
+		}
+		log.WithField("endpoint", v.beaconApiEndpoint).Info("Using the beacon node REST API")
+	} else {
+		dialOpts := ConstructDialOptions(
+			v.maxCallRecvMsgSize,
+			v.withCert,
+			v.grpcRetries,
+			v.grpcRetryDelay,
+		)
+		if dialOpts == nil {
+			return
+		}
 
-	v.ctx = grpcutil.AppendHeaders(v.ctx, v.grpcHeaders)
+		v.ctx = grpcutil.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
-	}
-	if v.withCert != "" {
-		log.Info("Established secure gRPC connection")
+		conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+			return
+		}
+		if v.withCert != "" {
+			log.Info("Established secure gRPC connection")
+		}
+
+		v.conn = conn
+		validatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+		ethValidatorClient = ethpbservice.NewBeaconValidatorClient(v.conn)
+		beaconClient = ethpb.NewBeaconChainClient(v.conn)
+		ethBeaconClient = ethpbservice.NewBeaconChainClient(v.conn)
+		slashingProtectionClient = ethpb.NewSlasherClient(v.conn)
+		nodeClient = ethpb.NewNodeClient(v.conn)
 	}
+	v.nodeClient = nodeClient
 
-	v.conn = conn
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -177,10 +234,12 @@ func (v *ValidatorService) Start() {
 
 	valStruct := &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		slashingProtectionClient:       ethpb.NewSlasherClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                validatorClient,
+		ethValidatorClient:             ethValidatorClient,
+		beaconClient:                   beaconClient,
+		ethBeaconClient:                ethBeaconClient,
+		slashingProtectionClient:       slashingProtectionClient,
+		node:                           nodeClient,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
@@ -197,8 +256,11 @@ func (v *ValidatorService) Start() {
 		blockFeed:                      new(event.Feed),
 		graffitiStruct:                 v.graffitiStruct,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
+		proposerSettings:               v.proposerSettings,
+		proposerSettingsFile:           v.proposerSettingsFile,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		enableBuilder:                  v.enableBuilder,
 		Web3SignerConfig:               v.web3SignerConfig,
 		walletIntializedChannel:        make(chan *wallet.Wallet, 1),
 	}
@@ -228,7 +290,7 @@ func (v *ValidatorService) Stop() error {
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.nodeClient == nil {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -239,6 +301,20 @@ func (v *ValidatorService) InteropKeysConfig() *local.InteropKeymanagerConfig {
 	return v.interopKeysConfig
 }
 
+// Web3SignerConfig returns the web3signer config of the validator service.
+func (v *ValidatorService) Web3SignerConfig() *remote_web3signer.SetupConfig {
+	return v.web3SignerConfig
+}
+
+// ProposerSettings returns the proposer settings currently used by the validator client, or nil
+// if the validator client is not started yet.
+func (v *ValidatorService) ProposerSettings() *proposer.Settings {
+	if v.validator == nil {
+		return nil
+	}
+	return v.validator.ProposerSettings()
+}
+
 func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
 }
@@ -299,8 +375,10 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return false, errors.New("no connection to beacon RPC")
+	}
+	resp, err := v.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -310,6 +388,8 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return nil, errors.New("no connection to beacon RPC")
+	}
+	return v.nodeClient.GetGenesis(ctx, &emptypb.Empty{})
 }
@@ -70,6 +70,11 @@ func TestStatus_NoConnectionError(t *testing.T) {
 	assert.ErrorContains(t, "no connection", validatorService.Status())
 }
 
+func TestProposerSettings_NotStarted(t *testing.T) {
+	validatorService := &ValidatorService{}
+	assert.Equal(t, true, validatorService.ProposerSettings() == nil)
+}
+
 func TestStart_GrpcHeaders(t *testing.T) {
 	hook := logTest.NewGlobal()
 	// Use canceled context so that the run function exits immediately.
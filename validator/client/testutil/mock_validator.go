@@ -11,45 +11,49 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 )
 
 var _ iface.Validator = (*FakeValidator)(nil)
 
 // FakeValidator for mocking.
 type FakeValidator struct {
-	DoneCalled                        bool
-	WaitForWalletInitializationCalled bool
-	SlasherReadyCalled                bool
-	NextSlotCalled                    bool
-	UpdateDutiesCalled                bool
-	UpdateProtectionsCalled           bool
-	RoleAtCalled                      bool
-	AttestToBlockHeadCalled           bool
-	ProposeBlockCalled                bool
-	LogValidatorGainsAndLossesCalled  bool
-	SaveProtectionsCalled             bool
-	DeleteProtectionCalled            bool
-	SlotDeadlineCalled                bool
-	HandleKeyReloadCalled             bool
-	WaitForChainStartCalled           int
-	WaitForSyncCalled                 int
-	WaitForActivationCalled           int
-	CanonicalHeadSlotCalled           int
-	ReceiveBlocksCalled               int
-	RetryTillSuccess                  int
-	ProposeBlockArg1                  uint64
-	AttestToBlockHeadArg1             uint64
-	RoleAtArg1                        uint64
-	UpdateDutiesArg1                  uint64
-	NextSlotRet                       <-chan types.Slot
-	PublicKey                         string
-	UpdateDutiesRet                   error
-	RolesAtRet                        []iface.ValidatorRole
-	Balances                          map[[fieldparams.BLSPubkeyLength]byte]uint64
-	IndexToPubkeyMap                  map[uint64][fieldparams.BLSPubkeyLength]byte
-	PubkeyToIndexMap                  map[[fieldparams.BLSPubkeyLength]byte]uint64
-	PubkeysToStatusesMap              map[[fieldparams.BLSPubkeyLength]byte]ethpb.ValidatorStatus
-	Km                                keymanager.IKeymanager
+	DoneCalled                         bool
+	WaitForWalletInitializationCalled  bool
+	SlasherReadyCalled                 bool
+	NextSlotCalled                     bool
+	UpdateDutiesCalled                 bool
+	UpdateProtectionsCalled            bool
+	RoleAtCalled                       bool
+	AttestToBlockHeadCalled            bool
+	ProposeBlockCalled                 bool
+	LogValidatorGainsAndLossesCalled   bool
+	SaveProtectionsCalled              bool
+	DeleteProtectionCalled             bool
+	SlotDeadlineCalled                 bool
+	HandleKeyReloadCalled              bool
+	PushProposerSettingsCalled         int
+	SubmitValidatorRegistrationsCalled int
+	WaitForChainStartCalled            int
+	WaitForSyncCalled                  int
+	WaitForActivationCalled            int
+	CanonicalHeadSlotCalled            int
+	ReceiveBlocksCalled                int
+	RetryTillSuccess                   int
+	ProposeBlockArg1                   uint64
+	AttestToBlockHeadArg1              uint64
+	RoleAtArg1                         uint64
+	UpdateDutiesArg1                   uint64
+	NextSlotRet                        <-chan types.Slot
+	PublicKey                          string
+	UpdateDutiesRet                    error
+	RolesAtRet                         []iface.ValidatorRole
+	ProposerSettingsRet                *proposer.Settings
+	Balances                           map[[fieldparams.BLSPubkeyLength]byte]uint64
+	IndexToPubkeyMap                   map[uint64][fieldparams.BLSPubkeyLength]byte
+	PubkeyToIndexMap                   map[[fieldparams.BLSPubkeyLength]byte]uint64
+	PubkeysToStatusesMap               map[[fieldparams.BLSPubkeyLength]byte]ethpb.ValidatorStatus
+	Km                                 keymanager.IKeymanager
 }
 
 type ctxKey string
@@ -224,6 +228,23 @@ func (_ *FakeValidator) CheckDoppelGanger(_ context.Context) error {
 	return nil
 }
 
+// PushProposerSettings for mocking
+func (fv *FakeValidator) PushProposerSettings(_ context.Context, _ keymanager.IKeymanager) error {
+	fv.PushProposerSettingsCalled++
+	return nil
+}
+
+// SubmitValidatorRegistrations for mocking
+func (fv *FakeValidator) SubmitValidatorRegistrations(_ context.Context, _ keymanager.IKeymanager) error {
+	fv.SubmitValidatorRegistrationsCalled++
+	return nil
+}
+
+// ProposerSettings for mocking
+func (fv *FakeValidator) ProposerSettings() *proposer.Settings {
+	return fv.ProposerSettingsRet
+}
+
 // ReceiveBlocks for mocking
 func (fv *FakeValidator) ReceiveBlocks(_ context.Context, connectionErrorChannel chan<- error) {
 	fv.ReceiveBlocksCalled++
@@ -25,6 +25,8 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
@@ -38,8 +40,11 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	remote_web3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -55,16 +60,22 @@ var (
 	msgNoKeysFetched     = "No validating keys fetched. Trying again"
 )
 
+// errLivenessUnknown is returned when the beacon node has not followed the chain long enough to know
+// the liveness of the validators in the requested epoch.
+var errLivenessUnknown = errors.New("beacon node does not know the liveness of validators yet")
+
 type validator struct {
 	logValidatorBalances               bool
 	useWeb                             bool
 	emitAccountMetrics                 bool
 	logDutyCountDown                   bool
+	enableBuilder                      bool
 	domainDataLock                     sync.Mutex
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
+	proposerSettingsLock               sync.Mutex
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
 	walletInitializedFeed              *event.Feed
 	attLogs                            map[[32]byte]*attSubmitted
@@ -80,13 +91,17 @@ type validator struct {
 	interopKeysConfig                  *local.InteropKeymanagerConfig
 	wallet                             *wallet.Wallet
 	graffitiStruct                     *graffiti.Graffiti
+	proposerSettings                   *proposer.Settings
+	proposerSettingsFile               string
 	node                               ethpb.NodeClient
 	slashingProtectionClient           ethpb.SlasherClient
 	db                                 vdb.Database
 	beaconClient                       ethpb.BeaconChainClient
+	ethBeaconClient                    ethpbservice.BeaconChainClient
 	keyManager                         keymanager.IKeymanager
 	ticker                             slots.Ticker
 	validatorClient                    ethpb.BeaconNodeValidatorClient
+	ethValidatorClient                 ethpbservice.BeaconValidatorClient
 	graffiti                           []byte
 	voteStats                          voteStats
 	Web3SignerConfig                   *remote_web3signer.SetupConfig
@@ -443,7 +458,12 @@ func (v *validator) SlotDeadline(slot types.Slot) time.Time {
 }
 
 // CheckDoppelGanger checks if the current actively provided keys have
-// any duplicates active in the network.
+// any duplicates active in the network. The beacon node is asked, through the
+// standard liveness endpoint, whether our validators were seen attesting or proposing
+// in the current or previous epoch. Activity in epochs up to the latest target epoch
+// in our own attestation history is attributed to this validator client. While the
+// beacon node has not followed the chain long enough to know the liveness of these
+// epochs, the check is retried at the start of the next epoch.
 func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	if !features.Get().EnableDoppelGanger {
 		return nil
@@ -457,56 +477,115 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	if len(pubkeys) == 0 {
 		return nil
 	}
-	req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-	for _, pkey := range pubkeys {
+	req := &ethpbv1.StateValidatorsRequest{StateId: []byte("head"), Id: make([][]byte, len(pubkeys))}
+	for i, pkey := range pubkeys {
 		copiedKey := pkey
-		attRec, err := v.db.AttestationHistoryForPubKey(ctx, copiedKey)
+		req.Id[i] = copiedKey[:]
+	}
+	// Validators unknown to the beacon chain are not returned, as they can not have been live.
+	resp, err := v.ethBeaconClient.ListValidators(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator indices")
+	}
+
+	indices := make([]types.ValidatorIndex, 0, len(resp.Data))
+	pubkeyByIndex := make(map[types.ValidatorIndex][]byte, len(resp.Data))
+	// Latest target epoch signed by this validator client, for validators with attestation history.
+	latestTarget := make(map[types.ValidatorIndex]types.Epoch)
+	for _, val := range resp.Data {
+		if val == nil || val.Validator == nil {
+			return errors.New("beacon node returned malformed validators response")
+		}
+		idx, pkey := val.Index, val.Validator.Pubkey
+		indices = append(indices, idx)
+		pubkeyByIndex[idx] = pkey
+		attRec, err := v.db.AttestationHistoryForPubKey(ctx, bytesutil.ToBytes48(pkey))
 		if err != nil {
 			return err
 		}
 		if len(attRec) == 0 {
-			// If no history exists we simply send in a zero
-			// value for the request epoch and root.
-			req.ValidatorRequests = append(req.ValidatorRequests,
-				&ethpb.DoppelGangerRequest_ValidatorRequest{
-					PublicKey:  copiedKey[:],
-					Epoch:      0,
-					SignedRoot: make([]byte, fieldparams.RootLength),
-				})
 			continue
 		}
 		r := retrieveLatestRecord(attRec)
-		if copiedKey != r.PubKey {
+		if bytesutil.ToBytes48(pkey) != r.PubKey {
 			return errors.New("attestation record mismatched public key")
 		}
-		req.ValidatorRequests = append(req.ValidatorRequests,
-			&ethpb.DoppelGangerRequest_ValidatorRequest{
-				PublicKey:  r.PubKey[:],
-				Epoch:      r.Target,
-				SignedRoot: r.SigningRoot[:],
-			})
+		latestTarget[idx] = r.Target
 	}
-	resp, err := v.validatorClient.CheckDoppelGanger(ctx, req)
-	if err != nil {
-		return err
+	if len(indices) == 0 {
+		return nil
 	}
-	// If nothing is returned by the beacon node, we return an
-	// error as it is unsafe for us to proceed.
-	if resp == nil || resp.Responses == nil || len(resp.Responses) == 0 {
-		return errors.New("beacon node returned 0 responses for doppelganger check")
+
+	for {
+		currentEpoch := slots.ToEpoch(slots.CurrentSlot(v.genesisTime))
+		duplicates, err := v.liveDuplicates(ctx, currentEpoch, indices, pubkeyByIndex, latestTarget)
+		if err == nil {
+			return buildDuplicateError(duplicates)
+		}
+		if !errors.Is(err, errLivenessUnknown) {
+			return err
+		}
+		log.WithError(err).Info("Waiting for the next epoch to check for doppelgangers")
+		nextEpochStart, err := slots.EpochStart(currentEpoch + 1)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-time.After(time.Until(slots.StartTime(v.genesisTime, nextEpochStart))):
+		case <-ctx.Done():
+			return errors.New("context canceled")
+		}
 	}
-	return buildDuplicateError(resp.Responses)
 }
 
-func buildDuplicateError(response []*ethpb.DoppelGangerResponse_ValidatorResponse) error {
+// liveDuplicates returns the public keys of the given validators which were live in the current or previous
+// epoch without this validator client having signed for them. errLivenessUnknown is returned while the beacon
+// node does not know the liveness of these epochs yet.
+func (v *validator) liveDuplicates(
+	ctx context.Context,
+	currentEpoch types.Epoch,
+	indices []types.ValidatorIndex,
+	pubkeyByIndex map[types.ValidatorIndex][]byte,
+	latestTarget map[types.ValidatorIndex]types.Epoch,
+) ([][]byte, error) {
+	epochs := []types.Epoch{currentEpoch}
+	if currentEpoch > 0 {
+		epochs = append([]types.Epoch{currentEpoch - 1}, epochs...)
+	}
 	duplicates := make([][]byte, 0)
-	for _, valRes := range response {
-		if valRes.DuplicateExists {
-			copiedKey := [fieldparams.BLSPubkeyLength]byte{}
-			copy(copiedKey[:], valRes.PublicKey)
-			duplicates = append(duplicates, copiedKey[:])
+	seen := make(map[types.ValidatorIndex]bool)
+	for _, epoch := range epochs {
+		liveness, err := v.ethValidatorClient.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: epoch, Index: indices})
+		if status.Code(err) == codes.Unavailable {
+			return nil, errors.Wrapf(errLivenessUnknown, "epoch %d: %v", epoch, err)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get liveness for epoch %d", epoch)
+		}
+		// If nothing is returned by the beacon node, we return an
+		// error as it is unsafe for us to proceed.
+		if liveness == nil || len(liveness.Data) == 0 {
+			return nil, errors.New("beacon node returned 0 responses for doppelganger check")
+		}
+		for _, l := range liveness.Data {
+			if !l.IsLive || seen[l.Index] {
+				continue
+			}
+			if target, ok := latestTarget[l.Index]; ok && l.Epoch <= target {
+				continue
+			}
+			pkey, ok := pubkeyByIndex[l.Index]
+			if !ok {
+				continue
+			}
+			seen[l.Index] = true
+			duplicates = append(duplicates, pkey)
 		}
 	}
+	return duplicates, nil
+}
+
+func buildDuplicateError(duplicates [][]byte) error {
 	if len(duplicates) == 0 {
 		return nil
 	}
@@ -606,6 +685,8 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 	subscribeSlots := make([]types.Slot, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
 	subscribeCommitteeIndices := make([]types.CommitteeIndex, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
 	subscribeIsAggregator := make([]bool, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
+	subscribeValidatorIndices := make([]types.ValidatorIndex, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
+	subscribeCommitteesAtSlot := make([]uint64, 0, len(res.CurrentEpochDuties)+len(res.NextEpochDuties))
 	alreadySubscribed := make(map[[64]byte]bool)
 
 	for _, duty := range res.CurrentEpochDuties {
@@ -630,6 +711,8 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 			subscribeSlots = append(subscribeSlots, attesterSlot)
 			subscribeCommitteeIndices = append(subscribeCommitteeIndices, committeeIndex)
 			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
+			subscribeValidatorIndices = append(subscribeValidatorIndices, duty.ValidatorIndex)
+			subscribeCommitteesAtSlot = append(subscribeCommitteesAtSlot, duty.CommitteesAtSlot)
 		}
 	}
 
@@ -654,13 +737,17 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 			subscribeSlots = append(subscribeSlots, attesterSlot)
 			subscribeCommitteeIndices = append(subscribeCommitteeIndices, committeeIndex)
 			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
+			subscribeValidatorIndices = append(subscribeValidatorIndices, duty.ValidatorIndex)
+			subscribeCommitteesAtSlot = append(subscribeCommitteesAtSlot, duty.CommitteesAtSlot)
 		}
 	}
 
 	_, err := v.validatorClient.SubscribeCommitteeSubnets(ctx, &ethpb.CommitteeSubnetsSubscribeRequest{
-		Slots:        subscribeSlots,
-		CommitteeIds: subscribeCommitteeIndices,
-		IsAggregator: subscribeIsAggregator,
+		Slots:            subscribeSlots,
+		CommitteeIds:     subscribeCommitteeIndices,
+		IsAggregator:     subscribeIsAggregator,
+		ValidatorIndices: subscribeValidatorIndices,
+		CommitteesAtSlot: subscribeCommitteesAtSlot,
 	})
 
 	return err
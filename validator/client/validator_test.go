@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"errors"
-	"fmt"
 	"io/ioutil"
 	"math"
 	"sync"
@@ -19,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -34,6 +34,8 @@ import (
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -1034,24 +1036,6 @@ func TestService_ReceiveBlocks_SetHighest(t *testing.T) {
 	require.Equal(t, slot, v.highestValidSlot)
 }
 
-type doppelGangerRequestMatcher struct {
-	req *ethpb.DoppelGangerRequest
-}
-
-var _ gomock.Matcher = (*doppelGangerRequestMatcher)(nil)
-
-func (m *doppelGangerRequestMatcher) Matches(x interface{}) bool {
-	r, ok := x.(*ethpb.DoppelGangerRequest)
-	if !ok {
-		panic("Invalid match type")
-	}
-	return gomock.InAnyOrder(m.req.ValidatorRequests).Matches(r.ValidatorRequests)
-}
-
-func (m *doppelGangerRequestMatcher) String() string {
-	return fmt.Sprintf("%#v", m.req.ValidatorRequests)
-}
-
 func TestValidator_CheckDoppelGanger(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1059,6 +1043,71 @@ func TestValidator_CheckDoppelGanger(t *testing.T) {
 	flgs.EnableDoppelGanger = true
 	reset := features.InitWithReset(flgs)
 	defer reset()
+
+	currentEpoch := types.Epoch(13)
+	epochDuration := uint64(params.BeaconConfig().SlotsPerEpoch) * params.BeaconConfig().SecondsPerSlot
+	genesisTime := uint64(time.Now().Unix()) - uint64(currentEpoch)*epochDuration
+
+	// setup saves an attestation with the given target for each key, unless target is nil, and returns
+	// a validator whose beacon node reports the keys at indices 0..n-1 and the given liveness.
+	setup := func(t *testing.T, numKeys int, target *types.Epoch, status ethpb.ValidatorStatus, live map[types.Epoch]map[types.ValidatorIndex]bool) *validator {
+		beaconClient := mock2.NewMockEthBeaconChainClient(ctrl)
+		ethClient := mock2.NewMockBeaconValidatorClient(ctrl)
+		km := genMockKeymanager(numKeys)
+		keys, err := km.FetchValidatingPublicKeys(context.Background())
+		require.NoError(t, err)
+		db := dbTest.SetupDB(t, keys)
+		validatorsResp := &ethpbv1.StateValidatorsResponse{}
+		indices := make([]types.ValidatorIndex, 0, len(keys))
+		for i, k := range keys {
+			pkey := k
+			if target != nil {
+				att := createAttestation(*target-2, *target)
+				rt, err := att.Data.HashTreeRoot()
+				require.NoError(t, err)
+				require.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
+			}
+			// Validators unknown to the beacon chain are left out of the response.
+			if status == ethpb.ValidatorStatus_UNKNOWN_STATUS {
+				continue
+			}
+			validatorsResp.Data = append(validatorsResp.Data, &ethpbv1.ValidatorContainer{
+				Index:     types.ValidatorIndex(i),
+				Status:    ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+				Validator: &ethpbv1.Validator{Pubkey: pkey[:]},
+			})
+			indices = append(indices, types.ValidatorIndex(i))
+		}
+		beaconClient.EXPECT().ListValidators(
+			gomock.Any(), // ctx
+			gomock.Any(), // request
+		).Return(validatorsResp, nil /*err*/)
+		if status != ethpb.ValidatorStatus_UNKNOWN_STATUS {
+			for _, epoch := range []types.Epoch{currentEpoch - 1, currentEpoch} {
+				resp := &ethpbv1.GetLivenessResponse{}
+				for _, idx := range indices {
+					resp.Data = append(resp.Data, &ethpbv1.GetLivenessResponse_Liveness{Index: idx, Epoch: epoch, IsLive: live[epoch][idx]})
+				}
+				ethClient.EXPECT().GetLiveness(
+					gomock.Any(), // ctx
+					&ethpbv1.GetLivenessRequest{Epoch: epoch, Index: indices},
+				).Return(resp, nil /*err*/)
+			}
+		}
+		return &validator{
+			ethBeaconClient:    beaconClient,
+			ethValidatorClient: ethClient,
+			keyManager:         km,
+			db:                 db,
+			genesisTime:        genesisTime,
+		}
+	}
+	previousTarget := currentEpoch - 1
+	allLive := make(map[types.ValidatorIndex]bool)
+	for i := types.ValidatorIndex(0); i < 10; i++ {
+		allLive[i] = true
+	}
+
 	tests := []struct {
 		name            string
 		validatorSetter func(t *testing.T) *validator
@@ -1067,181 +1116,114 @@ func TestValidator_CheckDoppelGanger(t *testing.T) {
 		{
 			name: "no doppelganger",
 			validatorSetter: func(t *testing.T) *validator {
-				client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
-				km := genMockKeymanager(10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-				for _, k := range keys {
-					pkey := k
-					att := createAttestation(10, 12)
-					rt, err := att.Data.HashTreeRoot()
-					assert.NoError(t, err)
-					assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-					resp.ValidatorRequests = append(resp.ValidatorRequests, &ethpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-					req.ValidatorRequests = append(req.ValidatorRequests, &ethpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(nil, nil /*err*/)
-
-				return v
+				// Liveness in the previous epoch is our own, as we attested to it before restarting.
+				return setup(t, 10, &previousTarget, ethpb.ValidatorStatus_ACTIVE, map[types.Epoch]map[types.ValidatorIndex]bool{
+					currentEpoch - 1: allLive,
+				})
 			},
 		},
 		{
 			name: "multiple doppelganger exists",
 			validatorSetter: func(t *testing.T) *validator {
-				client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
-				km := genMockKeymanager(10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &ethpb.DoppelGangerResponse{Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{}}
-				for i, k := range keys {
-					pkey := k
-					att := createAttestation(10, 12)
-					rt, err := att.Data.HashTreeRoot()
-					assert.NoError(t, err)
-					assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-					if i%3 == 0 {
-						resp.Responses = append(resp.Responses, &ethpb.DoppelGangerResponse_ValidatorResponse{PublicKey: pkey[:], DuplicateExists: true})
-					}
-					req.ValidatorRequests = append(req.ValidatorRequests, &ethpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(resp, nil /*err*/)
-				return v
+				return setup(t, 10, &previousTarget, ethpb.ValidatorStatus_ACTIVE, map[types.Epoch]map[types.ValidatorIndex]bool{
+					currentEpoch - 1: allLive,
+					currentEpoch:     {0: true, 3: true, 6: true, 9: true},
+				})
 			},
 			err: "Duplicate instances exists in the network for validator keys",
 		},
 		{
 			name: "single doppelganger exists",
 			validatorSetter: func(t *testing.T) *validator {
-				client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
-				km := genMockKeymanager(10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &ethpb.DoppelGangerResponse{Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{}}
-				for i, k := range keys {
-					pkey := k
-					att := createAttestation(10, 12)
-					rt, err := att.Data.HashTreeRoot()
-					assert.NoError(t, err)
-					assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-					if i%9 == 0 {
-						resp.Responses = append(resp.Responses, &ethpb.DoppelGangerResponse_ValidatorResponse{PublicKey: pkey[:], DuplicateExists: true})
-					}
-					req.ValidatorRequests = append(req.ValidatorRequests, &ethpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(resp, nil /*err*/)
-				return v
+				return setup(t, 10, &previousTarget, ethpb.ValidatorStatus_ACTIVE, map[types.Epoch]map[types.ValidatorIndex]bool{
+					currentEpoch: {9: true},
+				})
 			},
 			err: "Duplicate instances exists in the network for validator keys",
 		},
 		{
-			name: "multiple attestations saved",
+			name: "no history and live",
 			validatorSetter: func(t *testing.T) *validator {
-				client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
-				km := genMockKeymanager(10)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-				resp := &ethpb.DoppelGangerResponse{Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{}}
-				attLimit := 5
-				for i, k := range keys {
-					pkey := k
-					for j := 0; j < attLimit; j++ {
-						att := createAttestation(10+types.Epoch(j), 12+types.Epoch(j))
-						rt, err := att.Data.HashTreeRoot()
-						assert.NoError(t, err)
-						assert.NoError(t, db.SaveAttestationForPubKey(context.Background(), pkey, rt, att))
-						if j == attLimit-1 {
-							req.ValidatorRequests = append(req.ValidatorRequests, &ethpb.DoppelGangerRequest_ValidatorRequest{PublicKey: pkey[:], Epoch: att.Data.Target.Epoch, SignedRoot: rt[:]})
-						}
-					}
-					if i%3 == 0 {
-						resp.Responses = append(resp.Responses, &ethpb.DoppelGangerResponse_ValidatorResponse{PublicKey: pkey[:], DuplicateExists: true})
-					}
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(),                     // ctx
-					&doppelGangerRequestMatcher{req}, // request
-				).Return(resp, nil /*err*/)
-				return v
+				return setup(t, 1, nil, ethpb.ValidatorStatus_ACTIVE, map[types.Epoch]map[types.ValidatorIndex]bool{
+					currentEpoch - 1: {0: true},
+				})
 			},
 			err: "Duplicate instances exists in the network for validator keys",
 		},
 		{
 			name: "no history exists",
 			validatorSetter: func(t *testing.T) *validator {
-				client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
-				// Use only 1 key for deterministic order.
-				km := genMockKeymanager(1)
-				keys, err := km.FetchValidatingPublicKeys(context.Background())
-				assert.NoError(t, err)
-				db := dbTest.SetupDB(t, keys)
-				resp := &ethpb.DoppelGangerResponse{Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{}}
-				req := &ethpb.DoppelGangerRequest{ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{}}
-				for _, k := range keys {
-					resp.Responses = append(resp.Responses, &ethpb.DoppelGangerResponse_ValidatorResponse{PublicKey: k[:], DuplicateExists: false})
-					req.ValidatorRequests = append(req.ValidatorRequests, &ethpb.DoppelGangerRequest_ValidatorRequest{PublicKey: k[:], SignedRoot: make([]byte, 32), Epoch: 0})
-				}
-				v := &validator{
-					validatorClient: client,
-					keyManager:      km,
-					db:              db,
-				}
-				client.EXPECT().CheckDoppelGanger(
-					gomock.Any(), // ctx
-					req,          // request
-				).Return(resp, nil /*err*/)
-				return v
+				return setup(t, 1, nil, ethpb.ValidatorStatus_ACTIVE, nil)
+			},
+		},
+		{
+			name: "unknown validators",
+			validatorSetter: func(t *testing.T) *validator {
+				return setup(t, 10, nil, ethpb.ValidatorStatus_UNKNOWN_STATUS, nil)
 			},
-			err: "",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			v := tt.validatorSetter(t)
-			if err := v.CheckDoppelGanger(context.Background()); tt.err != "" {
+			err := v.CheckDoppelGanger(context.Background())
+			if tt.err != "" {
 				assert.ErrorContains(t, tt.err, err)
+			} else {
+				assert.NoError(t, err)
 			}
 		})
 	}
 }
 
+func TestValidator_CheckDoppelGanger_WaitsForLiveness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	flgs := features.Get()
+	flgs.EnableDoppelGanger = true
+	reset := features.InitWithReset(flgs)
+	defer reset()
+	// Use one second epochs, so that the check does not wait long for the next epoch.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SecondsPerSlot = 1
+	cfg.SlotsPerEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+
+	km := genMockKeymanager(1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	beaconClient := mock2.NewMockEthBeaconChainClient(ctrl)
+	beaconClient.EXPECT().ListValidators(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+	).Return(&ethpbv1.StateValidatorsResponse{Data: []*ethpbv1.ValidatorContainer{
+		{Index: 0, Validator: &ethpbv1.Validator{Pubkey: keys[0][:]}},
+	}}, nil /*err*/)
+	ethClient := mock2.NewMockBeaconValidatorClient(ctrl)
+	// The beacon node has just started and does not know the liveness of the previous epoch.
+	ethClient.EXPECT().GetLiveness(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+	).Return(nil, status.Error(codes.Unavailable, "liveness is not known"))
+	ethClient.EXPECT().GetLiveness(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+	).DoAndReturn(func(_ context.Context, req *ethpbv1.GetLivenessRequest, _ ...grpc.CallOption) (*ethpbv1.GetLivenessResponse, error) {
+		return &ethpbv1.GetLivenessResponse{Data: []*ethpbv1.GetLivenessResponse_Liveness{
+			{Index: 0, Epoch: req.Epoch, IsLive: req.Epoch%2 == 0},
+		}}, nil
+	}).Times(2)
+	v := &validator{
+		ethBeaconClient:    beaconClient,
+		ethValidatorClient: ethClient,
+		keyManager:         km,
+		db:                 dbTest.SetupDB(t, keys),
+		genesisTime:        uint64(time.Now().Unix()) - 13,
+	}
+	require.ErrorContains(t, "Duplicate instances exists in the network for validator keys", v.CheckDoppelGanger(context.Background()))
+}
+
 func TestValidatorAttestationsAreOrdered(t *testing.T) {
 	km := genMockKeymanager(10)
 	keys, err := km.FetchValidatingPublicKeys(context.Background())
@@ -0,0 +1,439 @@
+// Package bundle exports and imports the state of a validator client, its slashing protection
+// history, wallet keystores and keymanager configuration, as a single encrypted archive which
+// can be used to move validators between machines.
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	history "github.com/prysmaticlabs/prysm/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const (
+	// Version of the bundle format.
+	Version = 1
+
+	metadataFileName           = "metadata.json"
+	slashingProtectionFileName = "slashing_protection.json"
+	walletDirName              = "wallet"
+	backupsDirName             = "backups"
+)
+
+// encryptedBundle is the file written by Export: a zip archive of the bundle contents encrypted
+// the same way as keystores.
+type encryptedBundle struct {
+	Version uint                   `json:"version"`
+	Crypto  map[string]interface{} `json:"crypto"`
+}
+
+// metadata of a bundle, with the validator DB data not part of the EIP-3076 interchange format.
+type metadata struct {
+	GenesisValidatorsRoot string   `json:"genesis_validators_root"`
+	BlacklistedPublicKeys []string `json:"blacklisted_public_keys"`
+}
+
+// Export writes the slashing protection history of the validator DB, as EIP-3076 interchange
+// JSON, and the files of the wallet at walletDir to w, as a bundle encrypted with the password.
+func Export(ctx context.Context, validatorDB db.Database, walletDir, password string, w io.Writer) error {
+	interchangeJSON, err := history.ExportStandardProtectionJSON(ctx, validatorDB)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history")
+	}
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get blacklisted public keys")
+	}
+	md := &metadata{
+		GenesisValidatorsRoot: interchangeJSON.Metadata.GenesisValidatorsRoot,
+		BlacklistedPublicKeys: make([]string, len(blacklisted)),
+	}
+	for i, pubKey := range blacklisted {
+		md.BlacklistedPublicKeys[i] = fmt.Sprintf("%#x", pubKey)
+	}
+
+	w8, err := wallet.OpenWallet(ctx, &wallet.Config{WalletDir: walletDir})
+	if err != nil {
+		return errors.Wrapf(err, "could not open wallet at path %s", walletDir)
+	}
+
+	buf := new(bytes.Buffer)
+	zipWriter := zip.NewWriter(buf)
+	if err := writeJSON(zipWriter, metadataFileName, md); err != nil {
+		return err
+	}
+	if err := writeJSON(zipWriter, slashingProtectionFileName, interchangeJSON); err != nil {
+		return err
+	}
+	if err := writeWalletFiles(zipWriter, walletDir, w8.AccountsDir()); err != nil {
+		return errors.Wrap(err, "could not add wallet files to bundle")
+	}
+	if err := zipWriter.Close(); err != nil {
+		return errors.Wrap(err, "could not write bundle archive")
+	}
+
+	cryptoFields, err := keystorev4.New().Encrypt(buf.Bytes(), password)
+	if err != nil {
+		return errors.Wrap(err, "could not encrypt bundle")
+	}
+	encoded, err := json.MarshalIndent(&encryptedBundle{Version: Version, Crypto: cryptoFields}, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal bundle")
+	}
+	_, err = w.Write(encoded)
+	return err
+}
+
+// Import decrypts a bundle written by Export, imports its slashing protection history into the
+// validator DB and writes its wallet files to walletDir. Nothing is imported if a wallet already
+// exists at walletDir, if the bundle is for another chain than the DB, or if the DB has a
+// more recent proposal or attestation than the bundle for any of its public keys. The wallet
+// files are validated and written to a temporary directory before the DB is changed, and moved
+// into walletDir once the DB import succeeds.
+func Import(ctx context.Context, validatorDB db.Database, walletDir, password string, r io.Reader) error {
+	files, err := decrypt(r, password)
+	if err != nil {
+		return err
+	}
+	md := &metadata{}
+	if err := readJSON(files, metadataFileName, md); err != nil {
+		return err
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := readJSON(files, slashingProtectionFileName, interchangeJSON); err != nil {
+		return err
+	}
+	wFiles, accountsDirName, err := walletFiles(files)
+	if err != nil {
+		return err
+	}
+	blacklisted := make([][fieldparams.BLSPubkeyLength]byte, len(md.BlacklistedPublicKeys))
+	for i, k := range md.BlacklistedPublicKeys {
+		if blacklisted[i], err = history.PubKeyFromHex(k); err != nil {
+			return errors.Wrapf(err, "%s is not a valid public key", k)
+		}
+	}
+
+	exists, err := wallet.Exists(walletDir)
+	if err != nil {
+		return errors.Wrap(err, wallet.CheckExistsErrMsg)
+	}
+	if exists {
+		return fmt.Errorf("a wallet already exists at path %s", walletDir)
+	}
+	if err := checkNoNewerHistory(ctx, validatorDB, interchangeJSON); err != nil {
+		return err
+	}
+
+	stagingDir, err := stageWalletDir(wFiles, walletDir)
+	if err != nil {
+		return err
+	}
+	defer removeStagingDir(stagingDir)
+
+	if err := checkGenesisValidatorsRoot(ctx, validatorDB, md.GenesisValidatorsRoot); err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(interchangeJSON)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal slashing protection history")
+	}
+	if err := history.ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(encoded)); err != nil {
+		return errors.Wrap(err, "could not import slashing protection history")
+	}
+	if err := validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, blacklisted); err != nil {
+		return errors.Wrap(err, "could not save blacklisted public keys")
+	}
+	accountsDir := filepath.Join(walletDir, accountsDirName)
+	if err := os.Rename(filepath.Join(stagingDir, accountsDirName), accountsDir); err != nil {
+		return errors.Wrapf(err, "could not move wallet files to %s", accountsDir)
+	}
+	return nil
+}
+
+func writeJSON(zipWriter *zip.Writer, name string, v interface{}) error {
+	encoded, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal %s", name)
+	}
+	f, err := zipWriter.Create(name)
+	if err != nil {
+		return errors.Wrapf(err, "could not add %s to bundle", name)
+	}
+	_, err = f.Write(encoded)
+	return err
+}
+
+func readJSON(files map[string][]byte, name string, v interface{}) error {
+	encoded, ok := files[name]
+	if !ok {
+		return fmt.Errorf("bundle does not contain %s", name)
+	}
+	return errors.Wrapf(json.Unmarshal(encoded, v), "could not unmarshal %s", name)
+}
+
+// writeWalletFiles adds the files of the accounts directory of a wallet to the bundle, except
+// for a validator DB and its backups kept in the wallet.
+func writeWalletFiles(zipWriter *zip.Writer, walletDir, accountsDir string) error {
+	return filepath.Walk(accountsDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == backupsDirName {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Name() == kv.ProtectionDbFileName {
+			return nil
+		}
+		rel, err := filepath.Rel(walletDir, p)
+		if err != nil {
+			return err
+		}
+		data, err := file.ReadFileAsBytes(p)
+		if err != nil {
+			return err
+		}
+		f, err := zipWriter.Create(path.Join(walletDirName, filepath.ToSlash(rel)))
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	})
+}
+
+// walletFiles returns the wallet files of the bundle by their slash separated path relative to
+// the wallet directory, and the name of the accounts directory they are in. The files must all
+// be in the accounts directory of one keymanager kind, which must contain the keymanager
+// configuration.
+func walletFiles(files map[string][]byte) (map[string][]byte, string, error) {
+	wFiles := make(map[string][]byte)
+	var accountsDirName string
+	for name, data := range files {
+		if !strings.HasPrefix(name, walletDirName+"/") {
+			continue
+		}
+		rel := path.Clean(strings.TrimPrefix(name, walletDirName+"/"))
+		if path.IsAbs(rel) || rel == ".." || strings.HasPrefix(rel, "../") {
+			return nil, "", fmt.Errorf("invalid wallet file path %s in bundle", name)
+		}
+		parts := strings.SplitN(rel, "/", 2)
+		if len(parts) != 2 {
+			return nil, "", fmt.Errorf("wallet file %s in bundle is not in an accounts directory", name)
+		}
+		if _, err := keymanager.ParseKind(parts[0]); err != nil {
+			return nil, "", errors.Wrapf(err, "wallet file %s in bundle is not in an accounts directory", name)
+		}
+		if accountsDirName != "" && parts[0] != accountsDirName {
+			return nil, "", errors.New("bundle contains the wallet files of more than one keymanager")
+		}
+		accountsDirName = parts[0]
+		wFiles[rel] = data
+	}
+	if len(wFiles) == 0 {
+		return nil, "", errors.New("bundle does not contain wallet files")
+	}
+	if _, ok := wFiles[path.Join(accountsDirName, wallet.KeymanagerConfigFileName)]; !ok {
+		return nil, "", fmt.Errorf("bundle does not contain the %s file of the wallet", wallet.KeymanagerConfigFileName)
+	}
+	return wFiles, accountsDirName, nil
+}
+
+// stageWalletDir writes the wallet files to a temporary directory within walletDir, so that they
+// can be moved into place once the rest of the bundle is imported. The validator DB may be in
+// walletDir, which is then not replaced as a whole.
+func stageWalletDir(wFiles map[string][]byte, walletDir string) (string, error) {
+	if err := file.MkdirAll(walletDir); err != nil {
+		return "", errors.Wrapf(err, "could not create wallet directory %s", walletDir)
+	}
+	stagingDir, err := ioutil.TempDir(walletDir, ".import-bundle-")
+	if err != nil {
+		return "", errors.Wrap(err, "could not create temporary wallet directory")
+	}
+	for rel, data := range wFiles {
+		p := filepath.Join(stagingDir, filepath.FromSlash(rel))
+		if err := file.MkdirAll(filepath.Dir(p)); err != nil {
+			removeStagingDir(stagingDir)
+			return "", errors.Wrapf(err, "could not create directory for %s", p)
+		}
+		if err := file.WriteFile(p, data); err != nil {
+			removeStagingDir(stagingDir)
+			return "", errors.Wrapf(err, "could not write %s", p)
+		}
+	}
+	return stagingDir, nil
+}
+
+func removeStagingDir(stagingDir string) {
+	if err := os.RemoveAll(stagingDir); err != nil {
+		log.WithError(err).Errorf("Could not remove temporary wallet directory %s", stagingDir)
+	}
+}
+
+func decrypt(r io.Reader, password string) (map[string][]byte, error) {
+	encoded, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read bundle")
+	}
+	b := &encryptedBundle{}
+	if err := json.Unmarshal(encoded, b); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal bundle")
+	}
+	if b.Version != Version {
+		return nil, fmt.Errorf("bundle version %d is not supported, wanted %d", b.Version, Version)
+	}
+	decrypted, err := keystorev4.New().Decrypt(b.Crypto, password)
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return nil, errors.New("wrong bundle password")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt bundle")
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(decrypted), int64(len(decrypted)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read bundle archive")
+	}
+	files := make(map[string][]byte, len(zipReader.File))
+	for _, f := range zipReader.File {
+		rc, err := f.Open()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not open %s in bundle", f.Name)
+		}
+		data, err := ioutil.ReadAll(rc)
+		if closeErr := rc.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read %s in bundle", f.Name)
+		}
+		files[f.Name] = data
+	}
+	return files, nil
+}
+
+func checkGenesisValidatorsRoot(ctx context.Context, validatorDB db.Database, rootHex string) error {
+	root, err := history.RootFromHex(rootHex)
+	if err != nil {
+		return errors.Wrapf(err, "%s is not a valid genesis validators root", rootHex)
+	}
+	dbRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis validators root from DB")
+	}
+	if dbRoot == nil {
+		return errors.Wrap(validatorDB.SaveGenesisValidatorsRoot(ctx, root[:]), "could not save genesis validators root")
+	}
+	if !bytes.Equal(dbRoot, root[:]) {
+		return fmt.Errorf("bundle genesis validators root %#x does not match the DB's %#x", root, dbRoot)
+	}
+	return nil
+}
+
+// checkNoNewerHistory returns an error if the DB has a proposal at a later slot, or an attestation
+// with a later target epoch, than the bundle for a public key of the bundle. Importing the bundle
+// would then lose the latest signing history of the key.
+func checkNoNewerHistory(ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat) error {
+	var newer []string
+	for _, data := range interchangeJSON.Data {
+		pubKey, err := history.PubKeyFromHex(data.Pubkey)
+		if err != nil {
+			return errors.Wrapf(err, "%s is not a valid public key", data.Pubkey)
+		}
+		bundleSlot, hasBlocks, err := highestBundleSlot(data.SignedBlocks)
+		if err != nil {
+			return err
+		}
+		dbSlot, exists, err := validatorDB.HighestSignedProposal(ctx, pubKey)
+		if err != nil {
+			return errors.Wrapf(err, "could not get highest signed proposal for public key %s", data.Pubkey)
+		}
+		if exists && (!hasBlocks || dbSlot > bundleSlot) {
+			newer = append(newer, data.Pubkey)
+			continue
+		}
+
+		bundleTarget, hasAtts, err := highestBundleTarget(data.SignedAttestations)
+		if err != nil {
+			return err
+		}
+		atts, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+		if err != nil {
+			return errors.Wrapf(err, "could not get attestation history for public key %s", data.Pubkey)
+		}
+		for _, att := range atts {
+			if !hasAtts || att.Target > bundleTarget {
+				newer = append(newer, data.Pubkey)
+				break
+			}
+		}
+	}
+	if len(newer) > 0 {
+		sort.Strings(newer)
+		return fmt.Errorf(
+			"the validator DB has newer signing history than the bundle for public keys %s",
+			strings.Join(newer, ", "),
+		)
+	}
+	return nil
+}
+
+func highestBundleSlot(blocks []*format.SignedBlock) (types.Slot, bool, error) {
+	var highest types.Slot
+	var found bool
+	for _, b := range blocks {
+		if b == nil {
+			continue
+		}
+		slot, err := history.SlotFromString(b.Slot)
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "%s is not a valid slot", b.Slot)
+		}
+		if !found || slot > highest {
+			highest = slot
+		}
+		found = true
+	}
+	return highest, found, nil
+}
+
+func highestBundleTarget(atts []*format.SignedAttestation) (types.Epoch, bool, error) {
+	var highest types.Epoch
+	var found bool
+	for _, a := range atts {
+		if a == nil {
+			continue
+		}
+		target, err := history.EpochFromString(a.TargetEpoch)
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "%s is not a valid epoch", a.TargetEpoch)
+		}
+		if !found || target > highest {
+			highest = target
+		}
+		found = true
+	}
+	return highest, found, nil
+}
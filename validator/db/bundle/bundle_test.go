@@ -0,0 +1,221 @@
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	history "github.com/prysmaticlabs/prysm/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
+	valtest "github.com/prysmaticlabs/prysm/validator/testing"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const password = "Passw0rdz2020%"
+
+func attestation(source, target types.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+// exportSource exports a bundle from a validator DB and wallet, and returns it with the
+// slashing protection history of the DB. The DB is closed, as only one can be open at once.
+func exportSource(t *testing.T, pubKeys [][fieldparams.BLSPubkeyLength]byte) ([]byte, *format.EIPSlashingProtectionFormat) {
+	ctx := context.Background()
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, bytes.Repeat([]byte{1}, 32)))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 10, bytes.Repeat([]byte{2}, 32)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[1], [32]byte{3}, attestation(1, 2)))
+	require.NoError(t, validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, pubKeys[2:]))
+
+	walletDir := t.TempDir()
+	accountsDir := filepath.Join(walletDir, keymanager.Local.String())
+	require.NoError(t, file.MkdirAll(filepath.Join(accountsDir, "accounts")))
+	require.NoError(t, file.WriteFile(filepath.Join(accountsDir, "accounts", "all-accounts.keystore.json"), []byte("keystore")))
+	require.NoError(t, file.WriteFile(filepath.Join(accountsDir, wallet.KeymanagerConfigFileName), []byte("config")))
+	require.NoError(t, file.WriteFile(filepath.Join(accountsDir, "validator.db"), []byte("db")))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, Export(ctx, validatorDB, walletDir, password, buf))
+	interchangeJSON, err := history.ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	return buf.Bytes(), interchangeJSON
+}
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	b, sourceJSON := exportSource(t, pubKeys)
+	assert.Equal(t, false, bytes.Contains(b, []byte("keystore")))
+
+	targetDB := dbtest.SetupDB(t, nil)
+	targetWalletDir := filepath.Join(t.TempDir(), "wallet")
+	require.ErrorContains(t, "wrong bundle password", Import(ctx, targetDB, targetWalletDir, "wrong", bytes.NewReader(b)))
+	require.NoError(t, Import(ctx, targetDB, targetWalletDir, password, bytes.NewReader(b)))
+
+	targetJSON, err := history.ExportStandardProtectionJSON(ctx, targetDB)
+	require.NoError(t, err)
+	assert.DeepEqual(t, sourceJSON, targetJSON)
+	blacklisted, err := targetDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKeys[2:], blacklisted)
+
+	accountsDir := filepath.Join(targetWalletDir, keymanager.Local.String())
+	keystore, err := file.ReadFileAsBytes(filepath.Join(accountsDir, "accounts", "all-accounts.keystore.json"))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("keystore"), keystore)
+	config, err := file.ReadFileAsBytes(filepath.Join(accountsDir, wallet.KeymanagerConfigFileName))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("config"), config)
+	assert.Equal(t, false, file.FileExists(filepath.Join(accountsDir, "validator.db")))
+
+	// The wallet is not overwritten.
+	require.ErrorContains(t, "a wallet already exists", Import(ctx, targetDB, targetWalletDir, password, bytes.NewReader(b)))
+}
+
+func TestImport_RefusesNewerHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	b, _ := exportSource(t, pubKeys)
+
+	pubKeyHex := func(pubKey [fieldparams.BLSPubkeyLength]byte) string {
+		return fmt.Sprintf("%#x", pubKey)
+	}
+
+	t.Run("newer proposal", func(t *testing.T) {
+		targetDB := dbtest.SetupDB(t, nil)
+		require.NoError(t, targetDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 11, bytes.Repeat([]byte{2}, 32)))
+		err := Import(ctx, targetDB, filepath.Join(t.TempDir(), "wallet"), password, bytes.NewReader(b))
+		require.ErrorContains(t, "newer signing history than the bundle for public keys "+pubKeyHex(pubKeys[0]), err)
+	})
+	t.Run("newer attestation", func(t *testing.T) {
+		targetDB := dbtest.SetupDB(t, nil)
+		require.NoError(t, targetDB.SaveAttestationForPubKey(ctx, pubKeys[1], [32]byte{4}, attestation(2, 3)))
+		walletDir := filepath.Join(t.TempDir(), "wallet")
+		err := Import(ctx, targetDB, walletDir, password, bytes.NewReader(b))
+		require.ErrorContains(t, "newer signing history than the bundle for public keys "+pubKeyHex(pubKeys[1]), err)
+		// Nothing is imported.
+		proposals, err := targetDB.ProposalHistoryForPubKey(ctx, pubKeys[0])
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(proposals))
+		assert.Equal(t, false, file.FileExists(filepath.Join(walletDir, keymanager.Local.String(), wallet.KeymanagerConfigFileName)))
+	})
+	t.Run("older history", func(t *testing.T) {
+		targetDB := dbtest.SetupDB(t, nil)
+		require.NoError(t, targetDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 9, bytes.Repeat([]byte{2}, 32)))
+		require.NoError(t, targetDB.SaveAttestationForPubKey(ctx, pubKeys[1], [32]byte{4}, attestation(0, 1)))
+		require.NoError(t, Import(ctx, targetDB, filepath.Join(t.TempDir(), "wallet"), password, bytes.NewReader(b)))
+	})
+}
+
+// encryptFiles writes a bundle of the given files, as Export does.
+func encryptFiles(t *testing.T, files map[string][]byte) []byte {
+	buf := new(bytes.Buffer)
+	zipWriter := zip.NewWriter(buf)
+	for name, data := range files {
+		f, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+	cryptoFields, err := keystorev4.New().Encrypt(buf.Bytes(), password)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(&encryptedBundle{Version: Version, Crypto: cryptoFields})
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestImport_InvalidWalletFiles(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := valtest.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	b, _ := exportSource(t, pubKeys)
+	files, err := decrypt(bytes.NewReader(b), password)
+	require.NoError(t, err)
+	accountsDir := path.Join(walletDirName, keymanager.Local.String())
+
+	tests := []struct {
+		name    string
+		update  func(files map[string][]byte)
+		wantErr string
+	}{
+		{
+			name: "no keymanager configuration",
+			update: func(files map[string][]byte) {
+				delete(files, path.Join(accountsDir, wallet.KeymanagerConfigFileName))
+			},
+			wantErr: "bundle does not contain the " + wallet.KeymanagerConfigFileName,
+		},
+		{
+			name: "path outside of the wallet",
+			update: func(files map[string][]byte) {
+				files[walletDirName+"/../file"] = []byte("data")
+			},
+			wantErr: "invalid wallet file path",
+		},
+		{
+			name: "file outside of an accounts directory",
+			update: func(files map[string][]byte) {
+				files[path.Join(walletDirName, "file")] = []byte("data")
+			},
+			wantErr: "is not in an accounts directory",
+		},
+		{
+			name: "several accounts directories",
+			update: func(files map[string][]byte) {
+				files[path.Join(walletDirName, keymanager.Derived.String(), wallet.KeymanagerConfigFileName)] = []byte("config")
+			},
+			wantErr: "more than one keymanager",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invalid := make(map[string][]byte, len(files))
+			for name, data := range files {
+				invalid[name] = data
+			}
+			tt.update(invalid)
+			targetDB := dbtest.SetupDB(t, nil)
+			walletDir := filepath.Join(t.TempDir(), "wallet")
+			err := Import(ctx, targetDB, walletDir, password, bytes.NewReader(encryptFiles(t, invalid)))
+			require.ErrorContains(t, tt.wantErr, err)
+
+			// Nothing is imported.
+			root, err := targetDB.GenesisValidatorsRoot(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 0, len(root))
+			proposals, err := targetDB.ProposalHistoryForPubKey(ctx, pubKeys[0])
+			require.NoError(t, err)
+			assert.Equal(t, 0, len(proposals))
+			_, err = os.Stat(walletDir)
+			assert.Equal(t, true, os.IsNotExist(err))
+		})
+	}
+}
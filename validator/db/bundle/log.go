@@ -0,0 +1,5 @@
+package bundle
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "bundle")
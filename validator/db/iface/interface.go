@@ -5,6 +5,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/ethereum/go-ethereum/common"
 	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
@@ -62,4 +63,16 @@ type ValidatorDB interface {
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
+
+	// Proposer settings related methods.
+	FeeRecipientByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool, error)
+	SaveFeeRecipientByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, feeRecipient common.Address) error
+	DeleteFeeRecipientByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
+	GasLimitByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (uint64, bool, error)
+	SaveGasLimitByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, gasLimit uint64) error
+	DeleteGasLimitByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
+
+	// Builder registration related methods.
+	SignedValidatorRegistrationByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*ethpb.SignedValidatorRegistrationV1, bool, error)
+	SaveSignedValidatorRegistration(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, reg *ethpb.SignedValidatorRegistrationV1) error
 }
@@ -0,0 +1,85 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// FeeRecipientByPubKey returns the fee recipient set for a validator public key through the
+// key management API, and false if none was set.
+func (s *Store) FeeRecipientByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool, error) {
+	_, span := trace.StartSpan(ctx, "Validator.FeeRecipientByPubKey")
+	defer span.End()
+	var feeRecipient common.Address
+	var exists bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(feeRecipientBucket).Get(pubKey[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		feeRecipient = common.BytesToAddress(enc)
+		exists = true
+		return nil
+	})
+	return feeRecipient, exists, err
+}
+
+// SaveFeeRecipientByPubKey stores the fee recipient of a validator public key.
+func (s *Store) SaveFeeRecipientByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, feeRecipient common.Address) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveFeeRecipientByPubKey")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(feeRecipientBucket).Put(pubKey[:], feeRecipient.Bytes())
+	})
+}
+
+// DeleteFeeRecipientByPubKey removes the fee recipient of a validator public key, if any.
+func (s *Store) DeleteFeeRecipientByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	_, span := trace.StartSpan(ctx, "Validator.DeleteFeeRecipientByPubKey")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(feeRecipientBucket).Delete(pubKey[:])
+	})
+}
+
+// GasLimitByPubKey returns the execution gas limit set for a validator public key through the
+// key management API, and false if none was set.
+func (s *Store) GasLimitByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (uint64, bool, error) {
+	_, span := trace.StartSpan(ctx, "Validator.GasLimitByPubKey")
+	defer span.End()
+	var gasLimit uint64
+	var exists bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(gasLimitBucket).Get(pubKey[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		gasLimit = bytesutil.BytesToUint64BigEndian(enc)
+		exists = true
+		return nil
+	})
+	return gasLimit, exists, err
+}
+
+// SaveGasLimitByPubKey stores the execution gas limit of a validator public key.
+func (s *Store) SaveGasLimitByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, gasLimit uint64) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveGasLimitByPubKey")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(gasLimitBucket).Put(pubKey[:], bytesutil.Uint64ToBytesBigEndian(gasLimit))
+	})
+}
+
+// DeleteGasLimitByPubKey removes the execution gas limit of a validator public key, if any.
+func (s *Store) DeleteGasLimitByPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	_, span := trace.StartSpan(ctx, "Validator.DeleteGasLimitByPubKey")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(gasLimitBucket).Delete(pubKey[:])
+	})
+}
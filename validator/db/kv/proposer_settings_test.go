@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_FeeRecipientByPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	_, ok, err := db.FeeRecipientByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+
+	want := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	require.NoError(t, db.SaveFeeRecipientByPubKey(ctx, pubKey, want))
+	got, ok, err := db.FeeRecipientByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	require.Equal(t, want, got)
+
+	require.NoError(t, db.DeleteFeeRecipientByPubKey(ctx, pubKey))
+	_, ok, err = db.FeeRecipientByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+}
+
+func TestStore_GasLimitByPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	_, ok, err := db.GasLimitByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+
+	require.NoError(t, db.SaveGasLimitByPubKey(ctx, pubKey, 35000000))
+	got, ok, err := db.GasLimitByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	require.Equal(t, uint64(35000000), got)
+
+	require.NoError(t, db.DeleteGasLimitByPubKey(ctx, pubKey))
+	_, ok, err = db.GasLimitByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+}
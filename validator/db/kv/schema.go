@@ -37,4 +37,11 @@ var (
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
+
+	// Proposer settings set through the key management API, keyed by validator public key.
+	feeRecipientBucket = []byte("fee-recipient")
+	gasLimitBucket     = []byte("gas-limit")
+
+	// Signed builder registrations, keyed by validator public key.
+	validatorRegistrationBucket = []byte("validator-registration")
 )
@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"context"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SignedValidatorRegistrationByPubKey returns the last builder registration signed for a validator
+// public key, and false if none was signed yet.
+func (s *Store) SignedValidatorRegistrationByPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte,
+) (*ethpb.SignedValidatorRegistrationV1, bool, error) {
+	_, span := trace.StartSpan(ctx, "Validator.SignedValidatorRegistrationByPubKey")
+	defer span.End()
+	var reg *ethpb.SignedValidatorRegistrationV1
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(validatorRegistrationBucket).Get(pubKey[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		reg = &ethpb.SignedValidatorRegistrationV1{}
+		return reg.UnmarshalSSZ(enc)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return reg, reg != nil, nil
+}
+
+// SaveSignedValidatorRegistration stores the builder registration signed for a validator public key,
+// so that it is not signed again after a restart unless the registered preferences change.
+func (s *Store) SaveSignedValidatorRegistration(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, reg *ethpb.SignedValidatorRegistrationV1,
+) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveSignedValidatorRegistration")
+	defer span.End()
+	enc, err := reg.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(validatorRegistrationBucket).Put(pubKey[:], enc)
+	})
+}
@@ -0,0 +1,42 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_SignedValidatorRegistrationByPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	_, ok, err := db.SignedValidatorRegistrationByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+
+	want := &ethpb.SignedValidatorRegistrationV1{
+		Message: &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: bytesutil.PadTo([]byte{2}, fieldparams.FeeRecipientLength),
+			GasLimit:     30000000,
+			Timestamp:    1650000000,
+			Pubkey:       pubKey[:],
+		},
+		Signature: bytesutil.PadTo([]byte{3}, fieldparams.BLSSignatureLength),
+	}
+	require.NoError(t, db.SaveSignedValidatorRegistration(ctx, pubKey, want))
+	got, ok, err := db.SignedValidatorRegistrationByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, want, got)
+
+	want.Message.GasLimit = 35000000
+	require.NoError(t, db.SaveSignedValidatorRegistration(ctx, pubKey, want))
+	got, _, err = db.SignedValidatorRegistrationByPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, got)
+}
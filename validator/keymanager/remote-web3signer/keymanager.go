@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -12,6 +14,8 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer/internal"
 	v1 "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer/v1"
@@ -33,6 +37,12 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// KeyFilePath is the file where the public keys added and deleted through the keymanager API are
+	// persisted. The provided public keys are merged with the keys in the file, except for keys deleted
+	// through the API, so that they stay deleted after a restart. It is not used together with a public
+	// keys URL, which remains the only source of keys in that case.
+	KeyFilePath string
 }
 
 // Keymanager defines the web3signer keymanager.
@@ -41,8 +51,11 @@ type Keymanager struct {
 	genesisValidatorsRoot []byte
 	publicKeysURL         string
 	providedPublicKeys    [][48]byte
+	deletedPublicKeys     [][48]byte
+	keyFilePath           string
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
+	lock                  sync.RWMutex
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
@@ -53,27 +66,36 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.PublicKeysURL != "" && len(cfg.ProvidedPublicKeys) != 0 {
 		return nil, errors.New("Either a provided list of public keys or a URL to a list of public keys must be provided, but not both")
 	}
-	if cfg.PublicKeysURL == "" && len(cfg.ProvidedPublicKeys) == 0 {
+	if cfg.PublicKeysURL == "" && len(cfg.ProvidedPublicKeys) == 0 && cfg.KeyFilePath == "" {
 		return nil, errors.New("no valid public key options provided")
 	}
 	client, err := internal.NewApiClient(cfg.BaseEndpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
 		publicKeysURL:         cfg.PublicKeysURL,
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
-	}, nil
+	}
+	if cfg.PublicKeysURL == "" {
+		km.keyFilePath = cfg.KeyFilePath
+		if err := km.loadKeyFile(); err != nil {
+			return nil, errors.Wrapf(err, "could not load public keys from %s", cfg.KeyFilePath)
+		}
+	}
+	return km, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
 // or provides the existing keys in the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
 	if km.publicKeysURL != "" && len(km.providedPublicKeys) == 0 {
 		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
 		if err != nil {
@@ -82,11 +104,270 @@ func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldpa
 		}
 		km.providedPublicKeys = providedPublicKeys
 	}
-	return km.providedPublicKeys, nil
+	keys := make([][fieldparams.BLSPubkeyLength]byte, len(km.providedPublicKeys))
+	copy(keys, km.providedPublicKeys)
+	return keys, nil
+}
+
+// AddPublicKeys adds public keys of validators held by the web3signer, so that the validator client starts
+// performing duties for them. The new set of keys is persisted to the key file and sent to subscribers of
+// account changes.
+func (km *Keymanager) AddPublicKeys(_ context.Context, pubKeys [][fieldparams.BLSPubkeyLength]byte) ([]*ethpbservice.ImportedRemoteKeysStatus, error) {
+	km.lock.Lock()
+	statuses, changed, err := km.addPublicKeys(pubKeys)
+	km.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	// Subscribers may fetch the keys when notified, so the lock must be released before sending.
+	if changed != nil {
+		km.accountsChangedFeed.Send(changed)
+	}
+	return statuses, nil
+}
+
+// addPublicKeys returns the import statuses and the new set of keys, or nil if no key was imported.
+// The caller must hold the lock.
+func (km *Keymanager) addPublicKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) ([]*ethpbservice.ImportedRemoteKeysStatus, [][fieldparams.BLSPubkeyLength]byte, error) {
+	statuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(pubKeys))
+	if km.publicKeysURL != "" {
+		for i := range statuses {
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_ERROR,
+				Message: "Public keys are loaded from a URL and cannot be changed through the keymanager",
+			}
+		}
+		return statuses, nil, nil
+	}
+	existing := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(km.providedPublicKeys))
+	for _, pk := range km.providedPublicKeys {
+		existing[pk] = true
+	}
+	keys := km.providedPublicKeys
+	imported := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(pubKeys))
+	for i, pk := range pubKeys {
+		if existing[pk] {
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_DUPLICATE,
+				Message: fmt.Sprintf("Duplicate public key %#x", pk),
+			}
+			continue
+		}
+		existing[pk] = true
+		imported[pk] = true
+		keys = append(keys, pk)
+		statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{Status: ethpbservice.ImportedRemoteKeysStatus_IMPORTED}
+	}
+	if len(imported) == 0 {
+		return statuses, nil, nil
+	}
+	// Keys added back through the API are no longer considered deleted.
+	deleted := make([][fieldparams.BLSPubkeyLength]byte, 0, len(km.deletedPublicKeys))
+	for _, pk := range km.deletedPublicKeys {
+		if !imported[pk] {
+			deleted = append(deleted, pk)
+		}
+	}
+	changed, err := km.updateKeys(keys, deleted)
+	if err != nil {
+		return nil, nil, err
+	}
+	return statuses, changed, nil
+}
+
+// DeletePublicKeys removes public keys of validators held by the web3signer, so that the validator client
+// stops performing duties for them. The new set of keys is persisted to the key file and sent to subscribers
+// of account changes.
+func (km *Keymanager) DeletePublicKeys(_ context.Context, pubKeys [][fieldparams.BLSPubkeyLength]byte) ([]*ethpbservice.DeletedRemoteKeysStatus, error) {
+	km.lock.Lock()
+	statuses, changed, err := km.deletePublicKeys(pubKeys)
+	km.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	// Subscribers may fetch the keys when notified, so the lock must be released before sending.
+	if changed != nil {
+		km.accountsChangedFeed.Send(changed)
+	}
+	return statuses, nil
+}
+
+// deletePublicKeys returns the deletion statuses and the new set of keys, or nil if no key was deleted.
+// The caller must hold the lock.
+func (km *Keymanager) deletePublicKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) ([]*ethpbservice.DeletedRemoteKeysStatus, [][fieldparams.BLSPubkeyLength]byte, error) {
+	statuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(pubKeys))
+	if km.publicKeysURL != "" {
+		for i := range statuses {
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
+				Status:  ethpbservice.DeletedRemoteKeysStatus_ERROR,
+				Message: "Public keys are loaded from a URL and cannot be changed through the keymanager",
+			}
+		}
+		return statuses, nil, nil
+	}
+	toDelete := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(pubKeys))
+	for _, pk := range pubKeys {
+		toDelete[pk] = true
+	}
+	existing := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(km.providedPublicKeys))
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(km.providedPublicKeys))
+	deleted := km.deletedPublicKeys
+	for _, pk := range km.providedPublicKeys {
+		existing[pk] = true
+		if toDelete[pk] {
+			deleted = append(deleted, pk)
+		} else {
+			keys = append(keys, pk)
+		}
+	}
+	for i, pk := range pubKeys {
+		if !existing[pk] {
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
+				Status:  ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND,
+				Message: fmt.Sprintf("Public key %#x not found", pk),
+			}
+			continue
+		}
+		statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{Status: ethpbservice.DeletedRemoteKeysStatus_DELETED}
+	}
+	if len(keys) == len(km.providedPublicKeys) {
+		return statuses, nil, nil
+	}
+	changed, err := km.updateKeys(keys, deleted)
+	if err != nil {
+		return nil, nil, err
+	}
+	return statuses, changed, nil
+}
+
+// updateKeys persists the new sets of keys and returns a copy of the keys to send to subscribers.
+// The caller must hold the lock.
+func (km *Keymanager) updateKeys(keys, deleted [][fieldparams.BLSPubkeyLength]byte) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	if km.keyFilePath != "" {
+		if err := km.writeKeyFile(keys, deleted); err != nil {
+			return nil, errors.Wrap(err, "could not persist public keys")
+		}
+	}
+	km.providedPublicKeys = keys
+	km.deletedPublicKeys = deleted
+	changed := make([][fieldparams.BLSPubkeyLength]byte, len(keys))
+	copy(changed, keys)
+	return changed, nil
+}
+
+// keyFileJson is the content of the key file, which is written on every change made through the
+// keymanager API.
+type keyFileJson struct {
+	PublicKeys        []string `json:"public_keys"`
+	DeletedPublicKeys []string `json:"deleted_public_keys"`
+}
+
+// loadKeyFile merges the keys found in the key file, if it exists, with the provided public keys. Provided
+// public keys which were deleted through the keymanager API are left out.
+func (km *Keymanager) loadKeyFile() error {
+	if km.keyFilePath == "" || !file.FileExists(km.keyFilePath) {
+		return nil
+	}
+	enc, err := file.ReadFileAsBytes(km.keyFilePath)
+	if err != nil {
+		return err
+	}
+	keyFile := &keyFileJson{}
+	if err := json.Unmarshal(enc, keyFile); err != nil {
+		return err
+	}
+	keys, err := decodePublicKeys(keyFile.PublicKeys)
+	if err != nil {
+		return err
+	}
+	deleted, err := decodePublicKeys(keyFile.DeletedPublicKeys)
+	if err != nil {
+		return err
+	}
+	existing := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(keys))
+	for _, pk := range keys {
+		existing[pk] = true
+	}
+	isDeleted := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(deleted))
+	for _, pk := range deleted {
+		isDeleted[pk] = true
+	}
+	for _, pk := range km.providedPublicKeys {
+		if existing[pk] {
+			continue
+		}
+		if isDeleted[pk] {
+			log.WithField("publicKey", fmt.Sprintf("%#x", pk)).Warnf(
+				"Ignoring provided public key which was deleted through the keymanager API, as recorded in %s. "+
+					"Add it through the keymanager API to use it again",
+				km.keyFilePath,
+			)
+			continue
+		}
+		existing[pk] = true
+		keys = append(keys, pk)
+	}
+	km.providedPublicKeys = keys
+	km.deletedPublicKeys = deleted
+	return nil
+}
+
+func decodePublicKeys(hexKeys []string) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(hexKeys))
+	existing := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(hexKeys))
+	for _, hexKey := range hexKeys {
+		decoded, err := hexutil.Decode(hexKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", hexKey)
+		}
+		if len(decoded) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("public key %s has length %d, expected %d", hexKey, len(decoded), fieldparams.BLSPubkeyLength)
+		}
+		pk := bytesutil.ToBytes48(decoded)
+		if existing[pk] {
+			continue
+		}
+		existing[pk] = true
+		keys = append(keys, pk)
+	}
+	return keys, nil
+}
+
+func encodePublicKeys(keys [][fieldparams.BLSPubkeyLength]byte) []string {
+	hexKeys := make([]string, len(keys))
+	for i := range keys {
+		hexKeys[i] = hexutil.Encode(keys[i][:])
+	}
+	return hexKeys
+}
+
+func (km *Keymanager) writeKeyFile(keys, deleted [][fieldparams.BLSPubkeyLength]byte) error {
+	enc, err := json.MarshalIndent(&keyFileJson{
+		PublicKeys:        encodePublicKeys(keys),
+		DeletedPublicKeys: encodePublicKeys(deleted),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(km.keyFilePath)
+	hasDir, err := file.HasDir(dir)
+	if err != nil {
+		return err
+	}
+	if !hasDir {
+		if err := file.MkdirAll(dir); err != nil {
+			return err
+		}
+	}
+	return file.WriteFile(km.keyFilePath, enc)
 }
 
 // Sign signs the message by using a remote web3signer server.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
+	if request != nil && !km.hasPublicKey(bytesutil.ToBytes48(request.PublicKey)) {
+		erroredResponsesTotal.Inc()
+		return nil, fmt.Errorf("public key %#x is not managed by this keymanager", request.PublicKey)
+	}
 	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
 	if err != nil {
 		erroredResponsesTotal.Inc()
@@ -98,6 +379,22 @@ func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest
 	return km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
 }
 
+// hasPublicKey returns true if the public key was provided to the keymanager. Keys loaded from a public keys
+// URL are not checked, as they may not have been fetched yet.
+func (km *Keymanager) hasPublicKey(pubKey [fieldparams.BLSPubkeyLength]byte) bool {
+	if km.publicKeysURL != "" {
+		return true
+	}
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	for _, pk := range km.providedPublicKeys {
+		if pk == pubKey {
+			return true
+		}
+	}
+	return false
+}
+
 // getSignRequestJson returns a json request based on the SignRequest type.
 func getSignRequestJson(ctx context.Context, validator *validator.Validate, request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (internal.SignRequestJson, error) {
 	if request == nil {
@@ -220,17 +517,24 @@ func getSignRequestJson(ctx context.Context, validator *validator.Validate, requ
 		}
 		syncCommitteeContributionAndProofSignRequestsTotal.Inc()
 		return json.Marshal(contributionAndProofRequest)
+	case *validatorpb.SignRequest_Registration:
+		validatorRegistrationRequest, err := v1.GetValidatorRegistrationSignRequest(request)
+		if err != nil {
+			return nil, err
+		}
+		if err = validator.StructCtx(ctx, validatorRegistrationRequest); err != nil {
+			return nil, err
+		}
+		validatorRegistrationSignRequestsTotal.Inc()
+		return json.Marshal(validatorRegistrationRequest)
 	default:
 		return nil, fmt.Errorf("web3signer sign request type %T not supported", request.Object)
 	}
 }
 
-// SubscribeAccountChanges returns the event subscription for changes to public keys.
-func (*Keymanager) SubscribeAccountChanges(_ chan [][48]byte) event.Subscription {
-	// Not used right now.
-	// Returns a stub for the time being as there is a danger of being slashed if the apiClient reloads keys dynamically.
-	// Because there is no way to dynamically reload keys, add or remove remote keys we are returning a stub without any event updates for the time being.
-	return event.NewSubscription(func(i <-chan struct{}) error {
-		return nil
-	})
+// SubscribeAccountChanges returns the event subscription for changes to public keys, which are made
+// through AddPublicKeys and DeletePublicKeys. Keys fetched from a public keys URL are never reloaded,
+// as there is a danger of being slashed if the remote list changes underneath the validator client.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
 }
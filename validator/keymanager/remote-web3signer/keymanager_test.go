@@ -4,16 +4,20 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer/internal"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer/v1/mock"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -153,6 +157,14 @@ func TestKeymanager_Sign(t *testing.T) {
 			want:    desiredSig,
 			wantErr: false,
 		},
+		{
+			name: "VALIDATOR_REGISTRATION",
+			args: args{
+				request: mock.GetMockSignRequest("VALIDATOR_REGISTRATION"),
+			},
+			want:    desiredSig,
+			wantErr: false,
+		},
 		{
 			name: "VOLUNTARY_EXIT",
 			args: args{
@@ -266,3 +278,197 @@ func TestKeymanager_FetchValidatingPublicKeys_WithExternalURL_ThrowsError(t *tes
 	assert.Nil(t, resp)
 	assert.Equal(t, "could not get public keys from remote server url: http://example2.com/api/v1/eth2/publicKeys: mock error", fmt.Sprintf("%v", err))
 }
+
+func TestKeymanager_AddDeletePublicKeys(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	existing := bytesutil.ToBytes48([]byte("existing"))
+	added := bytesutil.ToBytes48([]byte("added"))
+	unknown := bytesutil.ToBytes48([]byte("unknown"))
+	keyFile := filepath.Join(t.TempDir(), "remote-keys.json")
+	config := &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    [][48]byte{existing},
+		KeyFilePath:           keyFile,
+	}
+	km, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+
+	changes := make(chan [][48]byte, 2)
+	sub := km.SubscribeAccountChanges(changes)
+	defer sub.Unsubscribe()
+
+	imported, err := km.AddPublicKeys(ctx, [][48]byte{existing, added})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_DUPLICATE, imported[0].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, imported[1].Status)
+	require.DeepEqual(t, [][48]byte{existing, added}, <-changes)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{existing, added}, keys)
+
+	// Keys added through the api are restored from the key file after a restart.
+	restarted, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	keys, err = restarted.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{existing, added}, keys)
+
+	deleted, err := km.DeletePublicKeys(ctx, [][48]byte{added, unknown})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, deleted[0].Status)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, deleted[1].Status)
+	require.DeepEqual(t, [][48]byte{existing}, <-changes)
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{existing}, keys)
+
+	// Deleted keys can no longer sign.
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: added[:]})
+	require.ErrorContains(t, "is not managed by this keymanager", err)
+}
+
+func TestKeymanager_DeleteProvidedPublicKey_PersistsAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	kept := bytesutil.ToBytes48([]byte("kept"))
+	deleted := bytesutil.ToBytes48([]byte("deleted"))
+	config := &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    [][48]byte{kept, deleted},
+		KeyFilePath:           filepath.Join(t.TempDir(), "remote-keys.json"),
+	}
+	km, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	statuses, err := km.DeletePublicKeys(ctx, [][48]byte{deleted})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, statuses[0].Status)
+
+	// The key is still provided through the flags, but stays deleted after a restart.
+	restarted, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	keys, err := restarted.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{kept}, keys)
+	_, err = restarted.Sign(ctx, &validatorpb.SignRequest{PublicKey: deleted[:]})
+	require.ErrorContains(t, "is not managed by this keymanager", err)
+	require.LogsContain(t, hook, "Ignoring provided public key which was deleted through the keymanager API")
+
+	// Adding the key back through the api removes it from the deleted keys.
+	imported, err := restarted.AddPublicKeys(ctx, [][48]byte{deleted})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, imported[0].Status)
+	restarted, err = NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	keys, err = restarted.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{kept, deleted}, keys)
+}
+
+func TestKeymanager_ProvidedPublicKeys_MergedWithKeyFile(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	provided := bytesutil.ToBytes48([]byte("provided"))
+	added := bytesutil.ToBytes48([]byte("added"))
+	config := &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    [][48]byte{provided},
+		KeyFilePath:           filepath.Join(t.TempDir(), "remote-keys.json"),
+	}
+	km, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	_, err = km.AddPublicKeys(ctx, [][48]byte{added})
+	require.NoError(t, err)
+
+	// A key provided through the flags after the key file was created is used after a restart.
+	newlyProvided := bytesutil.ToBytes48([]byte("newly provided"))
+	config.ProvidedPublicKeys = [][48]byte{provided, newlyProvided}
+	restarted, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	keys, err := restarted.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{provided, added, newlyProvided}, keys)
+}
+
+func TestKeymanager_DeletePublicKeys_NotFound(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "remote-keys.json")
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    [][48]byte{bytesutil.ToBytes48([]byte("existing"))},
+		KeyFilePath:           keyFile,
+	})
+	require.NoError(t, err)
+	changes := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(changes)
+	defer sub.Unsubscribe()
+
+	statuses, err := km.DeletePublicKeys(ctx, [][48]byte{bytesutil.ToBytes48([]byte("unknown"))})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, statuses[0].Status)
+	require.Equal(t, 0, len(changes))
+	require.Equal(t, false, file.FileExists(keyFile))
+}
+
+func TestKeymanager_AddDeletePublicKeys_SubscriberFetchesKeys(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	added := bytesutil.ToBytes48([]byte("added"))
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		KeyFilePath:           filepath.Join(t.TempDir(), "remote-keys.json"),
+	})
+	require.NoError(t, err)
+
+	// The subscriber fetches the keys on every change, as the validator client does, which must not
+	// block the next change.
+	changes := make(chan [][48]byte)
+	sub := km.SubscribeAccountChanges(changes)
+	defer sub.Unsubscribe()
+	fetched := make(chan [][48]byte, 2)
+	go func() {
+		for keys := range changes {
+			if _, err := km.FetchValidatingPublicKeys(ctx); err != nil {
+				return
+			}
+			fetched <- keys
+		}
+	}()
+	_, err = km.AddPublicKeys(ctx, [][48]byte{added})
+	require.NoError(t, err)
+	_, err = km.DeletePublicKeys(ctx, [][48]byte{added})
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{added}, <-fetched)
+	require.DeepEqual(t, [][48]byte{}, <-fetched)
+}
+
+func TestKeymanager_AddPublicKeys_WithExternalURL(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+		KeyFilePath:           filepath.Join(t.TempDir(), "remote-keys.json"),
+	})
+	require.NoError(t, err)
+	imported, err := km.AddPublicKeys(ctx, [][48]byte{bytesutil.ToBytes48([]byte("added"))})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_ERROR, imported[0].Status)
+	deleted, err := km.DeletePublicKeys(ctx, [][48]byte{bytesutil.ToBytes48([]byte("added"))})
+	require.NoError(t, err)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_ERROR, deleted[0].Status)
+}
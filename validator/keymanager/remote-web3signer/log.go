@@ -0,0 +1,5 @@
+package remote_web3signer
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "remote-web3signer-keymanager")
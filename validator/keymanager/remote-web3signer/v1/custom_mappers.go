@@ -412,3 +412,16 @@ func MapContributionAndProof(contribution *ethpb.ContributionAndProof) (*Contrib
 		},
 	}, nil
 }
+
+// MapValidatorRegistration maps the eth2.ValidatorRegistrationV1 proto to the Web3Signer spec.
+func MapValidatorRegistration(reg *ethpb.ValidatorRegistrationV1) (*ValidatorRegistration, error) {
+	if reg == nil {
+		return nil, fmt.Errorf("validator registration is nil")
+	}
+	return &ValidatorRegistration{
+		FeeRecipient: hexutil.Encode(reg.FeeRecipient),
+		GasLimit:     fmt.Sprint(reg.GasLimit),
+		Timestamp:    fmt.Sprint(reg.Timestamp),
+		Pubkey:       hexutil.Encode(reg.Pubkey),
+	}, nil
+}
@@ -379,6 +379,21 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 			},
 			SigningSlot: 0,
 		}
+	case "VALIDATOR_REGISTRATION":
+		return &validatorpb.SignRequest{
+			PublicKey:       make([]byte, fieldparams.BLSPubkeyLength),
+			SigningRoot:     make([]byte, fieldparams.RootLength),
+			SignatureDomain: make([]byte, 4),
+			Object: &validatorpb.SignRequest_Registration{
+				Registration: &eth.ValidatorRegistrationV1{
+					FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+					GasLimit:     0,
+					Timestamp:    0,
+					Pubkey:       make([]byte, fieldparams.BLSPubkeyLength),
+				},
+			},
+			SigningSlot: 0,
+		}
 	case "VOLUNTARY_EXIT":
 		return &validatorpb.SignRequest{
 			PublicKey:       make([]byte, fieldparams.BLSPubkeyLength),
@@ -509,6 +524,20 @@ func MockSyncCommitteeSelectionProofSignRequest() *v1.SyncCommitteeSelectionProo
 	}
 }
 
+// MockValidatorRegistrationSignRequest is a mock implementation of the ValidatorRegistrationSignRequest.
+func MockValidatorRegistrationSignRequest() *v1.ValidatorRegistrationSignRequest {
+	return &v1.ValidatorRegistrationSignRequest{
+		Type:        "VALIDATOR_REGISTRATION",
+		SigningRoot: hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		ValidatorRegistration: &v1.ValidatorRegistration{
+			FeeRecipient: hexutil.Encode(make([]byte, fieldparams.FeeRecipientLength)),
+			GasLimit:     "0",
+			Timestamp:    "0",
+			Pubkey:       hexutil.Encode(make([]byte, fieldparams.BLSPubkeyLength)),
+		},
+	}
+}
+
 // MockVoluntaryExitSignRequest is a mock implementation of the VoluntaryExitSignRequest.
 func MockVoluntaryExitSignRequest() *v1.VoluntaryExitSignRequest {
 	return &v1.VoluntaryExitSignRequest{
@@ -263,3 +263,23 @@ func GetSyncCommitteeContributionAndProofSignRequest(request *validatorpb.SignRe
 		ContributionAndProof: contribution,
 	}, nil
 }
+
+// GetValidatorRegistrationSignRequest maps the request for signing type VALIDATOR_REGISTRATION.
+func GetValidatorRegistrationSignRequest(request *validatorpb.SignRequest) (*ValidatorRegistrationSignRequest, error) {
+	validatorRegistration, ok := request.Object.(*validatorpb.SignRequest_Registration)
+	if !ok {
+		return nil, errors.New("failed to cast request object to validator registration")
+	}
+	if validatorRegistration == nil {
+		return nil, errors.New("invalid sign request: ValidatorRegistration is nil")
+	}
+	registration, err := MapValidatorRegistration(validatorRegistration.Registration)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistrationSignRequest{
+		Type:                  "VALIDATOR_REGISTRATION",
+		SigningRoot:           hexutil.Encode(request.SigningRoot),
+		ValidatorRegistration: registration,
+	}, nil
+}
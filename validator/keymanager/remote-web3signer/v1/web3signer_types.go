@@ -93,6 +93,13 @@ type SyncCommitteeContributionAndProofSignRequest struct {
 	ContributionAndProof *ContributionAndProof `json:"contribution_and_proof" validate:"required"`
 }
 
+// ValidatorRegistrationSignRequest is a request object for web3signer sign api.
+type ValidatorRegistrationSignRequest struct {
+	Type                  string                 `json:"type" validate:"required"`
+	SigningRoot           string                 `json:"signingRoot"`
+	ValidatorRegistration *ValidatorRegistration `json:"validator_registration" validate:"required"`
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // sub properties of Sign Requests /////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
@@ -310,6 +317,14 @@ type SyncCommitteeContribution struct {
 	Signature         string `json:"signature"`          /* 96 byte hexadecimal string */
 }
 
+// ValidatorRegistration a sub property of ValidatorRegistrationSignRequest.
+type ValidatorRegistration struct {
+	FeeRecipient string `json:"fee_recipient"` /* 20 byte hexadecimal string */
+	GasLimit     string `json:"gas_limit"`     /* uint64 */
+	Timestamp    string `json:"timestamp"`     /* uint64 */
+	Pubkey       string `json:"pubkey"`        /* 48 byte hexadecimal string */
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
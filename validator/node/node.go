@@ -43,6 +43,7 @@ import (
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	remote_web3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	validatorMiddleware "github.com/prysmaticlabs/prysm/validator/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/validator/web"
@@ -186,10 +187,10 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if !cliCtx.IsSet(flags.InteropNumValidators.Name) {
 		// Custom Check For Web3Signer
 		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) || cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
-			if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) && cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
+			if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 				c.wallet = wallet.NewWalletForWeb3Signer()
 			} else {
-				return errors.New("--validators-external-signer-url and --validators-external-signer-public-keys must be used together")
+				return errors.New("--validators-external-signer-public-keys must be used with --validators-external-signer-url")
 			}
 		} else {
 			w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
@@ -366,6 +367,7 @@ func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 
 func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	endpoint := c.cliCtx.String(flags.BeaconRPCProviderFlag.Name)
+	beaconApiEndpoint := c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name)
 	dataDir := c.cliCtx.String(cmd.DataDirFlag.Name)
 	logValidatorBalances := !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name)
 	emitAccountMetrics := !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name)
@@ -392,6 +394,15 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		}
 	}
 
+	var proposerSettings *proposer.Settings
+	proposerSettingsFile := c.cliCtx.String(flags.ProposerSettingsFileFlag.Name)
+	if proposerSettingsFile != "" {
+		proposerSettings, err = proposer.ParseSettingsFile(proposerSettingsFile)
+		if err != nil {
+			return errors.Wrap(err, "could not parse proposer settings file")
+		}
+	}
+
 	wsc, err := web3SignerConfig(c.cliCtx)
 	if err != nil {
 		return err
@@ -399,6 +410,7 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BeaconApiEndpoint:          beaconApiEndpoint,
 		DataDir:                    dataDir,
 		LogValidatorBalances:       logValidatorBalances,
 		EmitAccountMetrics:         emitAccountMetrics,
@@ -414,7 +426,10 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		Wallet:                     c.wallet,
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
+		ProposerSettingsFile:       proposerSettingsFile,
+		ProposerSettings:           proposerSettings,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		EnableBuilder:              c.cliCtx.Bool(flags.EnableBuilderFlag.Name),
 		Web3SignerConfig:           wsc,
 	})
 	if err != nil {
@@ -424,11 +439,19 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	return c.services.RegisterService(v)
 }
 
+// remoteKeysFileName is the default file, within the wallet directory, in which web3signer
+// public keys managed through the remote keys API are persisted.
+const remoteKeysFileName = "remote-keys.json"
+
 func web3SignerConfig(cliCtx *cli.Context) (*remote_web3signer.SetupConfig, error) {
 	var web3signerConfig *remote_web3signer.SetupConfig
-	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) && cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
+	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 		urlStr := cliCtx.String(flags.Web3SignerURLFlag.Name)
 		publicKeysStr := cliCtx.String(flags.Web3SignerPublicValidatorKeysFlag.Name)
+		keyFilePath := cliCtx.String(flags.Web3SignerKeyFileFlag.Name)
+		if keyFilePath == "" {
+			keyFilePath = filepath.Join(cliCtx.String(flags.WalletDirFlag.Name), remoteKeysFileName)
+		}
 		u, err := url.ParseRequestURI(urlStr)
 		if err != nil {
 			return nil, errors.Wrapf(err, "web3signer url %s is invalid", urlStr)
@@ -439,11 +462,12 @@ func web3SignerConfig(cliCtx *cli.Context) (*remote_web3signer.SetupConfig, erro
 		web3signerConfig = &remote_web3signer.SetupConfig{
 			BaseEndpoint:          u.String(),
 			GenesisValidatorsRoot: nil,
+			KeyFilePath:           keyFilePath,
 		}
 		pURL, err := url.ParseRequestURI(publicKeysStr)
 		if err == nil && pURL.Scheme != "" && pURL.Host != "" {
 			web3signerConfig.PublicKeysURL = publicKeysStr
-		} else {
+		} else if publicKeysStr != "" {
 			var validatorKeys [][48]byte
 			for _, key := range strings.Split(publicKeysStr, ",") {
 				decodedKey, decodeErr := hexutil.Decode(key)
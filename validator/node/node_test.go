@@ -96,6 +96,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 				BaseEndpoint:          "http://localhost:8545",
 				GenesisValidatorsRoot: nil,
 				PublicKeysURL:         "",
+				KeyFilePath:           remoteKeysFileName,
 				ProvidedPublicKeys: [][48]byte{
 					bytepubkey1,
 					bytepubkey2,
@@ -112,9 +113,22 @@ func TestWeb3SignerConfig(t *testing.T) {
 				BaseEndpoint:          "http://localhost:8545",
 				GenesisValidatorsRoot: nil,
 				PublicKeysURL:         "http://localhost:8545/api/v1/eth2/publicKeys",
+				KeyFilePath:           remoteKeysFileName,
 				ProvidedPublicKeys:    nil,
 			},
 		},
+		{
+			name: "happy path with keys from key file",
+			args: args{
+				baseURL:         "http://localhost:8545",
+				publicKeysOrURL: "",
+			},
+			want: &remote_web3signer.SetupConfig{
+				BaseEndpoint:          "http://localhost:8545",
+				GenesisValidatorsRoot: nil,
+				KeyFilePath:           remoteKeysFileName,
+			},
+		},
 		{
 			name: "Bad base URL",
 			args: args{
@@ -0,0 +1,135 @@
+// Package proposer parses the proposer settings file of the validator client, which assigns
+// a fee recipient and an optional gas limit to each validator public key, with a default for
+// all other keys.
+package proposer
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultGasLimit is the execution gas limit used for validators which have no gas limit configured.
+const DefaultGasLimit = uint64(30000000)
+
+// Option is the proposer configuration of a single validator. A zero GasLimit means the gas limit
+// is not set.
+type Option struct {
+	FeeRecipient common.Address
+	GasLimit     uint64
+}
+
+// Settings is a proposer settings container. ProposeConfig holds the options of specific
+// validators, while DefaultConfig applies to every validator which is not listed there.
+type Settings struct {
+	Hash          [32]byte
+	ProposeConfig map[[fieldparams.BLSPubkeyLength]byte]*Option
+	DefaultConfig *Option
+}
+
+// fileOption is the representation of an Option in the settings file.
+type fileOption struct {
+	FeeRecipient string `yaml:"fee_recipient"`
+	GasLimit     uint64 `yaml:"gas_limit,omitempty"`
+}
+
+// fileSettings is the representation of Settings in the settings file, keyed by hex encoded public key.
+type fileSettings struct {
+	ProposeConfig map[string]*fileOption `yaml:"proposer_config,omitempty"`
+	DefaultConfig *fileOption            `yaml:"default_config,omitempty"`
+}
+
+// ParseSettingsFile parses a proposer settings file and returns the settings struct. The file
+// may be written in YAML or JSON, for example:
+//
+//  {
+//    "proposer_config": {
+//      "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a": {
+//        "fee_recipient": "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"
+//      }
+//    },
+//    "default_config": {
+//      "fee_recipient": "0x6e35733c5af9B61374A128e6F85f553aF09ff89A",
+//      "gas_limit": 30000000
+//    }
+//  }
+func ParseSettingsFile(f string) (*Settings, error) {
+	enc, err := ioutil.ReadFile(f) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	fs := &fileSettings{}
+	if err := yaml.UnmarshalStrict(enc, fs); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer settings")
+	}
+	s := &Settings{
+		Hash:          hash.Hash(enc),
+		ProposeConfig: make(map[[fieldparams.BLSPubkeyLength]byte]*Option, len(fs.ProposeConfig)),
+	}
+	if fs.DefaultConfig != nil {
+		s.DefaultConfig, err = fs.DefaultConfig.toOption()
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid default_config")
+		}
+	}
+	for key, o := range fs.ProposeConfig {
+		pubKey, err := parsePubKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if o == nil {
+			return nil, fmt.Errorf("no options for public key %s", key)
+		}
+		s.ProposeConfig[pubKey], err = o.toOption()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid proposer_config for public key %s", key)
+		}
+	}
+	return s, nil
+}
+
+// FeeRecipient returns the fee recipient of the given validator, and false if neither the
+// validator nor the default config has one.
+func (s *Settings) FeeRecipient(pubKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool) {
+	if o, ok := s.ProposeConfig[pubKey]; ok {
+		return o.FeeRecipient, true
+	}
+	if s.DefaultConfig != nil {
+		return s.DefaultConfig.FeeRecipient, true
+	}
+	return common.Address{}, false
+}
+
+// GasLimit returns the gas limit of the given validator, falling back to the default config and
+// then to DefaultGasLimit.
+func (s *Settings) GasLimit(pubKey [fieldparams.BLSPubkeyLength]byte) uint64 {
+	if o, ok := s.ProposeConfig[pubKey]; ok && o.GasLimit != 0 {
+		return o.GasLimit
+	}
+	if s.DefaultConfig != nil && s.DefaultConfig.GasLimit != 0 {
+		return s.DefaultConfig.GasLimit
+	}
+	return DefaultGasLimit
+}
+
+func (o *fileOption) toOption() (*Option, error) {
+	if !common.IsHexAddress(o.FeeRecipient) {
+		return nil, fmt.Errorf("fee recipient %q is not a valid execution address", o.FeeRecipient)
+	}
+	return &Option{FeeRecipient: common.HexToAddress(o.FeeRecipient), GasLimit: o.GasLimit}, nil
+}
+
+func parsePubKey(key string) ([fieldparams.BLSPubkeyLength]byte, error) {
+	b, err := hexutil.Decode(key)
+	if err != nil || len(b) != fieldparams.BLSPubkeyLength {
+		return [fieldparams.BLSPubkeyLength]byte{}, fmt.Errorf("%q is not a valid validator public key", key)
+	}
+	return bytesutil.ToBytes48(b), nil
+}
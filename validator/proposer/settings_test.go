@@ -0,0 +1,134 @@
+package proposer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+const (
+	testPubKey       = "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+	testFeeRecipient = "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"
+	testDefault      = "0x6e35733c5af9B61374A128e6F85f553aF09ff89A"
+)
+
+func writeSettingsFile(t *testing.T, input []byte) string {
+	f := filepath.Join(t.TempDir(), "proposer-settings")
+	require.NoError(t, ioutil.WriteFile(f, input, os.ModePerm))
+	return f
+}
+
+func wantedSettings(t *testing.T, input []byte) *Settings {
+	pubKey, err := parsePubKey(testPubKey)
+	require.NoError(t, err)
+	return &Settings{
+		Hash: hash.Hash(input),
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*Option{
+			pubKey: {FeeRecipient: common.HexToAddress(testFeeRecipient), GasLimit: 35000000},
+		},
+		DefaultConfig: &Option{FeeRecipient: common.HexToAddress(testDefault)},
+	}
+}
+
+func TestParseSettingsFile_JSON(t *testing.T) {
+	input := []byte(`{
+  "proposer_config": {
+    "` + testPubKey + `": {
+      "fee_recipient": "` + testFeeRecipient + `",
+      "gas_limit": 35000000
+    }
+  },
+  "default_config": {
+    "fee_recipient": "` + testDefault + `"
+  }
+}`)
+	got, err := ParseSettingsFile(writeSettingsFile(t, input))
+	require.NoError(t, err)
+	require.DeepEqual(t, wantedSettings(t, input), got)
+}
+
+func TestParseSettingsFile_YAML(t *testing.T) {
+	input := []byte(`proposer_config:
+  "` + testPubKey + `":
+    fee_recipient: "` + testFeeRecipient + `"
+    gas_limit: 35000000
+default_config:
+  fee_recipient: "` + testDefault + `"
+`)
+	got, err := ParseSettingsFile(writeSettingsFile(t, input))
+	require.NoError(t, err)
+	require.DeepEqual(t, wantedSettings(t, input), got)
+}
+
+func TestParseSettingsFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "unknown field",
+			input:   `default_config: {fee_recipient: "` + testDefault + `", graffiti: "foo"}`,
+			wantErr: "could not unmarshal proposer settings",
+		},
+		{
+			name:    "bad default fee recipient",
+			input:   `default_config: {fee_recipient: "0x1234"}`,
+			wantErr: "invalid default_config",
+		},
+		{
+			name:    "bad public key",
+			input:   `proposer_config: {"0x1234": {fee_recipient: "` + testFeeRecipient + `"}}`,
+			wantErr: "is not a valid validator public key",
+		},
+		{
+			name:    "bad fee recipient",
+			input:   `proposer_config: {"` + testPubKey + `": {fee_recipient: "foo"}}`,
+			wantErr: "invalid proposer_config for public key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSettingsFile(writeSettingsFile(t, []byte(tt.input)))
+			require.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSettings_FeeRecipient(t *testing.T) {
+	s := wantedSettings(t, nil)
+	pubKey, err := parsePubKey(testPubKey)
+	require.NoError(t, err)
+	other := bytesutil.ToBytes48([]byte("other"))
+
+	got, ok := s.FeeRecipient(pubKey)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, common.HexToAddress(testFeeRecipient), got)
+	got, ok = s.FeeRecipient(other)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, common.HexToAddress(testDefault), got)
+
+	s.DefaultConfig = nil
+	_, ok = s.FeeRecipient(other)
+	assert.Equal(t, false, ok)
+}
+
+func TestSettings_GasLimit(t *testing.T) {
+	s := wantedSettings(t, nil)
+	pubKey, err := parsePubKey(testPubKey)
+	require.NoError(t, err)
+	other := bytesutil.ToBytes48([]byte("other"))
+
+	assert.Equal(t, uint64(35000000), s.GasLimit(pubKey))
+	assert.Equal(t, DefaultGasLimit, s.GasLimit(other))
+	s.DefaultConfig.GasLimit = 40000000
+	assert.Equal(t, uint64(40000000), s.GasLimit(other))
+}
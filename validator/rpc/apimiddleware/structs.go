@@ -32,3 +32,60 @@ type deleteKeystoresResponseJson struct {
 	Statuses           []*statusJson `json:"data"`
 	SlashingProtection string        `json:"slashing_protection"`
 }
+
+type listRemoteKeysResponseJson struct {
+	Keystores []*remoteKeysListJson `json:"data"`
+}
+
+type remoteKeysListJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	Url      string `json:"url"`
+	Readonly bool   `json:"readonly"`
+}
+
+type remoteKeysJson struct {
+	Pubkey string `json:"pubkey" hex:"true"`
+	Url    string `json:"url"`
+}
+
+type importRemoteKeysRequestJson struct {
+	Keystores []*remoteKeysJson `json:"remote_keys"`
+}
+
+type importRemoteKeysResponseJson struct {
+	Statuses []*statusJson `json:"data"`
+}
+
+type deleteRemoteKeysRequestJson struct {
+	PublicKeys []string `json:"pubkeys" hex:"true"`
+}
+
+type deleteRemoteKeysResponseJson struct {
+	Statuses []*statusJson `json:"data"`
+}
+
+type feeRecipientJson struct {
+	Pubkey     string `json:"pubkey" hex:"true"`
+	Ethaddress string `json:"ethaddress" hex:"true"`
+}
+
+type getFeeRecipientByPubkeyResponseJson struct {
+	Data *feeRecipientJson `json:"data"`
+}
+
+type setFeeRecipientByPubkeyRequestJson struct {
+	Ethaddress string `json:"ethaddress" hex:"true"`
+}
+
+type gasLimitJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	GasLimit string `json:"gas_limit"`
+}
+
+type getGasLimitResponseJson struct {
+	Data *gasLimitJson `json:"data"`
+}
+
+type setGasLimitRequestJson struct {
+	GasLimit string `json:"gas_limit"`
+}
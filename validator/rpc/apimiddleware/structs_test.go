@@ -159,3 +159,165 @@ func areJsonPropertyNamesEqual(internal, proto interface{}) (bool, error) {
 	}
 	return true, nil
 }
+
+func TestListRemoteKeys_JSONisEqual(t *testing.T) {
+	middlewareResponse := &listRemoteKeysResponseJson{
+		Keystores: []*remoteKeysListJson{
+			&remoteKeysListJson{
+				Pubkey:   "0x0",
+				Url:      "http://localhost:8080",
+				Readonly: true,
+			},
+		},
+	}
+
+	protoResponse := &service.ListRemoteKeysResponse{
+		Data: []*service.ListRemoteKeysResponse_Keystore{
+			&service.ListRemoteKeysResponse_Keystore{
+				Pubkey:   make([]byte, fieldparams.BLSPubkeyLength),
+				Url:      "http://localhost:8080",
+				Readonly: true,
+			},
+		},
+	}
+
+	listResp, err := areJsonPropertyNamesEqual(middlewareResponse, protoResponse)
+	require.NoError(t, err)
+	require.Equal(t, listResp, true)
+
+	resp, err := areJsonPropertyNamesEqual(middlewareResponse.Keystores[0], protoResponse.Data[0])
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+}
+
+func TestImportRemoteKeys_JSONisEqual(t *testing.T) {
+	importKeystoresRequest := &importRemoteKeysRequestJson{
+		Keystores: []*remoteKeysJson{
+			&remoteKeysJson{
+				Pubkey: "0x0",
+				Url:    "http://localhost:8080",
+			},
+		},
+	}
+
+	protoImportRequest := &service.ImportRemoteKeysRequest{
+		RemoteKeys: []*service.ImportRemoteKeysRequest_Keystore{
+			&service.ImportRemoteKeysRequest_Keystore{
+				Pubkey: make([]byte, fieldparams.BLSPubkeyLength),
+				Url:    "http://localhost:8080",
+			},
+		},
+	}
+
+	requestResp, err := areJsonPropertyNamesEqual(importKeystoresRequest, protoImportRequest)
+	require.NoError(t, err)
+	require.Equal(t, requestResp, true)
+
+	keystoreResp, err := areJsonPropertyNamesEqual(importKeystoresRequest.Keystores[0], protoImportRequest.RemoteKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, keystoreResp, true)
+
+	importKeystoresResponse := &importRemoteKeysResponseJson{
+		Statuses: []*statusJson{
+			&statusJson{
+				Status:  "Error",
+				Message: "a",
+			},
+		},
+	}
+
+	protoImportKeystoresResponse := &service.ImportRemoteKeysResponse{
+		Data: []*service.ImportedRemoteKeysStatus{
+			&service.ImportedRemoteKeysStatus{
+				Status:  service.ImportedRemoteKeysStatus_ERROR,
+				Message: "a",
+			},
+		},
+	}
+
+	responseResp, err := areJsonPropertyNamesEqual(importKeystoresResponse, protoImportKeystoresResponse)
+	require.NoError(t, err)
+	require.Equal(t, responseResp, true)
+}
+
+func TestDeleteRemoteKeys_JSONisEqual(t *testing.T) {
+	deleteKeystoresRequest := &deleteRemoteKeysRequestJson{}
+
+	protoDeleteRequest := &service.DeleteRemoteKeysRequest{
+		Pubkeys: [][]byte{[]byte{}},
+	}
+
+	requestResp, err := areJsonPropertyNamesEqual(deleteKeystoresRequest, protoDeleteRequest)
+	require.NoError(t, err)
+	require.Equal(t, requestResp, true)
+
+	deleteKeystoresResponse := &deleteRemoteKeysResponseJson{
+		Statuses: []*statusJson{
+			&statusJson{
+				Status:  "Error",
+				Message: "a",
+			},
+		},
+	}
+
+	protoDeleteResponse := &service.DeleteRemoteKeysResponse{
+		Data: []*service.DeletedRemoteKeysStatus{
+			&service.DeletedRemoteKeysStatus{
+				Status:  service.DeletedRemoteKeysStatus_ERROR,
+				Message: "a",
+			},
+		},
+	}
+
+	responseResp, err := areJsonPropertyNamesEqual(deleteKeystoresResponse, protoDeleteResponse)
+	require.NoError(t, err)
+	require.Equal(t, responseResp, true)
+}
+
+func TestGetFeeRecipientByPubkey_JSONisEqual(t *testing.T) {
+	middlewareResponse := &getFeeRecipientByPubkeyResponseJson{
+		Data: &feeRecipientJson{
+			Pubkey:     "0x0",
+			Ethaddress: "0x1",
+		},
+	}
+
+	protoResponse := &service.GetFeeRecipientByPubkeyResponse{
+		Data: &service.GetFeeRecipientByPubkeyResponse_FeeRecipient{
+			Pubkey:     make([]byte, fieldparams.BLSPubkeyLength),
+			Ethaddress: make([]byte, fieldparams.FeeRecipientLength),
+		},
+	}
+
+	resp, err := areJsonPropertyNamesEqual(middlewareResponse, protoResponse)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+
+	dataResp, err := areJsonPropertyNamesEqual(middlewareResponse.Data, protoResponse.Data)
+	require.NoError(t, err)
+	require.Equal(t, dataResp, true)
+}
+
+func TestGetGasLimit_JSONisEqual(t *testing.T) {
+	middlewareResponse := &getGasLimitResponseJson{
+		Data: &gasLimitJson{
+			Pubkey:   "0x0",
+			GasLimit: "30000000",
+		},
+	}
+
+	protoResponse := &service.GetGasLimitResponse{
+		Data: &service.GetGasLimitResponse_GasLimit{
+			Pubkey:   make([]byte, fieldparams.BLSPubkeyLength),
+			GasLimit: 30000000,
+		},
+	}
+
+	resp, err := areJsonPropertyNamesEqual(middlewareResponse, protoResponse)
+	require.NoError(t, err)
+	require.Equal(t, resp, true)
+
+	dataResp, err := areJsonPropertyNamesEqual(middlewareResponse.Data, protoResponse.Data)
+	require.NoError(t, err)
+	require.Equal(t, dataResp, true)
+}
@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/protobuf/ptypes/empty"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
 	"google.golang.org/grpc/codes"
@@ -256,3 +258,273 @@ func (s *Server) slashingProtectionHistoryForDeletedKeys(
 	}
 	return slashingprotection.ExportStandardProtectionJSON(ctx, s.valDB, filteredKeys...)
 }
+
+// ListRemoteKeys returns a list of all public keys defined for web3signer keymanager type.
+func (s *Server) ListRemoteKeys(ctx context.Context, _ *empty.Empty) (*ethpbservice.ListRemoteKeysResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Prysm Wallet not initialized. Please create a new wallet.")
+	}
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready. Please try again once validator is ready.")
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+		return nil, status.Errorf(codes.FailedPrecondition, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.")
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get Prysm keymanager: %v", err)
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve public keys: %v", err)
+	}
+	var url string
+	var readOnly bool
+	if cfg := s.validatorService.Web3SignerConfig(); cfg != nil {
+		url = cfg.BaseEndpoint
+		// Keys fetched from a public keys URL cannot be changed through the API.
+		readOnly = cfg.PublicKeysURL != ""
+	}
+	keysResponse := make([]*ethpbservice.ListRemoteKeysResponse_Keystore, len(pubKeys))
+	for i := 0; i < len(pubKeys); i++ {
+		keysResponse[i] = &ethpbservice.ListRemoteKeysResponse_Keystore{
+			Pubkey:   pubKeys[i][:],
+			Url:      url,
+			Readonly: readOnly,
+		}
+	}
+	return &ethpbservice.ListRemoteKeysResponse{
+		Data: keysResponse,
+	}, nil
+}
+
+// ImportRemoteKeys imports a list of public keys defined for web3signer keymanager type.
+func (s *Server) ImportRemoteKeys(ctx context.Context, req *ethpbservice.ImportRemoteKeysRequest) (*ethpbservice.ImportRemoteKeysResponse, error) {
+	if !s.walletInitialized {
+		statuses := groupImportRemoteKeysErrors(req, "Prysm Wallet not initialized. Please create a new wallet.")
+		return &ethpbservice.ImportRemoteKeysResponse{Data: statuses}, nil
+	}
+	if s.validatorService == nil {
+		statuses := groupImportRemoteKeysErrors(req, "Validator service not ready. Please try again once validator is ready.")
+		return &ethpbservice.ImportRemoteKeysResponse{Data: statuses}, nil
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get keymanager: %v", err)
+	}
+	adder, ok := km.(keymanager.PublicKeyAdder)
+	if !ok {
+		statuses := groupImportRemoteKeysErrors(req, "Keymanager kind cannot import public keys for web3signer keymanager type.")
+		return &ethpbservice.ImportRemoteKeysResponse{Data: statuses}, nil
+	}
+	var url string
+	if cfg := s.validatorService.Web3SignerConfig(); cfg != nil {
+		url = cfg.BaseEndpoint
+	}
+
+	// Keys failing validation keep their error status, the rest are imported together.
+	statuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(req.RemoteKeys))
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	var pubKeyIndices []int
+	for i, remoteKey := range req.RemoteKeys {
+		if len(remoteKey.Pubkey) != fieldparams.BLSPubkeyLength {
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_ERROR,
+				Message: fmt.Sprintf("Invalid public key length %d", len(remoteKey.Pubkey)),
+			}
+			continue
+		}
+		if remoteKey.Url != "" && remoteKey.Url != url {
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_ERROR,
+				Message: fmt.Sprintf("Only the configured web3signer url %s is supported", url),
+			}
+			continue
+		}
+		pubKeys = append(pubKeys, bytesutil.ToBytes48(remoteKey.Pubkey))
+		pubKeyIndices = append(pubKeyIndices, i)
+	}
+	if len(pubKeys) > 0 {
+		imported, err := adder.AddPublicKeys(ctx, pubKeys)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not import public keys: %v", err)
+		}
+		for i, st := range imported {
+			statuses[pubKeyIndices[i]] = st
+		}
+	}
+	return &ethpbservice.ImportRemoteKeysResponse{Data: statuses}, nil
+}
+
+func groupImportRemoteKeysErrors(req *ethpbservice.ImportRemoteKeysRequest, errorMessage string) []*ethpbservice.ImportedRemoteKeysStatus {
+	statuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(req.RemoteKeys))
+	for i := 0; i < len(req.RemoteKeys); i++ {
+		statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+			Status:  ethpbservice.ImportedRemoteKeysStatus_ERROR,
+			Message: errorMessage,
+		}
+	}
+	return statuses
+}
+
+// DeleteRemoteKeys deletes a list of public keys defined for web3signer keymanager type.
+func (s *Server) DeleteRemoteKeys(ctx context.Context, req *ethpbservice.DeleteRemoteKeysRequest) (*ethpbservice.DeleteRemoteKeysResponse, error) {
+	if !s.walletInitialized {
+		statuses := groupDeleteRemoteKeysErrors(req, "Prysm Wallet not initialized. Please create a new wallet.")
+		return &ethpbservice.DeleteRemoteKeysResponse{Data: statuses}, nil
+	}
+	if s.validatorService == nil {
+		statuses := groupDeleteRemoteKeysErrors(req, "Validator service not ready. Please try again once validator is ready.")
+		return &ethpbservice.DeleteRemoteKeysResponse{Data: statuses}, nil
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get keymanager: %v", err)
+	}
+	deleter, ok := km.(keymanager.PublicKeyDeleter)
+	if !ok {
+		statuses := groupDeleteRemoteKeysErrors(req, "Keymanager kind cannot delete public keys for web3signer keymanager type.")
+		return &ethpbservice.DeleteRemoteKeysResponse{Data: statuses}, nil
+	}
+
+	statuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(req.Pubkeys))
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	var pubKeyIndices []int
+	for i, pubKey := range req.Pubkeys {
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
+				Status:  ethpbservice.DeletedRemoteKeysStatus_ERROR,
+				Message: fmt.Sprintf("Invalid public key length %d", len(pubKey)),
+			}
+			continue
+		}
+		pubKeys = append(pubKeys, bytesutil.ToBytes48(pubKey))
+		pubKeyIndices = append(pubKeyIndices, i)
+	}
+	if len(pubKeys) > 0 {
+		deleted, err := deleter.DeletePublicKeys(ctx, pubKeys)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not delete public keys: %v", err)
+		}
+		for i, st := range deleted {
+			statuses[pubKeyIndices[i]] = st
+		}
+	}
+	return &ethpbservice.DeleteRemoteKeysResponse{Data: statuses}, nil
+}
+
+func groupDeleteRemoteKeysErrors(req *ethpbservice.DeleteRemoteKeysRequest, errorMessage string) []*ethpbservice.DeletedRemoteKeysStatus {
+	statuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(req.Pubkeys))
+	for i := 0; i < len(req.Pubkeys); i++ {
+		statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
+			Status:  ethpbservice.DeletedRemoteKeysStatus_ERROR,
+			Message: errorMessage,
+		}
+	}
+	return statuses
+}
+
+// ListFeeRecipientByPubkey returns the fee recipient of a validator public key. A fee recipient stored in the
+// validator database takes precedence over the one in the proposer settings file.
+func (s *Server) ListFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetFeeRecipientByPubkeyResponse, error) {
+	if len(req.Pubkey) != fieldparams.BLSPubkeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.Pubkey))
+	}
+	pubKey := bytesutil.ToBytes48(req.Pubkey)
+	feeRecipient, ok, err := s.valDB.FeeRecipientByPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get fee recipient: %v", err)
+	}
+	if !ok && s.validatorService != nil {
+		if settings := s.validatorService.ProposerSettings(); settings != nil {
+			feeRecipient, ok = settings.FeeRecipient(pubKey)
+		}
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "No fee recipient set for public key %#x", req.Pubkey)
+	}
+	return &ethpbservice.GetFeeRecipientByPubkeyResponse{
+		Data: &ethpbservice.GetFeeRecipientByPubkeyResponse_FeeRecipient{
+			Pubkey:     req.Pubkey,
+			Ethaddress: feeRecipient.Bytes(),
+		},
+	}, nil
+}
+
+// SetFeeRecipientByPubkey stores the fee recipient of a validator public key in the validator database.
+// The beacon node is informed of the new fee recipient at the start of the next epoch.
+func (s *Server) SetFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.SetFeeRecipientByPubkeyRequest) (*empty.Empty, error) {
+	if len(req.Pubkey) != fieldparams.BLSPubkeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.Pubkey))
+	}
+	if len(req.Ethaddress) != fieldparams.FeeRecipientLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid fee recipient length %d", len(req.Ethaddress))
+	}
+	if err := s.valDB.SaveFeeRecipientByPubKey(ctx, bytesutil.ToBytes48(req.Pubkey), common.BytesToAddress(req.Ethaddress)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save fee recipient: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteFeeRecipientByPubkey removes the fee recipient of a validator public key from the validator database.
+func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservice.PubkeyRequest) (*empty.Empty, error) {
+	if len(req.Pubkey) != fieldparams.BLSPubkeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.Pubkey))
+	}
+	if err := s.valDB.DeleteFeeRecipientByPubKey(ctx, bytesutil.ToBytes48(req.Pubkey)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete fee recipient: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// GetGasLimit returns the execution gas limit of a validator public key. A gas limit stored in the validator
+// database takes precedence over the one in the proposer settings file, which defaults to proposer.DefaultGasLimit.
+func (s *Server) GetGasLimit(ctx context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGasLimitResponse, error) {
+	if len(req.Pubkey) != fieldparams.BLSPubkeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.Pubkey))
+	}
+	pubKey := bytesutil.ToBytes48(req.Pubkey)
+	gasLimit, ok, err := s.valDB.GasLimitByPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get gas limit: %v", err)
+	}
+	if !ok {
+		gasLimit = proposer.DefaultGasLimit
+		if s.validatorService != nil {
+			if settings := s.validatorService.ProposerSettings(); settings != nil {
+				gasLimit = settings.GasLimit(pubKey)
+			}
+		}
+	}
+	return &ethpbservice.GetGasLimitResponse{
+		Data: &ethpbservice.GetGasLimitResponse_GasLimit{
+			Pubkey:   req.Pubkey,
+			GasLimit: gasLimit,
+		},
+	}, nil
+}
+
+// SetGasLimit stores the execution gas limit of a validator public key in the validator database.
+func (s *Server) SetGasLimit(ctx context.Context, req *ethpbservice.SetGasLimitRequest) (*empty.Empty, error) {
+	if len(req.Pubkey) != fieldparams.BLSPubkeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.Pubkey))
+	}
+	if req.GasLimit == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Gas limit must be greater than zero")
+	}
+	if err := s.valDB.SaveGasLimitByPubKey(ctx, bytesutil.ToBytes48(req.Pubkey), req.GasLimit); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save gas limit: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteGasLimit removes the execution gas limit of a validator public key from the validator database.
+func (s *Server) DeleteGasLimit(ctx context.Context, req *ethpbservice.PubkeyRequest) (*empty.Empty, error) {
+	if len(req.Pubkey) != fieldparams.BLSPubkeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.Pubkey))
+	}
+	if err := s.valDB.DeleteGasLimitByPubKey(ctx, bytesutil.ToBytes48(req.Pubkey)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete gas limit: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
@@ -24,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	remote_web3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
 	mocks "github.com/prysmaticlabs/prysm/validator/testing"
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
@@ -526,3 +529,183 @@ func createRandomKeystore(t testing.TB, password string) *keymanager.Keystore {
 		Name:    encryptor.Name(),
 	}
 }
+
+func TestServer_ListRemoteKeys(t *testing.T) {
+	t.Run("wallet not ready", func(t *testing.T) {
+		s := Server{}
+		_, err := s.ListRemoteKeys(context.Background(), &empty.Empty{})
+		require.ErrorContains(t, "Prysm Wallet not initialized. Please create a new wallet.", err)
+	})
+	ctx := context.Background()
+	pubKey := bytesutil.ToBytes48([]byte("a"))
+	s := setupServerWithWeb3Signer(t, &remote_web3signer.SetupConfig{
+		BaseEndpoint:       "http://example.com",
+		ProvidedPublicKeys: [][48]byte{pubKey},
+		KeyFilePath:        filepath.Join(t.TempDir(), "remote-keys.json"),
+	})
+	resp, err := s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	require.DeepEqual(t, pubKey[:], resp.Data[0].Pubkey)
+	require.Equal(t, "http://example.com", resp.Data[0].Url)
+	require.Equal(t, false, resp.Data[0].Readonly)
+}
+
+func TestServer_ImportDeleteRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+	s := setupServerWithWeb3Signer(t, &remote_web3signer.SetupConfig{
+		BaseEndpoint: "http://example.com",
+		KeyFilePath:  filepath.Join(t.TempDir(), "remote-keys.json"),
+	})
+	pubKey := bytesutil.ToBytes48([]byte("a"))
+
+	importResp, err := s.ImportRemoteKeys(ctx, &ethpbservice.ImportRemoteKeysRequest{
+		RemoteKeys: []*ethpbservice.ImportRemoteKeysRequest_Keystore{
+			{Pubkey: pubKey[:], Url: "http://example.com"},
+			{Pubkey: pubKey[:]},
+			{Pubkey: []byte("short")},
+			{Pubkey: pubKey[:], Url: "http://other.com"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(importResp.Data))
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, importResp.Data[0].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_DUPLICATE, importResp.Data[1].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_ERROR, importResp.Data[2].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_ERROR, importResp.Data[3].Status)
+
+	listResp, err := s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Data))
+
+	deleteResp, err := s.DeleteRemoteKeys(ctx, &ethpbservice.DeleteRemoteKeysRequest{
+		Pubkeys: [][]byte{pubKey[:], make([]byte, fieldparams.BLSPubkeyLength)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deleteResp.Data))
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, deleteResp.Data[0].Status)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, deleteResp.Data[1].Status)
+
+	listResp, err = s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(listResp.Data))
+}
+
+func TestServer_ImportRemoteKeys_WrongKeymanagerKind(t *testing.T) {
+	s := &Server{walletInitialized: true}
+	_, err := s.ListRemoteKeys(context.Background(), &empty.Empty{})
+	require.ErrorContains(t, "Validator service not ready", err)
+	resp, err := s.ImportRemoteKeys(context.Background(), &ethpbservice.ImportRemoteKeysRequest{
+		RemoteKeys: []*ethpbservice.ImportRemoteKeysRequest_Keystore{{Pubkey: make([]byte, fieldparams.BLSPubkeyLength)}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_ERROR, resp.Data[0].Status)
+}
+
+func setupServerWithWeb3Signer(t testing.TB, cfg *remote_web3signer.SetupConfig) *Server {
+	ctx := context.Background()
+	w := wallet.NewWalletForWeb3Signer()
+	root := make([]byte, fieldparams.RootLength)
+	root[0] = 1
+	cfg.GenesisValidatorsRoot = root
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false, Web3SignerConfig: cfg})
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet: w,
+		Validator: &mock.MockValidator{
+			Km: km,
+		},
+		Web3SignerConfig: cfg,
+	})
+	require.NoError(t, err)
+	return &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}
+}
+
+func TestServer_FeeRecipientByPubkey(t *testing.T) {
+	ctx := context.Background()
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, validatorDB.Close())
+	})
+	pubKey := bytesutil.ToBytes48([]byte("a"))
+	defaultRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: &mock.MockValidator{
+			ProposerSettingsRet: &proposer.Settings{
+				DefaultConfig: &proposer.Option{FeeRecipient: defaultRecipient},
+			},
+		},
+	})
+	require.NoError(t, err)
+	s := &Server{valDB: validatorDB, validatorService: vs}
+
+	_, err = s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: []byte("short")})
+	require.ErrorContains(t, "Invalid public key length", err)
+
+	// The proposer settings file is used when the database has no fee recipient for the key.
+	resp, err := s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	require.DeepEqual(t, defaultRecipient.Bytes(), resp.Data.Ethaddress)
+
+	feeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	_, err = s.SetFeeRecipientByPubkey(ctx, &ethpbservice.SetFeeRecipientByPubkeyRequest{
+		Pubkey:     pubKey[:],
+		Ethaddress: []byte("short"),
+	})
+	require.ErrorContains(t, "Invalid fee recipient length", err)
+	_, err = s.SetFeeRecipientByPubkey(ctx, &ethpbservice.SetFeeRecipientByPubkeyRequest{
+		Pubkey:     pubKey[:],
+		Ethaddress: feeRecipient.Bytes(),
+	})
+	require.NoError(t, err)
+	resp, err = s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	require.DeepEqual(t, pubKey[:], resp.Data.Pubkey)
+	require.DeepEqual(t, feeRecipient.Bytes(), resp.Data.Ethaddress)
+
+	_, err = s.DeleteFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	resp, err = s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	require.DeepEqual(t, defaultRecipient.Bytes(), resp.Data.Ethaddress)
+
+	// Without proposer settings there is no fee recipient to return.
+	s.validatorService = nil
+	_, err = s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.ErrorContains(t, "No fee recipient set for public key", err)
+}
+
+func TestServer_GasLimit(t *testing.T) {
+	ctx := context.Background()
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, validatorDB.Close())
+	})
+	pubKey := bytesutil.ToBytes48([]byte("a"))
+	s := &Server{valDB: validatorDB}
+
+	resp, err := s.GetGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	require.Equal(t, proposer.DefaultGasLimit, resp.Data.GasLimit)
+
+	_, err = s.SetGasLimit(ctx, &ethpbservice.SetGasLimitRequest{Pubkey: pubKey[:]})
+	require.ErrorContains(t, "Gas limit must be greater than zero", err)
+	_, err = s.SetGasLimit(ctx, &ethpbservice.SetGasLimitRequest{Pubkey: pubKey[:], GasLimit: 35000000})
+	require.NoError(t, err)
+	resp, err = s.GetGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(35000000), resp.Data.GasLimit)
+
+	_, err = s.DeleteGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	resp, err = s.GetGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	require.NoError(t, err)
+	require.Equal(t, proposer.DefaultGasLimit, resp.Data.GasLimit)
+}